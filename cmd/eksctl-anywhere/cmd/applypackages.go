@@ -63,6 +63,10 @@ func applyPackages(ctx context.Context) error {
 		deps.Kubectl,
 	)
 
+	if err = validatePackagesFile(ctx, apo.fileName, kubeConfig, deps.Kubectl); err != nil {
+		return err
+	}
+
 	curatedpackages.PrintLicense()
 	err = packages.ApplyPackages(ctx, apo.fileName, kubeConfig)
 	if err != nil {
@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"context"
+	"crypto/x509"
 	"fmt"
 	"log"
 
@@ -10,13 +11,16 @@ import (
 	"github.com/spf13/viper"
 
 	"github.com/aws/eks-anywhere/cmd/eksctl-anywhere/cmd/internal/commands/artifacts"
+	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
 	"github.com/aws/eks-anywhere/pkg/logger"
+	"github.com/aws/eks-anywhere/pkg/registry"
 	"github.com/aws/eks-anywhere/pkg/registrymirror"
 	"github.com/aws/eks-anywhere/pkg/version"
 )
 
 type checkImagesOptions struct {
-	fileName string
+	fileName   string
+	signatures signatureOptions
 }
 
 var cio = &checkImagesOptions{}
@@ -28,6 +32,7 @@ func init() {
 	if err != nil {
 		log.Fatalf("Error marking filename flag as required: %v", err)
 	}
+	applySignatureFlags(checkImagesCommand.Flags(), &cio.signatures)
 }
 
 var checkImagesCommand = &cobra.Command{
@@ -44,11 +49,11 @@ var checkImagesCommand = &cobra.Command{
 	},
 	SilenceUsage: true,
 	RunE: func(cmd *cobra.Command, args []string) error {
-		return checkImages(cmd.Context(), cio.fileName)
+		return checkImages(cmd.Context(), cio.fileName, cio.signatures)
 	},
 }
 
-func checkImages(context context.Context, spec string) error {
+func checkImages(context context.Context, spec string, signatures signatureOptions) error {
 	images, err := getImages(spec)
 	if err != nil {
 		return err
@@ -59,11 +64,24 @@ func checkImages(context context.Context, spec string) error {
 		return err
 	}
 
+	signatureVerifier, err := signatures.signatureVerifier()
+	if err != nil {
+		return err
+	}
+	imageSignatures, err := newImageSignatureChecker(clusterSpec.Cluster.Spec.RegistryMirrorConfiguration, signatureVerifier)
+	if err != nil {
+		return err
+	}
+
 	checkImageExistence := artifacts.CheckImageExistence{}
 	for _, image := range images {
 		myImageURI := registrymirror.FromCluster(clusterSpec.Cluster).ReplaceRegistry(image.URI)
 		checkImageExistence.ImageUri = myImageURI
-		if err = checkImageExistence.Run(context); err != nil {
+		err = checkImageExistence.Run(context)
+		if err == nil && imageSignatures != nil {
+			err = imageSignatures.check(context, myImageURI)
+		}
+		if err != nil {
 			fmt.Println(err.Error())
 			logger.MarkFail(myImageURI)
 		} else {
@@ -73,3 +91,53 @@ func checkImages(context context.Context, spec string) error {
 
 	return nil
 }
+
+// imageSignatureChecker checks image signatures in the registries they are pulled from.
+type imageSignatureChecker struct {
+	cache           *registry.Cache
+	credentialStore *registry.CredentialStore
+	certificates    *x509.CertPool
+	insecure        bool
+	verifier        *registry.SignatureVerifier
+}
+
+// newImageSignatureChecker returns nil if signatures aren't verified.
+func newImageSignatureChecker(mirrorConfig *v1alpha1.RegistryMirrorConfiguration, verifier *registry.SignatureVerifier) (*imageSignatureChecker, error) {
+	if verifier == nil {
+		return nil, nil
+	}
+	credentialStore := registry.NewCredentialStore()
+	if err := credentialStore.Init(); err != nil {
+		return nil, err
+	}
+	c := &imageSignatureChecker{
+		cache:           registry.NewCache(),
+		credentialStore: credentialStore,
+		verifier:        verifier,
+	}
+	if mirrorConfig != nil {
+		c.insecure = mirrorConfig.InsecureSkipVerify
+		if mirrorConfig.CACertContent != "" {
+			c.certificates = x509.NewCertPool()
+			c.certificates.AppendCertsFromPEM([]byte(mirrorConfig.CACertContent))
+		}
+	}
+	return c, nil
+}
+
+func (c *imageSignatureChecker) check(ctx context.Context, image string) error {
+	artifact := registry.NewArtifactFromURI(image)
+	client, err := c.cache.Get(registry.NewStorageContext(artifact.Registry, c.credentialStore, c.certificates, c.insecure))
+	if err != nil {
+		return fmt.Errorf("error with repository %s: %v", artifact.Registry, err)
+	}
+	storage, err := client.GetStorage(ctx, artifact)
+	if err != nil {
+		return fmt.Errorf("repository source: %v", err)
+	}
+	reference := artifact.Digest
+	if reference == "" {
+		reference = artifact.Tag
+	}
+	return c.verifier.Check(ctx, storage, image, reference)
+}
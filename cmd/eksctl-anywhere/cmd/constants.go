@@ -3,6 +3,10 @@ package cmd
 const (
 	imagesTarFile               = "images.tar"
 	eksaToolsImageTarFile       = "tools-image.tar"
+	ociLayoutFolder             = "oci-layout"
+	imageBackendFlag            = "image-backend"
+	dockerImageBackend          = "docker"
+	ociLayoutImageBackend       = "oci-layout"
 	cpWaitTimeoutFlag           = "control-plane-wait-timeout"
 	externalEtcdWaitTimeoutFlag = "external-etcd-wait-timeout"
 	perMachineWaitTimeoutFlag   = "per-machine-wait-timeout"
@@ -39,6 +39,7 @@ func init() {
 	copyPackagesCmd.Flags().StringVarP(&copyPackagesCommand.srcCert, "src-cert", "", "", "TLS certificate for source registry")
 	copyPackagesCmd.Flags().BoolVar(&copyPackagesCommand.insecure, "insecure", false, "Skip TLS verification while copying images and charts")
 	copyPackagesCmd.Flags().BoolVar(&copyPackagesCommand.dryRun, "dry-run", false, "Dry run copy to print images that would be copied")
+	applySignatureFlags(copyPackagesCmd.Flags(), &copyPackagesCommand.signatures)
 }
 
 var copyPackagesCommand = CopyPackagesCommand{}
@@ -51,7 +52,9 @@ type CopyPackagesCommand struct {
 	dstCert       string
 	insecure      bool
 	dryRun        bool
+	signatures    signatureOptions
 	registryCache *registry.Cache
+	verifier      *registry.SignatureVerifier
 }
 
 func runCopyPackages(_ *cobra.Command, args []string) error {
@@ -81,6 +84,10 @@ func (c CopyPackagesCommand) call(ctx context.Context, credentialStore *registry
 		return err
 	}
 
+	if c.verifier, err = c.signatures.signatureVerifier(); err != nil {
+		return err
+	}
+
 	c.registryCache = registry.NewCache()
 	bundleReader := curatedpackages.NewPackageReader(c.registryCache, credentialStore)
 
@@ -131,6 +138,12 @@ func (c CopyPackagesCommand) copyImages(ctx context.Context, dstRegistry registr
 			continue
 		}
 
+		if c.verifier != nil {
+			if err = c.verifySignature(ctx, srcRegistry, artifact); err != nil {
+				return err
+			}
+		}
+
 		err = registry.Copy(ctx, srcRegistry, dstRegistry, artifact)
 		if err != nil {
 			return err
@@ -138,3 +151,16 @@ func (c CopyPackagesCommand) copyImages(ctx context.Context, dstRegistry registr
 	}
 	return nil
 }
+
+// verifySignature checks the signature of artifact in its source registry before it's copied.
+func (c CopyPackagesCommand) verifySignature(ctx context.Context, srcRegistry registry.StorageClient, artifact registry.Artifact) error {
+	srcStorage, err := srcRegistry.GetStorage(ctx, artifact)
+	if err != nil {
+		return fmt.Errorf("repository source: %v", err)
+	}
+	reference := artifact.Digest
+	if reference == "" {
+		reference = artifact.Tag
+	}
+	return c.verifier.Check(ctx, srcStorage, artifact.VersionedImage(), reference)
+}
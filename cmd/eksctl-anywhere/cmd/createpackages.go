@@ -62,6 +62,10 @@ func createPackages(ctx context.Context) error {
 		deps.Kubectl,
 	)
 
+	if err = validatePackagesFile(ctx, cpo.fileName, kubeConfig, deps.Kubectl); err != nil {
+		return err
+	}
+
 	curatedpackages.PrintLicense()
 	err = packages.CreatePackages(ctx, cpo.fileName, kubeConfig)
 	if err != nil {
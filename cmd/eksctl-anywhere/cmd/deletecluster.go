@@ -96,6 +96,7 @@ func (dc *deleteClusterOptions) deleteCluster(ctx context.Context) error {
 		WithClusterManager(clusterSpec.Cluster).
 		WithProvider(dc.fileName, clusterSpec.Cluster, cc.skipIpCheck, dc.hardwareFileName, false, dc.tinkerbellBootstrapIP).
 		WithGitOpsFlux(clusterSpec.Cluster, clusterSpec.FluxConfig, cliConfig).
+		WithGitOpsArgoCD(clusterSpec.Cluster, clusterSpec.ArgoCDConfig, cliConfig).
 		WithWriter().
 		Build(ctx)
 	if err != nil {
@@ -107,7 +108,7 @@ func (dc *deleteClusterOptions) deleteCluster(ctx context.Context) error {
 		deps.Bootstrapper,
 		deps.Provider,
 		deps.ClusterManager,
-		deps.GitOpsFlux,
+		gitOpsManager(deps, clusterSpec),
 		deps.Writer,
 	)
 
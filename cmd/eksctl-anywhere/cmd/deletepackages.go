@@ -9,6 +9,7 @@ import (
 
 	"github.com/aws/eks-anywhere/pkg/curatedpackages"
 	"github.com/aws/eks-anywhere/pkg/kubeconfig"
+	"github.com/aws/eks-anywhere/pkg/logger"
 )
 
 type deletePackageOptions struct {
@@ -54,10 +55,27 @@ func deleteResources(ctx context.Context, args []string) error {
 	if err != nil {
 		return fmt.Errorf("unable to initialize executables: %v", err)
 	}
+	b := curatedpackages.NewBundleReader(kubeConfig, delPkgOpts.clusterName, deps.Kubectl, nil, nil)
+	dependencies, err := b.GetActiveBundleDependencies(ctx)
+	if err != nil {
+		logger.V(4).Info("Unable to read the active package bundle, skipping package dependencies validation", "error", err)
+	}
+
 	packages := curatedpackages.NewPackageClient(
 		deps.Kubectl,
+		curatedpackages.WithBundleDependencies(dependencies),
 	)
 
+	if dependencies != nil {
+		installed, err := packages.GetInstalledPackages(ctx, kubeConfig, delPkgOpts.clusterName)
+		if err != nil {
+			return err
+		}
+		if err = packages.ValidateDeletion(args, installed); err != nil {
+			return err
+		}
+	}
+
 	err = packages.DeletePackages(ctx, args, kubeConfig, delPkgOpts.clusterName)
 	if err != nil {
 		return err
@@ -17,11 +17,14 @@ import (
 	"github.com/spf13/viper"
 	"sigs.k8s.io/yaml"
 
+	"github.com/aws/eks-anywhere/cmd/eksctl-anywhere/cmd/internal/commands/artifacts"
 	"github.com/aws/eks-anywhere/pkg/dependencies"
 	"github.com/aws/eks-anywhere/pkg/files"
 	"github.com/aws/eks-anywhere/pkg/logger"
+	"github.com/aws/eks-anywhere/pkg/manifests/bundles"
 	"github.com/aws/eks-anywhere/pkg/manifests/releases"
 	"github.com/aws/eks-anywhere/pkg/version"
+	releasev1 "github.com/aws/eks-anywhere/release/api/v1alpha1"
 )
 
 type downloadArtifactsOptions struct {
@@ -29,6 +32,7 @@ type downloadArtifactsOptions struct {
 	fileName    string
 	dryRun      bool
 	retainDir   bool
+	since       string
 }
 
 var downloadArtifactsopts = &downloadArtifactsOptions{}
@@ -39,6 +43,7 @@ func init() {
 	downloadArtifactsCmd.Flags().StringVarP(&downloadArtifactsopts.downloadDir, "download-dir", "d", "eks-anywhere-downloads", "Directory to download the artifacts to")
 	downloadArtifactsCmd.Flags().BoolVarP(&downloadArtifactsopts.dryRun, "dry-run", "", false, "Print the manifest URIs without downloading them")
 	downloadArtifactsCmd.Flags().BoolVarP(&downloadArtifactsopts.retainDir, "retain-dir", "r", false, "Do not delete the download folder after creating a tarball")
+	downloadArtifactsCmd.Flags().StringVar(&downloadArtifactsopts.since, "since", "", "Bundles file of a previous download, manifests not changed since are reused from the download directory instead of downloaded again")
 }
 
 var downloadArtifactsCmd = &cobra.Command{
@@ -67,6 +72,15 @@ func downloadArtifacts(context context.Context, opts *downloadArtifactsOptions)
 
 	reader := deps.FileReader
 
+	var sinceManifests map[string]struct{}
+	if opts.since != "" {
+		sinceBundles, err := bundles.Read(reader, opts.since)
+		if err != nil {
+			return err
+		}
+		sinceManifests = bundleManifests(sinceBundles)
+	}
+
 	bundles, err := deps.ManifestReader.ReadBundlesForVersion(version.Get().GitVersion)
 	if err != nil {
 		return err
@@ -75,26 +89,41 @@ func downloadArtifacts(context context.Context, opts *downloadArtifactsOptions)
 	// download the eks-a-release.yaml
 	if !opts.dryRun {
 		releaseManifestURL := releases.ManifestURL()
-		if err := downloadArtifact(filepath.Join(opts.downloadDir, filepath.Base(releaseManifestURL)), releaseManifestURL, reader); err != nil {
+		if err := downloadArtifact(filepath.Join(opts.downloadDir, filepath.Base(releaseManifestURL)), releaseManifestURL, "", reader); err != nil {
 			return fmt.Errorf("downloading release manifest: %v", err)
 		}
 	}
 
 	versionBundles := bundles.Spec.VersionsBundles
 	for i, bundle := range versionBundles {
+		checksums := bundle.ManifestChecksums()
 		for component, manifestList := range bundle.Manifests() {
 			for _, manifest := range manifestList {
 				if *manifest == "" {
 					// This can happen if the provider is not GA and not added to the bundle-release corresponding to an EKS-A release
 					continue
 				}
+				filePath := filepath.Join(opts.downloadDir, bundle.KubeVersion, component, filepath.Base(*manifest))
+				// Unchanged manifests are at the same path in the previous download. They are reused when that
+				// download is in the download dir, so every manifest in the bundles is in the tarball.
+				if _, ok := sinceManifests[manifestKey(bundle.KubeVersion, component, *manifest)]; ok {
+					reused, err := reuseArtifact(filePath, checksums[*manifest])
+					if err != nil {
+						return fmt.Errorf("reusing artifact for component %s: %v", component, err)
+					}
+					if reused {
+						logger.V(3).Info("Reusing artifact not changed since previous bundles", "artifact", *manifest, "path", filePath)
+						*manifest = filePath
+						continue
+					}
+					logger.V(3).Info("Artifact not changed since previous bundles is not in the download dir", "artifact", *manifest)
+				}
 				if opts.dryRun {
 					logger.Info(fmt.Sprintf("Found artifact: %s\n", *manifest))
 					continue
 				}
 
-				filePath := filepath.Join(opts.downloadDir, bundle.KubeVersion, component, filepath.Base(*manifest))
-				if err = downloadArtifact(filePath, *manifest, reader); err != nil {
+				if err = downloadArtifact(filePath, *manifest, checksums[*manifest], reader); err != nil {
 					return fmt.Errorf("downloading artifact for component %s: %v", component, err)
 				}
 				*manifest = filePath
@@ -113,6 +142,9 @@ func downloadArtifacts(context context.Context, opts *downloadArtifactsOptions)
 	}
 
 	if !opts.dryRun {
+		if err = artifacts.WriteChecksums(opts.downloadDir); err != nil {
+			return err
+		}
 		if err = createTarball(opts.downloadDir); err != nil {
 			return err
 		}
@@ -127,6 +159,22 @@ func downloadArtifacts(context context.Context, opts *downloadArtifactsOptions)
 	return nil
 }
 
+func bundleManifests(b *releasev1.Bundles) map[string]struct{} {
+	manifests := map[string]struct{}{}
+	for _, vb := range b.Spec.VersionsBundles {
+		for component, manifestList := range vb.Manifests() {
+			for _, manifest := range manifestList {
+				manifests[manifestKey(vb.KubeVersion, component, *manifest)] = struct{}{}
+			}
+		}
+	}
+	return manifests
+}
+
+func manifestKey(kubeVersion, component, manifest string) string {
+	return kubeVersion + "/" + component + "/" + manifest
+}
+
 func preRunDownloadArtifactsCmd(cmd *cobra.Command, args []string) error {
 	cmd.Flags().VisitAll(func(flag *pflag.Flag) {
 		if err := viper.BindPFlag(flag.Name, flag); err != nil {
@@ -136,7 +184,27 @@ func preRunDownloadArtifactsCmd(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
-func downloadArtifact(filePath, artifactUri string, reader *files.Reader) error {
+// reuseArtifact returns true if filePath holds a previously downloaded artifact. If sha256Checksum is set,
+// the file contents must match it.
+func reuseArtifact(filePath, sha256Checksum string) (bool, error) {
+	contents, err := os.ReadFile(filePath)
+	if os.IsNotExist(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	if sha256Checksum != "" {
+		if err = artifacts.VerifyContentChecksum(contents, sha256Checksum); err != nil {
+			return false, fmt.Errorf("verifying previously downloaded artifact %s: %v", filePath, err)
+		}
+	}
+	return true, nil
+}
+
+// downloadArtifact downloads artifactUri to filePath. If sha256Checksum is set, the artifact contents
+// must match it or nothing is written.
+func downloadArtifact(filePath, artifactUri, sha256Checksum string, reader *files.Reader) error {
 	logger.V(3).Info(fmt.Sprintf("Downloading artifact: %s", artifactUri))
 
 	if err := os.MkdirAll(filepath.Dir(filePath), 0o755); err != nil {
@@ -149,6 +217,13 @@ func downloadArtifact(filePath, artifactUri string, reader *files.Reader) error
 	if err != nil {
 		return err
 	}
+	if sha256Checksum != "" {
+		if err = artifacts.VerifyContentChecksum(contents, sha256Checksum); err != nil {
+			return fmt.Errorf("verifying artifact %s: %v", artifactUri, err)
+		}
+	} else {
+		logger.V(3).Info("No checksum published for artifact, skipping verification", "artifact", artifactUri)
+	}
 	if err = ioutil.WriteFile(filePath, contents, 0o644); err != nil {
 		return err
 	}
@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"path/filepath"
 	"strings"
@@ -14,8 +15,11 @@ import (
 	"github.com/aws/eks-anywhere/pkg/docker"
 	"github.com/aws/eks-anywhere/pkg/executables"
 	"github.com/aws/eks-anywhere/pkg/helm"
+	"github.com/aws/eks-anywhere/pkg/manifests/bundles"
+	"github.com/aws/eks-anywhere/pkg/registry"
 	"github.com/aws/eks-anywhere/pkg/tar"
 	"github.com/aws/eks-anywhere/pkg/version"
+	releasev1 "github.com/aws/eks-anywhere/release/api/v1alpha1"
 )
 
 // imagesCmd represents the images command.
@@ -44,14 +48,18 @@ func init() {
 	downloadImagesCmd.Flags().BoolVar(&downloadImagesRunner.includePackages, "include-packages", false, "this flag no longer works, use copy packages instead")
 	downloadImagesCmd.Flag("include-packages").Deprecated = "use copy packages command"
 	downloadImagesCmd.Flags().BoolVar(&downloadImagesRunner.insecure, "insecure", false, "Flag to indicate skipping TLS verification while downloading helm charts")
+	downloadImagesCmd.Flags().StringVar(&downloadImagesRunner.imageBackend, imageBackendFlag, dockerImageBackend, "Backend used to download images: docker saves them with the docker daemon, oci-layout copies them into an OCI image layout without a docker daemon, preserving multi-arch images and digests")
+	downloadImagesCmd.Flags().StringVar(&downloadImagesRunner.sinceBundlesFile, "since", "", "Bundles file of a previous download, only images and charts not in it are downloaded")
 }
 
 var downloadImagesRunner = downloadImagesCommand{}
 
 type downloadImagesCommand struct {
-	outputFile      string
-	includePackages bool
-	insecure        bool
+	outputFile       string
+	includePackages  bool
+	insecure         bool
+	imageBackend     string
+	sinceBundlesFile string
 }
 
 func (c downloadImagesCommand) Run(ctx context.Context) error {
@@ -69,32 +77,67 @@ func (c downloadImagesCommand) Run(ctx context.Context) error {
 	}
 	defer deps.Close(ctx)
 
-	dockerClient := executables.BuildDockerExecutable()
+	var sinceBundles *releasev1.Bundles
+	if c.sinceBundlesFile != "" {
+		if sinceBundles, err = bundles.Read(deps.ManifestReader, c.sinceBundlesFile); err != nil {
+			return err
+		}
+	}
+
 	downloadFolder := "tmp-eks-a-artifacts-download"
-	imagesFile := filepath.Join(downloadFolder, imagesTarFile)
-	eksaToolsImageFile := filepath.Join(downloadFolder, eksaToolsImageTarFile)
+	bundlesImagesDownloader, eksaToolsImageDownloader, digestVerifier, err := c.imageDownloaders(downloadFolder)
+	if err != nil {
+		return err
+	}
 
 	downloadArtifacts := artifacts.Download{
-		Reader: deps.ManifestReader,
-		BundlesImagesDownloader: docker.NewImageMover(
-			docker.NewOriginalRegistrySource(dockerClient),
-			docker.NewDiskDestination(dockerClient, imagesFile),
-		),
-		EksaToolsImageDownloader: docker.NewImageMover(
-			docker.NewOriginalRegistrySource(dockerClient),
-			docker.NewDiskDestination(dockerClient, eksaToolsImageFile),
-		),
-		ChartDownloader:    helm.NewChartRegistryDownloader(deps.Helm, downloadFolder),
-		Version:            version.Get(),
-		TmpDowloadFolder:   downloadFolder,
-		DstFile:            c.outputFile,
-		Packager:           packagerForFile(c.outputFile),
-		ManifestDownloader: oras.NewBundleDownloader(downloadFolder),
+		Reader:                   deps.ManifestReader,
+		BundlesImagesDownloader:  bundlesImagesDownloader,
+		EksaToolsImageDownloader: eksaToolsImageDownloader,
+		ChartDownloader:          helm.NewChartRegistryDownloader(deps.Helm, downloadFolder),
+		Version:                  version.Get(),
+		TmpDowloadFolder:         downloadFolder,
+		DstFile:                  c.outputFile,
+		Packager:                 packagerForFile(c.outputFile),
+		ManifestDownloader:       oras.NewBundleDownloader(downloadFolder),
+		SinceBundles:             sinceBundles,
+		DigestVerifier:           digestVerifier,
 	}
 
 	return downloadArtifacts.Run(ctx)
 }
 
+// imageDownloaders returns the image movers of the image backend. Only the oci-layout backend keeps image
+// digests, so it's the only one returning a verifier for the digests pinned in the bundles.
+func (c downloadImagesCommand) imageDownloaders(downloadFolder string) (bundlesImagesDownloader, eksaToolsImageDownloader artifacts.ImageMover, digestVerifier artifacts.DigestVerifier, err error) {
+	switch c.imageBackend {
+	case dockerImageBackend:
+		dockerClient := executables.BuildDockerExecutable()
+		bundlesImagesDownloader = docker.NewImageMover(
+			docker.NewOriginalRegistrySource(dockerClient),
+			docker.NewDiskDestination(dockerClient, filepath.Join(downloadFolder, imagesTarFile)),
+		)
+		eksaToolsImageDownloader = docker.NewImageMover(
+			docker.NewOriginalRegistrySource(dockerClient),
+			docker.NewDiskDestination(dockerClient, filepath.Join(downloadFolder, eksaToolsImageTarFile)),
+		)
+		return bundlesImagesDownloader, eksaToolsImageDownloader, nil, nil
+	case ociLayoutImageBackend:
+		credentialStore := registry.NewCredentialStore()
+		if err = credentialStore.Init(); err != nil {
+			return nil, nil, nil, err
+		}
+		// The tools image and the bundle images share the layout, so images are only stored once.
+		downloader := registry.NewOCILayoutDownloader(
+			registry.NewOCILayout(filepath.Join(downloadFolder, ociLayoutFolder)),
+			registry.NewCache(), credentialStore, nil, c.insecure,
+		)
+		return downloader, downloader, downloader, nil
+	default:
+		return nil, nil, nil, fmt.Errorf("invalid %s %s, must be one of %s or %s", imageBackendFlag, c.imageBackend, dockerImageBackend, ociLayoutImageBackend)
+	}
+}
+
 type packager interface {
 	UnPackage(orgFile, dstFolder string) error
 	Package(sourceFolder, dstFile string) error
@@ -7,6 +7,7 @@ import (
 	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
 
+	"github.com/aws/eks-anywhere/pkg/registry"
 	"github.com/aws/eks-anywhere/pkg/validations"
 )
 
@@ -34,6 +35,37 @@ func applyClusterOptionFlags(flagSet *pflag.FlagSet, clusterOpt *clusterOptions)
 	flagSet.StringVar(&clusterOpt.managementKubeconfig, "kubeconfig", "", "Management cluster kubeconfig file")
 }
 
+// signatureOptions configures the verification of image signatures.
+type signatureOptions struct {
+	keys   []string
+	policy string
+}
+
+func applySignatureFlags(flagSet *pflag.FlagSet, opts *signatureOptions) {
+	flagSet.StringSliceVar(&opts.keys, "signature-key", nil, "Trusted public key files to verify cosign image signatures with, signatures are only verified if set")
+	flagSet.StringVar(&opts.policy, "signature-policy", string(registry.SignaturePolicyBlock), "What to do with images without a valid signature: warn or block")
+}
+
+// signatureVerifier returns the signature verifier for the options, or nil if no trusted key is set.
+func (opts signatureOptions) signatureVerifier() (*registry.SignatureVerifier, error) {
+	if len(opts.keys) == 0 {
+		return nil, nil
+	}
+	policy, err := registry.ParseSignaturePolicy(opts.policy)
+	if err != nil {
+		return nil, err
+	}
+	keys, err := registry.LoadPublicKeys(opts.keys...)
+	if err != nil {
+		return nil, err
+	}
+	return registry.NewSignatureVerifier(keys, policy), nil
+}
+
+func applyBootstrapRegistryCacheFlag(flagSet *pflag.FlagSet, cacheDir *string) {
+	flagSet.StringVar(cacheDir, "bootstrap-registry-cache", "", "Directory to cache the images pulled by the bootstrap cluster, reused across runs")
+}
+
 func applyTinkerbellHardwareFlag(flagSet *pflag.FlagSet, pathOut *string) {
 	flagSet.StringVarP(
 		pathOut,
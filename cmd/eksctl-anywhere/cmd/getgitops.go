@@ -0,0 +1,139 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
+
+	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/dependencies"
+	"github.com/aws/eks-anywhere/pkg/gitops/flux"
+)
+
+type getGitOpsOptions struct {
+	clusterOptions
+	output string
+}
+
+var ggo = &getGitOpsOptions{}
+
+var getGitOpsCmd = &cobra.Command{
+	Use:          "gitops",
+	Short:        "Get the GitOps sync status of a cluster",
+	Long:         "This command shows the state of the Flux objects that sync the cluster with its git repository and the cluster config fields that differ between the repository and the cluster",
+	PreRunE:      bindFlagsToViper,
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return ggo.getGitOps(cmd.Context())
+	},
+}
+
+func init() {
+	getCmd.AddCommand(getGitOpsCmd)
+	getGitOpsCmd.Flags().StringVarP(&ggo.fileName, "filename", "f", "", "Filename that contains EKS-A cluster configuration")
+	getGitOpsCmd.Flags().StringVar(&ggo.bundlesOverride, "bundles-override", "", "Override default Bundles manifest (not recommended)")
+	getGitOpsCmd.Flags().StringVar(&ggo.managementKubeconfig, "kubeconfig", "", "Management cluster kubeconfig file")
+	getGitOpsCmd.Flags().StringVarP(&ggo.output, outputFlagName, "o", outputDefault, "Output format: text|json")
+	if err := getGitOpsCmd.MarkFlagRequired("filename"); err != nil {
+		log.Fatalf("Error marking flag as required: %v", err)
+	}
+}
+
+func (o *getGitOpsOptions) getGitOps(ctx context.Context) error {
+	clusterSpec, err := newClusterSpec(o.clusterOptions)
+	if err != nil {
+		return err
+	}
+	if clusterSpec.FluxConfig == nil {
+		return fmt.Errorf("cluster %s doesn't have a GitOps configuration", clusterSpec.Cluster.Name)
+	}
+
+	cliConfig := buildCliConfig(clusterSpec)
+	dirs, err := o.directoriesToMount(clusterSpec, cliConfig)
+	if err != nil {
+		return err
+	}
+
+	deps, err := dependencies.ForSpec(ctx, clusterSpec).WithExecutableMountDirs(dirs...).
+		WithCliConfig(cliConfig).
+		WithGitOpsFlux(clusterSpec.Cluster, clusterSpec.FluxConfig, cliConfig).
+		Build(ctx)
+	if err != nil {
+		return err
+	}
+	defer close(ctx, deps)
+
+	status, err := deps.GitOpsFlux.Status(ctx, getManagementCluster(clusterSpec), clusterSpec)
+	if err != nil {
+		return fmt.Errorf("getting gitops status: %v", err)
+	}
+
+	serialized, err := serializeGitOpsStatus(status, o.output)
+	if err != nil {
+		return err
+	}
+	fmt.Print(serialized)
+	return nil
+}
+
+func serializeGitOpsStatus(status *flux.Status, outputFormat string) (string, error) {
+	switch outputFormat {
+	case outputText:
+		return gitOpsStatusToText(status), nil
+	case outputJson:
+		b, err := json.MarshalIndent(status, "", "  ")
+		if err != nil {
+			return "", fmt.Errorf("serializing gitops status: %v", err)
+		}
+		return string(b) + "\n", nil
+	default:
+		return "", fmt.Errorf("invalid output format [%s]", outputFormat)
+	}
+}
+
+func gitOpsStatusToText(status *flux.Status) string {
+	buffer := bytes.Buffer{}
+	w := tabwriter.NewWriter(&buffer, 10, 4, 3, ' ', 0)
+	fluxConfig := status.FluxConfig
+	fmt.Fprintln(w, "NAME\tREVISION\tREPOSITORY READY\tKUSTOMIZATION READY")
+	fmt.Fprintf(w, "%s\t%s\t%s\t%s\n",
+		fluxConfig.Name,
+		fluxConfig.Status.LastAppliedRevision,
+		conditionStatus(fluxConfig, v1alpha1.GitRepositoryReadyCondition),
+		conditionStatus(fluxConfig, v1alpha1.KustomizationReadyCondition),
+	)
+	w.Flush()
+
+	if fluxConfig.Status.FailureMessage != nil {
+		fmt.Fprintf(&buffer, "\nLast error: %s\n", *fluxConfig.Status.FailureMessage)
+	}
+
+	if len(status.Drift) == 0 {
+		fmt.Fprintln(&buffer, "\nThe cluster config in the git repository matches the cluster")
+		return buffer.String()
+	}
+
+	fmt.Fprintln(&buffer, "\nThe cluster config in the git repository differs from the cluster:")
+	w = tabwriter.NewWriter(&buffer, 10, 4, 3, ' ', 0)
+	fmt.Fprintln(w, "FIELD\tREPOSITORY\tCLUSTER")
+	for _, d := range status.Drift {
+		fmt.Fprintf(w, "%s\t%s\t%s\n", d.Path, d.Repository, d.Cluster)
+	}
+	w.Flush()
+	return buffer.String()
+}
+
+func conditionStatus(fluxConfig *v1alpha1.FluxConfig, t clusterv1.ConditionType) string {
+	c := conditions.Get(fluxConfig, t)
+	if c == nil {
+		return "Unknown"
+	}
+	return string(c.Status)
+}
@@ -2,8 +2,10 @@ package cmd
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"path/filepath"
+	"strings"
 
 	"github.com/spf13/cobra"
 
@@ -16,7 +18,10 @@ import (
 	"github.com/aws/eks-anywhere/pkg/executables"
 	"github.com/aws/eks-anywhere/pkg/helm"
 	"github.com/aws/eks-anywhere/pkg/manifests/bundles"
+	"github.com/aws/eks-anywhere/pkg/registry"
 	"github.com/aws/eks-anywhere/pkg/registrymirror"
+	"github.com/aws/eks-anywhere/pkg/validations"
+	releasev1 "github.com/aws/eks-anywhere/release/api/v1alpha1"
 )
 
 // imagesCmd represents the images command.
@@ -50,6 +55,8 @@ func init() {
 	importImagesCmd.Flags().BoolVar(&importImagesCommand.includePackages, "include-packages", false, "Flag to indicate inclusion of curated packages in imported images")
 	importImagesCmd.Flag("include-packages").Deprecated = "use copy packages command"
 	importImagesCmd.Flags().BoolVar(&importImagesCommand.insecure, "insecure", false, "Flag to indicate skipping TLS verification while pushing helm charts")
+	applySignatureFlags(importImagesCmd.Flags(), &importImagesCommand.signatures)
+	importImagesCmd.Flags().StringVar(&importImagesCommand.imageBackend, imageBackendFlag, dockerImageBackend, "Backend used to import images, it must match the one used to download them: docker or oci-layout")
 }
 
 var importImagesCommand = ImportImagesCommand{}
@@ -60,6 +67,8 @@ type ImportImagesCommand struct {
 	BundlesFile      string
 	includePackages  bool
 	insecure         bool
+	imageBackend     string
+	signatures       signatureOptions
 }
 
 func (c ImportImagesCommand) Call(ctx context.Context) error {
@@ -68,6 +77,15 @@ func (c ImportImagesCommand) Call(ctx context.Context) error {
 		return err
 	}
 
+	signatureVerifier, err := c.signatures.signatureVerifier()
+	if err != nil {
+		return err
+	}
+	// Docker doesn't keep image signatures and digests, so they can only be verified with the oci-layout backend.
+	if signatureVerifier != nil && c.imageBackend != ociLayoutImageBackend {
+		return fmt.Errorf("verifying image signatures requires --%s %s", imageBackendFlag, ociLayoutImageBackend)
+	}
+
 	factory := dependencies.NewFactory()
 	deps, err := factory.
 		WithManifestReader().
@@ -82,8 +100,10 @@ func (c ImportImagesCommand) Call(ctx context.Context) error {
 	}
 
 	artifactsFolder := "tmp-eks-a-artifacts"
-	dockerClient := executables.BuildDockerExecutable()
-	toolsImageFile := filepath.Join(artifactsFolder, eksaToolsImageTarFile)
+	toolsImageMover, imagesMover, digestVerifier, err := c.imageMovers(artifactsFolder, username, password)
+	if err != nil {
+		return err
+	}
 
 	// Import the eksa tools image into the registry first, so it can be used immediately
 	// after to build the helm executable
@@ -92,16 +112,27 @@ func (c ImportImagesCommand) Call(ctx context.Context) error {
 		InputFile:          c.InputFile,
 		TmpArtifactsFolder: artifactsFolder,
 		UnPackager:         packagerForFile(c.InputFile),
-		ImageMover: docker.NewImageMover(
-			docker.NewDiskSource(dockerClient, toolsImageFile),
-			docker.NewRegistryDestination(dockerClient, c.RegistryEndpoint),
-		),
+		ImageMover:         toolsImageMover,
 	}
 
 	if err = importToolsImage.Run(ctx); err != nil {
 		return err
 	}
 
+	// Incremental tarballs include the bundles they were diffed against.
+	var baseBundles *releasev1.Bundles
+	var imageVerifier artifacts.ImageVerifier
+	if baseBundlesFile := filepath.Join(artifactsFolder, artifacts.BaseBundlesFile); validations.FileExists(baseBundlesFile) {
+		if baseBundles, err = bundles.Read(deps.ManifestReader, baseBundlesFile); err != nil {
+			return err
+		}
+		dst, err := c.registryClient(username, password)
+		if err != nil {
+			return err
+		}
+		imageVerifier = registry.NewImageVerifier(dst, c.RegistryEndpoint)
+	}
+
 	dirsToMount, err := cc.cloudStackDirectoriesToMount()
 	if err != nil {
 		return err
@@ -130,14 +161,10 @@ func (c ImportImagesCommand) Call(ctx context.Context) error {
 	}
 	defer deps.Close(ctx)
 
-	imagesFile := filepath.Join(artifactsFolder, "images.tar")
 	importArtifacts := artifacts.Import{
-		Reader:  deps.ManifestReader,
-		Bundles: bundle,
-		ImageMover: docker.NewImageMover(
-			docker.NewDiskSource(dockerClient, imagesFile),
-			docker.NewRegistryDestination(dockerClient, c.RegistryEndpoint),
-		),
+		Reader:     deps.ManifestReader,
+		Bundles:    bundle,
+		ImageMover: imagesMover,
 		ChartImporter: helm.NewChartRegistryImporter(
 			deps.Helm, artifactsFolder,
 			c.RegistryEndpoint,
@@ -146,7 +173,53 @@ func (c ImportImagesCommand) Call(ctx context.Context) error {
 		),
 		TmpArtifactsFolder: artifactsFolder,
 		FileImporter:       oras.NewFileRegistryImporter(c.RegistryEndpoint, username, password, artifactsFolder),
+		BaseBundles:        baseBundles,
+		ImageVerifier:      imageVerifier,
+		DigestVerifier:     digestVerifier,
+	}
+	if signatureVerifier != nil {
+		importArtifacts.SignatureVerifier = registry.NewOCILayoutSignatureVerifier(
+			registry.NewOCILayout(filepath.Join(artifactsFolder, ociLayoutFolder)), signatureVerifier,
+		)
 	}
 
 	return importArtifacts.Run(ctx)
 }
+
+// imageMovers returns the image movers of the image backend. Only the oci-layout backend keeps image
+// digests, so it's the only one returning a verifier for the digests pinned in the bundles.
+func (c ImportImagesCommand) imageMovers(artifactsFolder, username, password string) (toolsImageMover, imagesMover artifacts.ImageMover, digestVerifier artifacts.DigestVerifier, err error) {
+	switch c.imageBackend {
+	case dockerImageBackend:
+		dockerClient := executables.BuildDockerExecutable()
+		toolsImageMover = docker.NewImageMover(
+			docker.NewDiskSource(dockerClient, filepath.Join(artifactsFolder, eksaToolsImageTarFile)),
+			docker.NewRegistryDestination(dockerClient, c.RegistryEndpoint),
+		)
+		imagesMover = docker.NewImageMover(
+			docker.NewDiskSource(dockerClient, filepath.Join(artifactsFolder, imagesTarFile)),
+			docker.NewRegistryDestination(dockerClient, c.RegistryEndpoint),
+		)
+		return toolsImageMover, imagesMover, nil, nil
+	case ociLayoutImageBackend:
+		dst, err := c.registryClient(username, password)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		importer := registry.NewOCILayoutImporter(registry.NewOCILayout(filepath.Join(artifactsFolder, ociLayoutFolder)), dst, c.RegistryEndpoint)
+		return importer, importer, registry.NewImageVerifier(dst, c.RegistryEndpoint), nil
+	default:
+		return nil, nil, nil, fmt.Errorf("invalid %s %s, must be one of %s or %s", imageBackendFlag, c.imageBackend, dockerImageBackend, ociLayoutImageBackend)
+	}
+}
+
+func (c ImportImagesCommand) registryClient(username, password string) (*registry.OCIRegistryClient, error) {
+	host, _, _ := strings.Cut(c.RegistryEndpoint, "/")
+	credentialStore := registry.NewCredentialStore()
+	credentialStore.SetCredential(host, username, password)
+	dst, err := registry.NewRegistryForEndpoint(c.RegistryEndpoint, credentialStore, nil, c.insecure)
+	if err != nil {
+		return nil, fmt.Errorf("error with repository %s: %v", c.RegistryEndpoint, err)
+	}
+	return dst, nil
+}
@@ -4,9 +4,11 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/spf13/cobra"
 
+	packagesv1 "github.com/aws/eks-anywhere-packages/api/v1alpha1"
 	"github.com/aws/eks-anywhere/pkg/curatedpackages"
 	"github.com/aws/eks-anywhere/pkg/kubeconfig"
 )
@@ -17,6 +19,8 @@ type installPackageOptions struct {
 	packageName   string
 	registry      string
 	customConfigs []string
+	// withDependencies installs the packages the package depends on when they are not installed yet.
+	withDependencies bool
 	// kubeConfig is an optional kubeconfig file to use when querying an
 	// existing cluster.
 	kubeConfig string
@@ -39,6 +43,8 @@ func init() {
 		"Path to an optional kubeconfig file to use.")
 	installPackageCommand.Flags().StringVar(&ipo.clusterName, "cluster", "",
 		"Target cluster for installation.")
+	installPackageCommand.Flags().BoolVar(&ipo.withDependencies, "with-dependencies", false,
+		"Install the packages the package depends on if they are not installed yet.")
 
 	if err := installPackageCommand.MarkFlagRequired("package-name"); err != nil {
 		log.Fatalf("marking package-name flag as required: %s", err)
@@ -86,14 +92,21 @@ func installPackages(ctx context.Context, args []string) error {
 
 	b := curatedpackages.NewBundleReader(kubeConfig, ipo.clusterName, deps.Kubectl, bm, deps.BundleRegistry)
 
-	bundle, err := b.GetLatestBundle(ctx, ipo.kubeVersion)
+	// The package controller installs the dependencies from the active bundle of the cluster.
+	bundle, bundleDependencies, err := b.GetActiveBundleWithDependencies(ctx)
 	if err != nil {
 		return err
 	}
+	if ipo.kubeVersion != "" {
+		if bundle, err = b.GetLatestBundle(ctx, ipo.kubeVersion); err != nil {
+			return err
+		}
+	}
 
 	packages := curatedpackages.NewPackageClient(
 		deps.Kubectl,
 		curatedpackages.WithBundle(bundle),
+		curatedpackages.WithBundleDependencies(bundleDependencies),
 		curatedpackages.WithCustomConfigs(ipo.customConfigs),
 	)
 
@@ -102,10 +115,37 @@ func installPackages(ctx context.Context, args []string) error {
 		return err
 	}
 
+	dependencies, err := packages.ResolveDependencies(p.Name, "")
+	if err != nil {
+		return err
+	}
+	var missing []packagesv1.BundlePackage
+	if len(dependencies) > 0 {
+		installed, err := packages.GetInstalledPackages(ctx, kubeConfig, ipo.clusterName)
+		if err != nil {
+			return err
+		}
+		missing = curatedpackages.MissingDependencies(dependencies, installed)
+	}
+	if len(missing) > 0 && !ipo.withDependencies {
+		return fmt.Errorf("package %s depends on packages that are not installed: %s. Install them first or use --with-dependencies", p.Name, bundlePackageNames(missing))
+	}
+
 	curatedpackages.PrintLicense()
+	if err = packages.InstallDependencies(ctx, missing, ipo.clusterName, kubeConfig); err != nil {
+		return err
+	}
 	err = packages.InstallPackage(ctx, p, ipo.packageName, ipo.clusterName, kubeConfig)
 	if err != nil {
 		return err
 	}
 	return nil
 }
+
+func bundlePackageNames(packages []packagesv1.BundlePackage) string {
+	names := make([]string, 0, len(packages))
+	for _, p := range packages {
+		names = append(names, p.Name)
+	}
+	return strings.Join(names, ", ")
+}
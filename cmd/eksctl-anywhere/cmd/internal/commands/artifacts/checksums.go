@@ -0,0 +1,142 @@
+package artifacts
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/aws/eks-anywhere/pkg/logger"
+)
+
+// ChecksumsFile lists the sha256 checksum of every file in an artifacts folder, in the sha256sum format.
+const ChecksumsFile = "SHA256SUMS"
+
+// WriteChecksums computes the checksum of every file in folder and writes them to its ChecksumsFile.
+func WriteChecksums(folder string) error {
+	checksums := map[string]string{}
+	err := filepath.WalkDir(folder, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() {
+			return nil
+		}
+		relPath, err := filepath.Rel(folder, path)
+		if err != nil {
+			return err
+		}
+		if relPath == ChecksumsFile {
+			return nil
+		}
+		checksum, err := fileChecksum(path)
+		if err != nil {
+			return err
+		}
+		checksums[filepath.ToSlash(relPath)] = checksum
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("computing artifact checksums: %v", err)
+	}
+
+	files := make([]string, 0, len(checksums))
+	for file := range checksums {
+		files = append(files, file)
+	}
+	sort.Strings(files)
+
+	var b strings.Builder
+	for _, file := range files {
+		fmt.Fprintf(&b, "%s  %s\n", checksums[file], file)
+	}
+	if err = os.WriteFile(filepath.Join(folder, ChecksumsFile), []byte(b.String()), 0o644); err != nil {
+		return fmt.Errorf("writing artifact checksums: %v", err)
+	}
+	return nil
+}
+
+// VerifyChecksums checks every file listed in the ChecksumsFile of folder exists and matches its
+// checksum, and that folder has no other files.
+func VerifyChecksums(folder string) error {
+	f, err := os.Open(filepath.Join(folder, ChecksumsFile))
+	if err != nil {
+		return fmt.Errorf("reading artifact checksums: %v", err)
+	}
+	defer f.Close()
+
+	listed := map[string]struct{}{}
+	var failures []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		checksum, file, found := strings.Cut(scanner.Text(), "  ")
+		if !found {
+			return fmt.Errorf("invalid artifact checksums line: %s", scanner.Text())
+		}
+		listed[file] = struct{}{}
+		actual, err := fileChecksum(filepath.Join(folder, filepath.FromSlash(file)))
+		if err != nil {
+			failures = append(failures, fmt.Sprintf("%s: %v", file, err))
+			continue
+		}
+		if actual != checksum {
+			failures = append(failures, fmt.Sprintf("%s: checksum %s does not match %s", file, actual, checksum))
+		}
+	}
+	if err = scanner.Err(); err != nil {
+		return fmt.Errorf("reading artifact checksums: %v", err)
+	}
+
+	err = filepath.WalkDir(folder, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil || entry.IsDir() {
+			return err
+		}
+		relPath, err := filepath.Rel(folder, path)
+		if err != nil {
+			return err
+		}
+		relPath = filepath.ToSlash(relPath)
+		if _, ok := listed[relPath]; !ok && relPath != ChecksumsFile {
+			failures = append(failures, fmt.Sprintf("%s: not listed in %s", relPath, ChecksumsFile))
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("verifying artifact checksums: %v", err)
+	}
+
+	if len(failures) > 0 {
+		return fmt.Errorf("verifying artifact checksums: %s", strings.Join(failures, "; "))
+	}
+	logger.V(3).Info("Verified artifact checksums", "files", len(listed))
+	return nil
+}
+
+// VerifyContentChecksum checks the sha256 checksum of content matches the one published for it.
+func VerifyContentChecksum(content []byte, sha256Checksum string) error {
+	sum := sha256.Sum256(content)
+	if actual := hex.EncodeToString(sum[:]); actual != sha256Checksum {
+		return fmt.Errorf("sha256 checksum %s does not match %s", actual, sha256Checksum)
+	}
+	return nil
+}
+
+func fileChecksum(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err = io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
@@ -0,0 +1,83 @@
+package artifacts_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/aws/eks-anywhere/cmd/eksctl-anywhere/cmd/internal/commands/artifacts"
+)
+
+func writeArtifactFiles(t *testing.T, folder string, files map[string]string) {
+	for name, content := range files {
+		path := filepath.Join(folder, name)
+		if err := os.MkdirAll(filepath.Dir(path), os.ModePerm); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestWriteAndVerifyChecksums(t *testing.T) {
+	g := NewWithT(t)
+	folder := t.TempDir()
+	writeArtifactFiles(t, folder, map[string]string{
+		"images.tar":                  "images",
+		"1.24/cilium/cilium.yaml":     "cilium",
+		"oci-layout/blobs/sha256/abc": "blob",
+	})
+
+	g.Expect(artifacts.WriteChecksums(folder)).To(Succeed())
+	content, err := os.ReadFile(filepath.Join(folder, artifacts.ChecksumsFile))
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(string(content)).To(ContainSubstring("  1.24/cilium/cilium.yaml\n"))
+	g.Expect(string(content)).To(ContainSubstring("  oci-layout/blobs/sha256/abc\n"))
+
+	g.Expect(artifacts.VerifyChecksums(folder)).To(Succeed())
+}
+
+func TestVerifyChecksumsModifiedFile(t *testing.T) {
+	g := NewWithT(t)
+	folder := t.TempDir()
+	writeArtifactFiles(t, folder, map[string]string{"images.tar": "images"})
+	g.Expect(artifacts.WriteChecksums(folder)).To(Succeed())
+	writeArtifactFiles(t, folder, map[string]string{"images.tar": "tampered"})
+
+	g.Expect(artifacts.VerifyChecksums(folder)).To(MatchError(ContainSubstring("images.tar: checksum")))
+}
+
+func TestVerifyChecksumsMissingAndExtraFiles(t *testing.T) {
+	g := NewWithT(t)
+	folder := t.TempDir()
+	writeArtifactFiles(t, folder, map[string]string{"images.tar": "images"})
+	g.Expect(artifacts.WriteChecksums(folder)).To(Succeed())
+	g.Expect(os.Remove(filepath.Join(folder, "images.tar"))).To(Succeed())
+	writeArtifactFiles(t, folder, map[string]string{"extra.yaml": "extra"})
+
+	err := artifacts.VerifyChecksums(folder)
+	g.Expect(err).To(MatchError(ContainSubstring("images.tar: open")))
+	g.Expect(err).To(MatchError(ContainSubstring("extra.yaml: not listed in SHA256SUMS")))
+}
+
+func TestVerifyChecksumsNoFile(t *testing.T) {
+	g := NewWithT(t)
+	g.Expect(artifacts.VerifyChecksums(t.TempDir())).To(MatchError(ContainSubstring("reading artifact checksums")))
+}
+
+func TestVerifyContentChecksum(t *testing.T) {
+	g := NewWithT(t)
+	g.Expect(artifacts.VerifyContentChecksum(
+		[]byte("cilium"), "8873184952915b685c7b6d50d0e91cc49af8935b61f11b4a47a67627abbee3c5",
+	)).To(Succeed())
+}
+
+func TestVerifyContentChecksumMismatch(t *testing.T) {
+	g := NewWithT(t)
+	g.Expect(artifacts.VerifyContentChecksum(
+		[]byte("tampered"), "8873184952915b685c7b6d50d0e91cc49af8935b61f11b4a47a67627abbee3c5",
+	)).To(MatchError(ContainSubstring("does not match 8873184952915b685c7b6d50d0e91cc49af8935b61f11b4a47a67627abbee3c5")))
+}
@@ -4,6 +4,9 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"path/filepath"
+
+	"sigs.k8s.io/yaml"
 
 	"github.com/aws/eks-anywhere/pkg/logger"
 	"github.com/aws/eks-anywhere/pkg/version"
@@ -28,6 +31,10 @@ type ManifestDownloader interface {
 	Download(ctx context.Context, bundles *releasev1.Bundles)
 }
 
+// BaseBundlesFile is the file in an incremental artifacts tarball with the bundles of the artifacts it
+// was diffed against.
+const BaseBundlesFile = "base-bundles.yaml"
+
 type Packager interface {
 	Package(folder string, dstFile string) error
 }
@@ -42,6 +49,15 @@ type Download struct {
 	TmpDowloadFolder         string
 	DstFile                  string
 	ManifestDownloader       ManifestDownloader
+	// SinceBundles, if set, makes the download incremental: images and charts already in these
+	// bundles are not downloaded. The eksa tools image is always downloaded, as it's needed to import.
+	SinceBundles *releasev1.Bundles
+	// DigestVerifier, if set, checks the downloaded images against the digests pinned in the bundles.
+	DigestVerifier DigestVerifier
+}
+
+type DigestVerifier interface {
+	VerifyDigests(ctx context.Context, images []releasev1.Image) error
 }
 
 func (d Download) Run(ctx context.Context) error {
@@ -62,19 +78,38 @@ func (d Download) Run(ctx context.Context) error {
 	if err != nil {
 		return fmt.Errorf("downloading images: %v", err)
 	}
+	charts := d.Reader.ReadChartsFromBundles(ctx, b)
+
+	if d.SinceBundles != nil {
+		if images, charts, err = d.removeSinceArtifacts(ctx, images, charts); err != nil {
+			return err
+		}
+	}
 
 	if err = d.BundlesImagesDownloader.Move(ctx, removeFromSlice(artifactNames(images), toolsImage)...); err != nil {
 		return err
 	}
 
-	charts := d.Reader.ReadChartsFromBundles(ctx, b)
+	if d.DigestVerifier != nil {
+		if err = d.DigestVerifier.VerifyDigests(ctx, images); err != nil {
+			return fmt.Errorf("verifying downloaded images: %v", err)
+		}
+	}
 
-	d.ManifestDownloader.Download(ctx, b)
+	if d.SinceBundles != nil {
+		d.ManifestDownloader.Download(ctx, removeBasePackageBundles(b, d.SinceBundles))
+	} else {
+		d.ManifestDownloader.Download(ctx, b)
+	}
 
 	if err := d.ChartDownloader.Download(ctx, artifactNames(charts)...); err != nil {
 		return err
 	}
 
+	if err := WriteChecksums(d.TmpDowloadFolder); err != nil {
+		return err
+	}
+
 	logger.Info("Packaging artifacts", "dst", d.DstFile)
 	if err := d.Packager.Package(d.TmpDowloadFolder, d.DstFile); err != nil {
 		return err
@@ -87,6 +122,67 @@ func (d Download) Run(ctx context.Context) error {
 	return nil
 }
 
+func (d Download) removeSinceArtifacts(ctx context.Context, images, charts []releasev1.Image) ([]releasev1.Image, []releasev1.Image, error) {
+	baseImages, err := d.Reader.ReadImagesFromBundles(ctx, d.SinceBundles)
+	if err != nil {
+		return nil, nil, fmt.Errorf("reading images from since bundles: %v", err)
+	}
+	baseCharts := d.Reader.ReadChartsFromBundles(ctx, d.SinceBundles)
+
+	newImages, _ := diffArtifacts(images, baseImages)
+	newCharts, _ := diffArtifacts(charts, baseCharts)
+	logger.Info("Downloading incremental artifacts", "newImages", len(newImages), "newCharts", len(newCharts))
+
+	content, err := yaml.Marshal(d.SinceBundles)
+	if err != nil {
+		return nil, nil, fmt.Errorf("marshalling since bundles: %v", err)
+	}
+	if err = os.WriteFile(filepath.Join(d.TmpDowloadFolder, BaseBundlesFile), content, 0o644); err != nil {
+		return nil, nil, fmt.Errorf("writing since bundles: %v", err)
+	}
+
+	return newImages, newCharts, nil
+}
+
+// removeBasePackageBundles returns a copy of bundles without the versions bundles whose curated packages
+// bundle is also in base. The packages bundle is pulled with a tag per kube version from the packages
+// controller registry, so it's considered unchanged when the kube version and the controller are the same.
+func removeBasePackageBundles(bundles, base *releasev1.Bundles) *releasev1.Bundles {
+	inBase := make(map[string]struct{}, len(base.Spec.VersionsBundles))
+	for _, vb := range base.Spec.VersionsBundles {
+		inBase[packageBundleKey(vb)] = struct{}{}
+	}
+
+	diff := bundles.DeepCopy()
+	diff.Spec.VersionsBundles = nil
+	for _, vb := range bundles.Spec.VersionsBundles {
+		if _, ok := inBase[packageBundleKey(vb)]; !ok {
+			diff.Spec.VersionsBundles = append(diff.Spec.VersionsBundles, *vb.DeepCopy())
+		}
+	}
+	return diff
+}
+
+func packageBundleKey(vb releasev1.VersionsBundle) string {
+	return vb.KubeVersion + "/" + vb.PackageController.Controller.VersionedImage()
+}
+
+// diffArtifacts splits artifacts between the ones not in base and the ones also in base.
+func diffArtifacts(artifacts, base []releasev1.Image) (added, existing []releasev1.Image) {
+	inBase := make(map[string]struct{}, len(base))
+	for _, a := range base {
+		inBase[a.VersionedImage()] = struct{}{}
+	}
+	for _, a := range artifacts {
+		if _, ok := inBase[a.VersionedImage()]; ok {
+			existing = append(existing, a)
+		} else {
+			added = append(added, a)
+		}
+	}
+	return added, existing
+}
+
 func artifactNames(artifacts []releasev1.Image) []string {
 	taggedArtifacts := make([]string, 0, len(artifacts))
 	for _, a := range artifacts {
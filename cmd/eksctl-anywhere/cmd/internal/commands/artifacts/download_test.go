@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/golang/mock/gomock"
@@ -127,3 +128,95 @@ func TestDownloadErrorReadingImages(t *testing.T) {
 
 	tt.Expect(tt.command.Run(tt.ctx)).To(MatchError(ContainSubstring("downloading images: error reading images")))
 }
+
+func TestDownloadRunSinceBundles(t *testing.T) {
+	tt := newDownloadArtifactsTest(t)
+	since := &releasev1.Bundles{Spec: releasev1.BundlesSpec{Number: 1}}
+	tt.command.SinceBundles = since
+	tt.reader.EXPECT().ReadBundlesForVersion("v1.0.0").Return(tt.bundles, nil)
+	tt.toolsDownloader.EXPECT().Move(tt.ctx, "tools:v1.0.0")
+	tt.reader.EXPECT().ReadImagesFromBundles(tt.ctx, tt.bundles).Return(tt.images, nil)
+	tt.reader.EXPECT().ReadImagesFromBundles(tt.ctx, since).Return(tt.images[:1], nil)
+	tt.mover.EXPECT().Move(tt.ctx, "image2:1")
+	tt.reader.EXPECT().ReadChartsFromBundles(tt.ctx, tt.bundles).Return(tt.charts)
+	tt.reader.EXPECT().ReadChartsFromBundles(tt.ctx, since).Return(tt.charts[1:])
+	tt.downloader.EXPECT().Download(tt.ctx, "chart:v1.0.0")
+	tt.packager.EXPECT().Package("tmp-folder", "artifacts.tar").DoAndReturn(func(folder, _ string) error {
+		tt.Expect(filepath.Join(folder, artifacts.BaseBundlesFile)).To(BeAnExistingFile())
+		return nil
+	})
+	tt.manifestDownloader.EXPECT().Download(tt.ctx, tt.bundles)
+
+	tt.Expect(tt.command.Run(tt.ctx)).To(Succeed())
+}
+
+func TestDownloadRunSinceBundlesSkipsUnchangedPackageBundles(t *testing.T) {
+	tt := newDownloadArtifactsTest(t)
+	tt.bundles.Spec.VersionsBundles[0].KubeVersion = "1.23"
+	tt.bundles.Spec.VersionsBundles = append(tt.bundles.Spec.VersionsBundles, releasev1.VersionsBundle{KubeVersion: "1.24"})
+	since := &releasev1.Bundles{
+		Spec: releasev1.BundlesSpec{
+			Number:          1,
+			VersionsBundles: []releasev1.VersionsBundle{{KubeVersion: "1.23"}},
+		},
+	}
+	tt.command.SinceBundles = since
+	wantManifestBundles := tt.bundles.DeepCopy()
+	wantManifestBundles.Spec.VersionsBundles = wantManifestBundles.Spec.VersionsBundles[1:]
+	tt.reader.EXPECT().ReadBundlesForVersion("v1.0.0").Return(tt.bundles, nil)
+	tt.toolsDownloader.EXPECT().Move(tt.ctx, "tools:v1.0.0")
+	tt.reader.EXPECT().ReadImagesFromBundles(tt.ctx, tt.bundles).Return(tt.images, nil)
+	tt.reader.EXPECT().ReadImagesFromBundles(tt.ctx, since).Return(tt.images, nil)
+	tt.reader.EXPECT().ReadChartsFromBundles(tt.ctx, tt.bundles).Return(tt.charts)
+	tt.reader.EXPECT().ReadChartsFromBundles(tt.ctx, since).Return(tt.charts)
+	tt.mover.EXPECT().Move(tt.ctx)
+	tt.downloader.EXPECT().Download(tt.ctx)
+	tt.packager.EXPECT().Package("tmp-folder", "artifacts.tar")
+	tt.manifestDownloader.EXPECT().Download(tt.ctx, wantManifestBundles)
+
+	tt.Expect(tt.command.Run(tt.ctx)).To(Succeed())
+}
+
+func TestDownloadErrorReadingSinceImages(t *testing.T) {
+	tt := newDownloadArtifactsTest(t)
+	since := &releasev1.Bundles{}
+	tt.command.SinceBundles = since
+	tt.reader.EXPECT().ReadBundlesForVersion("v1.0.0").Return(tt.bundles, nil)
+	tt.toolsDownloader.EXPECT().Move(tt.ctx, "tools:v1.0.0")
+	tt.reader.EXPECT().ReadImagesFromBundles(tt.ctx, tt.bundles).Return(tt.images, nil)
+	tt.reader.EXPECT().ReadChartsFromBundles(tt.ctx, tt.bundles).Return(tt.charts)
+	tt.reader.EXPECT().ReadImagesFromBundles(tt.ctx, since).Return(nil, errors.New("error reading images"))
+
+	tt.Expect(tt.command.Run(tt.ctx)).To(MatchError("reading images from since bundles: error reading images"))
+}
+
+func TestDownloadRunVerifiesDigests(t *testing.T) {
+	tt := newDownloadArtifactsTest(t)
+	verifier := mocks.NewMockDigestVerifier(gomock.NewController(t))
+	tt.command.DigestVerifier = verifier
+	tt.reader.EXPECT().ReadBundlesForVersion("v1.0.0").Return(tt.bundles, nil)
+	tt.toolsDownloader.EXPECT().Move(tt.ctx, "tools:v1.0.0")
+	tt.reader.EXPECT().ReadImagesFromBundles(tt.ctx, tt.bundles).Return(tt.images, nil)
+	tt.reader.EXPECT().ReadChartsFromBundles(tt.ctx, tt.bundles).Return(tt.charts)
+	tt.mover.EXPECT().Move(tt.ctx, "image1:1", "image2:1")
+	verifier.EXPECT().VerifyDigests(tt.ctx, tt.images).Return(errors.New("image digests don't match bundle"))
+
+	tt.Expect(tt.command.Run(tt.ctx)).To(MatchError("verifying downloaded images: image digests don't match bundle"))
+}
+
+func TestDownloadRunWritesChecksums(t *testing.T) {
+	tt := newDownloadArtifactsTest(t)
+	tt.reader.EXPECT().ReadBundlesForVersion("v1.0.0").Return(tt.bundles, nil)
+	tt.toolsDownloader.EXPECT().Move(tt.ctx, "tools:v1.0.0")
+	tt.reader.EXPECT().ReadImagesFromBundles(tt.ctx, tt.bundles).Return(tt.images, nil)
+	tt.reader.EXPECT().ReadChartsFromBundles(tt.ctx, tt.bundles).Return(tt.charts)
+	tt.mover.EXPECT().Move(tt.ctx, "image1:1", "image2:1")
+	tt.downloader.EXPECT().Download(tt.ctx, "chart:v1.0.0", "package-chart:v1.0.0")
+	tt.manifestDownloader.EXPECT().Download(tt.ctx, tt.bundles)
+	tt.packager.EXPECT().Package("tmp-folder", "artifacts.tar").DoAndReturn(func(folder, _ string) error {
+		tt.Expect(artifacts.VerifyChecksums(folder)).To(Succeed())
+		return nil
+	})
+
+	tt.Expect(tt.command.Run(tt.ctx)).To(Succeed())
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 
+	"github.com/aws/eks-anywhere/pkg/logger"
 	releasev1 "github.com/aws/eks-anywhere/release/api/v1alpha1"
 )
 
@@ -15,6 +16,22 @@ type Import struct {
 	ChartImporter      ChartImporter
 	TmpArtifactsFolder string
 	FileImporter       FileImporter
+	// BaseBundles are the bundles an incremental artifacts tarball was diffed against. If set, only the
+	// images and charts not in them are imported, after checking the rest already exist in the registry.
+	BaseBundles   *releasev1.Bundles
+	ImageVerifier ImageVerifier
+	// DigestVerifier, if set, checks the imported images against the digests pinned in the bundles.
+	DigestVerifier DigestVerifier
+	// SignatureVerifier, if set, checks the signatures of the images to import before they are pushed.
+	SignatureVerifier SignatureVerifier
+}
+
+type SignatureVerifier interface {
+	VerifySignatures(ctx context.Context, images ...string) error
+}
+
+type ImageVerifier interface {
+	Verify(ctx context.Context, images ...string) error
 }
 
 type ChartImporter interface {
@@ -31,17 +48,40 @@ func (i Import) Run(ctx context.Context) error {
 		return fmt.Errorf("downloading images: %v", err)
 	}
 
+	charts := i.Reader.ReadChartsFromBundles(ctx, i.Bundles)
+
+	if i.BaseBundles != nil {
+		if images, charts, err = i.removeBaseArtifacts(ctx, images, charts); err != nil {
+			return err
+		}
+	}
+
+	// Signatures are checked in the artifacts before pushing, so images without a valid one never reach the registry.
+	if i.SignatureVerifier != nil {
+		if err = i.SignatureVerifier.VerifySignatures(ctx, artifactNames(images)...); err != nil {
+			return err
+		}
+	}
+
 	if err = i.ImageMover.Move(ctx, artifactNames(images)...); err != nil {
 		return err
 	}
 
-	charts := i.Reader.ReadChartsFromBundles(ctx, i.Bundles)
+	if i.DigestVerifier != nil {
+		if err = i.DigestVerifier.VerifyDigests(ctx, images); err != nil {
+			return fmt.Errorf("verifying imported images: %v", err)
+		}
+	}
 
 	if err := i.ChartImporter.Import(ctx, artifactNames(charts)...); err != nil {
 		return err
 	}
 
-	i.FileImporter.Push(ctx, i.Bundles)
+	if i.BaseBundles != nil {
+		i.FileImporter.Push(ctx, removeBasePackageBundles(i.Bundles, i.BaseBundles))
+	} else {
+		i.FileImporter.Push(ctx, i.Bundles)
+	}
 
 	if err := os.RemoveAll(i.TmpArtifactsFolder); err != nil {
 		return fmt.Errorf("deleting tmp artifact import folder: %v", err)
@@ -49,3 +89,22 @@ func (i Import) Run(ctx context.Context) error {
 
 	return nil
 }
+
+// removeBaseArtifacts verifies the images and charts of the base bundles still in use were already
+// imported and returns the ones only in the incremental tarball.
+func (i Import) removeBaseArtifacts(ctx context.Context, images, charts []releasev1.Image) ([]releasev1.Image, []releasev1.Image, error) {
+	baseImages, err := i.Reader.ReadImagesFromBundles(ctx, i.BaseBundles)
+	if err != nil {
+		return nil, nil, fmt.Errorf("reading images from base bundles: %v", err)
+	}
+	baseCharts := i.Reader.ReadChartsFromBundles(ctx, i.BaseBundles)
+
+	newImages, existingImages := diffArtifacts(images, baseImages)
+	newCharts, existingCharts := diffArtifacts(charts, baseCharts)
+	logger.Info("Verifying base artifacts exist in registry", "images", len(existingImages), "charts", len(existingCharts))
+	if err = i.ImageVerifier.Verify(ctx, append(artifactNames(existingImages), artifactNames(existingCharts)...)...); err != nil {
+		return nil, nil, fmt.Errorf("verifying base artifacts for incremental import, import the base artifacts first: %v", err)
+	}
+
+	return newImages, newCharts, nil
+}
@@ -2,6 +2,7 @@ package artifacts_test
 
 import (
 	"context"
+	"errors"
 	"os"
 	"testing"
 
@@ -23,6 +24,7 @@ type importArtifactsTest struct {
 	images, charts []releasev1.Image
 	bundles        *releasev1.Bundles
 	fileImporter   *mocks.MockFileImporter
+	verifier       *mocks.MockImageVerifier
 }
 
 func newImportArtifactsTest(t *testing.T) *importArtifactsTest {
@@ -35,6 +37,7 @@ func newImportArtifactsTest(t *testing.T) *importArtifactsTest {
 	mover := mocks.NewMockImageMover(ctrl)
 	importer := mocks.NewMockChartImporter(ctrl)
 	fileImporter := mocks.NewMockFileImporter(ctrl)
+	verifier := mocks.NewMockImageVerifier(ctrl)
 	images := []releasev1.Image{
 		{
 			Name: "image 1",
@@ -80,9 +83,11 @@ func newImportArtifactsTest(t *testing.T) *importArtifactsTest {
 			TmpArtifactsFolder: downloadFolder,
 			Bundles:            bundles,
 			FileImporter:       fileImporter,
+			ImageVerifier:      verifier,
 		},
 		bundles:      bundles,
 		fileImporter: fileImporter,
+		verifier:     verifier,
 	}
 }
 
@@ -96,3 +101,96 @@ func TestImportRun(t *testing.T) {
 
 	tt.Expect(tt.command.Run(tt.ctx)).To(Succeed())
 }
+
+func TestImportRunBaseBundles(t *testing.T) {
+	tt := newImportArtifactsTest(t)
+	base := &releasev1.Bundles{Spec: releasev1.BundlesSpec{Number: 1}}
+	tt.command.BaseBundles = base
+	tt.reader.EXPECT().ReadImagesFromBundles(tt.ctx, tt.bundles).Return(tt.images, nil)
+	tt.reader.EXPECT().ReadImagesFromBundles(tt.ctx, base).Return(tt.images[:1], nil)
+	tt.reader.EXPECT().ReadChartsFromBundles(tt.ctx, tt.bundles).Return(tt.charts)
+	tt.reader.EXPECT().ReadChartsFromBundles(tt.ctx, base).Return(tt.charts[1:])
+	tt.verifier.EXPECT().Verify(tt.ctx, "image1:1", "package-chart:v1.0.0")
+	tt.mover.EXPECT().Move(tt.ctx, "image2:1")
+	tt.fileImporter.EXPECT().Push(tt.ctx, tt.bundles)
+	tt.importer.EXPECT().Import(tt.ctx, "chart:v1.0.0")
+
+	tt.Expect(tt.command.Run(tt.ctx)).To(Succeed())
+}
+
+func TestImportRunBaseBundlesSkipsUnchangedPackageBundles(t *testing.T) {
+	tt := newImportArtifactsTest(t)
+	tt.bundles.Spec.VersionsBundles = []releasev1.VersionsBundle{{KubeVersion: "1.23"}, {KubeVersion: "1.24"}}
+	base := &releasev1.Bundles{
+		Spec: releasev1.BundlesSpec{
+			Number:          1,
+			VersionsBundles: []releasev1.VersionsBundle{{KubeVersion: "1.23"}},
+		},
+	}
+	tt.command.BaseBundles = base
+	wantFileBundles := tt.bundles.DeepCopy()
+	wantFileBundles.Spec.VersionsBundles = wantFileBundles.Spec.VersionsBundles[1:]
+	tt.reader.EXPECT().ReadImagesFromBundles(tt.ctx, tt.bundles).Return(tt.images, nil)
+	tt.reader.EXPECT().ReadImagesFromBundles(tt.ctx, base).Return(tt.images, nil)
+	tt.reader.EXPECT().ReadChartsFromBundles(tt.ctx, tt.bundles).Return(tt.charts)
+	tt.reader.EXPECT().ReadChartsFromBundles(tt.ctx, base).Return(tt.charts)
+	tt.verifier.EXPECT().Verify(tt.ctx, "image1:1", "image2:1", "chart:v1.0.0", "package-chart:v1.0.0")
+	tt.mover.EXPECT().Move(tt.ctx)
+	tt.fileImporter.EXPECT().Push(tt.ctx, wantFileBundles)
+	tt.importer.EXPECT().Import(tt.ctx)
+
+	tt.Expect(tt.command.Run(tt.ctx)).To(Succeed())
+}
+
+func TestImportRunBaseArtifactsMissing(t *testing.T) {
+	tt := newImportArtifactsTest(t)
+	base := &releasev1.Bundles{Spec: releasev1.BundlesSpec{Number: 1}}
+	tt.command.BaseBundles = base
+	tt.reader.EXPECT().ReadImagesFromBundles(tt.ctx, tt.bundles).Return(tt.images, nil)
+	tt.reader.EXPECT().ReadImagesFromBundles(tt.ctx, base).Return(tt.images, nil)
+	tt.reader.EXPECT().ReadChartsFromBundles(tt.ctx, tt.bundles).Return(tt.charts)
+	tt.reader.EXPECT().ReadChartsFromBundles(tt.ctx, base).Return(nil)
+	tt.verifier.EXPECT().Verify(tt.ctx, "image1:1", "image2:1").Return(errors.New("images missing from registry harbor.local: image2:1"))
+
+	tt.Expect(tt.command.Run(tt.ctx)).To(MatchError(ContainSubstring("import the base artifacts first: images missing from registry harbor.local: image2:1")))
+}
+
+func TestImportRunVerifiesDigests(t *testing.T) {
+	tt := newImportArtifactsTest(t)
+	verifier := mocks.NewMockDigestVerifier(gomock.NewController(t))
+	tt.command.DigestVerifier = verifier
+	tt.reader.EXPECT().ReadImagesFromBundles(tt.ctx, tt.bundles).Return(tt.images, nil)
+	tt.reader.EXPECT().ReadChartsFromBundles(tt.ctx, tt.bundles).Return(tt.charts)
+	tt.mover.EXPECT().Move(tt.ctx, "image1:1", "image2:1")
+	verifier.EXPECT().VerifyDigests(tt.ctx, tt.images).Return(errors.New("image digests don't match bundle"))
+
+	tt.Expect(tt.command.Run(tt.ctx)).To(MatchError("verifying imported images: image digests don't match bundle"))
+}
+
+func TestImportRunVerifiesSignaturesBeforePush(t *testing.T) {
+	tt := newImportArtifactsTest(t)
+	verifier := mocks.NewMockSignatureVerifier(gomock.NewController(t))
+	tt.command.SignatureVerifier = verifier
+	tt.reader.EXPECT().ReadImagesFromBundles(tt.ctx, tt.bundles).Return(tt.images, nil)
+	tt.reader.EXPECT().ReadChartsFromBundles(tt.ctx, tt.bundles).Return(tt.charts)
+	gomock.InOrder(
+		verifier.EXPECT().VerifySignatures(tt.ctx, "image1:1", "image2:1"),
+		tt.mover.EXPECT().Move(tt.ctx, "image1:1", "image2:1"),
+	)
+	tt.fileImporter.EXPECT().Push(tt.ctx, tt.bundles)
+	tt.importer.EXPECT().Import(tt.ctx, "chart:v1.0.0", "package-chart:v1.0.0")
+
+	tt.Expect(tt.command.Run(tt.ctx)).To(Succeed())
+}
+
+func TestImportRunInvalidSignatureSkipsPush(t *testing.T) {
+	tt := newImportArtifactsTest(t)
+	verifier := mocks.NewMockSignatureVerifier(gomock.NewController(t))
+	tt.command.SignatureVerifier = verifier
+	tt.reader.EXPECT().ReadImagesFromBundles(tt.ctx, tt.bundles).Return(tt.images, nil)
+	tt.reader.EXPECT().ReadChartsFromBundles(tt.ctx, tt.bundles).Return(tt.charts)
+	verifier.EXPECT().VerifySignatures(tt.ctx, "image1:1", "image2:1").Return(errors.New("verifying signature of image image2:1: no signature found"))
+	tt.mover.EXPECT().Move(gomock.Any(), gomock.Any()).Times(0)
+
+	tt.Expect(tt.command.Run(tt.ctx)).To(MatchError("verifying signature of image image2:1: no signature found"))
+}
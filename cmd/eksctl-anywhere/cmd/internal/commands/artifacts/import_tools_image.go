@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"path/filepath"
 
 	"github.com/aws/eks-anywhere/pkg/logger"
 	releasev1 "github.com/aws/eks-anywhere/release/api/v1alpha1"
@@ -31,6 +32,13 @@ func (i ImportToolsImage) Run(ctx context.Context) error {
 		return err
 	}
 
+	// Tarballs from older versions don't include checksums.
+	if _, err := os.Stat(filepath.Join(i.TmpArtifactsFolder, ChecksumsFile)); err == nil {
+		if err = VerifyChecksums(i.TmpArtifactsFolder); err != nil {
+			return err
+		}
+	}
+
 	toolsImage := i.Bundles.DefaultEksAToolsImage().VersionedImage()
 
 	if err := i.ImageMover.Move(ctx, toolsImage); err != nil {
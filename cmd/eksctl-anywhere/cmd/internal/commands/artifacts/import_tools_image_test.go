@@ -68,3 +68,17 @@ func TestImportToolsImageRun(t *testing.T) {
 
 	tt.Expect(tt.command.Run(tt.ctx)).To(Succeed())
 }
+
+func TestImportToolsImageRunVerifiesChecksums(t *testing.T) {
+	tt := newImportToolsImageTest(t)
+	tt.unpackager.EXPECT().UnPackage(tt.command.InputFile, tt.command.TmpArtifactsFolder).DoAndReturn(func(_, folder string) error {
+		writeArtifactFiles(t, folder, map[string]string{"tools-image.tar": "tools"})
+		if err := artifacts.WriteChecksums(folder); err != nil {
+			return err
+		}
+		writeArtifactFiles(t, folder, map[string]string{"tools-image.tar": "tampered"})
+		return nil
+	})
+
+	tt.Expect(tt.command.Run(tt.ctx)).To(MatchError(ContainSubstring("tools-image.tar: checksum")))
+}
@@ -234,3 +234,40 @@ func (mr *MockPackagerMockRecorder) Package(folder, dstFile interface{}) *gomock
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Package", reflect.TypeOf((*MockPackager)(nil).Package), folder, dstFile)
 }
+
+// MockDigestVerifier is a mock of DigestVerifier interface.
+type MockDigestVerifier struct {
+	ctrl     *gomock.Controller
+	recorder *MockDigestVerifierMockRecorder
+}
+
+// MockDigestVerifierMockRecorder is the mock recorder for MockDigestVerifier.
+type MockDigestVerifierMockRecorder struct {
+	mock *MockDigestVerifier
+}
+
+// NewMockDigestVerifier creates a new mock instance.
+func NewMockDigestVerifier(ctrl *gomock.Controller) *MockDigestVerifier {
+	mock := &MockDigestVerifier{ctrl: ctrl}
+	mock.recorder = &MockDigestVerifierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDigestVerifier) EXPECT() *MockDigestVerifierMockRecorder {
+	return m.recorder
+}
+
+// VerifyDigests mocks base method.
+func (m *MockDigestVerifier) VerifyDigests(ctx context.Context, images []v1alpha1.Image) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyDigests", ctx, images)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// VerifyDigests indicates an expected call of VerifyDigests.
+func (mr *MockDigestVerifierMockRecorder) VerifyDigests(ctx, images interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyDigests", reflect.TypeOf((*MockDigestVerifier)(nil).VerifyDigests), ctx, images)
+}
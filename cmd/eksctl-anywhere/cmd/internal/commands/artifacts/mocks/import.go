@@ -12,6 +12,90 @@ import (
 	gomock "github.com/golang/mock/gomock"
 )
 
+// MockSignatureVerifier is a mock of SignatureVerifier interface.
+type MockSignatureVerifier struct {
+	ctrl     *gomock.Controller
+	recorder *MockSignatureVerifierMockRecorder
+}
+
+// MockSignatureVerifierMockRecorder is the mock recorder for MockSignatureVerifier.
+type MockSignatureVerifierMockRecorder struct {
+	mock *MockSignatureVerifier
+}
+
+// NewMockSignatureVerifier creates a new mock instance.
+func NewMockSignatureVerifier(ctrl *gomock.Controller) *MockSignatureVerifier {
+	mock := &MockSignatureVerifier{ctrl: ctrl}
+	mock.recorder = &MockSignatureVerifierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSignatureVerifier) EXPECT() *MockSignatureVerifierMockRecorder {
+	return m.recorder
+}
+
+// VerifySignatures mocks base method.
+func (m *MockSignatureVerifier) VerifySignatures(ctx context.Context, images ...string) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range images {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "VerifySignatures", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// VerifySignatures indicates an expected call of VerifySignatures.
+func (mr *MockSignatureVerifierMockRecorder) VerifySignatures(ctx interface{}, images ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, images...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifySignatures", reflect.TypeOf((*MockSignatureVerifier)(nil).VerifySignatures), varargs...)
+}
+
+// MockImageVerifier is a mock of ImageVerifier interface.
+type MockImageVerifier struct {
+	ctrl     *gomock.Controller
+	recorder *MockImageVerifierMockRecorder
+}
+
+// MockImageVerifierMockRecorder is the mock recorder for MockImageVerifier.
+type MockImageVerifierMockRecorder struct {
+	mock *MockImageVerifier
+}
+
+// NewMockImageVerifier creates a new mock instance.
+func NewMockImageVerifier(ctrl *gomock.Controller) *MockImageVerifier {
+	mock := &MockImageVerifier{ctrl: ctrl}
+	mock.recorder = &MockImageVerifierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockImageVerifier) EXPECT() *MockImageVerifierMockRecorder {
+	return m.recorder
+}
+
+// Verify mocks base method.
+func (m *MockImageVerifier) Verify(ctx context.Context, images ...string) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range images {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Verify", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Verify indicates an expected call of Verify.
+func (mr *MockImageVerifierMockRecorder) Verify(ctx interface{}, images ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, images...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Verify", reflect.TypeOf((*MockImageVerifier)(nil).Verify), varargs...)
+}
+
 // MockChartImporter is a mock of ChartImporter interface.
 type MockChartImporter struct {
 	ctrl     *gomock.Controller
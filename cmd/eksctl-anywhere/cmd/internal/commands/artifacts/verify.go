@@ -0,0 +1,56 @@
+package artifacts
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/aws/eks-anywhere/pkg/logger"
+)
+
+// Verify checks an artifacts tarball against the checksums it was packaged with, without network access.
+type Verify struct {
+	InputFile          string
+	UnPackager         UnPackager
+	TmpArtifactsFolder string
+}
+
+func (v Verify) Run(ctx context.Context) error {
+	if err := os.MkdirAll(v.TmpArtifactsFolder, os.ModePerm); err != nil {
+		return fmt.Errorf("creating tmp artifact folder to verify artifacts: %v", err)
+	}
+	defer os.RemoveAll(v.TmpArtifactsFolder)
+
+	logger.Info("Unpackaging artifacts", "dst", v.TmpArtifactsFolder)
+	if err := v.UnPackager.UnPackage(v.InputFile, v.TmpArtifactsFolder); err != nil {
+		return err
+	}
+
+	// download artifacts tarballs have their files in a top level folder.
+	var folders []string
+	err := filepath.WalkDir(v.TmpArtifactsFolder, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !entry.IsDir() && entry.Name() == ChecksumsFile {
+			folders = append(folders, filepath.Dir(path))
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("reading artifacts: %v", err)
+	}
+	if len(folders) == 0 {
+		return fmt.Errorf("no %s found in %s, it was created by a version without artifact checksums", ChecksumsFile, v.InputFile)
+	}
+
+	for _, folder := range folders {
+		if err = VerifyChecksums(folder); err != nil {
+			return err
+		}
+	}
+	logger.Info("Artifacts verified", "file", v.InputFile)
+	return nil
+}
@@ -0,0 +1,76 @@
+package artifacts_test
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+
+	"github.com/aws/eks-anywhere/cmd/eksctl-anywhere/cmd/internal/commands/artifacts"
+	"github.com/aws/eks-anywhere/cmd/eksctl-anywhere/cmd/internal/commands/artifacts/mocks"
+)
+
+type verifyArtifactsTest struct {
+	*WithT
+	ctx        context.Context
+	unpackager *mocks.MockUnPackager
+	command    *artifacts.Verify
+}
+
+func newVerifyArtifactsTest(t *testing.T) *verifyArtifactsTest {
+	unpackager := mocks.NewMockUnPackager(gomock.NewController(t))
+	return &verifyArtifactsTest{
+		WithT:      NewWithT(t),
+		ctx:        context.Background(),
+		unpackager: unpackager,
+		command: &artifacts.Verify{
+			InputFile:          "artifacts.tar",
+			UnPackager:         unpackager,
+			TmpArtifactsFolder: filepath.Join(t.TempDir(), "tmp-folder"),
+		},
+	}
+}
+
+func (tt *verifyArtifactsTest) expectUnPackage(t *testing.T, files map[string]string, tamper map[string]string) {
+	tt.unpackager.EXPECT().UnPackage("artifacts.tar", tt.command.TmpArtifactsFolder).DoAndReturn(func(_, folder string) error {
+		folder = filepath.Join(folder, "eks-anywhere-downloads")
+		writeArtifactFiles(t, folder, files)
+		if err := artifacts.WriteChecksums(folder); err != nil {
+			return err
+		}
+		writeArtifactFiles(t, folder, tamper)
+		return nil
+	})
+}
+
+func TestVerifyRun(t *testing.T) {
+	tt := newVerifyArtifactsTest(t)
+	tt.expectUnPackage(t, map[string]string{"bundle-release.yaml": "bundle", "1.24/cilium/cilium.yaml": "cilium"}, nil)
+
+	tt.Expect(tt.command.Run(tt.ctx)).To(Succeed())
+	tt.Expect(tt.command.TmpArtifactsFolder).NotTo(BeADirectory())
+}
+
+func TestVerifyRunTampered(t *testing.T) {
+	tt := newVerifyArtifactsTest(t)
+	tt.expectUnPackage(t, map[string]string{"bundle-release.yaml": "bundle"}, map[string]string{"bundle-release.yaml": "tampered"})
+
+	tt.Expect(tt.command.Run(tt.ctx)).To(MatchError(ContainSubstring("bundle-release.yaml: checksum")))
+}
+
+func TestVerifyRunNoChecksums(t *testing.T) {
+	tt := newVerifyArtifactsTest(t)
+	tt.unpackager.EXPECT().UnPackage("artifacts.tar", tt.command.TmpArtifactsFolder)
+
+	tt.Expect(tt.command.Run(tt.ctx)).To(MatchError("no SHA256SUMS found in artifacts.tar, it was created by a version without artifact checksums"))
+}
+
+func TestVerifyRunUnPackageError(t *testing.T) {
+	tt := newVerifyArtifactsTest(t)
+	tt.unpackager.EXPECT().UnPackage("artifacts.tar", tt.command.TmpArtifactsFolder).Return(errors.New("not a tarball"))
+
+	tt.Expect(tt.command.Run(tt.ctx)).To(MatchError("not a tarball"))
+}
@@ -0,0 +1,16 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// mirrorCmd represents the mirror command.
+var mirrorCmd = &cobra.Command{
+	Use:   "mirror",
+	Short: "Mirror resources",
+	Long:  "Mirror EKS Anywhere resources and artifacts to a registry",
+}
+
+func init() {
+	rootCmd.AddCommand(mirrorCmd)
+}
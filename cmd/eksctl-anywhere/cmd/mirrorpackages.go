@@ -0,0 +1,149 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/aws/eks-anywhere/pkg/curatedpackages"
+	"github.com/aws/eks-anywhere/pkg/dependencies"
+	"github.com/aws/eks-anywhere/pkg/logger"
+	"github.com/aws/eks-anywhere/pkg/manifests/bundles"
+	"github.com/aws/eks-anywhere/pkg/registry"
+)
+
+// mirrorPackagesCmd is the context for the mirror packages command.
+var mirrorPackagesCmd = &cobra.Command{
+	Use:          "packages",
+	Short:        "Mirror curated package bundles, charts and images to a registry",
+	Long:         `Mirror the EKS Anywhere curated package bundles, helm charts and images of the given Kubernetes versions to a registry, preserving their digests, and verify them in the registry.`,
+	SilenceUsage: true,
+	RunE:         runMirrorPackages,
+	Args: func(cmd *cobra.Command, args []string) error {
+		if err := cobra.ExactArgs(1)(cmd, args); err != nil {
+			return fmt.Errorf("A destination must be specified as an argument")
+		}
+		return nil
+	},
+}
+
+func init() {
+	mirrorCmd.AddCommand(mirrorPackagesCmd)
+
+	mirrorPackagesCmd.Flags().StringVarP(&mirrorPackagesCommand.bundleFile, "bundle", "b", "", "EKS-A bundle file to read package bundles from")
+	if err := mirrorPackagesCmd.MarkFlagRequired("bundle"); err != nil {
+		log.Fatalf("Cannot mark 'bundle' flag as required: %s", err)
+	}
+	mirrorPackagesCmd.Flags().StringSliceVar(&mirrorPackagesCommand.kubeVersions, "kube-versions", nil, "Kubernetes versions to mirror the package bundles of, all the versions of the EKS-A bundle by default")
+	mirrorPackagesCmd.Flags().StringVarP(&mirrorPackagesCommand.dstCert, "dst-cert", "", "", "TLS certificate for destination registry")
+	mirrorPackagesCmd.Flags().StringVarP(&mirrorPackagesCommand.srcCert, "src-cert", "", "", "TLS certificate for source registry")
+	mirrorPackagesCmd.Flags().BoolVar(&mirrorPackagesCommand.insecure, "insecure", false, "Skip TLS verification while mirroring images and charts")
+	mirrorPackagesCmd.Flags().BoolVar(&mirrorPackagesCommand.dryRun, "dry-run", false, "Dry run mirror to print the artifacts that would be mirrored")
+	mirrorPackagesCmd.Flags().StringVar(&mirrorPackagesCommand.reportFile, "report", "", "File to write the json verification report to")
+}
+
+var mirrorPackagesCommand = MirrorPackagesCommand{}
+
+// MirrorPackagesCommand mirrors the curated packages artifacts of a bundle to a destination.
+type MirrorPackagesCommand struct {
+	destination  string
+	bundleFile   string
+	kubeVersions []string
+	srcCert      string
+	dstCert      string
+	insecure     bool
+	dryRun       bool
+	reportFile   string
+}
+
+func runMirrorPackages(_ *cobra.Command, args []string) error {
+	ctx := context.Background()
+	mirrorPackagesCommand.destination = args[0]
+
+	credentialStore := registry.NewCredentialStore()
+	err := credentialStore.Init()
+	if err != nil {
+		return err
+	}
+
+	return mirrorPackagesCommand.call(ctx, credentialStore)
+}
+
+func (c MirrorPackagesCommand) call(ctx context.Context, credentialStore *registry.CredentialStore) error {
+	factory := dependencies.NewFactory()
+	deps, err := factory.
+		WithManifestReader().
+		Build(ctx)
+	if err != nil {
+		return err
+	}
+
+	eksaBundle, err := bundles.Read(deps.ManifestReader, c.bundleFile)
+	if err != nil {
+		return err
+	}
+	eksaBundle, err = curatedpackages.FilterBundlesForKubeVersions(eksaBundle, c.kubeVersions)
+	if err != nil {
+		return err
+	}
+
+	registryCache := registry.NewCache()
+	charts, images, err := curatedpackages.NewPackageReader(registryCache, credentialStore).ReadPackageArtifacts(ctx, eksaBundle)
+	if err != nil {
+		return err
+	}
+
+	srcCertificates, err := registry.GetCertificates(c.srcCert)
+	if err != nil {
+		return err
+	}
+	dstCertificates, err := registry.GetCertificates(c.dstCert)
+	if err != nil {
+		return err
+	}
+
+	// The destination client isn't cached with the source clients as its project changes between
+	// charts and images.
+	dstRegistry := registry.NewOCIRegistry(registry.NewStorageContext(c.destination, credentialStore, dstCertificates, c.insecure))
+	if err = dstRegistry.Init(); err != nil {
+		return fmt.Errorf("error with repository %s: %v", c.destination, err)
+	}
+
+	mirror := curatedpackages.NewPackageMirror(registryCache, credentialStore, srcCertificates, c.insecure, c.dryRun)
+	report := mirror.Mirror(ctx, dstRegistry, charts)
+	dstRegistry.SetProject("curated-packages/")
+	imagesReport := mirror.Mirror(ctx, dstRegistry, images)
+	report.Artifacts = append(report.Artifacts, imagesReport.Artifacts...)
+
+	if err = report.WriteTable(os.Stdout); err != nil {
+		return err
+	}
+	if c.reportFile != "" {
+		if err = c.writeReport(report); err != nil {
+			return err
+		}
+	}
+
+	if failed := report.Failed(); failed > 0 {
+		return fmt.Errorf("failed mirroring %d of %d curated packages artifacts", failed, len(report.Artifacts))
+	}
+	if !c.dryRun {
+		logger.MarkSuccess("Curated packages mirrored and verified", "artifacts", len(report.Artifacts), "destination", c.destination)
+	}
+	return nil
+}
+
+func (c MirrorPackagesCommand) writeReport(report *curatedpackages.MirrorReport) error {
+	f, err := os.Create(c.reportFile)
+	if err != nil {
+		return fmt.Errorf("creating mirror report: %v", err)
+	}
+	defer f.Close()
+	if err = report.WriteJSON(f); err != nil {
+		return fmt.Errorf("writing mirror report: %v", err)
+	}
+	return nil
+}
@@ -13,11 +13,14 @@ import (
 	"github.com/aws/eks-anywhere/pkg/cluster"
 	"github.com/aws/eks-anywhere/pkg/clustermanager"
 	"github.com/aws/eks-anywhere/pkg/config"
+	"github.com/aws/eks-anywhere/pkg/dependencies"
+	"github.com/aws/eks-anywhere/pkg/git/gitclient"
 	"github.com/aws/eks-anywhere/pkg/kubeconfig"
 	"github.com/aws/eks-anywhere/pkg/logger"
 	"github.com/aws/eks-anywhere/pkg/providers/cloudstack/decoder"
 	"github.com/aws/eks-anywhere/pkg/types"
 	"github.com/aws/eks-anywhere/pkg/version"
+	"github.com/aws/eks-anywhere/pkg/workflows/interfaces"
 )
 
 const timeoutErrorTemplate = "failed to parse timeout %s: %v"
@@ -145,8 +148,27 @@ func markFlagHidden(flagSet *pflag.FlagSet, flagName string) {
 }
 
 func buildCliConfig(clusterSpec *cluster.Spec) *config.CliConfig {
-	cliConfig := &config.CliConfig{}
-	if clusterSpec.FluxConfig != nil && clusterSpec.FluxConfig.Spec.Git != nil {
+	cliConfig := &config.CliConfig{
+		GitAuthorName:  os.Getenv(config.EksaGitAuthorNameEnv),
+		GitAuthorEmail: os.Getenv(config.EksaGitAuthorEmailEnv),
+	}
+	if signingKey := os.Getenv(config.EksaGitSigningKeyEnv); gitclient.IsGPGKeyFile(signingKey) {
+		cliConfig.GitGPGKeyRingFile = signingKey
+		cliConfig.GitGPGPassphrase = os.Getenv(config.EksaGitSigningPassphraseEnv)
+	}
+
+	git := clusterSpec.GitProviderConfig()
+	if git == nil {
+		return cliConfig
+	}
+
+	switch {
+	case git.IsHTTPS() && git.GithubApp != nil:
+		cliConfig.GitGithubAppPrivateKeyFile = os.Getenv(config.EksaGitGithubAppKeyEnv)
+	case git.IsHTTPS():
+		cliConfig.GitUsername = os.Getenv(config.EksaGitUsernameEnv)
+		cliConfig.GitPassword = os.Getenv(config.EksaGitPasswordEnv)
+	default:
 		cliConfig.GitSshKeyPassphrase = os.Getenv(config.EksaGitPassphraseTokenEnv)
 		cliConfig.GitPrivateKeyFile = os.Getenv(config.EksaGitPrivateKeyTokenEnv)
 		cliConfig.GitKnownHostsFile = os.Getenv(config.EksaGitKnownHostsFileEnv)
@@ -155,6 +177,15 @@ func buildCliConfig(clusterSpec *cluster.Spec) *config.CliConfig {
 	return cliConfig
 }
 
+// gitOpsManager returns the GitOps engine configured for the cluster, Argo CD when the cluster uses an
+// ArgoCDConfig and Flux otherwise.
+func gitOpsManager(deps *dependencies.Dependencies, clusterSpec *cluster.Spec) interfaces.GitOpsManager {
+	if clusterSpec.ArgoCDConfig != nil {
+		return deps.GitOpsArgoCD
+	}
+	return deps.GitOpsFlux
+}
+
 func getManagementCluster(clusterSpec *cluster.Spec) *types.Cluster {
 	if clusterSpec.ManagementCluster == nil {
 		return &types.Cluster{
@@ -171,10 +202,19 @@ func getManagementCluster(clusterSpec *cluster.Spec) *types.Cluster {
 
 func (c *clusterOptions) directoriesToMount(clusterSpec *cluster.Spec, cliConfig *config.CliConfig, addDirs ...string) ([]string, error) {
 	dirs := c.mountDirs()
-	fluxConfig := clusterSpec.FluxConfig
-	if fluxConfig != nil && fluxConfig.Spec.Git != nil {
-		dirs = append(dirs, filepath.Dir(cliConfig.GitPrivateKeyFile))
-		dirs = append(dirs, filepath.Dir(cliConfig.GitKnownHostsFile))
+	if clusterSpec.GitProviderConfig() != nil {
+		if cliConfig.GitPrivateKeyFile != "" {
+			dirs = append(dirs, filepath.Dir(cliConfig.GitPrivateKeyFile))
+		}
+		if cliConfig.GitKnownHostsFile != "" {
+			dirs = append(dirs, filepath.Dir(cliConfig.GitKnownHostsFile))
+		}
+		if cliConfig.GitGithubAppPrivateKeyFile != "" {
+			dirs = append(dirs, filepath.Dir(cliConfig.GitGithubAppPrivateKeyFile))
+		}
+		if cliConfig.GitGPGKeyRingFile != "" {
+			dirs = append(dirs, filepath.Dir(cliConfig.GitGPGKeyRingFile))
+		}
 	}
 
 	if clusterSpec.Config.Cluster.Spec.DatacenterRef.Kind == v1alpha1.CloudStackDatacenterKind {
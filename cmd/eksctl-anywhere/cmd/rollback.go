@@ -0,0 +1,15 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+var rollbackCmd = &cobra.Command{
+	Use:   "rollback",
+	Short: "Rollback resources",
+	Long:  "Use eksctl anywhere rollback to restore resources to a previous version, such as curated packages",
+}
+
+func init() {
+	rootCmd.AddCommand(rollbackCmd)
+}
@@ -0,0 +1,78 @@
+package cmd
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/aws/eks-anywhere/pkg/kubeconfig"
+	"github.com/aws/eks-anywhere/pkg/logger"
+)
+
+type rollbackPackageOptions struct {
+	wait    bool
+	timeout time.Duration
+	// kubeConfig is an optional kubeconfig file to use when querying an
+	// existing cluster.
+	kubeConfig  string
+	clusterName string
+}
+
+var rpo = &rollbackPackageOptions{}
+
+func init() {
+	rollbackCmd.AddCommand(rollbackPackageCommand)
+
+	rollbackPackageCommand.Flags().BoolVar(&rpo.wait, "wait", false,
+		"Wait for the package controller to install the previous version")
+	rollbackPackageCommand.Flags().DurationVar(&rpo.timeout, "timeout", 10*time.Minute,
+		"Time to wait for the package to be installed with --wait")
+	rollbackPackageCommand.Flags().StringVar(&rpo.kubeConfig, "kubeconfig", "",
+		"Path to an optional kubeconfig file to use.")
+	rollbackPackageCommand.Flags().StringVar(&rpo.clusterName, "cluster", "",
+		"Cluster of the package to roll back.")
+
+	if err := rollbackPackageCommand.MarkFlagRequired("cluster"); err != nil {
+		log.Fatalf("Error marking flag as required: %v", err)
+	}
+}
+
+var rollbackPackageCommand = &cobra.Command{
+	Use:          "package [flags] <package>",
+	Short:        "Rollback a curated package to its previous version",
+	Long:         "Restore the version a curated package had before its last upgrade with eksctl anywhere upgrade package",
+	PreRunE:      preRunPackages,
+	SilenceUsage: true,
+	Args:         cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return rollbackPackage(cmd.Context(), args[0])
+	},
+}
+
+func rollbackPackage(ctx context.Context, name string) error {
+	kubeConfig, err := kubeconfig.ResolveAndValidateFilename(rpo.kubeConfig, "")
+	if err != nil {
+		return err
+	}
+
+	packageClient, err := newActiveBundlePackageClient(ctx, kubeConfig, rpo.clusterName)
+	if err != nil {
+		return err
+	}
+	version, err := packageClient.RollbackPackage(ctx, name, kubeConfig, rpo.clusterName)
+	if err != nil {
+		return err
+	}
+
+	if !rpo.wait {
+		return nil
+	}
+	logger.Info("Waiting for package to be installed", "package", name, "version", version)
+	if err = packageClient.WaitForPackage(ctx, name, version, kubeConfig, rpo.clusterName, rpo.timeout); err != nil {
+		return err
+	}
+	logger.MarkSuccess("Package rolled back", "package", name, "version", version)
+	return nil
+}
@@ -0,0 +1,80 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/spf13/cobra"
+
+	"github.com/aws/eks-anywhere/pkg/config"
+	"github.com/aws/eks-anywhere/pkg/constants"
+	"github.com/aws/eks-anywhere/pkg/dependencies"
+	"github.com/aws/eks-anywhere/pkg/logger"
+	"github.com/aws/eks-anywhere/pkg/registrymirror"
+)
+
+type rotateRegistryCredentialsOptions struct {
+	clusterOptions
+}
+
+var rrco = &rotateRegistryCredentialsOptions{}
+
+var rotateRegistryCredentialsCmd = &cobra.Command{
+	Use:          "registry-credentials",
+	Short:        "Rotate the registry mirror credentials of a cluster",
+	Long:         "This command stores the registry mirror credentials set in the REGISTRY_USERNAME and REGISTRY_PASSWORD env vars in the management cluster. The EKS Anywhere controller propagates them to the nodes of every cluster that uses an authenticated registry mirror, without rolling out the nodes",
+	PreRunE:      bindFlagsToViper,
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return rrco.rotateRegistryCredentials(cmd.Context())
+	},
+}
+
+func init() {
+	rotateCmd.AddCommand(rotateRegistryCredentialsCmd)
+	rotateRegistryCredentialsCmd.Flags().StringVarP(&rrco.fileName, "filename", "f", "", "Filename that contains EKS-A cluster configuration")
+	rotateRegistryCredentialsCmd.Flags().StringVar(&rrco.bundlesOverride, "bundles-override", "", "Override default Bundles manifest (not recommended)")
+	rotateRegistryCredentialsCmd.Flags().StringVar(&rrco.managementKubeconfig, "kubeconfig", "", "Management cluster kubeconfig file")
+	if err := rotateRegistryCredentialsCmd.MarkFlagRequired("filename"); err != nil {
+		log.Fatalf("Error marking flag as required: %v", err)
+	}
+}
+
+func (o *rotateRegistryCredentialsOptions) rotateRegistryCredentials(ctx context.Context) error {
+	clusterSpec, err := newClusterSpec(o.clusterOptions)
+	if err != nil {
+		return err
+	}
+	if !clusterSpec.Cluster.RegistryAuth() {
+		return fmt.Errorf("cluster %s doesn't use an authenticated registry mirror", clusterSpec.Cluster.Name)
+	}
+
+	username, password, err := config.ReadCredentials()
+	if err != nil {
+		return err
+	}
+
+	cliConfig := buildCliConfig(clusterSpec)
+	dirs, err := o.directoriesToMount(clusterSpec, cliConfig)
+	if err != nil {
+		return err
+	}
+
+	deps, err := dependencies.ForSpec(ctx, clusterSpec).WithExecutableMountDirs(dirs...).
+		WithKubectl().
+		Build(ctx)
+	if err != nil {
+		return err
+	}
+	defer close(ctx, deps)
+
+	managementCluster := getManagementCluster(clusterSpec)
+	if err = registrymirror.RotateCredentials(ctx, deps.Kubectl, managementCluster, username, password); err != nil {
+		return err
+	}
+
+	logger.Info("Registry mirror credentials updated in the management cluster", "cluster", managementCluster.Name)
+	logger.Info(fmt.Sprintf("The nodes are refreshed by the %s daemonset in the %s namespace of each cluster", registrymirror.CredentialsRefresherName, constants.EksaSystemNamespace))
+	return nil
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/aws/eks-anywhere/pkg/dependencies"
 	"github.com/aws/eks-anywhere/pkg/diagnostics"
 	"github.com/aws/eks-anywhere/pkg/kubeconfig"
+	"github.com/aws/eks-anywhere/pkg/logger"
 	"github.com/aws/eks-anywhere/pkg/version"
 )
 
@@ -24,6 +25,7 @@ type createSupportBundleOptions struct {
 	bundleConfig          string
 	hardwareFileName      string
 	tinkerbellBootstrapIP string
+	output                string
 }
 
 var csbo = &createSupportBundleOptions{}
@@ -52,6 +54,7 @@ func init() {
 	supportbundleCmd.Flags().StringVarP(&csbo.bundleConfig, "bundle-config", "", "", "Bundle Config file to use when generating support bundle")
 	supportbundleCmd.Flags().StringVarP(&csbo.fileName, "filename", "f", "", "Filename that contains EKS-A cluster configuration")
 	supportbundleCmd.Flags().StringVarP(&csbo.wConfig, "w-config", "w", "", "Kubeconfig file to use when creating support bundle for a workload cluster")
+	supportbundleCmd.Flags().StringVarP(&csbo.output, outputFlagName, "o", outputDefault, "Output format of the analysis: text|json")
 	err := supportbundleCmd.MarkFlagRequired("filename")
 	if err != nil {
 		log.Fatalf("Error marking flag as required: %v", err)
@@ -59,6 +62,10 @@ func init() {
 }
 
 func (csbo *createSupportBundleOptions) validate(ctx context.Context) error {
+	if csbo.output != outputText && csbo.output != outputJson {
+		return fmt.Errorf("invalid output format [%s]", csbo.output)
+	}
+
 	clusterConfig, err := commonValidation(ctx, csbo.fileName)
 	if err != nil {
 		return err
@@ -113,8 +120,16 @@ func (csbo *createSupportBundleOptions) createBundle(ctx context.Context, since,
 		return fmt.Errorf("collecting and analyzing bundle: %v", err)
 	}
 
-	err = supportBundle.PrintAnalysis()
-	if err != nil {
+	if csbo.output == outputJson {
+		analysisPath, err := supportBundle.WriteAnalysisJSONToFile()
+		if err != nil {
+			return fmt.Errorf("writing analysis: %v", err)
+		}
+		logger.Info("Analysis json output generated", "path", analysisPath)
+		return nil
+	}
+
+	if err = supportBundle.PrintAnalysis(); err != nil {
 		return fmt.Errorf("printing analysis")
 	}
 
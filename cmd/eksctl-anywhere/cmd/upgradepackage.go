@@ -0,0 +1,99 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/aws/eks-anywhere/pkg/curatedpackages"
+	"github.com/aws/eks-anywhere/pkg/kubeconfig"
+	"github.com/aws/eks-anywhere/pkg/logger"
+)
+
+type upgradeSinglePackageOptions struct {
+	version string
+	wait    bool
+	timeout time.Duration
+	// kubeConfig is an optional kubeconfig file to use when querying an
+	// existing cluster.
+	kubeConfig  string
+	clusterName string
+}
+
+var uspo = &upgradeSinglePackageOptions{}
+
+func init() {
+	upgradeCmd.AddCommand(upgradePackageCommand)
+
+	upgradePackageCommand.Flags().StringVar(&uspo.version, "version", "",
+		"Version of the package to upgrade to, by name or digest")
+	upgradePackageCommand.Flags().BoolVar(&uspo.wait, "wait", false,
+		"Wait for the package controller to install the new version")
+	upgradePackageCommand.Flags().DurationVar(&uspo.timeout, "timeout", 10*time.Minute,
+		"Time to wait for the package to be installed with --wait")
+	upgradePackageCommand.Flags().StringVar(&uspo.kubeConfig, "kubeconfig", "",
+		"Path to an optional kubeconfig file to use.")
+	upgradePackageCommand.Flags().StringVar(&uspo.clusterName, "cluster", "",
+		"Cluster of the package to upgrade.")
+
+	if err := upgradePackageCommand.MarkFlagRequired("version"); err != nil {
+		log.Fatalf("Error marking flag as required: %v", err)
+	}
+	if err := upgradePackageCommand.MarkFlagRequired("cluster"); err != nil {
+		log.Fatalf("Error marking flag as required: %v", err)
+	}
+}
+
+var upgradePackageCommand = &cobra.Command{
+	Use:          "package [flags] <package>",
+	Short:        "Upgrade a curated package to another version",
+	Long:         "Upgrade an installed curated package to another version of the active package bundle. The previous version is recorded so the package can be rolled back",
+	PreRunE:      preRunPackages,
+	SilenceUsage: true,
+	Args:         cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return upgradePackage(cmd.Context(), args[0])
+	},
+}
+
+func upgradePackage(ctx context.Context, name string) error {
+	kubeConfig, err := kubeconfig.ResolveAndValidateFilename(uspo.kubeConfig, "")
+	if err != nil {
+		return err
+	}
+
+	packageClient, err := newActiveBundlePackageClient(ctx, kubeConfig, uspo.clusterName)
+	if err != nil {
+		return err
+	}
+	if err = packageClient.UpgradePackage(ctx, name, uspo.version, kubeConfig, uspo.clusterName); err != nil {
+		return err
+	}
+
+	if !uspo.wait {
+		return nil
+	}
+	logger.Info("Waiting for package to be installed", "package", name, "version", uspo.version)
+	if err = packageClient.WaitForPackage(ctx, name, uspo.version, kubeConfig, uspo.clusterName, uspo.timeout); err != nil {
+		return err
+	}
+	logger.MarkSuccess("Package upgraded", "package", name, "version", uspo.version)
+	return nil
+}
+
+// newActiveBundlePackageClient builds a package client for the active package bundle of the cluster.
+func newActiveBundlePackageClient(ctx context.Context, kubeConfig, clusterName string) (*curatedpackages.PackageClient, error) {
+	deps, err := NewDependenciesForPackages(ctx, WithMountPaths(kubeConfig))
+	if err != nil {
+		return nil, fmt.Errorf("unable to initialize executables: %v", err)
+	}
+	b := curatedpackages.NewBundleReader(kubeConfig, clusterName, deps.Kubectl, nil, nil)
+	bundle, dependencies, err := b.GetActiveBundleWithDependencies(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return curatedpackages.NewPackageClient(deps.Kubectl, curatedpackages.WithBundle(bundle), curatedpackages.WithBundleDependencies(dependencies)), nil
+}
@@ -0,0 +1,122 @@
+package cmd
+
+import (
+	"context"
+	"crypto/x509"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/aws/eks-anywhere/pkg/cluster"
+	"github.com/aws/eks-anywhere/pkg/config"
+	"github.com/aws/eks-anywhere/pkg/curatedpackages"
+	"github.com/aws/eks-anywhere/pkg/logger"
+	"github.com/aws/eks-anywhere/pkg/registry"
+	"github.com/aws/eks-anywhere/pkg/registrymirror"
+	"github.com/aws/eks-anywhere/pkg/validations/airgap"
+	"github.com/aws/eks-anywhere/pkg/version"
+)
+
+type validateAirgapOptions struct {
+	fileName     string
+	skipPackages bool
+}
+
+var vago = &validateAirgapOptions{}
+
+var validateAirgapCmd = &cobra.Command{
+	Use:          "airgap -f <cluster-config-file> [flags]",
+	Short:        "Validate air-gapped install readiness",
+	Long:         "Check every image, chart, OS image and EKS-D artifact the cluster needs is reachable from the registry mirror and file servers of the cluster config",
+	PreRunE:      bindFlagsToViper,
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return vago.validateAirgap(cmd.Context())
+	},
+}
+
+func init() {
+	validateCmd.AddCommand(validateAirgapCmd)
+	validateAirgapCmd.Flags().StringVarP(&vago.fileName, "filename", "f", "", "Filename that contains EKS-A cluster configuration")
+	validateAirgapCmd.Flags().BoolVar(&vago.skipPackages, "skip-packages", false, "Skip the curated packages charts and images")
+
+	if err := validateAirgapCmd.MarkFlagRequired("filename"); err != nil {
+		log.Fatalf("Error marking flag as required: %v", err)
+	}
+}
+
+func (o *validateAirgapOptions) validateAirgap(ctx context.Context) error {
+	clusterSpec, err := readAndValidateClusterSpec(o.fileName, version.Get())
+	if err != nil {
+		return fmt.Errorf("unable to get cluster config from file: %v", err)
+	}
+
+	credentialStore := registry.NewCredentialStore()
+	if err = credentialStore.Init(); err != nil {
+		return err
+	}
+	mirror := registrymirror.FromCluster(clusterSpec.Cluster)
+	var certificates *x509.CertPool
+	insecure := false
+	if mirror != nil {
+		if mirror.Auth {
+			username, password, err := config.ReadCredentials()
+			if err != nil {
+				return err
+			}
+			credentialStore.SetCredential(mirror.BaseRegistry, username, password)
+		}
+		if mirror.CACertContent != "" {
+			certificates = x509.NewCertPool()
+			certificates.AppendCertsFromPEM([]byte(mirror.CACertContent))
+		}
+		insecure = mirror.InsecureSkipVerify
+	}
+
+	registryCache := registry.NewCache()
+	requirements := airgap.Requirements(clusterSpec)
+	var bundleErr error
+	if !o.skipPackages {
+		var packageRequirements []airgap.Requirement
+		reader := curatedpackages.NewPackageReader(registryCache, credentialStore).WithRegistryMirror(mirror, certificates, insecure)
+		packageRequirements, bundleErr = readPackageRequirements(ctx, clusterSpec, reader)
+		requirements = append(requirements, packageRequirements...)
+	}
+
+	validator := airgap.NewValidator(
+		airgap.NewRegistryImageChecker(registryCache, credentialStore, certificates, insecure),
+		airgap.NewHTTPFileChecker(http.DefaultClient),
+	)
+	report := validator.Validate(ctx, requirements)
+	if bundleErr != nil {
+		// Without the package bundle the packages charts and images can't be listed, so the bundle
+		// is reported as a failed requirement instead of aborting the validation.
+		bundleRef, _ := curatedpackages.GetPackageBundleRef(*clusterSpec.VersionsBundle.VersionsBundle)
+		report.Add(airgap.Requirement{Kind: airgap.KindPackageBundle, Source: bundleRef, Location: mirror.ReplaceRegistry(bundleRef)}, bundleErr)
+	}
+
+	if err = report.WriteTable(os.Stdout); err != nil {
+		return err
+	}
+	if failed := report.Failed(); failed > 0 {
+		logger.MarkFail("Air-gap validation failed")
+		return fmt.Errorf("%d of %d air-gapped install requirements are not reachable", failed, len(report.Results))
+	}
+	logger.MarkPass("Air-gapped install requirements reachable", "requirements", len(report.Results))
+	return nil
+}
+
+func readPackageRequirements(ctx context.Context, clusterSpec *cluster.Spec, reader *curatedpackages.PackageReader) ([]airgap.Requirement, error) {
+	bundles, err := curatedpackages.FilterBundlesForKubeVersions(clusterSpec.Bundles, []string{string(clusterSpec.Cluster.Spec.KubernetesVersion)})
+	if err != nil {
+		return nil, err
+	}
+	charts, images, err := reader.ReadPackageArtifacts(ctx, bundles)
+	if err != nil {
+		return nil, err
+	}
+	return airgap.PackageRequirements(clusterSpec, charts, images), nil
+}
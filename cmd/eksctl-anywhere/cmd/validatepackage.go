@@ -0,0 +1,137 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	packagesv1 "github.com/aws/eks-anywhere-packages/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/curatedpackages"
+	"github.com/aws/eks-anywhere/pkg/kubeconfig"
+	"github.com/aws/eks-anywhere/pkg/logger"
+)
+
+type validatePackageOptions struct {
+	fileName      string
+	kubeVersion   string
+	clusterName   string
+	registry      string
+	customConfigs []string
+	// kubeConfig is an optional kubeconfig file to use when querying an
+	// existing cluster.
+	kubeConfig string
+}
+
+var vpo = &validatePackageOptions{}
+
+func init() {
+	validateCmd.AddCommand(validatePackageCommand)
+
+	validatePackageCommand.Flags().StringVarP(&vpo.fileName, "filename", "f",
+		"", "Filename that contains curated packages custom resources to validate")
+	validatePackageCommand.Flags().StringVar(&vpo.kubeVersion, "kube-version", "",
+		"Kubernetes Version of the cluster to be used. Format <major>.<minor>")
+	validatePackageCommand.Flags().StringVar(&vpo.clusterName, "cluster", "",
+		"Cluster whose active package bundle is used for validation.")
+	validatePackageCommand.Flags().StringVar(&vpo.registry, "registry", "",
+		"Used to specify an alternative registry for discovery")
+	validatePackageCommand.Flags().StringArrayVar(&vpo.customConfigs, "set",
+		[]string{}, "Custom configurations to validate for the package. Format key=value")
+	validatePackageCommand.Flags().StringVar(&vpo.kubeConfig, "kubeconfig", "",
+		"Path to an optional kubeconfig file to use.")
+}
+
+var validatePackageCommand = &cobra.Command{
+	Use:          "package(s) [flags] [package]",
+	Aliases:      []string{"package", "packages"},
+	Short:        "Validate curated packages configuration",
+	Long:         "Validate the configuration of curated packages, from a file or --set flags, against the schemas in the package bundle",
+	PreRunE:      preRunPackages,
+	SilenceUsage: true,
+	RunE:         runValidatePackages,
+	Args: func(cmd *cobra.Command, args []string) error {
+		if len(args) > 1 {
+			return fmt.Errorf("only one package can be validated with --set")
+		}
+		if (len(args) == 1) == (vpo.fileName != "") {
+			return fmt.Errorf("specify either a package name or a packages file with --filename")
+		}
+		return nil
+	},
+}
+
+func runValidatePackages(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+	kubeConfig, err := kubeconfig.ResolveAndValidateFilename(vpo.kubeConfig, "")
+	if err != nil {
+		return err
+	}
+
+	var packages []packagesv1.Package
+	clusterName := vpo.clusterName
+	if vpo.fileName != "" {
+		if packages, err = curatedpackages.ReadPackagesFile(vpo.fileName); err != nil {
+			return err
+		}
+		if clusterName == "" && vpo.kubeVersion == "" {
+			clusterName = curatedpackages.ClusterNameForPackages(packages)
+		}
+	}
+	if err = curatedpackages.ValidateKubeVersion(vpo.kubeVersion, clusterName); err != nil {
+		return err
+	}
+
+	deps, err := NewDependenciesForPackages(ctx, WithRegistryName(vpo.registry), WithKubeVersion(vpo.kubeVersion), WithMountPaths(kubeConfig))
+	if err != nil {
+		return fmt.Errorf("unable to initialize executables: %v", err)
+	}
+	bm := curatedpackages.CreateBundleManager()
+	b := curatedpackages.NewBundleReader(kubeConfig, clusterName, deps.Kubectl, bm, deps.BundleRegistry)
+	bundle, err := b.GetLatestBundle(ctx, vpo.kubeVersion)
+	if err != nil {
+		return err
+	}
+
+	packageClient := curatedpackages.NewPackageClient(
+		deps.Kubectl,
+		curatedpackages.WithBundle(bundle),
+		curatedpackages.WithCustomConfigs(vpo.customConfigs),
+	)
+
+	if vpo.fileName != "" {
+		err = packageClient.ValidatePackages(packages)
+	} else {
+		err = packageClient.ValidateCustomConfigs(args[0])
+	}
+	if err != nil {
+		return err
+	}
+
+	logger.MarkPass("Package configuration is valid")
+	return nil
+}
+
+// validatePackagesFile validates the configuration of the packages in fileName against the active bundle
+// of their cluster before they are created or applied. The package controller validates them anyway, so
+// validation is skipped when the bundle can't be read.
+func validatePackagesFile(ctx context.Context, fileName, kubeConfig string, kubectl curatedpackages.KubectlRunner) error {
+	packages, err := curatedpackages.ReadPackagesFile(fileName)
+	if err != nil {
+		return err
+	}
+	clusterName := curatedpackages.ClusterNameForPackages(packages)
+	if len(packages) == 0 || clusterName == "" {
+		return nil
+	}
+
+	b := curatedpackages.NewBundleReader(kubeConfig, clusterName, kubectl, nil, nil)
+	bundle, err := b.GetLatestBundle(ctx, "")
+	if err != nil {
+		logger.V(4).Info("Unable to read the active package bundle, skipping package configuration validation", "error", err)
+		return nil
+	}
+
+	packageClient := curatedpackages.NewPackageClient(kubectl, curatedpackages.WithBundle(bundle))
+	return packageClient.ValidatePackages(packages)
+}
@@ -0,0 +1,15 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+var verifyCmd = &cobra.Command{
+	Use:   "verify",
+	Short: "Verify resources",
+	Long:  "Use eksctl anywhere verify to verify resources",
+}
+
+func init() {
+	rootCmd.AddCommand(verifyCmd)
+}
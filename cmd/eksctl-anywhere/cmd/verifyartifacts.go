@@ -0,0 +1,39 @@
+package cmd
+
+import (
+	"log"
+
+	"github.com/spf13/cobra"
+
+	"github.com/aws/eks-anywhere/cmd/eksctl-anywhere/cmd/internal/commands/artifacts"
+)
+
+type verifyArtifactsOptions struct {
+	inputFile string
+}
+
+var vao = &verifyArtifactsOptions{}
+
+func init() {
+	verifyCmd.AddCommand(verifyArtifactsCmd)
+	verifyArtifactsCmd.Flags().StringVarP(&vao.inputFile, "input", "i", "", "Tarball created by download artifacts or download images")
+	if err := verifyArtifactsCmd.MarkFlagRequired("input"); err != nil {
+		log.Fatalf("Cannot mark 'input' as required: %s", err)
+	}
+}
+
+var verifyArtifactsCmd = &cobra.Command{
+	Use:   "artifacts",
+	Short: "Verify the checksums of an artifacts tarball",
+	Long: `Checks every file in a tarball created by download artifacts or download images against the checksums
+it was packaged with. It doesn't need network access, so it can be run on the air-gapped admin machine before import.`,
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		verify := artifacts.Verify{
+			InputFile:          vao.inputFile,
+			UnPackager:         packagerForFile(vao.inputFile),
+			TmpArtifactsFolder: "tmp-eks-a-artifacts-verify",
+		}
+		return verify.Run(cmd.Context())
+	},
+}
@@ -0,0 +1,78 @@
+// registry-credentials-refresher updates the registry mirror credentials in the containerd configuration
+// of the node it runs on, and restarts containerd if they changed. It runs as the init container of the
+// registry credentials refresher DaemonSet, with the host filesystem mounted.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"syscall"
+
+	"github.com/aws/eks-anywhere/pkg/registrymirror/containerd"
+)
+
+// restartPendingMarker is created in the host while containerd has to be restarted to load the refreshed credentials.
+const restartPendingMarker = "/etc/containerd/.registry-credentials-restart-pending"
+
+func main() {
+	hostRoot := flag.String("host-root", "/host", "Path the host filesystem is mounted at")
+	credentialsDir := flag.String("credentials-dir", "/etc/registry-credentials", "Directory with the username and password files")
+	registries := flag.String("registries", "", "Comma separated registry mirrors to refresh the credentials of")
+	flag.Parse()
+
+	if err := refresh(*hostRoot, *credentialsDir, strings.Split(*registries, ",")); err != nil {
+		log.Fatalf("Refreshing registry mirror credentials: %v", err)
+	}
+}
+
+func refresh(hostRoot, credentialsDir string, registries []string) error {
+	// Only nodes with a containerd configuration managed by EKS Anywhere (Ubuntu and RHEL) are refreshed.
+	if _, err := os.Stat(filepath.Join(hostRoot, "bin/systemctl")); err != nil {
+		log.Print("Node containerd is not managed with systemd, skipping")
+		return nil
+	}
+
+	username, err := os.ReadFile(filepath.Join(credentialsDir, "username"))
+	if err != nil {
+		return fmt.Errorf("reading username: %v", err)
+	}
+	password, err := os.ReadFile(filepath.Join(credentialsDir, "password"))
+	if err != nil {
+		return fmt.Errorf("reading password: %v", err)
+	}
+
+	// The marker is kept until containerd is restarted, so a failed restart is retried by the next run
+	// even though the config files are already up to date.
+	marker := filepath.Join(hostRoot, restartPendingMarker)
+	_, err = os.Stat(marker)
+	restartPending := err == nil
+	if err = os.WriteFile(marker, nil, 0o600); err != nil {
+		return fmt.Errorf("writing restart marker: %v", err)
+	}
+	changed, err := containerd.RefreshCredentialsInFiles(hostRoot, registries, string(username), string(password))
+	if err != nil {
+		return err
+	}
+	if !changed && !restartPending {
+		log.Print("Registry mirror credentials are up to date")
+		return os.Remove(marker)
+	}
+
+	if err = syscall.Chroot(hostRoot); err != nil {
+		return fmt.Errorf("changing root to host: %v", err)
+	}
+	if out, err := exec.Command("/bin/systemctl", "restart", "containerd").CombinedOutput(); err != nil {
+		return fmt.Errorf("restarting containerd: %v: %s", err, out)
+	}
+	if err = os.Remove(restartPendingMarker); err != nil {
+		return fmt.Errorf("removing restart marker: %v", err)
+	}
+
+	log.Print("Refreshed registry mirror credentials")
+	return nil
+}
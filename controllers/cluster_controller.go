@@ -41,6 +41,8 @@ type ClusterReconciler struct {
 	client                     client.Client
 	providerReconcilerRegistry ProviderClusterReconcilerRegistry
 	awsIamAuth                 AWSIamConfigReconciler
+	serviceLoadBalancer        ServiceLoadBalancerReconciler
+	machineHealthCheck         MachineHealthCheckReconciler
 }
 
 type ProviderClusterReconcilerRegistry interface {
@@ -54,12 +56,24 @@ type AWSIamConfigReconciler interface {
 	ReconcileDelete(ctx context.Context, logger logr.Logger, cluster *anywherev1.Cluster) error
 }
 
+// ServiceLoadBalancerReconciler manages the Service load balancer installation and configuration for an eks-a cluster.
+type ServiceLoadBalancerReconciler interface {
+	Reconcile(ctx context.Context, logger logr.Logger, cluster *anywherev1.Cluster) (controller.Result, error)
+}
+
+// MachineHealthCheckReconciler manages the MachineHealthChecks for an eks-a cluster.
+type MachineHealthCheckReconciler interface {
+	Reconcile(ctx context.Context, logger logr.Logger, cluster *anywherev1.Cluster) error
+}
+
 // NewClusterReconciler constructs a new ClusterReconciler.
-func NewClusterReconciler(client client.Client, registry ProviderClusterReconcilerRegistry, awsIamAuth AWSIamConfigReconciler) *ClusterReconciler {
+func NewClusterReconciler(client client.Client, registry ProviderClusterReconcilerRegistry, awsIamAuth AWSIamConfigReconciler, serviceLoadBalancer ServiceLoadBalancerReconciler, machineHealthCheck MachineHealthCheckReconciler) *ClusterReconciler {
 	return &ClusterReconciler{
 		client:                     client,
 		providerReconcilerRegistry: registry,
 		awsIamAuth:                 awsIamAuth,
+		serviceLoadBalancer:        serviceLoadBalancer,
+		machineHealthCheck:         machineHealthCheck,
 	}
 }
 
@@ -230,6 +244,10 @@ func (r *ClusterReconciler) preClusterProviderReconcile(ctx context.Context, log
 }
 
 func (r *ClusterReconciler) postClusterProviderReconcile(ctx context.Context, log logr.Logger, cluster *anywherev1.Cluster) (controller.Result, error) {
+	if err := r.machineHealthCheck.Reconcile(ctx, log, cluster); err != nil {
+		return controller.Result{}, err
+	}
+
 	if cluster.HasAWSIamConfig() {
 		if result, err := r.awsIamAuth.Reconcile(ctx, log, cluster); err != nil {
 			return controller.Result{}, err
@@ -238,6 +256,14 @@ func (r *ClusterReconciler) postClusterProviderReconcile(ctx context.Context, lo
 		}
 	}
 
+	if cluster.Spec.ServiceLoadBalancer != nil {
+		if result, err := r.serviceLoadBalancer.Reconcile(ctx, log, cluster); err != nil {
+			return controller.Result{}, err
+		} else if result.Return() {
+			return result, nil
+		}
+	}
+
 	return controller.Result{}, nil
 }
 
@@ -67,7 +67,7 @@ func newVsphereClusterReconcilerTest(t *testing.T, objs ...runtime.Object) *vsph
 		Add(anywherev1.VSphereDatacenterKind, reconciler).
 		Build()
 
-	r := controllers.NewClusterReconciler(cl, &registry, iam)
+	r := controllers.NewClusterReconciler(cl, &registry, iam, newMockServiceLoadBalancerReconciler(t), newMockMachineHealthCheckReconciler(t))
 
 	return &vsphereClusterReconcilerTest{
 		govcClient: govcClient,
@@ -97,9 +97,12 @@ func TestClusterReconcilerReconcileSelfManagedCluster(t *testing.T) {
 	registry := newRegistryMock(providerReconciler)
 	c := fake.NewClientBuilder().WithRuntimeObjects(selfManagedCluster).Build()
 
+	mhc := mocks.NewMockMachineHealthCheckReconciler(controller)
+
 	providerReconciler.EXPECT().ReconcileWorkerNodes(ctx, gomock.AssignableToTypeOf(logr.Logger{}), sameName(selfManagedCluster))
+	mhc.EXPECT().Reconcile(ctx, gomock.AssignableToTypeOf(logr.Logger{}), sameName(selfManagedCluster))
 
-	r := controllers.NewClusterReconciler(c, registry, iam)
+	r := controllers.NewClusterReconciler(c, registry, iam, newMockServiceLoadBalancerReconciler(t), mhc)
 	result, err := r.Reconcile(ctx, clusterRequest(selfManagedCluster))
 	g.Expect(err).ToNot(HaveOccurred())
 	g.Expect(result).To(Equal(ctrl.Result{}))
@@ -125,7 +128,7 @@ func TestClusterReconcilerReconcilePausedCluster(t *testing.T) {
 	providerReconciler := mocks.NewMockProviderClusterReconciler(ctrl)
 	iam := mocks.NewMockAWSIamConfigReconciler(ctrl)
 	registry := newRegistryMock(providerReconciler)
-	r := controllers.NewClusterReconciler(c, registry, iam)
+	r := controllers.NewClusterReconciler(c, registry, iam, newMockServiceLoadBalancerReconciler(t), newMockMachineHealthCheckReconciler(t))
 	g.Expect(r.Reconcile(ctx, clusterRequest(cluster))).To(Equal(reconcile.Result{}))
 	api := envtest.NewAPIExpecter(t, c)
 
@@ -160,7 +163,7 @@ func TestClusterReconcilerReconcileDeletedSelfManagedCluster(t *testing.T) {
 	registry := newRegistryMock(providerReconciler)
 	c := fake.NewClientBuilder().WithRuntimeObjects(selfManagedCluster).Build()
 
-	r := controllers.NewClusterReconciler(c, registry, iam)
+	r := controllers.NewClusterReconciler(c, registry, iam, newMockServiceLoadBalancerReconciler(t), newMockMachineHealthCheckReconciler(t))
 	_, err := r.Reconcile(ctx, clusterRequest(selfManagedCluster))
 	g.Expect(err).To(MatchError(ContainSubstring("deleting self-managed clusters is not supported")))
 }
@@ -228,7 +231,7 @@ func TestClusterReconcilerReconcileDeletePausedCluster(t *testing.T) {
 		managementCluster, cluster, capiCluster,
 	).Build()
 
-	r := controllers.NewClusterReconciler(c, newRegistryForDummyProviderReconciler(), iam)
+	r := controllers.NewClusterReconciler(c, newRegistryForDummyProviderReconciler(), iam, newMockServiceLoadBalancerReconciler(t), newMockMachineHealthCheckReconciler(t))
 	g.Expect(r.Reconcile(ctx, clusterRequest(cluster))).To(Equal(reconcile.Result{}))
 	api := envtest.NewAPIExpecter(t, c)
 
@@ -270,7 +273,7 @@ func TestClusterReconcilerReconcileDeleteClusterManagedByCLI(t *testing.T) {
 	controller := gomock.NewController(t)
 	iam := mocks.NewMockAWSIamConfigReconciler(controller)
 
-	r := controllers.NewClusterReconciler(c, newRegistryForDummyProviderReconciler(), iam)
+	r := controllers.NewClusterReconciler(c, newRegistryForDummyProviderReconciler(), iam, newMockServiceLoadBalancerReconciler(t), newMockMachineHealthCheckReconciler(t))
 	g.Expect(r.Reconcile(ctx, clusterRequest(cluster))).To(Equal(reconcile.Result{}))
 	api := envtest.NewAPIExpecter(t, c)
 
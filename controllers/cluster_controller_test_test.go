@@ -2,6 +2,7 @@ package controllers_test
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	"github.com/go-logr/logr"
@@ -80,7 +81,7 @@ func TestClusterReconcilerEnsureOwnerReferences(t *testing.T) {
 	iam.EXPECT().EnsureCASecret(ctx, gomock.AssignableToTypeOf(logr.Logger{}), gomock.AssignableToTypeOf(cluster)).Return(controller.Result{}, nil)
 	iam.EXPECT().Reconcile(ctx, gomock.AssignableToTypeOf(logr.Logger{}), gomock.AssignableToTypeOf(cluster)).Return(controller.Result{}, nil)
 
-	r := controllers.NewClusterReconciler(cl, newRegistryForDummyProviderReconciler(), iam)
+	r := controllers.NewClusterReconciler(cl, newRegistryForDummyProviderReconciler(), iam, newMockServiceLoadBalancerReconciler(t), newMockMachineHealthCheckReconciler(t))
 	_, err := r.Reconcile(ctx, clusterRequest(cluster))
 	g.Expect(err).NotTo(HaveOccurred())
 
@@ -129,7 +130,7 @@ func TestClusterReconcilerReconcileChildObjectNotFound(t *testing.T) {
 	cl := cb.WithRuntimeObjects(objs...).Build()
 	api := envtest.NewAPIExpecter(t, cl)
 
-	r := controllers.NewClusterReconciler(cl, newRegistryForDummyProviderReconciler(), newMockAWSIamConfigReconciler(t))
+	r := controllers.NewClusterReconciler(cl, newRegistryForDummyProviderReconciler(), newMockAWSIamConfigReconciler(t), newMockServiceLoadBalancerReconciler(t), newMockMachineHealthCheckReconciler(t))
 	g.Expect(r.Reconcile(ctx, clusterRequest(cluster))).Error().To(MatchError(ContainSubstring("not found")))
 	c := envtest.CloneNameNamespace(cluster)
 	api.ShouldEventuallyMatch(ctx, c, func(g Gomega) {
@@ -141,7 +142,7 @@ func TestClusterReconcilerReconcileChildObjectNotFound(t *testing.T) {
 
 func TestClusterReconcilerSetupWithManager(t *testing.T) {
 	client := env.Client()
-	r := controllers.NewClusterReconciler(client, newRegistryForDummyProviderReconciler(), newMockAWSIamConfigReconciler(t))
+	r := controllers.NewClusterReconciler(client, newRegistryForDummyProviderReconciler(), newMockAWSIamConfigReconciler(t), newMockServiceLoadBalancerReconciler(t), newMockMachineHealthCheckReconciler(t))
 
 	g := NewWithT(t)
 	g.Expect(r.SetupWithManager(env.Manager(), env.Manager().GetLogger())).To(Succeed())
@@ -170,7 +171,7 @@ func TestClusterReconcilerManagementClusterNotFound(t *testing.T) {
 	cl := cb.WithRuntimeObjects(objs...).Build()
 	api := envtest.NewAPIExpecter(t, cl)
 
-	r := controllers.NewClusterReconciler(cl, newRegistryForDummyProviderReconciler(), newMockAWSIamConfigReconciler(t))
+	r := controllers.NewClusterReconciler(cl, newRegistryForDummyProviderReconciler(), newMockAWSIamConfigReconciler(t), newMockServiceLoadBalancerReconciler(t), newMockMachineHealthCheckReconciler(t))
 	g.Expect(r.Reconcile(ctx, clusterRequest(cluster))).Error().To(MatchError(ContainSubstring("\"my-management-cluster\" not found")))
 	c := envtest.CloneNameNamespace(cluster)
 	api.ShouldEventuallyMatch(ctx, c, func(g Gomega) {
@@ -207,7 +208,7 @@ func TestClusterReconcilerSetBundlesRef(t *testing.T) {
 	mgmtCluster := &anywherev1.Cluster{}
 	g.Expect(cl.Get(ctx, client.ObjectKey{Namespace: cluster.Namespace, Name: managementCluster.Name}, mgmtCluster)).To(Succeed())
 
-	r := controllers.NewClusterReconciler(cl, newRegistryForDummyProviderReconciler(), newMockAWSIamConfigReconciler(t))
+	r := controllers.NewClusterReconciler(cl, newRegistryForDummyProviderReconciler(), newMockAWSIamConfigReconciler(t), newMockServiceLoadBalancerReconciler(t), newMockMachineHealthCheckReconciler(t))
 	_, err := r.Reconcile(ctx, clusterRequest(cluster))
 	g.Expect(err).ToNot(HaveOccurred())
 
@@ -216,6 +217,106 @@ func TestClusterReconcilerSetBundlesRef(t *testing.T) {
 	g.Expect(newCluster.Spec.BundlesRef).To(Equal(mgmtCluster.Spec.BundlesRef))
 }
 
+func TestClusterReconcilerReconcileServiceLoadBalancer(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	managementCluster := &anywherev1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "my-management-cluster",
+		},
+		Spec: anywherev1.ClusterSpec{
+			BundlesRef: &anywherev1.BundlesRef{
+				Name: "my-bundles-ref",
+			},
+		},
+	}
+
+	cluster := &anywherev1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "my-cluster",
+		},
+		Spec: anywherev1.ClusterSpec{
+			ServiceLoadBalancer: &anywherev1.ServiceLoadBalancerConfiguration{
+				Provider: anywherev1.KubeVipServiceLoadBalancer,
+				AddressPools: []anywherev1.ServiceLoadBalancerAddressPool{
+					{Name: "default", Addresses: []string{"10.0.0.0/28"}},
+				},
+			},
+		},
+	}
+	cluster.SetManagedBy("my-management-cluster")
+
+	objs := []runtime.Object{cluster, managementCluster}
+	cl := fake.NewClientBuilder().WithRuntimeObjects(objs...).Build()
+
+	serviceLB := newMockServiceLoadBalancerReconciler(t)
+	serviceLB.EXPECT().Reconcile(ctx, gomock.AssignableToTypeOf(logr.Logger{}), gomock.AssignableToTypeOf(cluster)).Return(controller.Result{}, nil)
+
+	r := controllers.NewClusterReconciler(cl, newRegistryForDummyProviderReconciler(), newMockAWSIamConfigReconciler(t), serviceLB, newMockMachineHealthCheckReconciler(t))
+	_, err := r.Reconcile(ctx, clusterRequest(cluster))
+	g.Expect(err).ToNot(HaveOccurred())
+}
+
+func TestClusterReconcilerReconcileMachineHealthChecksError(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	managementCluster := &anywherev1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "my-management-cluster",
+		},
+		Spec: anywherev1.ClusterSpec{
+			BundlesRef: &anywherev1.BundlesRef{
+				Name: "my-bundles-ref",
+			},
+		},
+	}
+
+	cluster := &anywherev1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "my-cluster",
+		},
+	}
+	cluster.SetManagedBy("my-management-cluster")
+
+	objs := []runtime.Object{cluster, managementCluster}
+	cl := fake.NewClientBuilder().WithRuntimeObjects(objs...).Build()
+
+	mhc := mocks.NewMockMachineHealthCheckReconciler(gomock.NewController(t))
+	mhc.EXPECT().Reconcile(ctx, gomock.AssignableToTypeOf(logr.Logger{}), gomock.AssignableToTypeOf(cluster)).Return(errors.New("applying machine health checks"))
+
+	r := controllers.NewClusterReconciler(cl, newRegistryForDummyProviderReconciler(), newMockAWSIamConfigReconciler(t), newMockServiceLoadBalancerReconciler(t), mhc)
+	_, err := r.Reconcile(ctx, clusterRequest(cluster))
+	g.Expect(err).To(MatchError(ContainSubstring("applying machine health checks")))
+}
+
+func TestClusterReconcilerReconcileSelfManagedClusterSkipsMachineHealthChecks(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	cluster := &anywherev1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "my-cluster",
+		},
+		Spec: anywherev1.ClusterSpec{
+			BundlesRef: &anywherev1.BundlesRef{
+				Name: "my-bundles-ref",
+			},
+		},
+	}
+
+	objs := []runtime.Object{cluster}
+	cl := fake.NewClientBuilder().WithRuntimeObjects(objs...).Build()
+
+	mhc := mocks.NewMockMachineHealthCheckReconciler(gomock.NewController(t))
+	mhc.EXPECT().Reconcile(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	r := controllers.NewClusterReconciler(cl, newRegistryForDummyProviderReconciler(), newMockAWSIamConfigReconciler(t), newMockServiceLoadBalancerReconciler(t), mhc)
+	_, err := r.Reconcile(ctx, clusterRequest(cluster))
+	g.Expect(err).ToNot(HaveOccurred())
+}
+
 func newRegistryForDummyProviderReconciler() controllers.ProviderClusterReconcilerRegistry {
 	return newRegistryMock(dummyProviderReconciler{})
 }
@@ -265,3 +366,15 @@ func newMockAWSIamConfigReconciler(t *testing.T) *mocks.MockAWSIamConfigReconcil
 	ctrl := gomock.NewController(t)
 	return mocks.NewMockAWSIamConfigReconciler(ctrl)
 }
+
+func newMockServiceLoadBalancerReconciler(t *testing.T) *mocks.MockServiceLoadBalancerReconciler {
+	ctrl := gomock.NewController(t)
+	return mocks.NewMockServiceLoadBalancerReconciler(ctrl)
+}
+
+func newMockMachineHealthCheckReconciler(t *testing.T) *mocks.MockMachineHealthCheckReconciler {
+	ctrl := gomock.NewController(t)
+	mhc := mocks.NewMockMachineHealthCheckReconciler(ctrl)
+	mhc.EXPECT().Reconcile(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	return mhc
+}
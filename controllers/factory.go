@@ -14,6 +14,7 @@ import (
 	"github.com/aws/eks-anywhere/pkg/controller/clusters"
 	"github.com/aws/eks-anywhere/pkg/crypto"
 	"github.com/aws/eks-anywhere/pkg/dependencies"
+	fluxreconciler "github.com/aws/eks-anywhere/pkg/gitops/flux/reconciler"
 	ciliumreconciler "github.com/aws/eks-anywhere/pkg/networking/cilium/reconciler"
 	cnireconciler "github.com/aws/eks-anywhere/pkg/networking/reconciler"
 	dockerreconciler "github.com/aws/eks-anywhere/pkg/providers/docker/reconciler"
@@ -21,6 +22,8 @@ import (
 	snowreconciler "github.com/aws/eks-anywhere/pkg/providers/snow/reconciler"
 	tinkerbellreconciler "github.com/aws/eks-anywhere/pkg/providers/tinkerbell/reconciler"
 	vspherereconciler "github.com/aws/eks-anywhere/pkg/providers/vsphere/reconciler"
+	registrymirrorreconciler "github.com/aws/eks-anywhere/pkg/registrymirror/reconciler"
+	servicelbreconciler "github.com/aws/eks-anywhere/pkg/servicelb/reconciler"
 )
 
 type Manager = manager.Manager
@@ -40,6 +43,8 @@ type Factory struct {
 	cniReconciler               *cnireconciler.Reconciler
 	ipValidator                 *clusters.IPValidator
 	awsIamConfigReconciler      *awsiamconfigreconciler.Reconciler
+	serviceLBReconciler         *servicelbreconciler.Reconciler
+	mhcReconciler               *clusters.MachineHealthCheckReconciler
 	logger                      logr.Logger
 	deps                        *dependencies.Dependencies
 }
@@ -50,6 +55,8 @@ type Reconcilers struct {
 	VSphereDatacenterReconciler    *VSphereDatacenterReconciler
 	SnowMachineConfigReconciler    *SnowMachineConfigReconciler
 	TinkerbellDatacenterReconciler *TinkerbellDatacenterReconciler
+	FluxConfigReconciler           *FluxConfigReconciler
+	RegistryCredentialsReconciler  *RegistryCredentialsReconciler
 }
 
 type buildStep func(ctx context.Context) error
@@ -89,7 +96,7 @@ func (f *Factory) Close(ctx context.Context) error {
 
 func (f *Factory) WithClusterReconciler(capiProviders []clusterctlv1.Provider) *Factory {
 	f.dependencyFactory.WithGovc()
-	f.withTracker().WithProviderClusterReconcilerRegistry(capiProviders).withAWSIamConfigReconciler()
+	f.withTracker().WithProviderClusterReconcilerRegistry(capiProviders).withAWSIamConfigReconciler().withServiceLoadBalancerReconciler().withMachineHealthCheckReconciler()
 
 	f.buildSteps = append(f.buildSteps, func(ctx context.Context) error {
 		if f.reconcilers.ClusterReconciler != nil {
@@ -100,6 +107,8 @@ func (f *Factory) WithClusterReconciler(capiProviders []clusterctlv1.Provider) *
 			f.manager.GetClient(),
 			f.registry,
 			f.awsIamConfigReconciler,
+			f.serviceLBReconciler,
+			f.mhcReconciler,
 		)
 
 		return nil
@@ -158,6 +167,42 @@ func (f *Factory) WithSnowMachineConfigReconciler() *Factory {
 	return f
 }
 
+// WithFluxConfigReconciler adds the FluxConfigReconciler to the controller factory.
+func (f *Factory) WithFluxConfigReconciler() *Factory {
+	f.buildSteps = append(f.buildSteps, func(ctx context.Context) error {
+		if f.reconcilers.FluxConfigReconciler != nil {
+			return nil
+		}
+
+		client := f.manager.GetClient()
+		f.reconcilers.FluxConfigReconciler = NewFluxConfigReconciler(
+			client,
+			fluxreconciler.New(client, f.manager.GetAPIReader(), fluxreconciler.NewGithubAppClient),
+		)
+		return nil
+	})
+	return f
+}
+
+// WithRegistryCredentialsReconciler adds the RegistryCredentialsReconciler to the controller factory.
+func (f *Factory) WithRegistryCredentialsReconciler() *Factory {
+	f.withTracker()
+
+	f.buildSteps = append(f.buildSteps, func(ctx context.Context) error {
+		if f.reconcilers.RegistryCredentialsReconciler != nil {
+			return nil
+		}
+
+		client := f.manager.GetClient()
+		f.reconcilers.RegistryCredentialsReconciler = NewRegistryCredentialsReconciler(
+			client,
+			registrymirrorreconciler.New(client, f.tracker),
+		)
+		return nil
+	})
+	return f
+}
+
 // WithTinkerbellDatacenterReconciler adds the TinkerbellDatacenterReconciler to the controller factory.
 func (f *Factory) WithTinkerbellDatacenterReconciler() *Factory {
 	f.buildSteps = append(f.buildSteps, func(ctx context.Context) error {
@@ -357,6 +402,39 @@ func (f *Factory) withIPValidator() *Factory {
 	return f
 }
 
+func (f *Factory) withServiceLoadBalancerReconciler() *Factory {
+	f.withTracker()
+
+	f.buildSteps = append(f.buildSteps, func(ctx context.Context) error {
+		if f.serviceLBReconciler != nil {
+			return nil
+		}
+
+		f.serviceLBReconciler = servicelbreconciler.New(
+			f.manager.GetClient(),
+			f.tracker,
+		)
+
+		return nil
+	})
+
+	return f
+}
+
+func (f *Factory) withMachineHealthCheckReconciler() *Factory {
+	f.buildSteps = append(f.buildSteps, func(ctx context.Context) error {
+		if f.mhcReconciler != nil {
+			return nil
+		}
+
+		f.mhcReconciler = clusters.NewMachineHealthCheckReconciler(f.manager.GetClient())
+
+		return nil
+	})
+
+	return f
+}
+
 func (f *Factory) withAWSIamConfigReconciler() *Factory {
 	f.withTracker()
 
@@ -139,6 +139,46 @@ func TestFactoryBuildAllSnowReconciler(t *testing.T) {
 	g.Expect(reconcilers.SnowMachineConfigReconciler).NotTo(BeNil())
 }
 
+func TestFactoryBuildFluxConfigReconciler(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	logger := nullLog()
+	ctrl := gomock.NewController(t)
+	manager := mocks.NewMockManager(ctrl)
+	manager.EXPECT().GetClient().AnyTimes()
+	manager.EXPECT().GetScheme().AnyTimes()
+
+	f := controllers.NewFactory(logger, manager).
+		WithFluxConfigReconciler()
+
+	// testing idempotence
+	f.WithFluxConfigReconciler()
+
+	reconcilers, err := f.Build(ctx)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(reconcilers.FluxConfigReconciler).NotTo(BeNil())
+}
+
+func TestFactoryBuildRegistryCredentialsReconciler(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	logger := nullLog()
+	ctrl := gomock.NewController(t)
+	manager := mocks.NewMockManager(ctrl)
+	manager.EXPECT().GetClient().AnyTimes()
+	manager.EXPECT().GetScheme().AnyTimes()
+
+	f := controllers.NewFactory(logger, manager).
+		WithRegistryCredentialsReconciler()
+
+	// testing idempotence
+	f.WithRegistryCredentialsReconciler()
+
+	reconcilers, err := f.Build(ctx)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(reconcilers.RegistryCredentialsReconciler).NotTo(BeNil())
+}
+
 func TestFactoryClose(t *testing.T) {
 	g := NewWithT(t)
 	ctx := context.Background()
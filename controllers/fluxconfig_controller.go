@@ -0,0 +1,79 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	"sigs.k8s.io/cluster-api/util/patch"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/controller"
+)
+
+// FluxStatusReconciler populates the status of a FluxConfig with the state of the Flux objects.
+type FluxStatusReconciler interface {
+	Reconcile(ctx context.Context, log logr.Logger, fluxConfig *anywherev1.FluxConfig) (controller.Result, error)
+}
+
+// FluxConfigReconciler reconciles a FluxConfig object.
+type FluxConfigReconciler struct {
+	client client.Client
+	status FluxStatusReconciler
+}
+
+// NewFluxConfigReconciler constructs a new FluxConfigReconciler.
+func NewFluxConfigReconciler(client client.Client, status FluxStatusReconciler) *FluxConfigReconciler {
+	return &FluxConfigReconciler{
+		client: client,
+		status: status,
+	}
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *FluxConfigReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&anywherev1.FluxConfig{}).
+		Complete(r)
+}
+
+// +kubebuilder:rbac:groups=anywhere.eks.amazonaws.com,resources=fluxconfigs,verbs=get;list;watch
+// +kubebuilder:rbac:groups=anywhere.eks.amazonaws.com,resources=fluxconfigs/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=source.toolkit.fluxcd.io,resources=gitrepositories,verbs=get;list;watch
+// +kubebuilder:rbac:groups=kustomize.toolkit.fluxcd.io,resources=kustomizations,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=secrets,resourceNames=flux-system,verbs=get;update
+
+// Reconcile implements the reconcile.Reconciler interface.
+func (r *FluxConfigReconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctrl.Result, reterr error) {
+	log := ctrl.LoggerFrom(ctx)
+
+	fluxConfig := &anywherev1.FluxConfig{}
+	if err := r.client.Get(ctx, req.NamespacedName, fluxConfig); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	patchHelper, err := patch.NewHelper(fluxConfig, r.client)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	defer func() {
+		// Always attempt to patch the status after each reconciliation.
+		if err := patchHelper.Patch(ctx, fluxConfig); err != nil {
+			reterr = kerrors.NewAggregate([]error{reterr, fmt.Errorf("patching fluxconfig: %v", err)})
+		}
+	}()
+
+	if !fluxConfig.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+
+	result, err := r.status.Reconcile(ctx, log, fluxConfig)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("reconciling fluxconfig status: %v", err)
+	}
+	return result.ToCtrlResult(), nil
+}
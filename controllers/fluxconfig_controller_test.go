@@ -0,0 +1,74 @@
+package controllers_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/aws/eks-anywhere/controllers"
+	"github.com/aws/eks-anywhere/controllers/mocks"
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/controller"
+)
+
+func newFluxConfigRequest() reconcile.Request {
+	return reconcile.Request{NamespacedName: types.NamespacedName{Name: "my-flux", Namespace: "default"}}
+}
+
+func TestFluxConfigReconcilerSetupWithManager(t *testing.T) {
+	client := env.Client()
+	r := controllers.NewFluxConfigReconciler(client, nil)
+
+	g := NewWithT(t)
+	g.Expect(r.SetupWithManager(env.Manager())).To(Succeed())
+}
+
+func TestFluxConfigReconcilerReconcileUpdatesStatus(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	fluxConfig := &anywherev1.FluxConfig{ObjectMeta: metav1.ObjectMeta{Name: "my-flux", Namespace: "default"}}
+	cl := fake.NewClientBuilder().WithRuntimeObjects(fluxConfig).Build()
+	status := mocks.NewMockFluxStatusReconciler(gomock.NewController(t))
+	status.EXPECT().Reconcile(ctx, gomock.Any(), gomock.AssignableToTypeOf(&anywherev1.FluxConfig{})).DoAndReturn(
+		func(_ context.Context, _ interface{}, c *anywherev1.FluxConfig) (controller.Result, error) {
+			c.Status.LastAppliedRevision = "main/abc123"
+			return controller.ResultWithRequeue(time.Minute), nil
+		},
+	)
+
+	result, err := controllers.NewFluxConfigReconciler(cl, status).Reconcile(ctx, newFluxConfigRequest())
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(result.RequeueAfter).To(Equal(time.Minute))
+
+	got := &anywherev1.FluxConfig{}
+	g.Expect(cl.Get(ctx, newFluxConfigRequest().NamespacedName, got)).To(Succeed())
+	g.Expect(got.Status.LastAppliedRevision).To(Equal("main/abc123"))
+}
+
+func TestFluxConfigReconcilerReconcileStatusError(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	fluxConfig := &anywherev1.FluxConfig{ObjectMeta: metav1.ObjectMeta{Name: "my-flux", Namespace: "default"}}
+	cl := fake.NewClientBuilder().WithRuntimeObjects(fluxConfig).Build()
+	status := mocks.NewMockFluxStatusReconciler(gomock.NewController(t))
+	status.EXPECT().Reconcile(ctx, gomock.Any(), gomock.Any()).Return(controller.Result{}, errors.New("forbidden"))
+
+	_, err := controllers.NewFluxConfigReconciler(cl, status).Reconcile(ctx, newFluxConfigRequest())
+	g.Expect(err).To(MatchError(ContainSubstring("reconciling fluxconfig status: forbidden")))
+}
+
+func TestFluxConfigReconcilerReconcileNotFound(t *testing.T) {
+	g := NewWithT(t)
+	cl := fake.NewClientBuilder().Build()
+
+	_, err := controllers.NewFluxConfigReconciler(cl, nil).Reconcile(context.Background(), newFluxConfigRequest())
+	g.Expect(err).NotTo(HaveOccurred())
+}
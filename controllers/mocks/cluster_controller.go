@@ -1,5 +1,5 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/aws/eks-anywhere/controllers (interfaces: AWSIamConfigReconciler)
+// Source: github.com/aws/eks-anywhere/controllers (interfaces: AWSIamConfigReconciler,ServiceLoadBalancerReconciler,MachineHealthCheckReconciler)
 
 // Package mocks is a generated GoMock package.
 package mocks
@@ -80,3 +80,78 @@ func (mr *MockAWSIamConfigReconcilerMockRecorder) ReconcileDelete(arg0, arg1, ar
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReconcileDelete", reflect.TypeOf((*MockAWSIamConfigReconciler)(nil).ReconcileDelete), arg0, arg1, arg2)
 }
+
+// MockServiceLoadBalancerReconciler is a mock of ServiceLoadBalancerReconciler interface.
+type MockServiceLoadBalancerReconciler struct {
+	ctrl     *gomock.Controller
+	recorder *MockServiceLoadBalancerReconcilerMockRecorder
+}
+
+// MockServiceLoadBalancerReconcilerMockRecorder is the mock recorder for MockServiceLoadBalancerReconciler.
+type MockServiceLoadBalancerReconcilerMockRecorder struct {
+	mock *MockServiceLoadBalancerReconciler
+}
+
+// NewMockServiceLoadBalancerReconciler creates a new mock instance.
+func NewMockServiceLoadBalancerReconciler(ctrl *gomock.Controller) *MockServiceLoadBalancerReconciler {
+	mock := &MockServiceLoadBalancerReconciler{ctrl: ctrl}
+	mock.recorder = &MockServiceLoadBalancerReconcilerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockServiceLoadBalancerReconciler) EXPECT() *MockServiceLoadBalancerReconcilerMockRecorder {
+	return m.recorder
+}
+
+// Reconcile mocks base method.
+func (m *MockServiceLoadBalancerReconciler) Reconcile(arg0 context.Context, arg1 logr.Logger, arg2 *v1alpha1.Cluster) (controller.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reconcile", arg0, arg1, arg2)
+	ret0, _ := ret[0].(controller.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Reconcile indicates an expected call of Reconcile.
+func (mr *MockServiceLoadBalancerReconcilerMockRecorder) Reconcile(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reconcile", reflect.TypeOf((*MockServiceLoadBalancerReconciler)(nil).Reconcile), arg0, arg1, arg2)
+}
+
+// MockMachineHealthCheckReconciler is a mock of MachineHealthCheckReconciler interface.
+type MockMachineHealthCheckReconciler struct {
+	ctrl     *gomock.Controller
+	recorder *MockMachineHealthCheckReconcilerMockRecorder
+}
+
+// MockMachineHealthCheckReconcilerMockRecorder is the mock recorder for MockMachineHealthCheckReconciler.
+type MockMachineHealthCheckReconcilerMockRecorder struct {
+	mock *MockMachineHealthCheckReconciler
+}
+
+// NewMockMachineHealthCheckReconciler creates a new mock instance.
+func NewMockMachineHealthCheckReconciler(ctrl *gomock.Controller) *MockMachineHealthCheckReconciler {
+	mock := &MockMachineHealthCheckReconciler{ctrl: ctrl}
+	mock.recorder = &MockMachineHealthCheckReconcilerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMachineHealthCheckReconciler) EXPECT() *MockMachineHealthCheckReconcilerMockRecorder {
+	return m.recorder
+}
+
+// Reconcile mocks base method.
+func (m *MockMachineHealthCheckReconciler) Reconcile(arg0 context.Context, arg1 logr.Logger, arg2 *v1alpha1.Cluster) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reconcile", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Reconcile indicates an expected call of Reconcile.
+func (mr *MockMachineHealthCheckReconcilerMockRecorder) Reconcile(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reconcile", reflect.TypeOf((*MockMachineHealthCheckReconciler)(nil).Reconcile), arg0, arg1, arg2)
+}
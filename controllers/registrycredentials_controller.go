@@ -0,0 +1,152 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/constants"
+	"github.com/aws/eks-anywhere/pkg/controller"
+	"github.com/aws/eks-anywhere/pkg/registrymirror"
+)
+
+// ClusterRegistryCredentialsReconciler propagates the registry mirror credentials to the nodes of a cluster.
+type ClusterRegistryCredentialsReconciler interface {
+	Reconcile(ctx context.Context, log logr.Logger, cluster *anywherev1.Cluster) (controller.Result, error)
+}
+
+// RegistryCredentialsReconciler reconciles the registry mirror credentials Secret.
+// Every time the Secret changes, it propagates the credentials to all the clusters
+// that use an authenticated registry mirror, without rolling out their nodes.
+// It also propagates them to a single cluster when it starts using an authenticated registry mirror
+// or its registry mirror configuration changes.
+type RegistryCredentialsReconciler struct {
+	client      client.Client
+	credentials ClusterRegistryCredentialsReconciler
+}
+
+// NewRegistryCredentialsReconciler constructs a new RegistryCredentialsReconciler.
+func NewRegistryCredentialsReconciler(client client.Client, credentials ClusterRegistryCredentialsReconciler) *RegistryCredentialsReconciler {
+	return &RegistryCredentialsReconciler{
+		client:      client,
+		credentials: credentials,
+	}
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *RegistryCredentialsReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("registrycredentials").
+		For(&corev1.Secret{}, builder.WithPredicates(predicate.NewPredicateFuncs(isRegistryCredentialsSecret))).
+		Watches(
+			&source.Kind{Type: &anywherev1.Cluster{}},
+			&handler.EnqueueRequestForObject{},
+			builder.WithPredicates(registryMirrorChanged()),
+		).
+		Complete(r)
+}
+
+// registryMirrorChanged filters the Cluster events that require propagating the credentials to that cluster.
+func registryMirrorChanged() predicate.Funcs {
+	return predicate.Funcs{
+		CreateFunc: func(e event.CreateEvent) bool {
+			cluster, ok := e.Object.(*anywherev1.Cluster)
+			return ok && cluster.RegistryAuth()
+		},
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			oldCluster, ok := e.ObjectOld.(*anywherev1.Cluster)
+			if !ok {
+				return false
+			}
+			newCluster, ok := e.ObjectNew.(*anywherev1.Cluster)
+			if !ok || !newCluster.RegistryAuth() {
+				return false
+			}
+			return !reflect.DeepEqual(oldCluster.Spec.RegistryMirrorConfiguration, newCluster.Spec.RegistryMirrorConfiguration) ||
+				oldCluster.IsReconcilePaused() != newCluster.IsReconcilePaused()
+		},
+		DeleteFunc: func(event.DeleteEvent) bool {
+			return false
+		},
+		GenericFunc: func(event.GenericEvent) bool {
+			return false
+		},
+	}
+}
+
+func isRegistryCredentialsSecret(o client.Object) bool {
+	return o.GetNamespace() == constants.EksaSystemNamespace && o.GetName() == registrymirror.CredentialsSecretName
+}
+
+// +kubebuilder:rbac:groups="",namespace=eksa-system,resources=secrets,verbs=get;list;watch
+// +kubebuilder:rbac:groups=anywhere.eks.amazonaws.com,resources=clusters,verbs=get;list;watch
+
+// Reconcile implements the reconcile.Reconciler interface.
+// Requests for the credentials Secret reconcile all the clusters, and any other request reconciles the Cluster it names.
+func (r *RegistryCredentialsReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := ctrl.LoggerFrom(ctx)
+
+	if req.Namespace != constants.EksaSystemNamespace || req.Name != registrymirror.CredentialsSecretName {
+		cluster := &anywherev1.Cluster{}
+		if err := r.client.Get(ctx, req.NamespacedName, cluster); apierrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		} else if err != nil {
+			return ctrl.Result{}, fmt.Errorf("getting cluster: %v", err)
+		}
+
+		result, err := r.reconcileCluster(ctx, log, cluster)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		return result, nil
+	}
+
+	clusters := &anywherev1.ClusterList{}
+	if err := r.client.List(ctx, clusters); err != nil {
+		return ctrl.Result{}, fmt.Errorf("listing clusters: %v", err)
+	}
+
+	var errs []error
+	result := ctrl.Result{}
+	for i := range clusters.Items {
+		res, err := r.reconcileCluster(ctx, log, &clusters.Items[i])
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		if after := res.RequeueAfter; after > 0 && (result.RequeueAfter == 0 || after < result.RequeueAfter) {
+			result.RequeueAfter = after
+		}
+	}
+
+	return result, kerrors.NewAggregate(errs)
+}
+
+func (r *RegistryCredentialsReconciler) reconcileCluster(ctx context.Context, log logr.Logger, cluster *anywherev1.Cluster) (ctrl.Result, error) {
+	if !cluster.RegistryAuth() || !cluster.DeletionTimestamp.IsZero() || cluster.IsReconcilePaused() {
+		return ctrl.Result{}, nil
+	}
+
+	clusterLog := log.WithValues("cluster", cluster.Name, "namespace", cluster.Namespace)
+	clusterLog.Info("Propagating registry mirror credentials")
+	result, err := r.credentials.Reconcile(ctx, clusterLog, cluster)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("propagating registry mirror credentials to cluster %s: %v", cluster.Name, err)
+	}
+
+	return result.ToCtrlResult(), nil
+}
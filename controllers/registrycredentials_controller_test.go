@@ -0,0 +1,149 @@
+package controllers_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/aws/eks-anywhere/controllers"
+	"github.com/aws/eks-anywhere/controllers/mocks"
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/controller"
+)
+
+func newRegistryCredentialsRequest() reconcile.Request {
+	return reconcile.Request{NamespacedName: types.NamespacedName{Name: "registry-credentials", Namespace: "eksa-system"}}
+}
+
+func registryCredentialsCluster(name string, authenticate bool) *anywherev1.Cluster {
+	return &anywherev1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+		Spec: anywherev1.ClusterSpec{
+			RegistryMirrorConfiguration: &anywherev1.RegistryMirrorConfiguration{
+				Endpoint:     "1.2.3.4",
+				Port:         "443",
+				Authenticate: authenticate,
+			},
+		},
+	}
+}
+
+func TestRegistryCredentialsReconcilerSetupWithManager(t *testing.T) {
+	client := env.Client()
+	r := controllers.NewRegistryCredentialsReconciler(client, nil)
+
+	g := NewWithT(t)
+	g.Expect(r.SetupWithManager(env.Manager())).To(Succeed())
+}
+
+func TestRegistryCredentialsReconcilerReconcileAuthenticatedClusters(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	authenticated := registryCredentialsCluster("authenticated", true)
+	anonymous := registryCredentialsCluster("anonymous", false)
+	paused := registryCredentialsCluster("paused", true)
+	paused.PauseReconcile()
+	noMirror := &anywherev1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "no-mirror", Namespace: "default"}}
+	cl := fake.NewClientBuilder().WithRuntimeObjects(authenticated, anonymous, paused, noMirror).Build()
+	credentials := mocks.NewMockClusterRegistryCredentialsReconciler(gomock.NewController(t))
+	credentials.EXPECT().Reconcile(ctx, gomock.Any(), gomock.AssignableToTypeOf(&anywherev1.Cluster{})).DoAndReturn(
+		func(_ context.Context, _ interface{}, c *anywherev1.Cluster) (controller.Result, error) {
+			g.Expect(c.Name).To(Equal("authenticated"))
+			return controller.ResultWithRequeue(time.Minute), nil
+		},
+	)
+
+	result, err := controllers.NewRegistryCredentialsReconciler(cl, credentials).Reconcile(ctx, newRegistryCredentialsRequest())
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(result.RequeueAfter).To(Equal(time.Minute))
+}
+
+func TestRegistryCredentialsReconcilerReconcileError(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	cl := fake.NewClientBuilder().WithRuntimeObjects(
+		registryCredentialsCluster("cluster-1", true),
+		registryCredentialsCluster("cluster-2", true),
+	).Build()
+	credentials := mocks.NewMockClusterRegistryCredentialsReconciler(gomock.NewController(t))
+	credentials.EXPECT().Reconcile(ctx, gomock.Any(), gomock.AssignableToTypeOf(&anywherev1.Cluster{})).DoAndReturn(
+		func(_ context.Context, _ interface{}, c *anywherev1.Cluster) (controller.Result, error) {
+			if c.Name == "cluster-1" {
+				return controller.Result{}, errors.New("unreachable")
+			}
+			return controller.Result{}, nil
+		},
+	).Times(2)
+
+	_, err := controllers.NewRegistryCredentialsReconciler(cl, credentials).Reconcile(ctx, newRegistryCredentialsRequest())
+	g.Expect(err).To(MatchError(ContainSubstring("propagating registry mirror credentials to cluster cluster-1: unreachable")))
+}
+
+func TestRegistryCredentialsReconcilerReconcileCluster(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	cl := fake.NewClientBuilder().WithRuntimeObjects(
+		registryCredentialsCluster("cluster-1", true),
+		registryCredentialsCluster("cluster-2", true),
+	).Build()
+	credentials := mocks.NewMockClusterRegistryCredentialsReconciler(gomock.NewController(t))
+	credentials.EXPECT().Reconcile(ctx, gomock.Any(), gomock.AssignableToTypeOf(&anywherev1.Cluster{})).DoAndReturn(
+		func(_ context.Context, _ interface{}, c *anywherev1.Cluster) (controller.Result, error) {
+			g.Expect(c.Name).To(Equal("cluster-2"))
+			return controller.Result{}, nil
+		},
+	)
+
+	req := reconcile.Request{NamespacedName: types.NamespacedName{Name: "cluster-2", Namespace: "default"}}
+	_, err := controllers.NewRegistryCredentialsReconciler(cl, credentials).Reconcile(ctx, req)
+	g.Expect(err).NotTo(HaveOccurred())
+}
+
+func TestRegistryCredentialsReconcilerReconcileClusterSkipped(t *testing.T) {
+	tests := []struct {
+		name    string
+		cluster string
+	}{
+		{
+			name:    "cluster not found",
+			cluster: "missing",
+		},
+		{
+			name:    "registry without authentication",
+			cluster: "anonymous",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			ctx := context.Background()
+			cl := fake.NewClientBuilder().WithRuntimeObjects(registryCredentialsCluster("anonymous", false)).Build()
+			credentials := mocks.NewMockClusterRegistryCredentialsReconciler(gomock.NewController(t))
+
+			req := reconcile.Request{NamespacedName: types.NamespacedName{Name: tt.cluster, Namespace: "default"}}
+			result, err := controllers.NewRegistryCredentialsReconciler(cl, credentials).Reconcile(ctx, req)
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(result).To(Equal(reconcile.Result{}))
+		})
+	}
+}
+
+func TestRegistryCredentialsReconcilerReconcileClusterError(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	cl := fake.NewClientBuilder().WithRuntimeObjects(registryCredentialsCluster("cluster-1", true)).Build()
+	credentials := mocks.NewMockClusterRegistryCredentialsReconciler(gomock.NewController(t))
+	credentials.EXPECT().Reconcile(ctx, gomock.Any(), gomock.AssignableToTypeOf(&anywherev1.Cluster{})).Return(controller.Result{}, errors.New("unreachable"))
+
+	req := reconcile.Request{NamespacedName: types.NamespacedName{Name: "cluster-1", Namespace: "default"}}
+	_, err := controllers.NewRegistryCredentialsReconciler(cl, credentials).Reconcile(ctx, req)
+	g.Expect(err).To(MatchError("propagating registry mirror credentials to cluster cluster-1: unreachable"))
+}
@@ -172,7 +172,9 @@ func setupFullLifecycleReconcilers(ctx context.Context, setupLog logr.Logger, mg
 	factory := controllers.NewFactory(ctrl.Log, mgr).
 		WithClusterReconciler(providers).
 		WithVSphereDatacenterReconciler().
-		WithSnowMachineConfigReconciler()
+		WithSnowMachineConfigReconciler().
+		WithFluxConfigReconciler().
+		WithRegistryCredentialsReconciler()
 
 	reconcilers, err := factory.Build(ctx)
 	if err != nil {
@@ -199,6 +201,18 @@ func setupFullLifecycleReconcilers(ctx context.Context, setupLog logr.Logger, mg
 		failed = true
 	}
 
+	setupLog.Info("Setting up fluxconfig controller")
+	if err := (reconcilers.FluxConfigReconciler).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", anywherev1.FluxConfigKind)
+		failed = true
+	}
+
+	setupLog.Info("Setting up registry credentials controller")
+	if err := (reconcilers.RegistryCredentialsReconciler).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "RegistryCredentials")
+		failed = true
+	}
+
 	if failed {
 		if err := factory.Close(ctx); err != nil {
 			setupLog.Error(err, "Failed closing controller factory")
@@ -241,6 +255,10 @@ func setupCoreWebhooks(setupLog logr.Logger, mgr ctrl.Manager) {
 		setupLog.Error(err, "unable to create webhook", WEBHOOK, anywherev1.FluxConfigKind)
 		os.Exit(1)
 	}
+	if err := (&anywherev1.ArgoCDConfig{}).SetupWebhookWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create webhook", WEBHOOK, anywherev1.ArgoCDConfigKind)
+		os.Exit(1)
+	}
 	if err := (&anywherev1.OIDCConfig{}).SetupWebhookWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create webhook", WEBHOOK, anywherev1.OIDCConfigKind)
 		os.Exit(1)
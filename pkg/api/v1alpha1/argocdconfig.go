@@ -0,0 +1,44 @@
+package v1alpha1
+
+import (
+	"errors"
+)
+
+const (
+	ArgoCDConfigKind       = "ArgoCDConfig"
+	ArgoCDDefaultNamespace = "argocd"
+	ArgoCDDefaultBranch    = "main"
+)
+
+func validateArgoCDConfig(config *ArgoCDConfig) error {
+	if config.Spec.Git == nil {
+		return errors.New("must specify a git repository for Argo CD")
+	}
+
+	if err := validateGitProviderConfig(*config.Spec.Git); err != nil {
+		return err
+	}
+
+	if len(config.Spec.Branch) > 0 {
+		if err := validateGitBranchName(config.Spec.Branch); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func setArgoCDConfigDefaults(argocd *ArgoCDConfig) {
+	if argocd == nil {
+		return
+	}
+
+	c := &argocd.Spec
+	if len(c.SystemNamespace) == 0 {
+		c.SystemNamespace = ArgoCDDefaultNamespace
+	}
+
+	if len(c.Branch) == 0 {
+		c.Branch = ArgoCDDefaultBranch
+	}
+}
@@ -0,0 +1,100 @@
+package v1alpha1_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+)
+
+func argoCDConfig() *v1alpha1.ArgoCDConfig {
+	return &v1alpha1.ArgoCDConfig{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       v1alpha1.ArgoCDConfigKind,
+			APIVersion: v1alpha1.SchemeBuilder.GroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-argocd",
+			Namespace: "default",
+		},
+		Spec: v1alpha1.ArgoCDConfigSpec{
+			Git: &v1alpha1.GitProviderConfig{
+				RepositoryUrl: "ssh://git@github.com/username/repo.git",
+			},
+		},
+	}
+}
+
+func TestArgoCDConfigValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  func(*v1alpha1.ArgoCDConfig)
+		wantErr string
+	}{
+		{
+			name:   "valid",
+			config: func(*v1alpha1.ArgoCDConfig) {},
+		},
+		{
+			name: "missing git",
+			config: func(c *v1alpha1.ArgoCDConfig) {
+				c.Spec.Git = nil
+			},
+			wantErr: "must specify a git repository for Argo CD",
+		},
+		{
+			name: "missing repository url",
+			config: func(c *v1alpha1.ArgoCDConfig) {
+				c.Spec.Git.RepositoryUrl = ""
+			},
+			wantErr: "repositoryUrl is a required field",
+		},
+		{
+			name: "invalid branch",
+			config: func(c *v1alpha1.ArgoCDConfig) {
+				c.Spec.Branch = "main..branch"
+			},
+			wantErr: "is not a valid git branch name",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			c := argoCDConfig()
+			tt.config(c)
+			err := c.Validate()
+			if tt.wantErr == "" {
+				g.Expect(err).NotTo(HaveOccurred())
+			} else {
+				g.Expect(err).To(MatchError(ContainSubstring(tt.wantErr)))
+			}
+		})
+	}
+}
+
+func TestArgoCDConfigSetDefaults(t *testing.T) {
+	g := NewWithT(t)
+	c := argoCDConfig()
+	c.SetDefaults()
+	g.Expect(c.Spec.SystemNamespace).To(Equal("argocd"))
+	g.Expect(c.Spec.Branch).To(Equal("main"))
+}
+
+func TestArgoCDConfigValidateUpdateImmutable(t *testing.T) {
+	g := NewWithT(t)
+	old := argoCDConfig()
+	c := old.DeepCopy()
+	c.Spec.Git.RepositoryUrl = "ssh://git@github.com/username/repo2.git"
+
+	g.Expect(c.ValidateUpdate(old)).To(MatchError(ContainSubstring("Forbidden: config is immutable")))
+}
+
+func TestArgoCDConfigValidateCreateInvalid(t *testing.T) {
+	g := NewWithT(t)
+	c := argoCDConfig()
+	c.Spec.Git = nil
+
+	g.Expect(c.ValidateCreate()).To(MatchError(ContainSubstring("must specify a git repository for Argo CD")))
+}
@@ -0,0 +1,112 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ArgoCDConfigSpec defines the desired state of ArgoCDConfig.
+type ArgoCDConfigSpec struct {
+	// SystemNamespace where Argo CD is installed. Defaults to argocd
+	SystemNamespace string `json:"systemNamespace,omitempty"`
+
+	// ClusterConfigPath relative to the repository root, when specified the cluster sync will be scoped to this path.
+	ClusterConfigPath string `json:"clusterConfigPath,omitempty"`
+
+	// Git branch. Defaults to main.
+	// +kubebuilder:default:="main"
+	Branch string `json:"branch,omitempty"`
+
+	// Git repository Argo CD syncs the cluster configuration from. Can be either an SSH or HTTPS url.
+	Git *GitProviderConfig `json:"git"`
+}
+
+// ArgoCDConfigStatus defines the observed state of ArgoCDConfig.
+type ArgoCDConfigStatus struct{}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+
+// ArgoCDConfig is the Schema for the argocdconfigs API and defines the configurations of the Argo CD
+// installation and Git repository it syncs the cluster from.
+type ArgoCDConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ArgoCDConfigSpec   `json:"spec,omitempty"`
+	Status ArgoCDConfigStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:generate=false
+// Same as ArgoCDConfig except stripped down for generation of yaml file while writing to the git repo.
+type ArgoCDConfigGenerate struct {
+	metav1.TypeMeta `json:",inline"`
+	ObjectMeta      `json:"metadata,omitempty"`
+
+	Spec ArgoCDConfigSpec `json:"spec,omitempty"`
+}
+
+func (e *ArgoCDConfigSpec) Equal(n *ArgoCDConfigSpec) bool {
+	if e == n {
+		return true
+	}
+	if e == nil || n == nil {
+		return false
+	}
+	if e.SystemNamespace != n.SystemNamespace {
+		return false
+	}
+	if e.Branch != n.Branch {
+		return false
+	}
+	if e.ClusterConfigPath != n.ClusterConfigPath {
+		return false
+	}
+	return e.Git.Equal(n.Git)
+}
+
+//+kubebuilder:object:root=true
+
+// ArgoCDConfigList contains a list of ArgoCDConfig.
+type ArgoCDConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ArgoCDConfig `json:"items"`
+}
+
+func (c *ArgoCDConfig) Kind() string {
+	return c.TypeMeta.Kind
+}
+
+func (c *ArgoCDConfig) ExpectedKind() string {
+	return ArgoCDConfigKind
+}
+
+func (c *ArgoCDConfig) ConvertConfigToConfigGenerateStruct() *ArgoCDConfigGenerate {
+	namespace := defaultEksaNamespace
+	if c.Namespace != "" {
+		namespace = c.Namespace
+	}
+	config := &ArgoCDConfigGenerate{
+		TypeMeta: c.TypeMeta,
+		ObjectMeta: ObjectMeta{
+			Name:        c.Name,
+			Annotations: c.Annotations,
+			Namespace:   namespace,
+		},
+		Spec: c.Spec,
+	}
+
+	return config
+}
+
+func (c *ArgoCDConfig) Validate() error {
+	return validateArgoCDConfig(c)
+}
+
+func (c *ArgoCDConfig) SetDefaults() {
+	setArgoCDConfigDefaults(c)
+}
+
+func init() {
+	SchemeBuilder.Register(&ArgoCDConfig{}, &ArgoCDConfigList{})
+}
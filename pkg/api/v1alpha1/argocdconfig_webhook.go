@@ -0,0 +1,98 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+// log is for logging in this package.
+var argocdconfiglog = logf.Log.WithName("argocdconfig-resource")
+
+func (r *ArgoCDConfig) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		Complete()
+}
+
+// Change verbs to "verbs=create;update;delete" if you want to enable deletion validation.
+//+kubebuilder:webhook:path=/validate-anywhere-eks-amazonaws-com-v1alpha1-argocdconfig,mutating=false,failurePolicy=fail,sideEffects=None,groups=anywhere.eks.amazonaws.com,resources=argocdconfigs,verbs=create;update,versions=v1alpha1,name=validation.argocdconfig.anywhere.amazonaws.com,admissionReviewVersions={v1,v1beta1}
+
+var _ webhook.Validator = &ArgoCDConfig{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type.
+func (r *ArgoCDConfig) ValidateCreate() error {
+	argocdconfiglog.Info("validate create", "name", r.Name)
+
+	if err := r.Validate(); err != nil {
+		return apierrors.NewInvalid(
+			r.GroupVersionKind().GroupKind(),
+			r.Name,
+			field.ErrorList{field.Invalid(field.NewPath("spec"), r.Spec, err.Error())})
+	}
+
+	return nil
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type.
+func (r *ArgoCDConfig) ValidateUpdate(old runtime.Object) error {
+	argocdconfiglog.Info("validate update", "name", r.Name)
+
+	oldArgoCDConfig, ok := old.(*ArgoCDConfig)
+	if !ok {
+		return apierrors.NewBadRequest(fmt.Sprintf("expected a ArgoCDConfig but got a %T", old))
+	}
+
+	var allErrs field.ErrorList
+
+	allErrs = append(allErrs, validateImmutableArgoCDFields(r, oldArgoCDConfig)...)
+
+	if err := r.Validate(); err != nil {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec"), r.Spec, err.Error()))
+	}
+
+	if len(allErrs) == 0 {
+		return nil
+	}
+
+	return apierrors.NewInvalid(GroupVersion.WithKind(ArgoCDConfigKind).GroupKind(), r.Name, allErrs)
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type.
+func (r *ArgoCDConfig) ValidateDelete() error {
+	argocdconfiglog.Info("validate delete", "name", r.Name)
+
+	return nil
+}
+
+func validateImmutableArgoCDFields(new, old *ArgoCDConfig) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if !new.Spec.Equal(&old.Spec) {
+		allErrs = append(
+			allErrs,
+			field.Forbidden(field.NewPath(ArgoCDConfigKind), "config is immutable"),
+		)
+	}
+
+	return allErrs
+}
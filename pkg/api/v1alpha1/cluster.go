@@ -1,6 +1,7 @@
 package v1alpha1
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
@@ -11,9 +12,12 @@ import (
 	"strconv"
 	"strings"
 
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/validation"
 	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	apimachineryvalidation "k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"sigs.k8s.io/yaml"
 
@@ -31,6 +35,12 @@ const (
 
 var re = regexp.MustCompile(constants.DefaultCuratedPackagesRegistryRegex)
 
+var ipv6SupportedDatacenterKinds = map[string]struct{}{
+	VSphereDatacenterKind:    {},
+	TinkerbellDatacenterKind: {},
+	DockerDatacenterKind:     {},
+}
+
 // +kubebuilder:object:generate=false
 type ClusterGenerateOpt func(config *ClusterGenerate)
 
@@ -198,8 +208,10 @@ var clusterConfigValidations = []func(*Cluster) error{
 	validateProxyConfig,
 	validateMirrorConfig,
 	validatePodIAMConfig,
+	validateServiceLoadBalancer,
 	validateCPUpgradeRolloutStrategy,
 	validateControlPlaneLabels,
+	validateMachineHealthChecks,
 }
 
 // GetClusterConfig parses a Cluster object from a multiobject yaml file in disk
@@ -556,19 +568,45 @@ func validateNetworking(clusterConfig *Cluster) error {
 	if len(clusterNetwork.Services.CidrBlocks) <= 0 {
 		return errors.New("services CIDR block not specified or empty")
 	}
-	if len(clusterNetwork.Pods.CidrBlocks) > 1 {
-		return fmt.Errorf("multiple CIDR blocks for Pods are not yet supported")
+	if len(clusterNetwork.Pods.CidrBlocks) > 2 {
+		return fmt.Errorf("at most two CIDR blocks for Pods, one IPv4 and one IPv6, are supported")
 	}
-	if len(clusterNetwork.Services.CidrBlocks) > 1 {
-		return fmt.Errorf("multiple CIDR blocks for Services are not yet supported")
+	if len(clusterNetwork.Services.CidrBlocks) > 2 {
+		return fmt.Errorf("at most two CIDR blocks for Services, one IPv4 and one IPv6, are supported")
 	}
-	_, podCIDRIpNet, err := net.ParseCIDR(clusterNetwork.Pods.CidrBlocks[0])
-	if err != nil {
-		return fmt.Errorf("invalid CIDR block format for Pods: %s. Please specify a valid CIDR block for pod subnet", clusterNetwork.Pods)
+	var podCIDRIpNet *net.IPNet
+	for _, cidr := range clusterNetwork.Pods.CidrBlocks {
+		_, ipNet, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return fmt.Errorf("invalid CIDR block format for Pods: %s. Please specify a valid CIDR block for pod subnet", clusterNetwork.Pods)
+		}
+		// the node cidr mask size applies to the IPv4 pod subnet in dual-stack clusters
+		if podCIDRIpNet == nil || ipNet.IP.To4() != nil {
+			podCIDRIpNet = ipNet
+		}
 	}
-	_, _, err = net.ParseCIDR(clusterNetwork.Services.CidrBlocks[0])
-	if err != nil {
-		return fmt.Errorf("invalid CIDR block for Services: %s. Please specify a valid CIDR block for service subnet", clusterNetwork.Services)
+	for _, cidr := range clusterNetwork.Services.CidrBlocks {
+		if _, _, err := net.ParseCIDR(cidr); err != nil {
+			return fmt.Errorf("invalid CIDR block for Services: %s. Please specify a valid CIDR block for service subnet", clusterNetwork.Services)
+		}
+	}
+
+	podFamilies := cidrIPFamilies(clusterNetwork.Pods.CidrBlocks)
+	serviceFamilies := cidrIPFamilies(clusterNetwork.Services.CidrBlocks)
+	if len(podFamilies) == 2 && podFamilies[0] == podFamilies[1] {
+		return fmt.Errorf("dual-stack CIDR blocks for Pods must include one IPv4 and one IPv6 block")
+	}
+	if len(serviceFamilies) == 2 && serviceFamilies[0] == serviceFamilies[1] {
+		return fmt.Errorf("dual-stack CIDR blocks for Services must include one IPv4 and one IPv6 block")
+	}
+	if strings.Join(podFamilies, ",") != strings.Join(serviceFamilies, ",") {
+		return fmt.Errorf("CIDR blocks for Pods and Services must have the same IP families in the same order")
+	}
+
+	if clusterNetwork.IPv6Enabled() {
+		if err := validateIPv6Networking(clusterConfig); err != nil {
+			return err
+		}
 	}
 
 	if clusterNetwork.Nodes != nil && clusterNetwork.Nodes.CIDRMaskSize != nil {
@@ -589,6 +627,29 @@ func validateNetworking(clusterConfig *Cluster) error {
 	return validateCNIPlugin(clusterNetwork)
 }
 
+func cidrIPFamilies(cidrs []string) []string {
+	families := make([]string, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		if networkutils.IsIPv6CIDR(cidr) {
+			families = append(families, "IPv6")
+		} else {
+			families = append(families, "IPv4")
+		}
+	}
+	return families
+}
+
+func validateIPv6Networking(clusterConfig *Cluster) error {
+	if _, ok := ipv6SupportedDatacenterKinds[clusterConfig.Spec.DatacenterRef.Kind]; !ok {
+		return fmt.Errorf("IPv6 and dual-stack networking are not supported for %s", clusterConfig.Spec.DatacenterRef.Kind)
+	}
+	cniConfig := clusterConfig.Spec.ClusterNetwork.CNIConfig
+	if clusterConfig.Spec.ClusterNetwork.CNI == Kindnetd || (cniConfig != nil && cniConfig.Kindnetd != nil) {
+		return fmt.Errorf("IPv6 and dual-stack networking are not supported with kindnetd")
+	}
+	return nil
+}
+
 func validateCNIPlugin(network ClusterNetwork) error {
 	if network.CNI != "" {
 		if network.CNIConfig != nil {
@@ -621,6 +682,13 @@ func validateCNIConfig(cniConfig *CNIConfig) error {
 		cniPluginSpecified++
 	}
 
+	if cniConfig.Custom != nil {
+		cniPluginSpecified++
+		if err := validateCustomCNIConfig(cniConfig.Custom); err != nil {
+			allErrs = append(allErrs, err)
+		}
+	}
+
 	if cniPluginSpecified == 0 {
 		allErrs = append(allErrs, fmt.Errorf("no cni plugin specified"))
 	} else if cniPluginSpecified > 1 {
@@ -636,11 +704,55 @@ func validateCNIConfig(cniConfig *CNIConfig) error {
 }
 
 func validateCiliumConfig(cilium *CiliumConfig) error {
-	if cilium.PolicyEnforcementMode == "" {
+	if cilium.PolicyEnforcementMode != "" && !validCiliumPolicyEnforcementModes[cilium.PolicyEnforcementMode] {
+		return fmt.Errorf("cilium policyEnforcementMode \"%s\" not supported", cilium.PolicyEnforcementMode)
+	}
+
+	if cilium.RoutingMode != "" && !validCiliumRoutingModes[cilium.RoutingMode] {
+		return fmt.Errorf("cilium routingMode \"%s\" not supported", cilium.RoutingMode)
+	}
+
+	if cilium.RoutingMode == CiliumRoutingModeNative {
+		if cilium.IPv4NativeRoutingCIDR == "" {
+			return errors.New("cilium ipv4NativeRoutingCIDR is required when routingMode is native")
+		}
+		if _, _, err := net.ParseCIDR(cilium.IPv4NativeRoutingCIDR); err != nil {
+			return fmt.Errorf("cilium ipv4NativeRoutingCIDR \"%s\" is invalid: %v", cilium.IPv4NativeRoutingCIDR, err)
+		}
+	} else if cilium.IPv4NativeRoutingCIDR != "" {
+		return errors.New("cilium ipv4NativeRoutingCIDR can only be set when routingMode is native")
+	}
+
+	if cilium.KubeProxyReplacement != "" && !validCiliumKubeProxyReplacementModes[cilium.KubeProxyReplacement] {
+		return fmt.Errorf("cilium kubeProxyReplacement \"%s\" not supported", cilium.KubeProxyReplacement)
+	}
+
+	if cilium.EgressGateway && cilium.KubeProxyReplacement != CiliumKubeProxyReplacementStrict {
+		return errors.New("cilium egressGateway requires kubeProxyReplacement to be strict")
+	}
+
+	if cilium.Hubble != nil && cilium.Hubble.UI && !cilium.Hubble.Relay {
+		return errors.New("cilium hubble ui requires hubble relay to be enabled")
+	}
+
+	return nil
+}
+
+func validateCustomCNIConfig(custom *CustomCNIConfig) error {
+	if custom.ManifestPath != "" && custom.HelmChart != nil {
+		return errors.New("custom cni manifestPath and helmChart are mutually exclusive")
+	}
+	if custom.HelmChart == nil {
 		return nil
 	}
-	if !validCiliumPolicyEnforcementModes[cilium.PolicyEnforcementMode] {
-		return fmt.Errorf("cilium policyEnforcementMode \"%s\" not supported", cilium.PolicyEnforcementMode)
+	if custom.HelmChart.Name == "" {
+		return errors.New("custom cni helmChart name is required")
+	}
+	if custom.HelmChart.URI == "" {
+		return errors.New("custom cni helmChart uri is required")
+	}
+	if custom.HelmChart.Version == "" {
+		return errors.New("custom cni helmChart version is required")
 	}
 	return nil
 }
@@ -705,6 +817,19 @@ func validateMirrorConfig(clusterConfig *Cluster) error {
 		return errors.New("insecureSkipVerify is only supported for snow provider")
 	}
 
+	for _, failover := range clusterConfig.Spec.RegistryMirrorConfiguration.FailoverEndpoints {
+		if failover.Endpoint == "" {
+			return errors.New("no value set for endpoint in RegistryMirrorConfiguration.FailoverEndpoints")
+		}
+		if !networkutils.IsPortValid(failover.Port) {
+			return fmt.Errorf("registry mirror failover endpoint %s port %s is invalid, please provide a valid port", failover.Endpoint, failover.Port)
+		}
+	}
+
+	if err := validateUpstreamRegistries(clusterConfig.Spec.RegistryMirrorConfiguration); err != nil {
+		return err
+	}
+
 	mirrorCount := 0
 	ociNamespaces := clusterConfig.Spec.RegistryMirrorConfiguration.OCINamespaces
 	for _, ociNamespace := range ociNamespaces {
@@ -729,6 +854,23 @@ func validateMirrorConfig(clusterConfig *Cluster) error {
 	return nil
 }
 
+func validateUpstreamRegistries(mirrorConfig *RegistryMirrorConfiguration) error {
+	upstreams := make(map[string]bool, len(mirrorConfig.Upstreams))
+	for _, upstream := range mirrorConfig.Upstreams {
+		if upstream.Registry == "" {
+			return errors.New("registry can't be set to empty in Upstreams")
+		}
+		if upstreams[upstream.Registry] {
+			return fmt.Errorf("upstream registry %s is specified more than once", upstream.Registry)
+		}
+		upstreams[upstream.Registry] = true
+		if upstream.SkipMirror && upstream.Registry == constants.DefaultCoreEKSARegistry {
+			return fmt.Errorf("skipMirror can't be set for %s, EKS Anywhere images are always pulled through the registry mirror", constants.DefaultCoreEKSARegistry)
+		}
+	}
+	return nil
+}
+
 func validateIdentityProviderRefs(clusterConfig *Cluster) error {
 	refs := clusterConfig.Spec.IdentityProviderRefs
 	if len(refs) == 0 {
@@ -753,8 +895,8 @@ func validateGitOps(clusterConfig *Cluster) error {
 
 	gitOpsRefKind := gitOpsRef.Kind
 
-	if gitOpsRefKind != GitOpsConfigKind && gitOpsRefKind != FluxConfigKind {
-		return errors.New("only GitOpsConfig or FluxConfig Kind are supported at this time")
+	if gitOpsRefKind != GitOpsConfigKind && gitOpsRefKind != FluxConfigKind && gitOpsRefKind != ArgoCDConfigKind {
+		return errors.New("only GitOpsConfig, FluxConfig or ArgoCDConfig Kind are supported at this time")
 	}
 
 	if gitOpsRef.Name == "" {
@@ -773,6 +915,80 @@ func validatePodIAMConfig(clusterConfig *Cluster) error {
 	return nil
 }
 
+func validateServiceLoadBalancer(clusterConfig *Cluster) error {
+	lb := clusterConfig.Spec.ServiceLoadBalancer
+	if lb == nil {
+		return nil
+	}
+	if !validServiceLoadBalancerProviders[lb.Provider] {
+		return fmt.Errorf("service load balancer provider \"%s\" not supported", lb.Provider)
+	}
+	if len(lb.AddressPools) == 0 {
+		return errors.New("service load balancer requires at least one address pool")
+	}
+
+	poolNames := make(map[string]struct{}, len(lb.AddressPools))
+	for _, pool := range lb.AddressPools {
+		if pool.Name == "" {
+			return errors.New("service load balancer address pool name is required")
+		}
+		if _, ok := poolNames[pool.Name]; ok {
+			return fmt.Errorf("service load balancer address pool name %s is duplicated", pool.Name)
+		}
+		poolNames[pool.Name] = struct{}{}
+		if lb.Provider == KubeVipServiceLoadBalancer {
+			if err := validateKubeVipAddressPoolName(pool.Name); err != nil {
+				return err
+			}
+		}
+		if len(pool.Addresses) == 0 {
+			return fmt.Errorf("service load balancer address pool %s has no addresses", pool.Name)
+		}
+		for _, address := range pool.Addresses {
+			if err := validateAddressPoolEntry(address); err != nil {
+				return fmt.Errorf("service load balancer address pool %s: %v", pool.Name, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateKubeVipAddressPoolName checks name is either "global" or a namespace name, since
+// kube-vip cloud provider only reads pools keyed by the namespace of the Service or "global".
+func validateKubeVipAddressPoolName(name string) error {
+	if name == kubeVipGlobalAddressPool {
+		return nil
+	}
+	if errs := apimachineryvalidation.IsDNS1123Label(name); len(errs) != 0 {
+		return fmt.Errorf("service load balancer address pool name %s must be \"%s\" or a valid namespace name for kube-vip: %s", name, kubeVipGlobalAddressPool, strings.Join(errs, ", "))
+	}
+	return nil
+}
+
+// validateAddressPoolEntry checks address is either a CIDR block or an IP range in the form "<start>-<end>".
+func validateAddressPoolEntry(address string) error {
+	if _, _, err := net.ParseCIDR(address); err == nil {
+		return nil
+	}
+	start, end, found := strings.Cut(address, "-")
+	if !found {
+		return fmt.Errorf("address %s is not a valid CIDR block or IP range", address)
+	}
+	startIP := net.ParseIP(strings.TrimSpace(start))
+	endIP := net.ParseIP(strings.TrimSpace(end))
+	if startIP == nil || endIP == nil {
+		return fmt.Errorf("address range %s is invalid", address)
+	}
+	if (startIP.To4() == nil) != (endIP.To4() == nil) {
+		return fmt.Errorf("address range %s mixes IPv4 and IPv6 addresses", address)
+	}
+	if bytes.Compare(startIP.To16(), endIP.To16()) > 0 {
+		return fmt.Errorf("address range %s start is greater than its end", address)
+	}
+	return nil
+}
+
 func validateCPUpgradeRolloutStrategy(clusterConfig *Cluster) error {
 	if clusterConfig.Spec.ControlPlaneConfiguration.UpgradeRolloutStrategy == nil {
 		return nil
@@ -793,6 +1009,61 @@ func validateCPUpgradeRolloutStrategy(clusterConfig *Cluster) error {
 	return nil
 }
 
+func validateMachineHealthChecks(clusterConfig *Cluster) error {
+	if err := validateMachineHealthCheck(clusterConfig.Spec.ControlPlaneConfiguration.MachineHealthCheck); err != nil {
+		return fmt.Errorf("ControlPlaneConfiguration: %v", err)
+	}
+
+	for _, w := range clusterConfig.Spec.WorkerNodeGroupConfigurations {
+		if err := validateMachineHealthCheck(w.MachineHealthCheck); err != nil {
+			return fmt.Errorf("WorkerNodeGroupConfiguration %s: %v", w.Name, err)
+		}
+	}
+
+	return nil
+}
+
+func validateMachineHealthCheck(mhc *MachineHealthCheck) error {
+	if mhc == nil {
+		return nil
+	}
+
+	if mhc.NodeStartupTimeout != nil && mhc.NodeStartupTimeout.Duration <= 0 {
+		return errors.New("machineHealthCheck nodeStartupTimeout must be greater than 0")
+	}
+
+	if mhc.UnhealthyMachineTimeout != nil && mhc.UnhealthyMachineTimeout.Duration <= 0 {
+		return errors.New("machineHealthCheck unhealthyMachineTimeout must be greater than 0")
+	}
+
+	if mhc.MaxUnhealthy != nil {
+		if mhc.DisableRemediation {
+			return errors.New("machineHealthCheck maxUnhealthy can't be set when remediation is disabled")
+		}
+		maxUnhealthy, err := intstr.GetScaledValueFromIntOrPercent(mhc.MaxUnhealthy, 100, false)
+		if err != nil {
+			return fmt.Errorf("machineHealthCheck maxUnhealthy %s is invalid: %v", mhc.MaxUnhealthy.String(), err)
+		}
+		if maxUnhealthy < 0 {
+			return fmt.Errorf("machineHealthCheck maxUnhealthy %s can't be negative", mhc.MaxUnhealthy.String())
+		}
+	}
+
+	for _, c := range mhc.UnhealthyConditions {
+		if c.Type == "" {
+			return errors.New("machineHealthCheck unhealthyConditions must specify a type")
+		}
+		if c.Status != corev1.ConditionTrue && c.Status != corev1.ConditionFalse && c.Status != corev1.ConditionUnknown {
+			return fmt.Errorf("machineHealthCheck unhealthyCondition %s status %s is invalid, it must be True, False or Unknown", c.Type, c.Status)
+		}
+		if c.Timeout.Duration <= 0 {
+			return fmt.Errorf("machineHealthCheck unhealthyCondition %s timeout must be greater than 0", c.Type)
+		}
+	}
+
+	return nil
+}
+
 func validateMDUpgradeRolloutStrategy(w *WorkerNodeGroupConfiguration) error {
 	if w.UpgradeRolloutStrategy == nil {
 		return nil
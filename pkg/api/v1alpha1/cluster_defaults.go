@@ -33,6 +33,11 @@ func setRegistryMirrorConfigDefaults(clusterConfig *Cluster) error {
 		logger.V(1).Info("RegistryMirrorConfiguration.Port is not specified, default port will be used", "Default Port", constants.DefaultHttpsPort)
 		clusterConfig.Spec.RegistryMirrorConfiguration.Port = constants.DefaultHttpsPort
 	}
+	for i := range clusterConfig.Spec.RegistryMirrorConfiguration.FailoverEndpoints {
+		if clusterConfig.Spec.RegistryMirrorConfiguration.FailoverEndpoints[i].Port == "" {
+			clusterConfig.Spec.RegistryMirrorConfiguration.FailoverEndpoints[i].Port = constants.DefaultHttpsPort
+		}
+	}
 	if clusterConfig.Spec.RegistryMirrorConfiguration.CACertContent == "" {
 		if caCert, set := os.LookupEnv(RegistryMirrorCAKey); set && len(caCert) > 0 {
 			content, err := ioutil.ReadFile(caCert)
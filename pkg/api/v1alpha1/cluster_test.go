@@ -6,10 +6,12 @@ import (
 	"reflect"
 	"strings"
 	"testing"
+	"time"
 
 	. "github.com/onsi/gomega"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 
 	"github.com/aws/eks-anywhere/pkg/utils/ptr"
 )
@@ -1917,6 +1919,36 @@ func TestClusterNetworkEquals(t *testing.T) {
 				CNIConfig: &CNIConfig{Cilium: &CiliumConfig{PolicyEnforcementMode: "default"}},
 			},
 		},
+		{
+			name: "previous != new, new cniConfig format, cilium to custom cni",
+			want: false,
+			prev: &ClusterNetwork{
+				CNIConfig: &CNIConfig{Cilium: &CiliumConfig{}},
+			},
+			new: &ClusterNetwork{
+				CNIConfig: &CNIConfig{Custom: &CustomCNIConfig{}},
+			},
+		},
+		{
+			name: "previous == new, new cniConfig format, same custom cni",
+			want: true,
+			prev: &ClusterNetwork{
+				CNIConfig: &CNIConfig{Custom: &CustomCNIConfig{ManifestPath: "calico.yaml"}},
+			},
+			new: &ClusterNetwork{
+				CNIConfig: &CNIConfig{Custom: &CustomCNIConfig{ManifestPath: "calico.yaml"}},
+			},
+		},
+		{
+			name: "previous != new, new cniConfig format, same custom cni, diff helm chart",
+			want: false,
+			prev: &ClusterNetwork{
+				CNIConfig: &CNIConfig{Custom: &CustomCNIConfig{HelmChart: &CustomCNIHelmChart{Name: "calico", Version: "v1"}}},
+			},
+			new: &ClusterNetwork{
+				CNIConfig: &CNIConfig{Custom: &CustomCNIConfig{HelmChart: &CustomCNIHelmChart{Name: "calico", Version: "v2"}}},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -2179,6 +2211,181 @@ func TestValidateNetworking(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:    "dual-stack valid",
+			wantErr: nil,
+			cluster: &Cluster{
+				Spec: ClusterSpec{
+					DatacenterRef: Ref{
+						Kind: VSphereDatacenterKind,
+					},
+					ClusterNetwork: ClusterNetwork{
+						Pods: Pods{
+							CidrBlocks: []string{
+								"192.168.0.0/16",
+								"fd00:100:64::/48",
+							},
+						},
+						Services: Services{
+							CidrBlocks: []string{
+								"10.96.0.0/12",
+								"fd00:10:96::/108",
+							},
+						},
+						CNIConfig: &CNIConfig{Cilium: &CiliumConfig{}},
+					},
+				},
+			},
+		},
+		{
+			name:    "ipv6 only valid",
+			wantErr: nil,
+			cluster: &Cluster{
+				Spec: ClusterSpec{
+					DatacenterRef: Ref{
+						Kind: DockerDatacenterKind,
+					},
+					ClusterNetwork: ClusterNetwork{
+						Pods: Pods{
+							CidrBlocks: []string{
+								"fd00:100:64::/48",
+							},
+						},
+						Services: Services{
+							CidrBlocks: []string{
+								"fd00:10:96::/108",
+							},
+						},
+						CNIConfig: &CNIConfig{Cilium: &CiliumConfig{}},
+					},
+				},
+			},
+		},
+		{
+			name:    "too many pods CIDR blocks",
+			wantErr: fmt.Errorf("at most two CIDR blocks for Pods, one IPv4 and one IPv6, are supported"),
+			cluster: &Cluster{
+				Spec: ClusterSpec{
+					DatacenterRef: Ref{
+						Kind: VSphereDatacenterKind,
+					},
+					ClusterNetwork: ClusterNetwork{
+						Pods: Pods{
+							CidrBlocks: []string{
+								"192.168.0.0/16",
+								"fd00:100:64::/48",
+								"10.0.0.0/8",
+							},
+						},
+						Services: Services{
+							CidrBlocks: []string{
+								"10.96.0.0/12",
+							},
+						},
+						CNIConfig: &CNIConfig{Cilium: &CiliumConfig{}},
+					},
+				},
+			},
+		},
+		{
+			name:    "dual-stack pods CIDR blocks same family",
+			wantErr: fmt.Errorf("dual-stack CIDR blocks for Pods must include one IPv4 and one IPv6 block"),
+			cluster: &Cluster{
+				Spec: ClusterSpec{
+					DatacenterRef: Ref{
+						Kind: VSphereDatacenterKind,
+					},
+					ClusterNetwork: ClusterNetwork{
+						Pods: Pods{
+							CidrBlocks: []string{
+								"192.168.0.0/16",
+								"10.0.0.0/8",
+							},
+						},
+						Services: Services{
+							CidrBlocks: []string{
+								"10.96.0.0/12",
+							},
+						},
+						CNIConfig: &CNIConfig{Cilium: &CiliumConfig{}},
+					},
+				},
+			},
+		},
+		{
+			name:    "pods and services families mismatch",
+			wantErr: fmt.Errorf("CIDR blocks for Pods and Services must have the same IP families in the same order"),
+			cluster: &Cluster{
+				Spec: ClusterSpec{
+					DatacenterRef: Ref{
+						Kind: VSphereDatacenterKind,
+					},
+					ClusterNetwork: ClusterNetwork{
+						Pods: Pods{
+							CidrBlocks: []string{
+								"192.168.0.0/16",
+								"fd00:100:64::/48",
+							},
+						},
+						Services: Services{
+							CidrBlocks: []string{
+								"fd00:10:96::/108",
+								"10.96.0.0/12",
+							},
+						},
+						CNIConfig: &CNIConfig{Cilium: &CiliumConfig{}},
+					},
+				},
+			},
+		},
+		{
+			name:    "ipv6 unsupported provider",
+			wantErr: fmt.Errorf("IPv6 and dual-stack networking are not supported for CloudStackDatacenterConfig"),
+			cluster: &Cluster{
+				Spec: ClusterSpec{
+					DatacenterRef: Ref{
+						Kind: CloudStackDatacenterKind,
+					},
+					ClusterNetwork: ClusterNetwork{
+						Pods: Pods{
+							CidrBlocks: []string{
+								"fd00:100:64::/48",
+							},
+						},
+						Services: Services{
+							CidrBlocks: []string{
+								"fd00:10:96::/108",
+							},
+						},
+						CNIConfig: &CNIConfig{Cilium: &CiliumConfig{}},
+					},
+				},
+			},
+		},
+		{
+			name:    "ipv6 with kindnetd",
+			wantErr: fmt.Errorf("IPv6 and dual-stack networking are not supported with kindnetd"),
+			cluster: &Cluster{
+				Spec: ClusterSpec{
+					DatacenterRef: Ref{
+						Kind: TinkerbellDatacenterKind,
+					},
+					ClusterNetwork: ClusterNetwork{
+						Pods: Pods{
+							CidrBlocks: []string{
+								"fd00:100:64::/48",
+							},
+						},
+						Services: Services{
+							CidrBlocks: []string{
+								"fd00:10:96::/108",
+							},
+						},
+						CNIConfig: &CNIConfig{Kindnetd: &KindnetdConfig{}},
+					},
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -2269,6 +2476,139 @@ func TestValidateCNIConfig(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:    "valid custom cni without manifest",
+			wantErr: nil,
+			clusterNetwork: &ClusterNetwork{
+				CNIConfig: &CNIConfig{
+					Custom: &CustomCNIConfig{},
+				},
+			},
+		},
+		{
+			name:    "custom cni with manifest and helm chart",
+			wantErr: fmt.Errorf("validating cniConfig: custom cni manifestPath and helmChart are mutually exclusive"),
+			clusterNetwork: &ClusterNetwork{
+				CNIConfig: &CNIConfig{
+					Custom: &CustomCNIConfig{
+						ManifestPath: "calico.yaml",
+						HelmChart: &CustomCNIHelmChart{
+							Name:    "calico",
+							URI:     "oci://registry/calico",
+							Version: "v3.25.0",
+						},
+					},
+				},
+			},
+		},
+		{
+			name:    "custom cni helm chart without version",
+			wantErr: fmt.Errorf("validating cniConfig: custom cni helmChart version is required"),
+			clusterNetwork: &ClusterNetwork{
+				CNIConfig: &CNIConfig{
+					Custom: &CustomCNIConfig{
+						HelmChart: &CustomCNIHelmChart{
+							Name: "calico",
+							URI:  "oci://registry/calico",
+						},
+					},
+				},
+			},
+		},
+		{
+			name:    "custom cni and cilium specified",
+			wantErr: fmt.Errorf("validating cniConfig: cannot specify more than one cni plugins"),
+			clusterNetwork: &ClusterNetwork{
+				CNIConfig: &CNIConfig{
+					Cilium: &CiliumConfig{},
+					Custom: &CustomCNIConfig{},
+				},
+			},
+		},
+		{
+			name:    "invalid cilium routing mode",
+			wantErr: fmt.Errorf("validating cniConfig: cilium routingMode \"bgp\" not supported"),
+			clusterNetwork: &ClusterNetwork{
+				CNIConfig: &CNIConfig{
+					Cilium: &CiliumConfig{
+						RoutingMode: "bgp",
+					},
+				},
+			},
+		},
+		{
+			name:    "cilium native routing without cidr",
+			wantErr: fmt.Errorf("validating cniConfig: cilium ipv4NativeRoutingCIDR is required when routingMode is native"),
+			clusterNetwork: &ClusterNetwork{
+				CNIConfig: &CNIConfig{
+					Cilium: &CiliumConfig{
+						RoutingMode: CiliumRoutingModeNative,
+					},
+				},
+			},
+		},
+		{
+			name:    "cilium native routing cidr with tunnel mode",
+			wantErr: fmt.Errorf("validating cniConfig: cilium ipv4NativeRoutingCIDR can only be set when routingMode is native"),
+			clusterNetwork: &ClusterNetwork{
+				CNIConfig: &CNIConfig{
+					Cilium: &CiliumConfig{
+						IPv4NativeRoutingCIDR: "10.0.0.0/8",
+					},
+				},
+			},
+		},
+		{
+			name:    "invalid cilium kube proxy replacement",
+			wantErr: fmt.Errorf("validating cniConfig: cilium kubeProxyReplacement \"full\" not supported"),
+			clusterNetwork: &ClusterNetwork{
+				CNIConfig: &CNIConfig{
+					Cilium: &CiliumConfig{
+						KubeProxyReplacement: "full",
+					},
+				},
+			},
+		},
+		{
+			name:    "cilium egress gateway without strict kube proxy replacement",
+			wantErr: fmt.Errorf("validating cniConfig: cilium egressGateway requires kubeProxyReplacement to be strict"),
+			clusterNetwork: &ClusterNetwork{
+				CNIConfig: &CNIConfig{
+					Cilium: &CiliumConfig{
+						EgressGateway:        true,
+						KubeProxyReplacement: CiliumKubeProxyReplacementPartial,
+					},
+				},
+			},
+		},
+		{
+			name:    "cilium hubble ui without relay",
+			wantErr: fmt.Errorf("validating cniConfig: cilium hubble ui requires hubble relay to be enabled"),
+			clusterNetwork: &ClusterNetwork{
+				CNIConfig: &CNIConfig{
+					Cilium: &CiliumConfig{
+						Hubble: &CiliumHubbleConfig{UI: true},
+					},
+				},
+			},
+		},
+		{
+			name:    "valid cilium advanced config",
+			wantErr: nil,
+			clusterNetwork: &ClusterNetwork{
+				CNIConfig: &CNIConfig{
+					Cilium: &CiliumConfig{
+						RoutingMode:                CiliumRoutingModeNative,
+						IPv4NativeRoutingCIDR:      "10.0.0.0/8",
+						KubeProxyReplacement:       CiliumKubeProxyReplacementStrict,
+						EgressGateway:              true,
+						BGPControlPlane:            true,
+						EgressMasqueradeInterfaces: "eth+",
+						Hubble:                     &CiliumHubbleConfig{Relay: true, UI: true},
+					},
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -2393,16 +2733,99 @@ func TestValidateMirrorConfig(t *testing.T) {
 			},
 		},
 		{
-			name:    "insecureSkipVerify on snow provider",
-			wantErr: "",
+			name:    "invalid failover endpoint port",
+			wantErr: "registry mirror failover endpoint 1.2.3.5 port 65536 is invalid",
 			cluster: &Cluster{
 				Spec: ClusterSpec{
 					RegistryMirrorConfiguration: &RegistryMirrorConfiguration{
-						Endpoint:           "1.2.3.4",
-						Port:               "443",
-						InsecureSkipVerify: true,
-					},
-					DatacenterRef: Ref{
+						Endpoint: "1.2.3.4",
+						Port:     "443",
+						FailoverEndpoints: []RegistryMirrorEndpoint{
+							{
+								Endpoint: "1.2.3.5",
+								Port:     "65536",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:    "skip mirror for public.ecr.aws",
+			wantErr: "skipMirror can't be set for public.ecr.aws",
+			cluster: &Cluster{
+				Spec: ClusterSpec{
+					RegistryMirrorConfiguration: &RegistryMirrorConfiguration{
+						Endpoint: "1.2.3.4",
+						Port:     "443",
+						Upstreams: []UpstreamRegistry{
+							{
+								Registry:   "public.ecr.aws",
+								SkipMirror: true,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:    "duplicate upstream registry",
+			wantErr: "upstream registry docker.io is specified more than once",
+			cluster: &Cluster{
+				Spec: ClusterSpec{
+					RegistryMirrorConfiguration: &RegistryMirrorConfiguration{
+						Endpoint: "1.2.3.4",
+						Port:     "443",
+						Upstreams: []UpstreamRegistry{
+							{
+								Registry:   "docker.io",
+								SkipMirror: true,
+							},
+							{
+								Registry:     "docker.io",
+								Authenticate: true,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:    "failover endpoints and upstreams",
+			wantErr: "",
+			cluster: &Cluster{
+				Spec: ClusterSpec{
+					RegistryMirrorConfiguration: &RegistryMirrorConfiguration{
+						Endpoint: "1.2.3.4",
+						Port:     "443",
+						FailoverEndpoints: []RegistryMirrorEndpoint{
+							{
+								Endpoint: "1.2.3.5",
+								Port:     "443",
+							},
+						},
+						Upstreams: []UpstreamRegistry{
+							{
+								Registry:     "docker.io",
+								SkipMirror:   true,
+								Authenticate: true,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:    "insecureSkipVerify on snow provider",
+			wantErr: "",
+			cluster: &Cluster{
+				Spec: ClusterSpec{
+					RegistryMirrorConfiguration: &RegistryMirrorConfiguration{
+						Endpoint:           "1.2.3.4",
+						Port:               "443",
+						InsecureSkipVerify: true,
+					},
+					DatacenterRef: Ref{
 						Kind: SnowDatacenterKind,
 					},
 				},
@@ -2655,6 +3078,203 @@ func TestValidateControlPlaneEndpoint(t *testing.T) {
 	}
 }
 
+func TestValidateServiceLoadBalancer(t *testing.T) {
+	tests := []struct {
+		name    string
+		wantErr string
+		cluster *Cluster
+	}{
+		{
+			name:    "no service load balancer",
+			wantErr: "",
+			cluster: &Cluster{
+				Spec: ClusterSpec{
+					ServiceLoadBalancer: nil,
+				},
+			},
+		},
+		{
+			name:    "metallb valid",
+			wantErr: "",
+			cluster: &Cluster{
+				Spec: ClusterSpec{
+					ServiceLoadBalancer: &ServiceLoadBalancerConfiguration{
+						Provider: MetalLBServiceLoadBalancer,
+						AddressPools: []ServiceLoadBalancerAddressPool{
+							{Name: "default", Addresses: []string{"10.100.100.0/28", "10.100.101.1-10.100.101.20"}},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:    "kube-vip valid",
+			wantErr: "",
+			cluster: &Cluster{
+				Spec: ClusterSpec{
+					ServiceLoadBalancer: &ServiceLoadBalancerConfiguration{
+						Provider: KubeVipServiceLoadBalancer,
+						AddressPools: []ServiceLoadBalancerAddressPool{
+							{Name: "global", Addresses: []string{"10.100.100.0/28"}},
+							{Name: "apps", Addresses: []string{"fd00::10-fd00::20"}},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:    "kube-vip pool name not a namespace",
+			wantErr: "service load balancer address pool name Default_Pool must be \"global\" or a valid namespace name for kube-vip",
+			cluster: &Cluster{
+				Spec: ClusterSpec{
+					ServiceLoadBalancer: &ServiceLoadBalancerConfiguration{
+						Provider: KubeVipServiceLoadBalancer,
+						AddressPools: []ServiceLoadBalancerAddressPool{
+							{Name: "Default_Pool", Addresses: []string{"10.100.100.0/28"}},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:    "provider not supported",
+			wantErr: "service load balancer provider \"haproxy\" not supported",
+			cluster: &Cluster{
+				Spec: ClusterSpec{
+					ServiceLoadBalancer: &ServiceLoadBalancerConfiguration{
+						Provider: "haproxy",
+						AddressPools: []ServiceLoadBalancerAddressPool{
+							{Name: "default", Addresses: []string{"10.100.100.0/28"}},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:    "no address pools",
+			wantErr: "service load balancer requires at least one address pool",
+			cluster: &Cluster{
+				Spec: ClusterSpec{
+					ServiceLoadBalancer: &ServiceLoadBalancerConfiguration{
+						Provider:     MetalLBServiceLoadBalancer,
+						AddressPools: []ServiceLoadBalancerAddressPool{},
+					},
+				},
+			},
+		},
+		{
+			name:    "pool name missing",
+			wantErr: "service load balancer address pool name is required",
+			cluster: &Cluster{
+				Spec: ClusterSpec{
+					ServiceLoadBalancer: &ServiceLoadBalancerConfiguration{
+						Provider: MetalLBServiceLoadBalancer,
+						AddressPools: []ServiceLoadBalancerAddressPool{
+							{Name: "", Addresses: []string{"10.100.100.0/28"}},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:    "pool name duplicated",
+			wantErr: "service load balancer address pool name default is duplicated",
+			cluster: &Cluster{
+				Spec: ClusterSpec{
+					ServiceLoadBalancer: &ServiceLoadBalancerConfiguration{
+						Provider: MetalLBServiceLoadBalancer,
+						AddressPools: []ServiceLoadBalancerAddressPool{
+							{Name: "default", Addresses: []string{"10.100.100.0/28"}},
+							{Name: "default", Addresses: []string{"10.100.101.0/28"}},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:    "pool without addresses",
+			wantErr: "service load balancer address pool default has no addresses",
+			cluster: &Cluster{
+				Spec: ClusterSpec{
+					ServiceLoadBalancer: &ServiceLoadBalancerConfiguration{
+						Provider: MetalLBServiceLoadBalancer,
+						AddressPools: []ServiceLoadBalancerAddressPool{
+							{Name: "default", Addresses: []string{}},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:    "invalid address",
+			wantErr: "address 10.100.100.1 is not a valid CIDR block or IP range",
+			cluster: &Cluster{
+				Spec: ClusterSpec{
+					ServiceLoadBalancer: &ServiceLoadBalancerConfiguration{
+						Provider: MetalLBServiceLoadBalancer,
+						AddressPools: []ServiceLoadBalancerAddressPool{
+							{Name: "default", Addresses: []string{"10.100.100.1"}},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:    "invalid range",
+			wantErr: "address range 10.100.100.1-abc is invalid",
+			cluster: &Cluster{
+				Spec: ClusterSpec{
+					ServiceLoadBalancer: &ServiceLoadBalancerConfiguration{
+						Provider: MetalLBServiceLoadBalancer,
+						AddressPools: []ServiceLoadBalancerAddressPool{
+							{Name: "default", Addresses: []string{"10.100.100.1-abc"}},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:    "range mixing families",
+			wantErr: "address range 10.100.100.1-fd00::1 mixes IPv4 and IPv6 addresses",
+			cluster: &Cluster{
+				Spec: ClusterSpec{
+					ServiceLoadBalancer: &ServiceLoadBalancerConfiguration{
+						Provider: MetalLBServiceLoadBalancer,
+						AddressPools: []ServiceLoadBalancerAddressPool{
+							{Name: "default", Addresses: []string{"10.100.100.1-fd00::1"}},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:    "range reversed",
+			wantErr: "address range 10.100.100.20-10.100.100.1 start is greater than its end",
+			cluster: &Cluster{
+				Spec: ClusterSpec{
+					ServiceLoadBalancer: &ServiceLoadBalancerConfiguration{
+						Provider: MetalLBServiceLoadBalancer,
+						AddressPools: []ServiceLoadBalancerAddressPool{
+							{Name: "default", Addresses: []string{"10.100.100.20-10.100.100.1"}},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			err := validateServiceLoadBalancer(tt.cluster)
+			if tt.wantErr == "" {
+				g.Expect(err).To(BeNil())
+			} else {
+				g.Expect(err).To(MatchError(ContainSubstring(tt.wantErr)))
+			}
+		})
+	}
+}
+
 func TestValidateCPUpgradeRolloutStrategy(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -2741,6 +3361,166 @@ func TestValidateCPUpgradeRolloutStrategy(t *testing.T) {
 	}
 }
 
+func TestValidateMachineHealthChecks(t *testing.T) {
+	maxUnhealthy := intstr.FromString("40%")
+	invalidMaxUnhealthy := intstr.FromString("forty")
+	negativeMaxUnhealthy := intstr.FromInt(-1)
+	tests := []struct {
+		name    string
+		wantErr string
+		cluster *Cluster
+	}{
+		{
+			name:    "no machine health checks",
+			wantErr: "",
+			cluster: &Cluster{},
+		},
+		{
+			name:    "valid machine health checks",
+			wantErr: "",
+			cluster: &Cluster{
+				Spec: ClusterSpec{
+					ControlPlaneConfiguration: ControlPlaneConfiguration{
+						MachineHealthCheck: &MachineHealthCheck{
+							NodeStartupTimeout:      &metav1.Duration{Duration: 20 * time.Minute},
+							UnhealthyMachineTimeout: &metav1.Duration{Duration: 10 * time.Minute},
+						},
+					},
+					WorkerNodeGroupConfigurations: []WorkerNodeGroupConfiguration{
+						{
+							Name: "md-0",
+							MachineHealthCheck: &MachineHealthCheck{
+								MaxUnhealthy: &maxUnhealthy,
+								UnhealthyConditions: []UnhealthyCondition{
+									{Type: "DiskPressure", Status: v1.ConditionTrue, Timeout: metav1.Duration{Duration: time.Minute}},
+								},
+							},
+						},
+						{
+							Name:               "md-1",
+							MachineHealthCheck: &MachineHealthCheck{DisableRemediation: true},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:    "control plane node startup timeout not positive",
+			wantErr: "ControlPlaneConfiguration: machineHealthCheck nodeStartupTimeout must be greater than 0",
+			cluster: &Cluster{
+				Spec: ClusterSpec{
+					ControlPlaneConfiguration: ControlPlaneConfiguration{
+						MachineHealthCheck: &MachineHealthCheck{NodeStartupTimeout: &metav1.Duration{}},
+					},
+				},
+			},
+		},
+		{
+			name:    "worker unhealthy machine timeout not positive",
+			wantErr: "WorkerNodeGroupConfiguration md-0: machineHealthCheck unhealthyMachineTimeout must be greater than 0",
+			cluster: &Cluster{
+				Spec: ClusterSpec{
+					WorkerNodeGroupConfigurations: []WorkerNodeGroupConfiguration{
+						{
+							Name:               "md-0",
+							MachineHealthCheck: &MachineHealthCheck{UnhealthyMachineTimeout: &metav1.Duration{Duration: -time.Minute}},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:    "max unhealthy invalid",
+			wantErr: "machineHealthCheck maxUnhealthy forty is invalid",
+			cluster: &Cluster{
+				Spec: ClusterSpec{
+					WorkerNodeGroupConfigurations: []WorkerNodeGroupConfiguration{
+						{
+							Name:               "md-0",
+							MachineHealthCheck: &MachineHealthCheck{MaxUnhealthy: &invalidMaxUnhealthy},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:    "max unhealthy negative",
+			wantErr: "machineHealthCheck maxUnhealthy -1 can't be negative",
+			cluster: &Cluster{
+				Spec: ClusterSpec{
+					WorkerNodeGroupConfigurations: []WorkerNodeGroupConfiguration{
+						{
+							Name:               "md-0",
+							MachineHealthCheck: &MachineHealthCheck{MaxUnhealthy: &negativeMaxUnhealthy},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:    "max unhealthy with remediation disabled",
+			wantErr: "machineHealthCheck maxUnhealthy can't be set when remediation is disabled",
+			cluster: &Cluster{
+				Spec: ClusterSpec{
+					ControlPlaneConfiguration: ControlPlaneConfiguration{
+						MachineHealthCheck: &MachineHealthCheck{MaxUnhealthy: &maxUnhealthy, DisableRemediation: true},
+					},
+				},
+			},
+		},
+		{
+			name:    "unhealthy condition without type",
+			wantErr: "machineHealthCheck unhealthyConditions must specify a type",
+			cluster: &Cluster{
+				Spec: ClusterSpec{
+					ControlPlaneConfiguration: ControlPlaneConfiguration{
+						MachineHealthCheck: &MachineHealthCheck{
+							UnhealthyConditions: []UnhealthyCondition{{Status: v1.ConditionTrue, Timeout: metav1.Duration{Duration: time.Minute}}},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:    "unhealthy condition invalid status",
+			wantErr: "machineHealthCheck unhealthyCondition Ready status Maybe is invalid, it must be True, False or Unknown",
+			cluster: &Cluster{
+				Spec: ClusterSpec{
+					ControlPlaneConfiguration: ControlPlaneConfiguration{
+						MachineHealthCheck: &MachineHealthCheck{
+							UnhealthyConditions: []UnhealthyCondition{{Type: v1.NodeReady, Status: "Maybe", Timeout: metav1.Duration{Duration: time.Minute}}},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:    "unhealthy condition without timeout",
+			wantErr: "machineHealthCheck unhealthyCondition Ready timeout must be greater than 0",
+			cluster: &Cluster{
+				Spec: ClusterSpec{
+					ControlPlaneConfiguration: ControlPlaneConfiguration{
+						MachineHealthCheck: &MachineHealthCheck{
+							UnhealthyConditions: []UnhealthyCondition{{Type: v1.NodeReady, Status: v1.ConditionFalse}},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			err := validateMachineHealthChecks(tt.cluster)
+			if tt.wantErr == "" {
+				g.Expect(err).To(BeNil())
+			} else {
+				g.Expect(err).To(MatchError(ContainSubstring(tt.wantErr)))
+			}
+		})
+	}
+}
+
 func TestValidateMDUpgradeRolloutStrategy(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -5,9 +5,11 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
 
 	"github.com/aws/eks-anywhere/pkg/logger"
+	"github.com/aws/eks-anywhere/pkg/networkutils"
 )
 
 const (
@@ -54,6 +56,8 @@ type ClusterSpec struct {
 	RegistryMirrorConfiguration *RegistryMirrorConfiguration `json:"registryMirrorConfiguration,omitempty"`
 	ManagementCluster           ManagementCluster            `json:"managementCluster,omitempty"`
 	PodIAMConfig                *PodIAMConfig                `json:"podIamConfig,omitempty"`
+	// ServiceLoadBalancer configures a load balancer managed by EKS-A for Services of type LoadBalancer.
+	ServiceLoadBalancer *ServiceLoadBalancerConfiguration `json:"serviceLoadBalancer,omitempty"`
 	// BundlesRef contains a reference to the Bundles containing the desired dependencies for the cluster
 	BundlesRef *BundlesRef `json:"bundlesRef,omitempty"`
 }
@@ -106,6 +110,9 @@ func (n *Cluster) Equal(o *Cluster) bool {
 	if !n.Spec.RegistryMirrorConfiguration.Equal(o.Spec.RegistryMirrorConfiguration) {
 		return false
 	}
+	if !n.Spec.ServiceLoadBalancer.Equal(o.Spec.ServiceLoadBalancer) {
+		return false
+	}
 	if !n.ManagementClusterEqual(o) {
 		return false
 	}
@@ -152,6 +159,13 @@ type RegistryMirrorConfiguration struct {
 	// Port defines the port exposed for registry mirror endpoint
 	Port string `json:"port,omitempty"`
 
+	// FailoverEndpoints defines additional registry mirror endpoints, tried in order when Endpoint is unavailable.
+	// They must serve the same OCINamespaces and use the same CA certificate and credentials as Endpoint.
+	FailoverEndpoints []RegistryMirrorEndpoint `json:"failoverEndpoints,omitempty"`
+
+	// Upstreams defines per upstream registry settings for pulling images
+	Upstreams []UpstreamRegistry `json:"upstreams,omitempty"`
+
 	// OCINamespaces defines the mapping from an upstream registry to a local namespace where upstream
 	// artifacts are placed into
 	OCINamespaces []OCINamespace `json:"ociNamespaces,omitempty"`
@@ -176,6 +190,24 @@ type OCINamespace struct {
 	Namespace string `json:"namespace"`
 }
 
+// RegistryMirrorEndpoint represents an additional registry mirror endpoint.
+type RegistryMirrorEndpoint struct {
+	// Endpoint defines the registry mirror endpoint
+	Endpoint string `json:"endpoint"`
+	// Port defines the port exposed for the registry mirror endpoint
+	Port string `json:"port,omitempty"`
+}
+
+// UpstreamRegistry defines how images from an upstream registry are pulled.
+type UpstreamRegistry struct {
+	// Registry refers to the host of the upstream registry
+	Registry string `json:"registry"`
+	// SkipMirror pulls images from the upstream registry directly instead of through the registry mirror
+	SkipMirror bool `json:"skipMirror,omitempty"`
+	// Authenticate defines if pulling directly from the upstream registry requires its own credentials
+	Authenticate bool `json:"authenticate,omitempty"`
+}
+
 func (n *RegistryMirrorConfiguration) Equal(o *RegistryMirrorConfiguration) bool {
 	if n == o {
 		return true
@@ -185,7 +217,41 @@ func (n *RegistryMirrorConfiguration) Equal(o *RegistryMirrorConfiguration) bool
 	}
 	return n.Endpoint == o.Endpoint && n.Port == o.Port && n.CACertContent == o.CACertContent &&
 		n.InsecureSkipVerify == o.InsecureSkipVerify && n.Authenticate == o.Authenticate &&
-		OCINamespacesSliceEqual(n.OCINamespaces, o.OCINamespaces)
+		OCINamespacesSliceEqual(n.OCINamespaces, o.OCINamespaces) &&
+		RegistryMirrorEndpointsSliceEqual(n.FailoverEndpoints, o.FailoverEndpoints) &&
+		UpstreamRegistriesSliceEqual(n.Upstreams, o.Upstreams)
+}
+
+// RegistryMirrorEndpointsSliceEqual is used to check equality of the FailoverEndpoints fields of two RegistryMirrorConfiguration.
+// The order of the endpoints matters, since it defines the failover order.
+func RegistryMirrorEndpointsSliceEqual(a, b []RegistryMirrorEndpoint) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// UpstreamRegistriesSliceEqual is used to check equality of the Upstreams fields of two RegistryMirrorConfiguration.
+func UpstreamRegistriesSliceEqual(a, b []UpstreamRegistry) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	m := make(map[UpstreamRegistry]int, len(a))
+	for _, v := range a {
+		m[v]++
+	}
+	for _, v := range b {
+		if m[v] == 0 {
+			return false
+		}
+		m[v]--
+	}
+	return true
 }
 
 // OCINamespacesSliceEqual is used to check equality of the OCINamespaces fields of two RegistryMirrorConfiguration.
@@ -228,6 +294,8 @@ type ControlPlaneConfiguration struct {
 	// UpgradeRolloutStrategy determines the rollout strategy to use for rolling upgrades
 	// and related parameters/knobs
 	UpgradeRolloutStrategy *ControlPlaneUpgradeRolloutStrategy `json:"upgradeRolloutStrategy,omitempty"`
+	// MachineHealthCheck overrides the default health check settings for the control plane machines.
+	MachineHealthCheck *MachineHealthCheck `json:"machineHealthCheck,omitempty"`
 }
 
 func TaintsSliceEqual(s1, s2 []corev1.Taint) bool {
@@ -272,7 +340,7 @@ func (n *ControlPlaneConfiguration) Equal(o *ControlPlaneConfiguration) bool {
 		return false
 	}
 	return n.Count == o.Count && n.Endpoint.Equal(o.Endpoint) && n.MachineGroupRef.Equal(o.MachineGroupRef) &&
-		TaintsSliceEqual(n.Taints, o.Taints) && MapEqual(n.Labels, o.Labels)
+		TaintsSliceEqual(n.Taints, o.Taints) && MapEqual(n.Labels, o.Labels) && n.MachineHealthCheck.Equal(o.MachineHealthCheck)
 }
 
 type Endpoint struct {
@@ -306,6 +374,8 @@ type WorkerNodeGroupConfiguration struct {
 	// UpgradeRolloutStrategy determines the rollout strategy to use for rolling upgrades
 	// and related parameters/knobs
 	UpgradeRolloutStrategy *WorkerNodesUpgradeRolloutStrategy `json:"upgradeRolloutStrategy,omitempty"`
+	// MachineHealthCheck overrides the default health check settings for the worker node group machines.
+	MachineHealthCheck *MachineHealthCheck `json:"machineHealthCheck,omitempty"`
 }
 
 func generateWorkerNodeGroupKey(c WorkerNodeGroupConfiguration) (key string) {
@@ -344,7 +414,8 @@ func WorkerNodeGroupConfigurationsSliceEqual(a, b []WorkerNodeGroupConfiguration
 		return false
 	}
 
-	return WorkerNodeGroupConfigurationSliceTaintsEqual(a, b) && WorkerNodeGroupConfigurationsLabelsMapEqual(a, b)
+	return WorkerNodeGroupConfigurationSliceTaintsEqual(a, b) && WorkerNodeGroupConfigurationsLabelsMapEqual(a, b) &&
+		WorkerNodeGroupConfigurationsMachineHealthCheckEqual(a, b)
 }
 
 func WorkerNodeGroupConfigurationSliceTaintsEqual(a, b []WorkerNodeGroupConfiguration) bool {
@@ -389,6 +460,26 @@ func WorkerNodeGroupConfigurationsLabelsMapEqual(a, b []WorkerNodeGroupConfigura
 	return true
 }
 
+// WorkerNodeGroupConfigurationsMachineHealthCheckEqual compares the machine health check settings
+// of the worker node groups present in both a and b.
+func WorkerNodeGroupConfigurationsMachineHealthCheckEqual(a, b []WorkerNodeGroupConfiguration) bool {
+	m := make(map[string]*MachineHealthCheck, len(a))
+	for _, nodeGroup := range a {
+		m[nodeGroup.Name] = nodeGroup.MachineHealthCheck
+	}
+
+	for _, nodeGroup := range b {
+		mhc, ok := m[nodeGroup.Name]
+		if !ok {
+			continue
+		}
+		if !mhc.Equal(nodeGroup.MachineHealthCheck) {
+			return false
+		}
+	}
+	return true
+}
+
 type ClusterNetwork struct {
 	// Comma-separated list of CIDR blocks to use for pod and service subnets.
 	// Defaults to 192.168.0.0/16 for pod subnet.
@@ -426,6 +517,31 @@ func (n *ClusterNetwork) Equal(o *ClusterNetwork) bool {
 		n.Nodes.Equal(o.Nodes)
 }
 
+// IPv6Enabled returns true if any of the pod CIDR blocks is an IPv6 block.
+func (n *ClusterNetwork) IPv6Enabled() bool {
+	for _, cidr := range n.Pods.CidrBlocks {
+		if networkutils.IsIPv6CIDR(cidr) {
+			return true
+		}
+	}
+	return false
+}
+
+// IPv4Enabled returns true if any of the pod CIDR blocks is an IPv4 block.
+func (n *ClusterNetwork) IPv4Enabled() bool {
+	for _, cidr := range n.Pods.CidrBlocks {
+		if !networkutils.IsIPv6CIDR(cidr) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsDualStack returns true if the cluster is configured with both IPv4 and IPv6 pod CIDR blocks.
+func (n *ClusterNetwork) IsDualStack() bool {
+	return n.IPv4Enabled() && n.IPv6Enabled()
+}
+
 func getCNIConfig(cn *ClusterNetwork) *CNIConfig {
 	/* Only needed since we're introducing CNIConfig to replace the deprecated CNI field. This way we can compare the individual fields
 	for the CNI plugin configuration*/
@@ -469,6 +585,9 @@ func (n *CNIConfig) Equal(o *CNIConfig) bool {
 	if !n.Kindnetd.Equal(o.Kindnetd) {
 		return false
 	}
+	if !n.Custom.Equal(o.Custom) {
+		return false
+	}
 	return true
 }
 
@@ -479,7 +598,64 @@ func (n *CiliumConfig) Equal(o *CiliumConfig) bool {
 	if n == nil || o == nil {
 		return false
 	}
-	return n.PolicyEnforcementMode == o.PolicyEnforcementMode
+	return n.PolicyEnforcementMode == o.PolicyEnforcementMode &&
+		n.RoutingMode == o.RoutingMode &&
+		n.IPv4NativeRoutingCIDR == o.IPv4NativeRoutingCIDR &&
+		n.KubeProxyReplacement == o.KubeProxyReplacement &&
+		n.EgressMasqueradeInterfaces == o.EgressMasqueradeInterfaces &&
+		n.EgressGateway == o.EgressGateway &&
+		n.BGPControlPlane == o.BGPControlPlane &&
+		n.Hubble.Equal(o.Hubble)
+}
+
+// Equal compares two CiliumHubbleConfigs and returns true if they are equivalent.
+func (n *CiliumHubbleConfig) Equal(o *CiliumHubbleConfig) bool {
+	if n == o {
+		return true
+	}
+	if n == nil || o == nil {
+		return false
+	}
+	return *n == *o
+}
+
+// ciliumRoutingModeEqual returns true if the Cilium routing configuration of both cluster networks is the same.
+// Changing it on a running cluster would disrupt pod connectivity, so it can't be updated.
+func ciliumRoutingModeEqual(n, o ClusterNetwork) bool {
+	if n.CNIConfig == nil || n.CNIConfig.Cilium == nil || o.CNIConfig == nil || o.CNIConfig.Cilium == nil {
+		return true
+	}
+	return n.CNIConfig.Cilium.RoutingModeEqual(o.CNIConfig.Cilium)
+}
+
+// CiliumLeavesStrictKubeProxyReplacement returns true if the new cluster network moves Cilium away from
+// strict kube-proxy replacement. kube-proxy is removed from clusters with strict replacement, so services
+// would stop working.
+func CiliumLeavesStrictKubeProxyReplacement(n, o ClusterNetwork) bool {
+	if o.CNIConfig == nil || o.CNIConfig.Cilium == nil || o.CNIConfig.Cilium.KubeProxyReplacement != CiliumKubeProxyReplacementStrict {
+		return false
+	}
+	return n.CNIConfig == nil || n.CNIConfig.Cilium == nil || n.CNIConfig.Cilium.KubeProxyReplacement != CiliumKubeProxyReplacementStrict
+}
+
+// RoutingModeEqual returns true if both configs route pod traffic in the same way.
+// An empty RoutingMode is equivalent to tunnel.
+func (n *CiliumConfig) RoutingModeEqual(o *CiliumConfig) bool {
+	return n.routingMode() == o.routingMode() && n.ipv4NativeRoutingCIDR() == o.ipv4NativeRoutingCIDR()
+}
+
+func (n *CiliumConfig) routingMode() CiliumRoutingMode {
+	if n == nil || n.RoutingMode == "" {
+		return CiliumRoutingModeTunnel
+	}
+	return n.RoutingMode
+}
+
+func (n *CiliumConfig) ipv4NativeRoutingCIDR() string {
+	if n == nil {
+		return ""
+	}
+	return n.IPv4NativeRoutingCIDR
 }
 
 func (n *KindnetdConfig) Equal(o *KindnetdConfig) bool {
@@ -492,6 +668,31 @@ func (n *KindnetdConfig) Equal(o *KindnetdConfig) bool {
 	return true
 }
 
+// Equal compares two CustomCNIConfigs and returns true if they are equivalent.
+func (n *CustomCNIConfig) Equal(o *CustomCNIConfig) bool {
+	if n == o {
+		return true
+	}
+	if n == nil || o == nil {
+		return false
+	}
+	if n.ManifestPath != o.ManifestPath {
+		return false
+	}
+	return n.HelmChart.Equal(o.HelmChart)
+}
+
+// Equal compares two CustomCNIHelmCharts and returns true if they are equivalent.
+func (n *CustomCNIHelmChart) Equal(o *CustomCNIHelmChart) bool {
+	if n == o {
+		return true
+	}
+	if n == nil || o == nil {
+		return false
+	}
+	return *n == *o
+}
+
 func UsersSliceEqual(a, b []UserConfiguration) bool {
 	if len(a) != len(b) {
 		return false
@@ -542,6 +743,9 @@ func CNIPluginSame(n ClusterNetwork, o ClusterNetwork) bool {
 			if (n.CNIConfig.Kindnetd != nil && o.CNIConfig.Kindnetd == nil) || (n.CNIConfig.Kindnetd == nil && o.CNIConfig.Kindnetd != nil) {
 				return false
 			}
+			if (n.CNIConfig.Custom != nil && o.CNIConfig.Custom == nil) || (n.CNIConfig.Custom == nil && o.CNIConfig.Custom != nil) {
+				return false
+			}
 		}
 	}
 
@@ -659,18 +863,76 @@ type CNI string
 
 type CiliumPolicyEnforcementMode string
 
+// CiliumRoutingMode defines how Cilium routes pod traffic between nodes.
+type CiliumRoutingMode string
+
+// CiliumKubeProxyReplacementMode defines the Cilium kube-proxy replacement mode.
+type CiliumKubeProxyReplacementMode string
+
 type CNIConfig struct {
 	Cilium   *CiliumConfig   `json:"cilium,omitempty"`
 	Kindnetd *KindnetdConfig `json:"kindnetd,omitempty"`
+	// Custom configures a CNI plugin not managed by EKS Anywhere. When set, EKS Anywhere
+	// skips installing and upgrading a CNI and only waits for the custom one to become ready.
+	Custom *CustomCNIConfig `json:"custom,omitempty"`
 }
 
 type CiliumConfig struct {
 	// PolicyEnforcementMode determines communication allowed between pods. Accepted values are default, always, never.
 	PolicyEnforcementMode CiliumPolicyEnforcementMode `json:"policyEnforcementMode,omitempty"`
+	// RoutingMode determines how pod traffic is routed between nodes. Accepted values are tunnel, native.
+	// Defaults to tunnel.
+	RoutingMode CiliumRoutingMode `json:"routingMode,omitempty"`
+	// IPv4NativeRoutingCIDR is the CIDR within which native routing is possible. Required when RoutingMode is native.
+	IPv4NativeRoutingCIDR string `json:"ipv4NativeRoutingCIDR,omitempty"`
+	// KubeProxyReplacement determines if Cilium replaces kube-proxy functionality.
+	// Accepted values are disabled, probe, partial, strict.
+	KubeProxyReplacement CiliumKubeProxyReplacementMode `json:"kubeProxyReplacement,omitempty"`
+	// EgressMasqueradeInterfaces limits which network interfaces are used for masquerading.
+	// Accepts an interface name or prefix, for example eth+.
+	EgressMasqueradeInterfaces string `json:"egressMasqueradeInterfaces,omitempty"`
+	// EgressGateway enables the Cilium egress gateway. It requires KubeProxyReplacement to be strict.
+	EgressGateway bool `json:"egressGateway,omitempty"`
+	// BGPControlPlane enables the Cilium BGP control plane.
+	BGPControlPlane bool `json:"bgpControlPlane,omitempty"`
+	// Hubble configures the Hubble observability components.
+	Hubble *CiliumHubbleConfig `json:"hubble,omitempty"`
+}
+
+// CiliumHubbleConfig defines which Hubble components are deployed.
+type CiliumHubbleConfig struct {
+	// Relay deploys Hubble Relay.
+	Relay bool `json:"relay,omitempty"`
+	// UI deploys Hubble UI. It requires Relay to be enabled.
+	UI bool `json:"ui,omitempty"`
 }
 
 type KindnetdConfig struct{}
 
+// CustomCNIConfig defines a user supplied CNI plugin.
+// At most one of ManifestPath or HelmChart can be set. If none is set, the CNI is expected
+// to be installed by the user out of band.
+type CustomCNIConfig struct {
+	// ManifestPath is a local path or URL to a manifest that installs the CNI plugin.
+	ManifestPath string `json:"manifestPath,omitempty"`
+	// HelmChart defines a Helm chart that installs the CNI plugin.
+	HelmChart *CustomCNIHelmChart `json:"helmChart,omitempty"`
+}
+
+// CustomCNIHelmChart defines the location of a Helm chart for a custom CNI plugin.
+type CustomCNIHelmChart struct {
+	// Name is the name of the Helm release.
+	Name string `json:"name"`
+	// URI is the location of the chart, for example oci://public.ecr.aws/my-org/my-cni.
+	URI string `json:"uri"`
+	// Version is the chart version.
+	Version string `json:"version"`
+	// Namespace where the chart is installed. Defaults to kube-system.
+	Namespace string `json:"namespace,omitempty"`
+	// ValuesFile is an optional local path to a Helm values file.
+	ValuesFile string `json:"valuesFile,omitempty"`
+}
+
 const (
 	Cilium           CNI = "cilium"
 	CiliumEnterprise CNI = "cilium-enterprise"
@@ -694,6 +956,30 @@ var validCiliumPolicyEnforcementModes = map[CiliumPolicyEnforcementMode]bool{
 	CiliumPolicyModeNever:   true,
 }
 
+const (
+	CiliumRoutingModeTunnel CiliumRoutingMode = "tunnel"
+	CiliumRoutingModeNative CiliumRoutingMode = "native"
+)
+
+var validCiliumRoutingModes = map[CiliumRoutingMode]bool{
+	CiliumRoutingModeTunnel: true,
+	CiliumRoutingModeNative: true,
+}
+
+const (
+	CiliumKubeProxyReplacementDisabled CiliumKubeProxyReplacementMode = "disabled"
+	CiliumKubeProxyReplacementProbe    CiliumKubeProxyReplacementMode = "probe"
+	CiliumKubeProxyReplacementPartial  CiliumKubeProxyReplacementMode = "partial"
+	CiliumKubeProxyReplacementStrict   CiliumKubeProxyReplacementMode = "strict"
+)
+
+var validCiliumKubeProxyReplacementModes = map[CiliumKubeProxyReplacementMode]bool{
+	CiliumKubeProxyReplacementDisabled: true,
+	CiliumKubeProxyReplacementProbe:    true,
+	CiliumKubeProxyReplacementPartial:  true,
+	CiliumKubeProxyReplacementStrict:   true,
+}
+
 // ClusterStatus defines the observed state of Cluster.
 type ClusterStatus struct {
 	// Descriptive message about a fatal problem while reconciling a cluster
@@ -787,6 +1073,71 @@ func (n *ManagementCluster) Equal(o ManagementCluster) bool {
 	return n.Name == o.Name
 }
 
+// ServiceLoadBalancerProvider is the implementation used to load balance Services.
+type ServiceLoadBalancerProvider string
+
+const (
+	// KubeVipServiceLoadBalancer runs kube-vip with the kube-vip cloud provider.
+	KubeVipServiceLoadBalancer ServiceLoadBalancerProvider = "kube-vip"
+	// MetalLBServiceLoadBalancer runs MetalLB in L2 mode.
+	MetalLBServiceLoadBalancer ServiceLoadBalancerProvider = "metallb"
+)
+
+// kubeVipGlobalAddressPool is the kube-vip pool name shared by Services in every namespace.
+const kubeVipGlobalAddressPool = "global"
+
+var validServiceLoadBalancerProviders = map[ServiceLoadBalancerProvider]bool{
+	KubeVipServiceLoadBalancer: true,
+	MetalLBServiceLoadBalancer: true,
+}
+
+// ServiceLoadBalancerConfiguration defines the load balancer EKS-A installs for Services of type LoadBalancer.
+type ServiceLoadBalancerConfiguration struct {
+	// Provider is the load balancer implementation, either kube-vip or metallb.
+	Provider ServiceLoadBalancerProvider `json:"provider"`
+	// AddressPools are the IP addresses the load balancer can assign to Services.
+	AddressPools []ServiceLoadBalancerAddressPool `json:"addressPools"`
+}
+
+// ServiceLoadBalancerAddressPool is a named group of addresses for Services.
+type ServiceLoadBalancerAddressPool struct {
+	// Name identifies the pool. For kube-vip, it is the namespace the pool serves
+	// or "global" for a pool shared by all namespaces.
+	Name string `json:"name"`
+	// Addresses is a list of CIDR blocks or IP ranges in the form "<start>-<end>".
+	Addresses []string `json:"addresses"`
+}
+
+func (n *ServiceLoadBalancerConfiguration) Equal(o *ServiceLoadBalancerConfiguration) bool {
+	if n == o {
+		return true
+	}
+	if n == nil || o == nil {
+		return false
+	}
+	if n.Provider != o.Provider || len(n.AddressPools) != len(o.AddressPools) {
+		return false
+	}
+	for i := range n.AddressPools {
+		if !n.AddressPools[i].Equal(&o.AddressPools[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (n *ServiceLoadBalancerAddressPool) Equal(o *ServiceLoadBalancerAddressPool) bool {
+	if n.Name != o.Name || len(n.Addresses) != len(o.Addresses) {
+		return false
+	}
+	for i := range n.Addresses {
+		if n.Addresses[i] != o.Addresses[i] {
+			return false
+		}
+	}
+	return true
+}
+
 type PodIAMConfig struct {
 	ServiceAccountIssuer string `json:"serviceAccountIssuer"`
 }
@@ -835,6 +1186,65 @@ type WorkerNodesRollingUpdateParams struct {
 	MaxUnavailable int `json:"maxUnavailable"`
 }
 
+// MachineHealthCheck defines the health check settings for a group of machines.
+// Any field left empty takes the EKS Anywhere default.
+type MachineHealthCheck struct {
+	// NodeStartupTimeout is the time a machine has to join the cluster before it's considered unhealthy.
+	NodeStartupTimeout *metav1.Duration `json:"nodeStartupTimeout,omitempty"`
+	// UnhealthyMachineTimeout is the time a node can have the Ready condition as Unknown or False
+	// before its machine is considered unhealthy. It's ignored when UnhealthyConditions are set.
+	UnhealthyMachineTimeout *metav1.Duration `json:"unhealthyMachineTimeout,omitempty"`
+	// MaxUnhealthy is the number or percentage of unhealthy machines above which remediation stops.
+	MaxUnhealthy *intstr.IntOrString `json:"maxUnhealthy,omitempty"`
+	// UnhealthyConditions replaces the default Ready conditions used to consider a node unhealthy.
+	UnhealthyConditions []UnhealthyCondition `json:"unhealthyConditions,omitempty"`
+	// DisableRemediation stops unhealthy machines from being replaced. They are still reported as unhealthy.
+	DisableRemediation bool `json:"disableRemediation,omitempty"`
+}
+
+// UnhealthyCondition is a node condition that makes a machine unhealthy when the node
+// has been in that status for longer than the timeout.
+type UnhealthyCondition struct {
+	Type    corev1.NodeConditionType `json:"type"`
+	Status  corev1.ConditionStatus   `json:"status"`
+	Timeout metav1.Duration          `json:"timeout"`
+}
+
+// Equal compares two MachineHealthChecks.
+func (m *MachineHealthCheck) Equal(o *MachineHealthCheck) bool {
+	if m == o {
+		return true
+	}
+	if m == nil || o == nil {
+		return false
+	}
+	if !durationEqual(m.NodeStartupTimeout, o.NodeStartupTimeout) ||
+		!durationEqual(m.UnhealthyMachineTimeout, o.UnhealthyMachineTimeout) ||
+		m.DisableRemediation != o.DisableRemediation {
+		return false
+	}
+	if (m.MaxUnhealthy == nil) != (o.MaxUnhealthy == nil) ||
+		(m.MaxUnhealthy != nil && m.MaxUnhealthy.String() != o.MaxUnhealthy.String()) {
+		return false
+	}
+	if len(m.UnhealthyConditions) != len(o.UnhealthyConditions) {
+		return false
+	}
+	for i := range m.UnhealthyConditions {
+		if m.UnhealthyConditions[i] != o.UnhealthyConditions[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func durationEqual(a, b *metav1.Duration) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Duration == b.Duration
+}
+
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 // Cluster is the Schema for the clusters API.
@@ -2,10 +2,12 @@ package v1alpha1_test
 
 import (
 	"testing"
+	"time"
 
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 
 	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
 	"github.com/aws/eks-anywhere/pkg/utils/ptr"
@@ -1495,6 +1497,86 @@ func TestControlPlaneConfigurationEqual(t *testing.T) {
 	}
 }
 
+func TestMachineHealthCheckEqual(t *testing.T) {
+	maxUnhealthy := intstr.FromString("40%")
+	otherMaxUnhealthy := intstr.FromInt(2)
+	testCases := []struct {
+		testName   string
+		mhc1, mhc2 *v1alpha1.MachineHealthCheck
+		want       bool
+	}{
+		{
+			testName: "both nil",
+			want:     true,
+		},
+		{
+			testName: "one nil, one exists",
+			mhc1:     &v1alpha1.MachineHealthCheck{},
+			want:     false,
+		},
+		{
+			testName: "same timeouts and max unhealthy",
+			mhc1: &v1alpha1.MachineHealthCheck{
+				NodeStartupTimeout: &metav1.Duration{Duration: 20 * time.Minute},
+				MaxUnhealthy:       &maxUnhealthy,
+			},
+			mhc2: &v1alpha1.MachineHealthCheck{
+				NodeStartupTimeout: &metav1.Duration{Duration: 20 * time.Minute},
+				MaxUnhealthy:       &maxUnhealthy,
+			},
+			want: true,
+		},
+		{
+			testName: "different node startup timeout",
+			mhc1: &v1alpha1.MachineHealthCheck{
+				NodeStartupTimeout: &metav1.Duration{Duration: 20 * time.Minute},
+			},
+			mhc2: &v1alpha1.MachineHealthCheck{
+				NodeStartupTimeout: &metav1.Duration{Duration: 10 * time.Minute},
+			},
+			want: false,
+		},
+		{
+			testName: "different max unhealthy",
+			mhc1: &v1alpha1.MachineHealthCheck{
+				MaxUnhealthy: &maxUnhealthy,
+			},
+			mhc2: &v1alpha1.MachineHealthCheck{
+				MaxUnhealthy: &otherMaxUnhealthy,
+			},
+			want: false,
+		},
+		{
+			testName: "different unhealthy conditions",
+			mhc1: &v1alpha1.MachineHealthCheck{
+				UnhealthyConditions: []v1alpha1.UnhealthyCondition{
+					{Type: corev1.NodeReady, Status: corev1.ConditionUnknown, Timeout: metav1.Duration{Duration: time.Minute}},
+				},
+			},
+			mhc2: &v1alpha1.MachineHealthCheck{
+				UnhealthyConditions: []v1alpha1.UnhealthyCondition{
+					{Type: corev1.NodeReady, Status: corev1.ConditionFalse, Timeout: metav1.Duration{Duration: time.Minute}},
+				},
+			},
+			want: false,
+		},
+		{
+			testName: "different disable remediation",
+			mhc1: &v1alpha1.MachineHealthCheck{
+				DisableRemediation: true,
+			},
+			mhc2: &v1alpha1.MachineHealthCheck{},
+			want: false,
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.testName, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(tt.mhc1.Equal(tt.mhc2)).To(Equal(tt.want))
+		})
+	}
+}
+
 func TestRegistryMirrorConfigurationEqual(t *testing.T) {
 	testCases := []struct {
 		testName                   string
@@ -1616,6 +1698,52 @@ func TestRegistryMirrorConfigurationEqual(t *testing.T) {
 			},
 			want: false,
 		},
+		{
+			testName: "both exist, failover endpoints in different order",
+			cluster1Regi: &v1alpha1.RegistryMirrorConfiguration{
+				FailoverEndpoints: []v1alpha1.RegistryMirrorEndpoint{
+					{Endpoint: "1.2.3.5", Port: "443"},
+					{Endpoint: "1.2.3.6", Port: "443"},
+				},
+			},
+			cluster2Regi: &v1alpha1.RegistryMirrorConfiguration{
+				FailoverEndpoints: []v1alpha1.RegistryMirrorEndpoint{
+					{Endpoint: "1.2.3.6", Port: "443"},
+					{Endpoint: "1.2.3.5", Port: "443"},
+				},
+			},
+			want: false,
+		},
+		{
+			testName: "both exist, upstreams in different order",
+			cluster1Regi: &v1alpha1.RegistryMirrorConfiguration{
+				Upstreams: []v1alpha1.UpstreamRegistry{
+					{Registry: "docker.io", SkipMirror: true},
+					{Registry: "quay.io", Authenticate: true},
+				},
+			},
+			cluster2Regi: &v1alpha1.RegistryMirrorConfiguration{
+				Upstreams: []v1alpha1.UpstreamRegistry{
+					{Registry: "quay.io", Authenticate: true},
+					{Registry: "docker.io", SkipMirror: true},
+				},
+			},
+			want: true,
+		},
+		{
+			testName: "both exist, upstreams diff (skip mirror)",
+			cluster1Regi: &v1alpha1.RegistryMirrorConfiguration{
+				Upstreams: []v1alpha1.UpstreamRegistry{
+					{Registry: "docker.io", SkipMirror: true},
+				},
+			},
+			cluster2Regi: &v1alpha1.RegistryMirrorConfiguration{
+				Upstreams: []v1alpha1.UpstreamRegistry{
+					{Registry: "docker.io"},
+				},
+			},
+			want: false,
+		},
 	}
 	for _, tt := range testCases {
 		t.Run(tt.testName, func(t *testing.T) {
@@ -1895,3 +2023,44 @@ func TestClusterHasAWSIamConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestClusterNetworkIPFamilies(t *testing.T) {
+	tests := []struct {
+		name          string
+		podCidrBlocks []string
+		wantIPv4      bool
+		wantIPv6      bool
+		wantDualStack bool
+	}{
+		{
+			name:          "ipv4 only",
+			podCidrBlocks: []string{"192.168.0.0/16"},
+			wantIPv4:      true,
+		},
+		{
+			name:          "ipv6 only",
+			podCidrBlocks: []string{"fd00:100:64::/48"},
+			wantIPv6:      true,
+		},
+		{
+			name:          "dual-stack",
+			podCidrBlocks: []string{"fd00:100:64::/48", "192.168.0.0/16"},
+			wantIPv4:      true,
+			wantIPv6:      true,
+			wantDualStack: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			network := &v1alpha1.ClusterNetwork{
+				Pods: v1alpha1.Pods{
+					CidrBlocks: tt.podCidrBlocks,
+				},
+			}
+			g.Expect(network.IPv4Enabled()).To(Equal(tt.wantIPv4))
+			g.Expect(network.IPv6Enabled()).To(Equal(tt.wantIPv6))
+			g.Expect(network.IsDualStack()).To(Equal(tt.wantDualStack))
+		})
+	}
+}
@@ -168,10 +168,24 @@ func validateImmutableFieldsCluster(new, old *Cluster) field.ErrorList {
 			field.Forbidden(specPath.Child("clusterNetwork", "nodes"), "field is immutable"))
 	}
 
+	if !ciliumRoutingModeEqual(new.Spec.ClusterNetwork, old.Spec.ClusterNetwork) {
+		allErrs = append(
+			allErrs,
+			field.Forbidden(specPath.Child("clusterNetwork", "cniConfig", "cilium", "routingMode"), "field is immutable"))
+	}
+
+	if CiliumLeavesStrictKubeProxyReplacement(new.Spec.ClusterNetwork, old.Spec.ClusterNetwork) {
+		allErrs = append(
+			allErrs,
+			field.Forbidden(specPath.Child("clusterNetwork", "cniConfig", "cilium", "kubeProxyReplacement"), "can't be changed from strict, kube-proxy has been removed from the cluster"))
+	}
+
+	// The CLI pauses the reconciliation while it upgrades the cluster, so it can update the proxy configuration along
+	// with the curated packages controller and the Flux controllers. The controller only updates the nodes.
 	if !new.Spec.ProxyConfiguration.Equal(old.Spec.ProxyConfiguration) {
 		allErrs = append(
 			allErrs,
-			field.Forbidden(specPath.Child("ProxyConfiguration"), fmt.Sprintf("field is immutable %v", new.Spec.ProxyConfiguration)))
+			field.Forbidden(specPath.Child("ProxyConfiguration"), "field can only be updated with eksctl anywhere upgrade cluster"))
 	}
 
 	if new.Spec.ExternalEtcdConfiguration != nil && old.Spec.ExternalEtcdConfiguration == nil {
@@ -592,6 +592,79 @@ func TestClusterValidateUpdateClusterNetworkNodesImmutable(t *testing.T) {
 	g.Expect(c.ValidateUpdate(cOld)).To(MatchError(ContainSubstring("spec.clusterNetwork.nodes: Forbidden: field is immutable")))
 }
 
+func TestClusterValidateUpdateCiliumRoutingModeImmutable(t *testing.T) {
+	features.ClearCache()
+	cOld := &v1alpha1.Cluster{
+		Spec: v1alpha1.ClusterSpec{
+			ClusterNetwork: v1alpha1.ClusterNetwork{
+				CNIConfig: &v1alpha1.CNIConfig{
+					Cilium: &v1alpha1.CiliumConfig{},
+				},
+			},
+		},
+	}
+	c := cOld.DeepCopy()
+	c.Spec.ClusterNetwork.CNIConfig.Cilium.RoutingMode = v1alpha1.CiliumRoutingModeNative
+	c.Spec.ClusterNetwork.CNIConfig.Cilium.IPv4NativeRoutingCIDR = "10.0.0.0/8"
+
+	g := NewWithT(t)
+	g.Expect(c.ValidateUpdate(cOld)).To(MatchError(ContainSubstring("spec.clusterNetwork.cniConfig.cilium.routingMode: Forbidden: field is immutable")))
+}
+
+func TestClusterValidateUpdateCiliumRoutingModeExplicitDefault(t *testing.T) {
+	features.ClearCache()
+	cOld := &v1alpha1.Cluster{
+		Spec: v1alpha1.ClusterSpec{
+			ClusterNetwork: v1alpha1.ClusterNetwork{
+				CNIConfig: &v1alpha1.CNIConfig{
+					Cilium: &v1alpha1.CiliumConfig{},
+				},
+			},
+		},
+	}
+	c := cOld.DeepCopy()
+	c.Spec.ClusterNetwork.CNIConfig.Cilium.RoutingMode = v1alpha1.CiliumRoutingModeTunnel
+
+	g := NewWithT(t)
+	g.Expect(c.ValidateUpdate(cOld)).NotTo(MatchError(ContainSubstring("routingMode")))
+}
+
+func TestClusterValidateUpdateCiliumLeaveStrictKubeProxyReplacement(t *testing.T) {
+	features.ClearCache()
+	cOld := &v1alpha1.Cluster{
+		Spec: v1alpha1.ClusterSpec{
+			ClusterNetwork: v1alpha1.ClusterNetwork{
+				CNIConfig: &v1alpha1.CNIConfig{
+					Cilium: &v1alpha1.CiliumConfig{KubeProxyReplacement: v1alpha1.CiliumKubeProxyReplacementStrict},
+				},
+			},
+		},
+	}
+	c := cOld.DeepCopy()
+	c.Spec.ClusterNetwork.CNIConfig.Cilium.KubeProxyReplacement = v1alpha1.CiliumKubeProxyReplacementProbe
+
+	g := NewWithT(t)
+	g.Expect(c.ValidateUpdate(cOld)).To(MatchError(ContainSubstring("spec.clusterNetwork.cniConfig.cilium.kubeProxyReplacement: Forbidden: can't be changed from strict")))
+}
+
+func TestClusterValidateUpdateCiliumEnableStrictKubeProxyReplacement(t *testing.T) {
+	features.ClearCache()
+	cOld := &v1alpha1.Cluster{
+		Spec: v1alpha1.ClusterSpec{
+			ClusterNetwork: v1alpha1.ClusterNetwork{
+				CNIConfig: &v1alpha1.CNIConfig{
+					Cilium: &v1alpha1.CiliumConfig{},
+				},
+			},
+		},
+	}
+	c := cOld.DeepCopy()
+	c.Spec.ClusterNetwork.CNIConfig.Cilium.KubeProxyReplacement = v1alpha1.CiliumKubeProxyReplacementStrict
+
+	g := NewWithT(t)
+	g.Expect(c.ValidateUpdate(cOld)).NotTo(MatchError(ContainSubstring("kubeProxyReplacement")))
+}
+
 func TestClusterValidateUpdateProxyConfigurationEqualOrder(t *testing.T) {
 	cOld := createCluster()
 	cOld.Spec.ProxyConfiguration = &v1alpha1.ProxyConfiguration{
@@ -620,64 +693,80 @@ func TestClusterValidateUpdateProxyConfigurationEqualOrder(t *testing.T) {
 func TestClusterValidateUpdateProxyConfigurationImmutable(t *testing.T) {
 	cOld := createCluster()
 	cOld.Spec.ProxyConfiguration = &v1alpha1.ProxyConfiguration{
-		HttpProxy:  "http://test.com",
-		HttpsProxy: "https://test.com",
+		HttpProxy:  "http://1.2.3.4:3128",
+		HttpsProxy: "http://1.2.3.4:3128",
 		NoProxy:    []string{"noproxy1"},
 	}
 	c := cOld.DeepCopy()
 	c.Spec.ProxyConfiguration = &v1alpha1.ProxyConfiguration{
-		HttpProxy:  "http://test.com",
-		HttpsProxy: "https://test.com",
+		HttpProxy:  "http://1.2.3.5:3128",
+		HttpsProxy: "http://1.2.3.5:3128",
 		NoProxy:    []string{"noproxy1", "noproxy2"},
 	}
 
 	g := NewWithT(t)
-	g.Expect(c.ValidateUpdate(cOld)).To(MatchError(ContainSubstring("spec.ProxyConfiguration: Forbidden: field is immutable")))
+	g.Expect(c.ValidateUpdate(cOld)).To(MatchError(ContainSubstring("spec.ProxyConfiguration: Forbidden: field can only be updated with eksctl anywhere upgrade cluster")))
 }
 
-func TestClusterValidateUpdateProxyConfigurationNoProxyImmutable(t *testing.T) {
+func TestClusterValidateUpdateProxyConfigurationOldNilImmutable(t *testing.T) {
 	cOld := createCluster()
-	cOld.Spec.ProxyConfiguration = &v1alpha1.ProxyConfiguration{
-		HttpProxy:  "httpproxy",
-		HttpsProxy: "httpsproxy",
-	}
+	cOld.Spec.ProxyConfiguration = nil
+
 	c := cOld.DeepCopy()
 	c.Spec.ProxyConfiguration = &v1alpha1.ProxyConfiguration{
-		HttpProxy:  "httpproxy",
-		HttpsProxy: "httpsproxy",
+		HttpProxy:  "http://1.2.3.4:3128",
+		HttpsProxy: "http://1.2.3.4:3128",
 		NoProxy:    []string{"noproxy"},
 	}
 
 	g := NewWithT(t)
-	g.Expect(c.ValidateUpdate(cOld)).To(MatchError(ContainSubstring("spec.ProxyConfiguration: Forbidden: field is immutable")))
+	g.Expect(c.ValidateUpdate(cOld)).To(MatchError(ContainSubstring("spec.ProxyConfiguration: Forbidden")))
 }
 
-func TestClusterValidateUpdateProxyConfigurationOldNilImmutable(t *testing.T) {
+func TestClusterValidateUpdateProxyConfigurationNewNilImmutable(t *testing.T) {
 	cOld := createCluster()
-	cOld.Spec.ProxyConfiguration = nil
+	cOld.Spec.ProxyConfiguration = &v1alpha1.ProxyConfiguration{
+		HttpProxy:  "http://1.2.3.4:3128",
+		HttpsProxy: "http://1.2.3.4:3128",
+		NoProxy:    []string{"noproxy"},
+	}
+	c := cOld.DeepCopy()
+	c.Spec.ProxyConfiguration = nil
+	g := NewWithT(t)
+	g.Expect(c.ValidateUpdate(cOld)).To(MatchError(ContainSubstring("spec.ProxyConfiguration: Forbidden")))
+}
 
+func TestClusterValidateUpdateProxyConfigurationPausedByCLI(t *testing.T) {
+	cOld := createCluster()
+	cOld.PauseReconcile()
+	cOld.Spec.ProxyConfiguration = &v1alpha1.ProxyConfiguration{
+		HttpProxy:  "http://1.2.3.4:3128",
+		HttpsProxy: "http://1.2.3.4:3128",
+		NoProxy:    []string{"noproxy1"},
+	}
 	c := cOld.DeepCopy()
 	c.Spec.ProxyConfiguration = &v1alpha1.ProxyConfiguration{
-		HttpProxy:  "httpproxy",
-		HttpsProxy: "httpsproxy",
-		NoProxy:    []string{"noproxy"},
+		HttpProxy:  "http://1.2.3.5:3128",
+		HttpsProxy: "http://1.2.3.5:3128",
+		NoProxy:    []string{"noproxy1", "noproxy2"},
 	}
 
 	g := NewWithT(t)
-	g.Expect(c.ValidateUpdate(cOld)).To(MatchError(ContainSubstring("spec.ProxyConfiguration: Forbidden: field is immutable")))
+	g.Expect(c.ValidateUpdate(cOld)).To(Succeed())
 }
 
-func TestClusterValidateUpdateProxyConfigurationNewNilImmutable(t *testing.T) {
+func TestClusterValidateUpdateProxyConfigurationInvalid(t *testing.T) {
 	cOld := createCluster()
+	cOld.PauseReconcile()
 	cOld.Spec.ProxyConfiguration = &v1alpha1.ProxyConfiguration{
-		HttpProxy:  "httpproxy",
-		HttpsProxy: "httpsproxy",
-		NoProxy:    []string{"noproxy"},
+		HttpProxy:  "http://1.2.3.4:3128",
+		HttpsProxy: "http://1.2.3.4:3128",
 	}
 	c := cOld.DeepCopy()
-	c.Spec.ProxyConfiguration = nil
+	c.Spec.ProxyConfiguration.HttpsProxy = ""
+
 	g := NewWithT(t)
-	g.Expect(c.ValidateUpdate(cOld)).To(MatchError(ContainSubstring("spec.ProxyConfiguration: Forbidden: field is immutable")))
+	g.Expect(c.ValidateUpdate(cOld)).To(MatchError(ContainSubstring("no value set for httpsProxy")))
 }
 
 func TestClusterValidateUpdateGitOpsRefImmutableNilEqual(t *testing.T) {
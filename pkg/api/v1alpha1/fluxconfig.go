@@ -16,11 +16,17 @@ const (
 )
 
 func validateFluxConfig(config *FluxConfig) error {
-	if config.Spec.Git != nil && config.Spec.Github != nil {
+	providers := 0
+	for _, set := range []bool{config.Spec.Git != nil, config.Spec.Github != nil, config.Spec.Gitlab != nil, config.Spec.BitbucketServer != nil} {
+		if set {
+			providers++
+		}
+	}
+	if providers > 1 {
 		return errors.New("must specify only one provider")
 	}
-	if config.Spec.Git == nil && config.Spec.Github == nil {
-		return errors.New("must specify a provider. Valid options are git and github")
+	if providers == 0 {
+		return errors.New("must specify a provider. Valid options are git, github, gitlab and bitbucketServer")
 	}
 	if config.Spec.Github != nil {
 		err := validateGithubProviderConfig(*config.Spec.Github)
@@ -34,6 +40,18 @@ func validateFluxConfig(config *FluxConfig) error {
 			return err
 		}
 	}
+	if config.Spec.Gitlab != nil {
+		err := validateGitlabProviderConfig(*config.Spec.Gitlab)
+		if err != nil {
+			return err
+		}
+	}
+	if config.Spec.BitbucketServer != nil {
+		err := validateBitbucketServerProviderConfig(*config.Spec.BitbucketServer)
+		if err != nil {
+			return err
+		}
+	}
 
 	if len(config.Spec.Branch) > 0 {
 		err := validateGitBranchName(config.Spec.Branch)
@@ -42,6 +60,16 @@ func validateFluxConfig(config *FluxConfig) error {
 		}
 	}
 
+	if config.Spec.PullRequest != nil {
+		if config.Spec.Git != nil {
+			return errors.New("pullRequest is not supported with the git provider; use github, gitlab or bitbucketServer")
+		}
+		err := validatePullRequestConfig(*config.Spec.PullRequest)
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -49,6 +77,24 @@ func validateGitProviderConfig(gitProviderConfig GitProviderConfig) error {
 	if len(gitProviderConfig.RepositoryUrl) <= 0 {
 		return errors.New("'repositoryUrl' is not set or empty in gitProviderConfig; repositoryUrl is a required field")
 	}
+	if err := validateRepositoryUrl(gitProviderConfig.RepositoryUrl); err != nil {
+		return err
+	}
+
+	if gitProviderConfig.IsHTTPS() {
+		if len(gitProviderConfig.SshKeyAlgorithm) > 0 {
+			return errors.New("'sshKeyAlgorithm' is only supported with ssh repository urls in gitProviderConfig")
+		}
+		if gitProviderConfig.GithubApp != nil {
+			return validateGithubAppConfig(*gitProviderConfig.GithubApp)
+		}
+		return nil
+	}
+
+	if gitProviderConfig.GithubApp != nil {
+		return errors.New("'githubApp' is only supported with https repository urls in gitProviderConfig")
+	}
+
 	if len(gitProviderConfig.SshKeyAlgorithm) > 0 {
 		if err := validateSshKeyAlgorithm(gitProviderConfig.SshKeyAlgorithm); err != nil {
 			return err
@@ -57,7 +103,17 @@ func validateGitProviderConfig(gitProviderConfig GitProviderConfig) error {
 		logger.Info("Warning: 'sshKeyAlgorithm' is not set, defaulting to 'ecdsa'")
 	}
 
-	return validateRepositoryUrl(gitProviderConfig.RepositoryUrl)
+	return nil
+}
+
+func validateGithubAppConfig(config GithubAppConfig) error {
+	if config.AppID <= 0 {
+		return errors.New("'appID' is not set or invalid in githubApp; appID is a required field")
+	}
+	if config.InstallationID <= 0 {
+		return errors.New("'installationID' is not set or invalid in githubApp; installationID is a required field")
+	}
+	return nil
 }
 
 func validateGithubProviderConfig(config GithubProviderConfig) error {
@@ -74,12 +130,68 @@ func validateGithubProviderConfig(config GithubProviderConfig) error {
 	return nil
 }
 
+func validateGitlabProviderConfig(config GitlabProviderConfig) error {
+	if len(config.Owner) <= 0 {
+		return errors.New("'owner' is not set or empty in gitlabProviderConfig; owner is a required field")
+	}
+	if len(config.Repository) <= 0 {
+		return errors.New("'repository' is not set or empty in gitlabProviderConfig; repository is a required field")
+	}
+	if err := validateGitRepoName(config.Repository); err != nil {
+		return err
+	}
+	return validateHostname(config.Hostname, "gitlabProviderConfig")
+}
+
+func validateBitbucketServerProviderConfig(config BitbucketServerProviderConfig) error {
+	if len(config.Owner) <= 0 {
+		return errors.New("'owner' is not set or empty in bitbucketServerProviderConfig; owner is a required field")
+	}
+	if len(config.Repository) <= 0 {
+		return errors.New("'repository' is not set or empty in bitbucketServerProviderConfig; repository is a required field")
+	}
+	if len(config.Hostname) <= 0 {
+		return errors.New("'hostname' is not set or empty in bitbucketServerProviderConfig; hostname is a required field")
+	}
+	if err := validateGitRepoName(config.Repository); err != nil {
+		return err
+	}
+	return validateHostname(config.Hostname, "bitbucketServerProviderConfig")
+}
+
+// validatePullRequestConfig checks the branch prefix and merge timeout of the pull request mode.
+func validatePullRequestConfig(config PullRequestConfig) error {
+	if len(config.BranchPrefix) > 0 {
+		// The prefix is not a branch by itself, so validate it as the start of one.
+		if err := validateGitBranchName(config.BranchPrefix + "branch"); err != nil {
+			return fmt.Errorf("'branchPrefix' %s in pullRequest is invalid: %v", config.BranchPrefix, err)
+		}
+	}
+	if config.MergeTimeout != nil && config.MergeTimeout.Duration <= 0 {
+		return fmt.Errorf("'mergeTimeout' in pullRequest must be greater than 0, got %s", config.MergeTimeout.Duration)
+	}
+	return nil
+}
+
+// validateHostname checks the hostname is a bare host with an optional port, since the provider
+// builds the https API and clone urls from it.
+func validateHostname(hostname, providerConfig string) error {
+	if hostname == "" {
+		return nil
+	}
+	u, err := url.Parse("https://" + hostname)
+	if err != nil || u.Host != hostname {
+		return fmt.Errorf("'hostname' %s in %s is invalid; it must be a host name with an optional port and without scheme or path", hostname, providerConfig)
+	}
+	return nil
+}
+
 func validateRepositoryUrl(repositoryUrl string) error {
 	url, err := url.Parse(repositoryUrl)
 	if err != nil {
 		return fmt.Errorf("unable to parse repository url: %v", err)
 	}
-	if url.Scheme != "ssh" {
+	if url.Scheme != "ssh" && url.Scheme != "https" {
 		return fmt.Errorf("invalid repository url scheme: %v", url.Scheme)
 	}
 	return nil
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"reflect"
 	"testing"
+	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -188,6 +189,365 @@ func TestValidateFluxConfig(t *testing.T) {
 			gitProvider: true,
 			error:       nil,
 		},
+		{
+			testName: "valid https repo url",
+			fluxConfig: &FluxConfig{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       FluxConfigKind,
+					APIVersion: SchemeBuilder.GroupVersion.String(),
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-flux-git",
+					Namespace: "default",
+				},
+				Spec: FluxConfigSpec{
+					Git: &GitProviderConfig{
+						RepositoryUrl: "https://git.example.com/username/repo.git",
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			testName: "valid https repo url with github app",
+			fluxConfig: &FluxConfig{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       FluxConfigKind,
+					APIVersion: SchemeBuilder.GroupVersion.String(),
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-flux-git",
+					Namespace: "default",
+				},
+				Spec: FluxConfigSpec{
+					Git: &GitProviderConfig{
+						RepositoryUrl: "https://github.com/org/repo.git",
+						GithubApp:     &GithubAppConfig{AppID: 1234, InstallationID: 5678},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			testName: "https repo url with ssh key algo",
+			fluxConfig: &FluxConfig{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       FluxConfigKind,
+					APIVersion: SchemeBuilder.GroupVersion.String(),
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-flux-git",
+					Namespace: "default",
+				},
+				Spec: FluxConfigSpec{
+					Git: &GitProviderConfig{
+						RepositoryUrl:   "https://git.example.com/username/repo.git",
+						SshKeyAlgorithm: RsaAlgorithm,
+					},
+				},
+			},
+			wantErr: true,
+			error:   errors.New("'sshKeyAlgorithm' is only supported with ssh repository urls in gitProviderConfig"),
+		},
+		{
+			testName: "github app with ssh repo url",
+			fluxConfig: &FluxConfig{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       FluxConfigKind,
+					APIVersion: SchemeBuilder.GroupVersion.String(),
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-flux-git",
+					Namespace: "default",
+				},
+				Spec: FluxConfigSpec{
+					Git: &GitProviderConfig{
+						RepositoryUrl: "ssh://git@github.com/org/repo.git",
+						GithubApp:     &GithubAppConfig{AppID: 1234, InstallationID: 5678},
+					},
+				},
+			},
+			wantErr: true,
+			error:   errors.New("'githubApp' is only supported with https repository urls in gitProviderConfig"),
+		},
+		{
+			testName: "github app without app id",
+			fluxConfig: &FluxConfig{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       FluxConfigKind,
+					APIVersion: SchemeBuilder.GroupVersion.String(),
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-flux-git",
+					Namespace: "default",
+				},
+				Spec: FluxConfigSpec{
+					Git: &GitProviderConfig{
+						RepositoryUrl: "https://github.com/org/repo.git",
+						GithubApp:     &GithubAppConfig{InstallationID: 5678},
+					},
+				},
+			},
+			wantErr: true,
+			error:   errors.New("'appID' is not set or invalid in githubApp; appID is a required field"),
+		},
+		{
+			testName: "github app without installation id",
+			fluxConfig: &FluxConfig{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       FluxConfigKind,
+					APIVersion: SchemeBuilder.GroupVersion.String(),
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-flux-git",
+					Namespace: "default",
+				},
+				Spec: FluxConfigSpec{
+					Git: &GitProviderConfig{
+						RepositoryUrl: "https://github.com/org/repo.git",
+						GithubApp:     &GithubAppConfig{AppID: 1234},
+					},
+				},
+			},
+			wantErr: true,
+			error:   errors.New("'installationID' is not set or invalid in githubApp; installationID is a required field"),
+		},
+		{
+			testName: "valid fluxconfig gitlab",
+			fluxConfig: &FluxConfig{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       FluxConfigKind,
+					APIVersion: SchemeBuilder.GroupVersion.String(),
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-flux",
+					Namespace: "default",
+				},
+				Spec: FluxConfigSpec{
+					Gitlab: &GitlabProviderConfig{
+						Owner:      "janedoe/clusters",
+						Repository: "flux-fleet",
+						Hostname:   "gitlab.example.com:8443",
+					},
+				},
+			},
+			wantErr: false,
+			error:   nil,
+		},
+		{
+			testName: "valid fluxconfig bitbucket server",
+			fluxConfig: &FluxConfig{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       FluxConfigKind,
+					APIVersion: SchemeBuilder.GroupVersion.String(),
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-flux",
+					Namespace: "default",
+				},
+				Spec: FluxConfigSpec{
+					BitbucketServer: &BitbucketServerProviderConfig{
+						Owner:      "OPS",
+						Repository: "flux-fleet",
+						Hostname:   "bitbucket.example.com",
+					},
+				},
+			},
+			wantErr: false,
+			error:   nil,
+		},
+		{
+			testName: "multiple providers",
+			fluxConfig: &FluxConfig{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       FluxConfigKind,
+					APIVersion: SchemeBuilder.GroupVersion.String(),
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-flux",
+					Namespace: "default",
+				},
+				Spec: FluxConfigSpec{
+					Github: &GithubProviderConfig{
+						Owner:      "janedoe",
+						Repository: "flux-fleet",
+					},
+					Gitlab: &GitlabProviderConfig{
+						Owner:      "janedoe",
+						Repository: "flux-fleet",
+					},
+				},
+			},
+			wantErr: true,
+			error:   errors.New("must specify only one provider"),
+		},
+		{
+			testName: "no provider",
+			fluxConfig: &FluxConfig{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       FluxConfigKind,
+					APIVersion: SchemeBuilder.GroupVersion.String(),
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-flux",
+					Namespace: "default",
+				},
+				Spec: FluxConfigSpec{
+					Branch: "main",
+				},
+			},
+			wantErr: true,
+			error:   errors.New("must specify a provider. Valid options are git, github, gitlab and bitbucketServer"),
+		},
+		{
+			testName: "gitlab empty owner",
+			fluxConfig: &FluxConfig{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       FluxConfigKind,
+					APIVersion: SchemeBuilder.GroupVersion.String(),
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-flux",
+					Namespace: "default",
+				},
+				Spec: FluxConfigSpec{
+					Gitlab: &GitlabProviderConfig{
+						Repository: "flux-fleet",
+					},
+				},
+			},
+			wantErr: true,
+			error:   errors.New("'owner' is not set or empty in gitlabProviderConfig; owner is a required field"),
+		},
+		{
+			testName: "gitlab invalid hostname",
+			fluxConfig: &FluxConfig{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       FluxConfigKind,
+					APIVersion: SchemeBuilder.GroupVersion.String(),
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-flux",
+					Namespace: "default",
+				},
+				Spec: FluxConfigSpec{
+					Gitlab: &GitlabProviderConfig{
+						Owner:      "janedoe",
+						Repository: "flux-fleet",
+						Hostname:   "https://gitlab.example.com",
+					},
+				},
+			},
+			wantErr: true,
+			error:   errors.New("'hostname' https://gitlab.example.com in gitlabProviderConfig is invalid; it must be a host name with an optional port and without scheme or path"),
+		},
+		{
+			testName: "bitbucket server empty hostname",
+			fluxConfig: &FluxConfig{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       FluxConfigKind,
+					APIVersion: SchemeBuilder.GroupVersion.String(),
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-flux",
+					Namespace: "default",
+				},
+				Spec: FluxConfigSpec{
+					BitbucketServer: &BitbucketServerProviderConfig{
+						Owner:      "OPS",
+						Repository: "flux-fleet",
+					},
+				},
+			},
+			wantErr: true,
+			error:   errors.New("'hostname' is not set or empty in bitbucketServerProviderConfig; hostname is a required field"),
+		},
+		{
+			testName: "valid pull request config",
+			fluxConfig: &FluxConfig{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       FluxConfigKind,
+					APIVersion: SchemeBuilder.GroupVersion.String(),
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-flux",
+					Namespace: "default",
+				},
+				Spec: FluxConfigSpec{
+					Github: &GithubProviderConfig{
+						Owner:      "test-owner",
+						Repository: "test-repo",
+					},
+					PullRequest: &PullRequestConfig{BranchPrefix: "clusters/", MergeTimeout: &metav1.Duration{Duration: 30 * time.Minute}},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			testName: "pull request with git provider",
+			fluxConfig: &FluxConfig{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       FluxConfigKind,
+					APIVersion: SchemeBuilder.GroupVersion.String(),
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-flux",
+					Namespace: "default",
+				},
+				Spec: FluxConfigSpec{
+					Git: &GitProviderConfig{
+						RepositoryUrl: "ssh://git@github.com/repo.git",
+					},
+					PullRequest: &PullRequestConfig{},
+				},
+			},
+			wantErr: true,
+			error:   errors.New("pullRequest is not supported with the git provider; use github, gitlab or bitbucketServer"),
+		},
+		{
+			testName: "pull request invalid branch prefix",
+			fluxConfig: &FluxConfig{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       FluxConfigKind,
+					APIVersion: SchemeBuilder.GroupVersion.String(),
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-flux",
+					Namespace: "default",
+				},
+				Spec: FluxConfigSpec{
+					Github: &GithubProviderConfig{
+						Owner:      "test-owner",
+						Repository: "test-repo",
+					},
+					PullRequest: &PullRequestConfig{BranchPrefix: "eksa/../"},
+				},
+			},
+			wantErr: true,
+			error:   errors.New("'branchPrefix' eksa/../ in pullRequest is invalid: eksa/../branch is not a valid git branch name, please check with this documentation https://git-scm.com/docs/git-check-ref-format for valid git branch names"),
+		},
+		{
+			testName: "pull request zero merge timeout",
+			fluxConfig: &FluxConfig{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       FluxConfigKind,
+					APIVersion: SchemeBuilder.GroupVersion.String(),
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-flux",
+					Namespace: "default",
+				},
+				Spec: FluxConfigSpec{
+					Github: &GithubProviderConfig{
+						Owner:      "test-owner",
+						Repository: "test-repo",
+					},
+					PullRequest: &PullRequestConfig{MergeTimeout: &metav1.Duration{}},
+				},
+			},
+			wantErr: true,
+			error:   errors.New("'mergeTimeout' in pullRequest must be greater than 0, got 0s"),
+		},
 	}
 
 	for _, tt := range tests {
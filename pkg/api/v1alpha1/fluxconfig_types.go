@@ -15,7 +15,10 @@
 package v1alpha1
 
 import (
+	"strings"
+
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
 )
 
 // FluxConfigSpec defines the desired state of FluxConfig.
@@ -35,6 +38,17 @@ type FluxConfigSpec struct {
 
 	// Used to specify Git provider that will be used to host the git files
 	Git *GitProviderConfig `json:"git,omitempty"`
+
+	// Used to specify GitLab provider to host the Git repo and host the git files
+	Gitlab *GitlabProviderConfig `json:"gitlab,omitempty"`
+
+	// Used to specify Bitbucket Server provider to host the Git repo and host the git files
+	BitbucketServer *BitbucketServerProviderConfig `json:"bitbucketServer,omitempty"`
+
+	// PullRequest enables pull request mode. When set, the CLI pushes cluster changes to a generated branch
+	// and opens a pull request against Branch instead of pushing to it directly. Only supported with the
+	// github, gitlab and bitbucketServer providers.
+	PullRequest *PullRequestConfig `json:"pullRequest,omitempty"`
 }
 
 type GithubProviderConfig struct {
@@ -54,13 +68,87 @@ type GitProviderConfig struct {
 
 	// SSH public key algorithm for the private key specified (rsa, ecdsa, ed25519) (default ecdsa)
 	SshKeyAlgorithm string `json:"sshKeyAlgorithm,omitempty"`
+
+	// GithubApp authenticates with GitHub App installation tokens instead of a username and token.
+	// Only valid with HTTPS repository urls hosted in GitHub or GitHub Enterprise Server.
+	GithubApp *GithubAppConfig `json:"githubApp,omitempty"`
+}
+
+type GithubAppConfig struct {
+	// AppID is the ID of the GitHub App.
+	AppID int64 `json:"appID"`
+
+	// InstallationID is the ID of the GitHub App installation with access to the repository.
+	InstallationID int64 `json:"installationID"`
+}
+
+// IsHTTPS returns true if the repository is accessed over HTTPS, which uses token or GitHub App authentication
+// instead of SSH keys.
+func (c *GitProviderConfig) IsHTTPS() bool {
+	return strings.HasPrefix(c.RepositoryUrl, "https://")
 }
 
+type GitlabProviderConfig struct {
+	// Owner is the user or group name of the GitLab repository.
+	// Subgroups can be specified with their full path, e.g. group/subgroup.
+	Owner string `json:"owner"`
+
+	// Repository name.
+	Repository string `json:"repository"`
+
+	// Hostname of a self-managed GitLab instance. Defaults to gitlab.com.
+	Hostname string `json:"hostname,omitempty"`
+
+	// if true, the owner is assumed to be a GitLab user; otherwise a group.
+	Personal bool `json:"personal,omitempty"`
+}
+
+type BitbucketServerProviderConfig struct {
+	// Owner is the project key or, for personal repositories, the user name of the Bitbucket Server repository.
+	Owner string `json:"owner"`
+
+	// Repository name.
+	Repository string `json:"repository"`
+
+	// Hostname of the Bitbucket Server instance.
+	Hostname string `json:"hostname"`
+
+	// if true, the owner is assumed to be a Bitbucket Server user; otherwise a project.
+	Personal bool `json:"personal,omitempty"`
+}
+
+type PullRequestConfig struct {
+	// BranchPrefix for the branches created for pull requests. Defaults to eksa/.
+	BranchPrefix string `json:"branchPrefix,omitempty"`
+
+	// MergeTimeout is how long the CLI waits for a pull request to be merged before failing. Defaults to 1h.
+	MergeTimeout *metav1.Duration `json:"mergeTimeout,omitempty"`
+}
+
+const (
+	// GitRepositoryReadyCondition reports the Ready condition of the Flux GitRepository that syncs the FluxConfig repository.
+	GitRepositoryReadyCondition clusterv1.ConditionType = "GitRepositoryReady"
+	// KustomizationReadyCondition reports the Ready condition of the Flux Kustomization that applies the FluxConfig repository.
+	KustomizationReadyCondition clusterv1.ConditionType = "KustomizationReady"
+)
+
 // FluxConfigStatus defines the observed state of FluxConfig.
-type FluxConfigStatus struct{}
+type FluxConfigStatus struct {
+	// LastAppliedRevision is the revision of the git repository last applied to the cluster by Flux.
+	// +optional
+	LastAppliedRevision string `json:"lastAppliedRevision,omitempty"`
+	// FailureMessage is the last error reported by the Flux GitRepository or Kustomization.
+	// +optional
+	FailureMessage *string `json:"failureMessage,omitempty"`
+	// +optional
+	Conditions []clusterv1.Condition `json:"conditions,omitempty"`
+}
 
 //+kubebuilder:object:root=true
 //+kubebuilder:subresource:status
+//+kubebuilder:printcolumn:name="Revision",type="string",JSONPath=".status.lastAppliedRevision",description="Git revision last applied by Flux"
+//+kubebuilder:printcolumn:name="Repository Ready",type="string",JSONPath=".status.conditions[?(@.type=='GitRepositoryReady')].status"
+//+kubebuilder:printcolumn:name="Kustomization Ready",type="string",JSONPath=".status.conditions[?(@.type=='KustomizationReady')].status"
 
 // FluxConfig is the Schema for the fluxconfigs API and defines the configurations of the Flux GitOps Toolkit and
 // Git repository it links to.
@@ -72,6 +160,14 @@ type FluxConfig struct {
 	Status FluxConfigStatus `json:"status,omitempty"`
 }
 
+func (c *FluxConfig) GetConditions() clusterv1.Conditions {
+	return c.Status.Conditions
+}
+
+func (c *FluxConfig) SetConditions(conditions clusterv1.Conditions) {
+	c.Status.Conditions = conditions
+}
+
 // +kubebuilder:object:generate=false
 // Same as FluxConfig except stripped down for generation of yaml file while writing to github repo when flux is enabled.
 type FluxConfigGenerate struct {
@@ -97,7 +193,8 @@ func (e *FluxConfigSpec) Equal(n *FluxConfigSpec) bool {
 	if e.ClusterConfigPath != n.ClusterConfigPath {
 		return false
 	}
-	return e.Git.Equal(n.Git) && e.Github.Equal(n.Github)
+	return e.Git.Equal(n.Git) && e.Github.Equal(n.Github) && e.Gitlab.Equal(n.Gitlab) && e.BitbucketServer.Equal(n.BitbucketServer) &&
+		e.PullRequest.Equal(n.PullRequest)
 }
 
 func (e *GithubProviderConfig) Equal(n *GithubProviderConfig) bool {
@@ -111,6 +208,39 @@ func (e *GithubProviderConfig) Equal(n *GithubProviderConfig) bool {
 }
 
 func (e *GitProviderConfig) Equal(n *GitProviderConfig) bool {
+	if e == n {
+		return true
+	}
+	if e == nil || n == nil {
+		return false
+	}
+	if e.RepositoryUrl != n.RepositoryUrl || e.SshKeyAlgorithm != n.SshKeyAlgorithm {
+		return false
+	}
+	return e.GithubApp.Equal(n.GithubApp)
+}
+
+func (e *GithubAppConfig) Equal(n *GithubAppConfig) bool {
+	if e == n {
+		return true
+	}
+	if e == nil || n == nil {
+		return false
+	}
+	return *e == *n
+}
+
+func (e *GitlabProviderConfig) Equal(n *GitlabProviderConfig) bool {
+	if e == n {
+		return true
+	}
+	if e == nil || n == nil {
+		return false
+	}
+	return *e == *n
+}
+
+func (e *BitbucketServerProviderConfig) Equal(n *BitbucketServerProviderConfig) bool {
 	if e == n {
 		return true
 	}
@@ -120,6 +250,22 @@ func (e *GitProviderConfig) Equal(n *GitProviderConfig) bool {
 	return *e == *n
 }
 
+func (e *PullRequestConfig) Equal(n *PullRequestConfig) bool {
+	if e == n {
+		return true
+	}
+	if e == nil || n == nil {
+		return false
+	}
+	if e.BranchPrefix != n.BranchPrefix {
+		return false
+	}
+	if e.MergeTimeout == nil || n.MergeTimeout == nil {
+		return e.MergeTimeout == n.MergeTimeout
+	}
+	return e.MergeTimeout.Duration == n.MergeTimeout.Duration
+}
+
 //+kubebuilder:object:root=true
 
 // FluxConfigList contains a list of FluxConfig.
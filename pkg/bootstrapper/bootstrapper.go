@@ -20,6 +20,7 @@ const (
 
 type Bootstrapper struct {
 	clusterClient *retrierClient
+	clusterOpts   []BootstrapClusterOption
 }
 
 type ClusterClient interface {
@@ -28,6 +29,7 @@ type ClusterClient interface {
 	WithExtraDockerMounts() BootstrapClusterClientOption
 	WithExtraPortMappings([]int) BootstrapClusterClientOption
 	WithEnv(env map[string]string) BootstrapClusterClientOption
+	WithRegistryCache(cacheDir string) BootstrapClusterClientOption
 	ApplyKubeSpecFromBytes(ctx context.Context, cluster *types.Cluster, data []byte) error
 	GetClusters(ctx context.Context, cluster *types.Cluster) ([]types.CAPICluster, error)
 	GetKubeconfig(ctx context.Context, clusterName string) (string, error)
@@ -55,6 +57,7 @@ func New(clusterClient ClusterClient, opts ...BootstrapperOpt) *Bootstrapper {
 }
 
 func (b *Bootstrapper) CreateBootstrapCluster(ctx context.Context, clusterSpec *cluster.Spec, opts ...BootstrapClusterOption) (*types.Cluster, error) {
+	opts = append(append([]BootstrapClusterOption{}, b.clusterOpts...), opts...)
 	kubeconfigFile, err := b.clusterClient.CreateBootstrapCluster(ctx, clusterSpec, b.getClientOptions(opts)...)
 	if err != nil {
 		return nil, fmt.Errorf("creating bootstrap cluster: %v, try rerunning with --force-cleanup to force delete previously created bootstrap cluster", err)
@@ -81,6 +84,14 @@ func WithRetrier(retrier *retrier.Retrier) BootstrapperOpt {
 	}
 }
 
+// WithBootstrapClusterOptions sets options applied to every bootstrap cluster created,
+// in addition to the ones passed to CreateBootstrapCluster.
+func WithBootstrapClusterOptions(opts ...BootstrapClusterOption) BootstrapperOpt {
+	return func(b *Bootstrapper) {
+		b.clusterOpts = append(b.clusterOpts, opts...)
+	}
+}
+
 func (b *Bootstrapper) DeleteBootstrapCluster(ctx context.Context, cluster *types.Cluster, operationType constants.Operation, isForceCleanup bool) error {
 	clusterExists, err := b.clusterClient.ClusterExists(ctx, cluster.Name)
 	if err != nil {
@@ -152,3 +163,11 @@ func WithEnv(env map[string]string) BootstrapClusterOption {
 		return b.clusterClient.WithEnv(env)
 	}
 }
+
+// WithRegistryCache runs a local pull-through registry cache alongside the bootstrap cluster,
+// storing the cached images in cacheDir so they can be reused across runs.
+func WithRegistryCache(cacheDir string) BootstrapClusterOption {
+	return func(b *Bootstrapper) BootstrapClusterClientOption {
+		return b.clusterClient.WithRegistryCache(cacheDir)
+	}
+}
@@ -50,6 +50,28 @@ func TestBootstrapperCreateBootstrapClusterSuccess(t *testing.T) {
 	}
 }
 
+func TestBootstrapperCreateBootstrapClusterWithRegistryCache(t *testing.T) {
+	kubeconfigFile := "c.kubeconfig"
+	clusterName := "cluster-name"
+	clusterSpec, wantCluster := given(t, clusterName, kubeconfigFile)
+
+	ctx := context.Background()
+	b, client := newBootstrapper(t, bootstrapper.WithBootstrapClusterOptions(bootstrapper.WithRegistryCache("registry-cache")))
+	client.EXPECT().WithRegistryCache("registry-cache").Return(func() error { return nil })
+	client.EXPECT().WithEnv(map[string]string{"ENV": "value"}).Return(func() error { return nil })
+	client.EXPECT().CreateBootstrapCluster(ctx, clusterSpec, gomock.Any(), gomock.Any()).Return(kubeconfigFile, nil)
+	client.EXPECT().CreateNamespaceIfNotPresent(ctx, kubeconfigFile, constants.EksaSystemNamespace)
+
+	got, err := b.CreateBootstrapCluster(ctx, clusterSpec, bootstrapper.WithEnv(map[string]string{"ENV": "value"}))
+	if err != nil {
+		t.Fatalf("Bootstrapper.CreateBootstrapCluster() error = %v, wantErr nil", err)
+	}
+
+	if !reflect.DeepEqual(got, wantCluster) {
+		t.Fatalf("Bootstrapper.CreateBootstrapCluster() cluster = %#v, want %#v", got, wantCluster)
+	}
+}
+
 func TestBootstrapperCreateBootstrapClusterFailureOnCreateNamespaceIfNotPresentFailure(t *testing.T) {
 	kubeconfigFile := "c.kubeconfig"
 	clusterName := "cluster-name"
@@ -199,3 +199,17 @@ func (mr *MockClusterClientMockRecorder) WithExtraPortMappings(arg0 interface{})
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithExtraPortMappings", reflect.TypeOf((*MockClusterClient)(nil).WithExtraPortMappings), arg0)
 }
+
+// WithRegistryCache mocks base method.
+func (m *MockClusterClient) WithRegistryCache(arg0 string) bootstrapper.BootstrapClusterClientOption {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithRegistryCache", arg0)
+	ret0, _ := ret[0].(bootstrapper.BootstrapClusterClientOption)
+	return ret0
+}
+
+// WithRegistryCache indicates an expected call of WithRegistryCache.
+func (mr *MockClusterClientMockRecorder) WithRegistryCache(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithRegistryCache", reflect.TypeOf((*MockClusterClient)(nil).WithRegistryCache), arg0)
+}
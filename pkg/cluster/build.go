@@ -16,5 +16,7 @@ func NewDefaultConfigClientBuilder() *ConfigClientBuilder {
 		getAWSIam,
 		getGitOps,
 		getFluxConfig,
+		getArgoCDConfig,
+		getRegistryCredentialsSecret,
 	)
 }
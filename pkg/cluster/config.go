@@ -27,8 +27,10 @@ type Config struct {
 	AWSIAMConfigs             map[string]*anywherev1.AWSIamConfig
 	GitOpsConfig              *anywherev1.GitOpsConfig
 	FluxConfig                *anywherev1.FluxConfig
+	ArgoCDConfig              *anywherev1.ArgoCDConfig
 	SnowCredentialsSecret     *v1.Secret
 	SnowIPPools               map[string]*anywherev1.SnowIPPool
+	RegistryCredentialsSecret *v1.Secret
 }
 
 func (c *Config) VsphereMachineConfig(name string) *anywherev1.VSphereMachineConfig {
@@ -60,17 +62,32 @@ func (c *Config) NutanixMachineConfig(name string) *anywherev1.NutanixMachineCon
 	return c.NutanixMachineConfigs[name]
 }
 
+// GitProviderConfig returns the generic git repository configuration of the GitOps engine of the cluster,
+// or nil if the cluster doesn't sync from a generic git repository.
+func (c *Config) GitProviderConfig() *anywherev1.GitProviderConfig {
+	switch {
+	case c.FluxConfig != nil:
+		return c.FluxConfig.Spec.Git
+	case c.ArgoCDConfig != nil:
+		return c.ArgoCDConfig.Spec.Git
+	default:
+		return nil
+	}
+}
+
 func (c *Config) DeepCopy() *Config {
 	c2 := &Config{
-		Cluster:              c.Cluster.DeepCopy(),
-		CloudStackDatacenter: c.CloudStackDatacenter.DeepCopy(),
-		VSphereDatacenter:    c.VSphereDatacenter.DeepCopy(),
-		NutanixDatacenter:    c.NutanixDatacenter.DeepCopy(),
-		DockerDatacenter:     c.DockerDatacenter.DeepCopy(),
-		SnowDatacenter:       c.SnowDatacenter.DeepCopy(),
-		TinkerbellDatacenter: c.TinkerbellDatacenter.DeepCopy(),
-		GitOpsConfig:         c.GitOpsConfig.DeepCopy(),
-		FluxConfig:           c.FluxConfig.DeepCopy(),
+		Cluster:                   c.Cluster.DeepCopy(),
+		CloudStackDatacenter:      c.CloudStackDatacenter.DeepCopy(),
+		VSphereDatacenter:         c.VSphereDatacenter.DeepCopy(),
+		NutanixDatacenter:         c.NutanixDatacenter.DeepCopy(),
+		DockerDatacenter:          c.DockerDatacenter.DeepCopy(),
+		SnowDatacenter:            c.SnowDatacenter.DeepCopy(),
+		TinkerbellDatacenter:      c.TinkerbellDatacenter.DeepCopy(),
+		GitOpsConfig:              c.GitOpsConfig.DeepCopy(),
+		FluxConfig:                c.FluxConfig.DeepCopy(),
+		ArgoCDConfig:              c.ArgoCDConfig.DeepCopy(),
+		RegistryCredentialsSecret: c.RegistryCredentialsSecret.DeepCopy(),
 	}
 
 	if c.VSphereMachineConfigs != nil {
@@ -158,6 +175,7 @@ func (c *Config) ChildObjects() []kubernetes.Object {
 		c.TinkerbellDatacenter,
 		c.GitOpsConfig,
 		c.FluxConfig,
+		c.ArgoCDConfig,
 	)
 
 	for _, e := range c.VSphereMachineConfigs {
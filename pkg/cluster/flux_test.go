@@ -53,3 +53,43 @@ func TestDefaultConfigClientBuilderFluxConfig(t *testing.T) {
 	g.Expect(config.Cluster).To(Equal(cluster))
 	g.Expect(config.FluxConfig).To(Equal(fluxConfig))
 }
+
+func TestDefaultConfigClientBuilderArgoCDConfig(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	b := cluster.NewDefaultConfigClientBuilder()
+	ctrl := gomock.NewController(t)
+	client := mocks.NewMockClient(ctrl)
+	cluster := &anywherev1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-cluster",
+			Namespace: "default",
+		},
+		Spec: anywherev1.ClusterSpec{
+			GitOpsRef: &anywherev1.Ref{
+				Kind: anywherev1.ArgoCDConfigKind,
+				Name: "my-argocd",
+			},
+		},
+	}
+	argoCDConfig := &anywherev1.ArgoCDConfig{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-argocd",
+			Namespace: "default",
+		},
+	}
+
+	client.EXPECT().Get(ctx, "my-argocd", "default", &anywherev1.ArgoCDConfig{}).DoAndReturn(
+		func(ctx context.Context, name, namespace string, obj runtime.Object) error {
+			c := obj.(*anywherev1.ArgoCDConfig)
+			c.ObjectMeta = argoCDConfig.ObjectMeta
+			return nil
+		},
+	)
+
+	config, err := b.Build(ctx, client, cluster)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(config).NotTo(BeNil())
+	g.Expect(config.ArgoCDConfig).To(Equal(argoCDConfig))
+	g.Expect(config.FluxConfig).To(BeNil())
+}
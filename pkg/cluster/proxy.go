@@ -0,0 +1,63 @@
+package cluster
+
+import (
+	"net/url"
+)
+
+// NoProxyDefaults returns the addresses that never go through the cluster proxy.
+func NoProxyDefaults() []string {
+	return []string{
+		"localhost",
+		"127.0.0.1",
+		".svc",
+	}
+}
+
+// NoProxyList returns all the addresses excluded from the cluster proxy: the pod and service CIDRs,
+// the noProxy entries in the proxy configuration, the NoProxyDefaults, the datacenter endpoints
+// and the control plane endpoint. It returns nil if the cluster doesn't use a proxy.
+func NoProxyList(config *Config) []string {
+	proxy := config.Cluster.Spec.ProxyConfiguration
+	if proxy == nil {
+		return nil
+	}
+
+	network := config.Cluster.Spec.ClusterNetwork
+	endpoints := datacenterEndpoints(config)
+	capacity := len(network.Pods.CidrBlocks) +
+		len(network.Services.CidrBlocks) +
+		len(proxy.NoProxy) + len(endpoints) + 4
+
+	noProxyList := make([]string, 0, capacity)
+	noProxyList = append(noProxyList, network.Pods.CidrBlocks...)
+	noProxyList = append(noProxyList, network.Services.CidrBlocks...)
+	noProxyList = append(noProxyList, proxy.NoProxy...)
+	noProxyList = append(noProxyList, NoProxyDefaults()...)
+	noProxyList = append(noProxyList, endpoints...)
+	if endpoint := config.Cluster.Spec.ControlPlaneConfiguration.Endpoint; endpoint != nil {
+		noProxyList = append(noProxyList, endpoint.Host)
+	}
+
+	return noProxyList
+}
+
+func datacenterEndpoints(config *Config) []string {
+	switch {
+	case config.VSphereDatacenter != nil:
+		return []string{config.VSphereDatacenter.Spec.Server}
+	case config.CloudStackDatacenter != nil:
+		endpoints := make([]string, 0, len(config.CloudStackDatacenter.Spec.AvailabilityZones))
+		for _, az := range config.CloudStackDatacenter.Spec.AvailabilityZones {
+			if u, err := url.Parse(az.ManagementApiEndpoint); err == nil {
+				endpoints = append(endpoints, u.Hostname())
+			}
+		}
+		return endpoints
+	case config.TinkerbellDatacenter != nil:
+		return []string{config.TinkerbellDatacenter.Spec.TinkerbellIP}
+	case config.NutanixDatacenter != nil:
+		return []string{config.NutanixDatacenter.Spec.Endpoint}
+	default:
+		return nil
+	}
+}
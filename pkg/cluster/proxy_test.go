@@ -0,0 +1,103 @@
+package cluster_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/cluster"
+)
+
+func proxyConfig() *cluster.Config {
+	return &cluster.Config{
+		Cluster: &anywherev1.Cluster{
+			Spec: anywherev1.ClusterSpec{
+				ControlPlaneConfiguration: anywherev1.ControlPlaneConfiguration{
+					Endpoint: &anywherev1.Endpoint{Host: "1.2.3.4"},
+				},
+				ClusterNetwork: anywherev1.ClusterNetwork{
+					Pods:     anywherev1.Pods{CidrBlocks: []string{"192.168.0.0/16"}},
+					Services: anywherev1.Services{CidrBlocks: []string{"10.96.0.0/12"}},
+				},
+				ProxyConfiguration: &anywherev1.ProxyConfiguration{
+					HttpProxy:  "http://10.0.0.1:3128",
+					HttpsProxy: "http://10.0.0.1:3128",
+					NoProxy:    []string{"internal.example.com"},
+				},
+			},
+		},
+	}
+}
+
+func TestNoProxyListNoProxyConfiguration(t *testing.T) {
+	g := NewWithT(t)
+	config := proxyConfig()
+	config.Cluster.Spec.ProxyConfiguration = nil
+
+	g.Expect(cluster.NoProxyList(config)).To(BeNil())
+}
+
+func TestNoProxyListVSphere(t *testing.T) {
+	g := NewWithT(t)
+	config := proxyConfig()
+	config.VSphereDatacenter = &anywherev1.VSphereDatacenterConfig{
+		Spec: anywherev1.VSphereDatacenterConfigSpec{Server: "vcenter.example.com"},
+	}
+
+	g.Expect(cluster.NoProxyList(config)).To(Equal([]string{
+		"192.168.0.0/16",
+		"10.96.0.0/12",
+		"internal.example.com",
+		"localhost",
+		"127.0.0.1",
+		".svc",
+		"vcenter.example.com",
+		"1.2.3.4",
+	}))
+}
+
+func TestNoProxyListCloudStack(t *testing.T) {
+	g := NewWithT(t)
+	config := proxyConfig()
+	config.CloudStackDatacenter = &anywherev1.CloudStackDatacenterConfig{
+		Spec: anywherev1.CloudStackDatacenterConfigSpec{
+			AvailabilityZones: []anywherev1.CloudStackAvailabilityZone{
+				{ManagementApiEndpoint: "https://cloudstack-1.example.com:8080/client/api"},
+				{ManagementApiEndpoint: "https://cloudstack-2.example.com:8080/client/api"},
+			},
+		},
+	}
+
+	g.Expect(cluster.NoProxyList(config)).To(Equal([]string{
+		"192.168.0.0/16",
+		"10.96.0.0/12",
+		"internal.example.com",
+		"localhost",
+		"127.0.0.1",
+		".svc",
+		"cloudstack-1.example.com",
+		"cloudstack-2.example.com",
+		"1.2.3.4",
+	}))
+}
+
+func TestNoProxyListTinkerbell(t *testing.T) {
+	g := NewWithT(t)
+	config := proxyConfig()
+	config.TinkerbellDatacenter = &anywherev1.TinkerbellDatacenterConfig{
+		Spec: anywherev1.TinkerbellDatacenterConfigSpec{TinkerbellIP: "1.2.3.5"},
+	}
+
+	g.Expect(cluster.NoProxyList(config)).To(ContainElements("1.2.3.5", "1.2.3.4"))
+}
+
+func TestNoProxyListNutanix(t *testing.T) {
+	g := NewWithT(t)
+	config := proxyConfig()
+	config.NutanixDatacenter = &anywherev1.NutanixDatacenterConfig{
+		Spec: anywherev1.NutanixDatacenterConfigSpec{Endpoint: "prism.example.com"},
+	}
+
+	g.Expect(cluster.NoProxyList(config)).To(ContainElements("prism.example.com", "1.2.3.4"))
+}
@@ -0,0 +1,30 @@
+package cluster
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+
+	"github.com/aws/eks-anywhere/pkg/constants"
+	"github.com/aws/eks-anywhere/pkg/registrymirror"
+)
+
+// getRegistryCredentialsSecret retrieves the Secret that stores the credentials of an authenticated registry mirror.
+// Clusters created before the Secret was introduced don't have it, so a missing Secret is not an error.
+func getRegistryCredentialsSecret(ctx context.Context, client Client, c *Config) error {
+	if !c.Cluster.RegistryAuth() {
+		return nil
+	}
+
+	secret := &corev1.Secret{}
+	if err := client.Get(ctx, registrymirror.CredentialsSecretName, constants.EksaSystemNamespace, secret); apierrors.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	c.RegistryCredentialsSecret = secret
+
+	return nil
+}
@@ -0,0 +1,73 @@
+package cluster_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/cluster"
+	"github.com/aws/eks-anywhere/pkg/cluster/mocks"
+	"github.com/aws/eks-anywhere/pkg/registrymirror"
+)
+
+func registryAuthCluster() *anywherev1.Cluster {
+	return &anywherev1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-cluster",
+			Namespace: "default",
+		},
+		Spec: anywherev1.ClusterSpec{
+			RegistryMirrorConfiguration: &anywherev1.RegistryMirrorConfiguration{
+				Endpoint:     "1.2.3.4",
+				Port:         "443",
+				Authenticate: true,
+			},
+		},
+	}
+}
+
+func TestDefaultConfigClientBuilderRegistryCredentialsSecret(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	b := cluster.NewDefaultConfigClientBuilder()
+	ctrl := gomock.NewController(t)
+	client := mocks.NewMockClient(ctrl)
+	secret := registrymirror.CredentialsSecret("user", "pass")
+
+	client.EXPECT().Get(ctx, "registry-credentials", "eksa-system", &corev1.Secret{}).DoAndReturn(
+		func(ctx context.Context, name, namespace string, obj runtime.Object) error {
+			s := obj.(*corev1.Secret)
+			s.ObjectMeta = secret.ObjectMeta
+			s.Data = secret.Data
+			return nil
+		},
+	)
+
+	config, err := b.Build(ctx, client, registryAuthCluster())
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(config.RegistryCredentialsSecret.Data).To(Equal(secret.Data))
+}
+
+func TestDefaultConfigClientBuilderRegistryCredentialsSecretNotFound(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	b := cluster.NewDefaultConfigClientBuilder()
+	ctrl := gomock.NewController(t)
+	client := mocks.NewMockClient(ctrl)
+
+	client.EXPECT().Get(ctx, "registry-credentials", "eksa-system", &corev1.Secret{}).Return(
+		apierrors.NewNotFound(schema.GroupResource{Resource: "secrets"}, "registry-credentials"),
+	)
+
+	config, err := b.Build(ctx, client, registryAuthCluster())
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(config.RegistryCredentialsSecret).To(BeNil())
+}
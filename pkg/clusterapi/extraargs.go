@@ -68,10 +68,25 @@ func NodeCIDRMaskExtraArgs(clusterNetwork *v1alpha1.ClusterNetwork) ExtraArgs {
 		return nil
 	}
 	args := ExtraArgs{}
-	args.AddIfNotEmpty("node-cidr-mask-size", strconv.Itoa(*clusterNetwork.Nodes.CIDRMaskSize))
+	// kube-controller-manager doesn't accept node-cidr-mask-size for dual-stack clusters
+	if clusterNetwork.IsDualStack() {
+		args.AddIfNotEmpty("node-cidr-mask-size-ipv4", strconv.Itoa(*clusterNetwork.Nodes.CIDRMaskSize))
+	} else {
+		args.AddIfNotEmpty("node-cidr-mask-size", strconv.Itoa(*clusterNetwork.Nodes.CIDRMaskSize))
+	}
 	return args
 }
 
+// NodeIPExtraArgs makes the kubelet prefer IPv6 node addresses for IPv6 only clusters.
+func NodeIPExtraArgs(clusterNetwork *v1alpha1.ClusterNetwork) ExtraArgs {
+	if clusterNetwork == nil || !clusterNetwork.IPv6Enabled() || clusterNetwork.IPv4Enabled() {
+		return nil
+	}
+	return ExtraArgs{
+		"node-ip": "::",
+	}
+}
+
 func ResolvConfExtraArgs(resolvConf *v1alpha1.ResolvConf) ExtraArgs {
 	if resolvConf == nil {
 		return nil
@@ -472,6 +472,51 @@ func TestAppend(t *testing.T) {
 	}
 }
 
+func TestNodeIPExtraArgs(t *testing.T) {
+	tests := []struct {
+		testName       string
+		clusterNetwork *v1alpha1.ClusterNetwork
+		want           clusterapi.ExtraArgs
+	}{
+		{
+			testName:       "no cluster network config",
+			clusterNetwork: nil,
+			want:           nil,
+		},
+		{
+			testName: "ipv4",
+			clusterNetwork: &v1alpha1.ClusterNetwork{
+				Pods: v1alpha1.Pods{CidrBlocks: []string{"192.168.0.0/16"}},
+			},
+			want: nil,
+		},
+		{
+			testName: "dual-stack",
+			clusterNetwork: &v1alpha1.ClusterNetwork{
+				Pods: v1alpha1.Pods{CidrBlocks: []string{"192.168.0.0/16", "fd00:100:64::/48"}},
+			},
+			want: nil,
+		},
+		{
+			testName: "ipv6",
+			clusterNetwork: &v1alpha1.ClusterNetwork{
+				Pods: v1alpha1.Pods{CidrBlocks: []string{"fd00:100:64::/48"}},
+			},
+			want: clusterapi.ExtraArgs{
+				"node-ip": "::",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			if got := clusterapi.NodeIPExtraArgs(tt.clusterNetwork); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NodeIPExtraArgs() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestNodeCIDRMaskExtraArgs(t *testing.T) {
 	nodeCidrMaskSize := new(int)
 	*nodeCidrMaskSize = 28
@@ -508,6 +553,16 @@ func TestNodeCIDRMaskExtraArgs(t *testing.T) {
 			},
 			want: nil,
 		},
+		{
+			testName: "with nodes config dual-stack",
+			clusterNetwork: &v1alpha1.ClusterNetwork{
+				Pods:  v1alpha1.Pods{CidrBlocks: []string{"192.168.0.0/16", "fd00:100:64::/48"}},
+				Nodes: &v1alpha1.Nodes{CIDRMaskSize: nodeCidrMaskSize},
+			},
+			want: clusterapi.ExtraArgs{
+				"node-cidr-mask-size-ipv4": "28",
+			},
+		},
 	}
 
 	for _, tt := range tests {
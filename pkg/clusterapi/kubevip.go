@@ -10,6 +10,7 @@ import (
 	"sigs.k8s.io/yaml"
 
 	"github.com/aws/eks-anywhere/pkg/constants"
+	"github.com/aws/eks-anywhere/pkg/networkutils"
 )
 
 // SetKubeVipInKubeadmControlPlane appends kube-vip manifest to kubeadmControlPlane's kubeadmConfigSpec files.
@@ -28,6 +29,14 @@ func SetKubeVipInKubeadmControlPlane(kcp *controlplanev1.KubeadmControlPlane, ad
 	return nil
 }
 
+// KubeVipCIDR returns the subnet mask size kube-vip should use for the control plane endpoint address.
+func KubeVipCIDR(address string) string {
+	if networkutils.IsIPv6(address) {
+		return "128"
+	}
+	return "32"
+}
+
 func kubeVip(address, image string) *corev1.Pod {
 	return &corev1.Pod{
 		TypeMeta: metav1.TypeMeta{
@@ -55,7 +64,7 @@ func kubeVip(address, image string) *corev1.Pod {
 						},
 						{
 							Name:  "vip_cidr",
-							Value: "32",
+							Value: KubeVipCIDR(address),
 						},
 						{
 							Name:  "cp_enable",
@@ -25,3 +25,9 @@ func TestSetKubeVipInKubeadmControlPlane(t *testing.T) {
 	g.Expect(clusterapi.SetKubeVipInKubeadmControlPlane(got, g.clusterSpec.Cluster.Spec.ControlPlaneConfiguration.Endpoint.Host, "public.ecr.aws/l0g8r8j6/kube-vip/kube-vip:v0.3.7-eks-a-v0.0.0-dev-build.1433")).To(Succeed())
 	g.Expect(got).To(Equal(want))
 }
+
+func TestKubeVipCIDR(t *testing.T) {
+	g := NewWithT(t)
+	g.Expect(clusterapi.KubeVipCIDR("1.2.3.4")).To(Equal("32"))
+	g.Expect(clusterapi.KubeVipCIDR("fd00::10")).To(Equal("128"))
+}
@@ -18,6 +18,11 @@ const (
 	machineHealthCheckKind   = "MachineHealthCheck"
 	maxUnhealthyControlPlane = "100%"
 	maxUnhealthyWorker       = "40%"
+
+	// DefaultUnhealthyMachineTimeout is the default timeout for an unhealthy machine health check.
+	DefaultUnhealthyMachineTimeout = 5 * time.Minute
+	// DefaultNodeStartupTimeout is the default timeout for a machine without a node to be considered to have failed machine health check.
+	DefaultNodeStartupTimeout = 10 * time.Minute
 )
 
 func machineHealthCheck(clusterName string, unhealthyTimeout, nodeStartupTimeout time.Duration) *clusterv1.MachineHealthCheck {
@@ -52,6 +57,46 @@ func machineHealthCheck(clusterName string, unhealthyTimeout, nodeStartupTimeout
 	}
 }
 
+// applyMachineHealthCheckConfig overrides the defaults in mhc with the settings from the node group config.
+func applyMachineHealthCheckConfig(mhc *clusterv1.MachineHealthCheck, config *v1alpha1.MachineHealthCheck) {
+	if config == nil {
+		return
+	}
+
+	if config.NodeStartupTimeout != nil {
+		mhc.Spec.NodeStartupTimeout = &metav1.Duration{Duration: config.NodeStartupTimeout.Duration}
+	}
+
+	if config.UnhealthyMachineTimeout != nil {
+		for i := range mhc.Spec.UnhealthyConditions {
+			mhc.Spec.UnhealthyConditions[i].Timeout = *config.UnhealthyMachineTimeout
+		}
+	}
+
+	if len(config.UnhealthyConditions) > 0 {
+		mhc.Spec.UnhealthyConditions = make([]clusterv1.UnhealthyCondition, 0, len(config.UnhealthyConditions))
+		for _, c := range config.UnhealthyConditions {
+			mhc.Spec.UnhealthyConditions = append(mhc.Spec.UnhealthyConditions, clusterv1.UnhealthyCondition{
+				Type:    c.Type,
+				Status:  c.Status,
+				Timeout: c.Timeout,
+			})
+		}
+	}
+
+	if config.MaxUnhealthy != nil {
+		maxUnhealthy := *config.MaxUnhealthy
+		mhc.Spec.MaxUnhealthy = &maxUnhealthy
+	}
+
+	if config.DisableRemediation {
+		// With maxUnhealthy at 0, any unhealthy machine short-circuits remediation
+		// while the MachineHealthCheck keeps reporting the machine health.
+		maxUnhealthy := intstr.FromInt(0)
+		mhc.Spec.MaxUnhealthy = &maxUnhealthy
+	}
+}
+
 // MachineHealthCheckForControlPlane creates MachineHealthCheck resources for the control plane.
 func MachineHealthCheckForControlPlane(clusterSpec *cluster.Spec, unhealthyTimeout, nodeStartupTimeout time.Duration) *clusterv1.MachineHealthCheck {
 	mhc := machineHealthCheck(ClusterName(clusterSpec.Cluster), unhealthyTimeout, nodeStartupTimeout)
@@ -59,6 +104,7 @@ func MachineHealthCheckForControlPlane(clusterSpec *cluster.Spec, unhealthyTimeo
 	mhc.Spec.Selector.MatchLabels[clusterv1.MachineControlPlaneLabelName] = ""
 	maxUnhealthy := intstr.Parse(maxUnhealthyControlPlane)
 	mhc.Spec.MaxUnhealthy = &maxUnhealthy
+	applyMachineHealthCheckConfig(mhc, clusterSpec.Cluster.Spec.ControlPlaneConfiguration.MachineHealthCheck)
 	return mhc
 }
 
@@ -78,10 +124,12 @@ func machineHealthCheckForWorker(clusterSpec *cluster.Spec, workerNodeGroupConfi
 	mhc.Spec.Selector.MatchLabels[clusterv1.MachineDeploymentLabelName] = MachineDeploymentName(clusterSpec, workerNodeGroupConfig)
 	maxUnhealthy := intstr.Parse(maxUnhealthyWorker)
 	mhc.Spec.MaxUnhealthy = &maxUnhealthy
+	applyMachineHealthCheckConfig(mhc, workerNodeGroupConfig.MachineHealthCheck)
 	return mhc
 }
 
 // MachineHealthCheckObjects creates MachineHealthCheck resources for control plane and all the worker node groups.
+// unhealthyTimeout and nodeStartupTimeout are used for the node groups that don't override them in the cluster spec.
 func MachineHealthCheckObjects(clusterSpec *cluster.Spec, unhealthyTimeout, nodeStartupTimeout time.Duration) []runtime.Object {
 	mhcWorkers := MachineHealthCheckForWorkers(clusterSpec, unhealthyTimeout, nodeStartupTimeout)
 	o := make([]runtime.Object, 0, len(mhcWorkers)+1)
@@ -122,3 +122,66 @@ func TestMachineHealthCheckObjects(t *testing.T) {
 	got := clusterapi.MachineHealthCheckObjects(tt.clusterSpec, timeout, timeout)
 	tt.Expect(got).To(Equal([]runtime.Object{wantWN[0], wantCP}))
 }
+
+func TestMachineHealthCheckForControlPlaneWithConfig(t *testing.T) {
+	tt := newApiBuilerTest(t)
+	timeout := 5 * time.Minute
+	tt.clusterSpec.Cluster.Spec.ControlPlaneConfiguration.MachineHealthCheck = &v1alpha1.MachineHealthCheck{
+		NodeStartupTimeout:      &metav1.Duration{Duration: 20 * time.Minute},
+		UnhealthyMachineTimeout: &metav1.Duration{Duration: 15 * time.Minute},
+	}
+
+	want := expectedMachineHealthCheckForControlPlane(timeout)
+	want.Spec.NodeStartupTimeout = &metav1.Duration{Duration: 20 * time.Minute}
+	want.Spec.UnhealthyConditions[0].Timeout = metav1.Duration{Duration: 15 * time.Minute}
+	want.Spec.UnhealthyConditions[1].Timeout = metav1.Duration{Duration: 15 * time.Minute}
+
+	got := clusterapi.MachineHealthCheckForControlPlane(tt.clusterSpec, timeout, timeout)
+	tt.Expect(got).To(Equal(want))
+}
+
+func TestMachineHealthCheckForControlPlaneRemediationDisabled(t *testing.T) {
+	tt := newApiBuilerTest(t)
+	timeout := 5 * time.Minute
+	tt.clusterSpec.Cluster.Spec.ControlPlaneConfiguration.MachineHealthCheck = &v1alpha1.MachineHealthCheck{
+		DisableRemediation: true,
+	}
+
+	want := expectedMachineHealthCheckForControlPlane(timeout)
+	maxUnhealthy := intstr.FromInt(0)
+	want.Spec.MaxUnhealthy = &maxUnhealthy
+
+	got := clusterapi.MachineHealthCheckForControlPlane(tt.clusterSpec, timeout, timeout)
+	tt.Expect(got).To(Equal(want))
+}
+
+func TestMachineHealthCheckForWorkersWithConfig(t *testing.T) {
+	tt := newApiBuilerTest(t)
+	timeout := 5 * time.Minute
+	maxUnhealthy := intstr.FromInt(2)
+	tt.workerNodeGroupConfig.MachineHealthCheck = &v1alpha1.MachineHealthCheck{
+		UnhealthyMachineTimeout: &metav1.Duration{Duration: 15 * time.Minute},
+		MaxUnhealthy:            &maxUnhealthy,
+		UnhealthyConditions: []v1alpha1.UnhealthyCondition{
+			{
+				Type:    corev1.NodeDiskPressure,
+				Status:  corev1.ConditionTrue,
+				Timeout: metav1.Duration{Duration: 2 * time.Minute},
+			},
+		},
+	}
+	tt.clusterSpec.Cluster.Spec.WorkerNodeGroupConfigurations = []v1alpha1.WorkerNodeGroupConfiguration{*tt.workerNodeGroupConfig}
+
+	want := expectedMachineHealthCheckForWorkers(timeout)
+	want[0].Spec.MaxUnhealthy = &maxUnhealthy
+	want[0].Spec.UnhealthyConditions = []clusterv1.UnhealthyCondition{
+		{
+			Type:    corev1.NodeDiskPressure,
+			Status:  corev1.ConditionTrue,
+			Timeout: metav1.Duration{Duration: 2 * time.Minute},
+		},
+	}
+
+	got := clusterapi.MachineHealthCheckForWorkers(tt.clusterSpec, timeout, timeout)
+	tt.Expect(got).To(Equal(want))
+}
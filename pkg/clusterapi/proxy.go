@@ -10,6 +10,7 @@ import (
 	controlplanev1 "sigs.k8s.io/cluster-api/controlplane/kubeadm/api/v1beta1"
 
 	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/cluster"
 	"github.com/aws/eks-anywhere/pkg/templater"
 )
 
@@ -73,12 +74,9 @@ func setProxyConfigInEtcdCluster(etcd *etcdv1.EtcdadmCluster, cluster *v1alpha1.
 	}
 }
 
+// NoProxyDefaults returns the addresses that never go through the cluster proxy.
 func NoProxyDefaults() []string {
-	return []string{
-		"localhost",
-		"127.0.0.1",
-		".svc",
-	}
+	return cluster.NoProxyDefaults()
 }
 
 func noProxyList(cluster *v1alpha1.Cluster) []string {
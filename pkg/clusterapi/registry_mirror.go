@@ -3,6 +3,7 @@ package clusterapi
 import (
 	_ "embed"
 	"fmt"
+	"path/filepath"
 
 	etcdbootstrapv1 "github.com/aws/etcdadm-bootstrap-provider/api/v1beta1"
 	etcdv1 "github.com/aws/etcdadm-controller/api/v1beta1"
@@ -67,6 +68,8 @@ func setRegistryMirrorInEtcdCluster(etcd *etcdv1.EtcdadmCluster, mirrorConfig *v
 	}
 }
 
+// registryMirror builds the Bottlerocket registry mirror settings, which take a single endpoint. Failover endpoints
+// and upstreams are rejected for Bottlerocket by the cluster preflight validations.
 func registryMirror(mirrorConfig *v1alpha1.RegistryMirrorConfiguration) bootstrapv1.RegistryMirrorConfiguration {
 	return bootstrapv1.RegistryMirrorConfiguration{
 		Endpoint: containerd.ToAPIEndpoint(registrymirror.FromClusterRegistryMirrorConfiguration(mirrorConfig).CoreEKSAMirror()),
@@ -76,12 +79,16 @@ func registryMirror(mirrorConfig *v1alpha1.RegistryMirrorConfiguration) bootstra
 
 type values map[string]interface{}
 
+// registryMirrorConfigContent builds the containerd config for the registry mirror. With failover registry
+// mirrors, it points containerd to the hosts.toml files in its certs directory instead of configuring the mirrors inline.
 func registryMirrorConfigContent(registryMirror *registrymirror.RegistryMirror) (string, error) {
 	val := values{
-		"registryMirrorMap": containerd.ToAPIEndpoints(registryMirror.NamespacedRegistryMap),
+		"registryMirrorMap": containerd.ToAPIEndpointMirrors(registryMirror),
 		"mirrorBase":        registryMirror.BaseRegistry,
 		"registryCACert":    registryMirror.CACertContent,
 		"insecureSkip":      registryMirror.InsecureSkipVerify,
+		"hostsConfig":       len(registryMirror.FailoverRegistries) > 0,
+		"certsDir":          containerd.CertsDir,
 	}
 
 	config, err := templater.Execute(containerdConfig, val)
@@ -113,6 +120,20 @@ func registryMirrorConfig(registryMirrorConfig *v1alpha1.RegistryMirrorConfigura
 		})
 	}
 
+	if len(registryMirror.FailoverRegistries) > 0 {
+		hostsFiles, err := containerd.HostsFiles(registryMirror)
+		if err != nil {
+			return nil, err
+		}
+		for _, hostsFile := range hostsFiles {
+			files = append(files, bootstrapv1.File{
+				Path:    filepath.Join(containerd.CertsDir, hostsFile.Path),
+				Owner:   "root:root",
+				Content: hostsFile.Content,
+			})
+		}
+	}
+
 	return files, nil
 }
 
@@ -67,6 +67,63 @@ var registryMirrorTests = []struct {
 			CACert:   "xyz",
 		},
 	},
+	{
+		name: "with ca cert and failover endpoints",
+		registryMirrorConfig: &v1alpha1.RegistryMirrorConfiguration{
+			Endpoint:      "1.2.3.4",
+			Port:          "443",
+			CACertContent: "xyz",
+			FailoverEndpoints: []v1alpha1.RegistryMirrorEndpoint{
+				{
+					Endpoint: "1.2.3.5",
+					Port:     "443",
+				},
+			},
+			OCINamespaces: []v1alpha1.OCINamespace{
+				{
+					Registry:  "public.ecr.aws",
+					Namespace: "eks-anywhere",
+				},
+			},
+		},
+		wantFiles: []bootstrapv1.File{
+			{
+				Path:  "/etc/containerd/config_append.toml",
+				Owner: "root:root",
+				Content: `[plugins."io.containerd.grpc.v1.cri".registry]
+  config_path = "/etc/containerd/certs.d"`,
+			},
+			{
+				Path:    "/etc/containerd/certs.d/1.2.3.4:443/ca.crt",
+				Owner:   "root:root",
+				Content: "xyz",
+			},
+			{
+				Path:  "/etc/containerd/certs.d/public.ecr.aws/hosts.toml",
+				Owner: "root:root",
+				Content: `server = "https://public.ecr.aws"
+
+[host."https://1.2.3.4:443/v2/eks-anywhere"]
+  capabilities = ["pull", "resolve"]
+  override_path = true
+  ca = "/etc/containerd/certs.d/1.2.3.4:443/ca.crt"
+
+[host."https://1.2.3.5:443/v2/eks-anywhere"]
+  capabilities = ["pull", "resolve"]
+  override_path = true
+  ca = "/etc/containerd/certs.d/1.2.3.4:443/ca.crt"
+`,
+			},
+		},
+		wantRegistryConfig: bootstrapv1.RegistryMirrorConfiguration{
+			Endpoint: "1.2.3.4:443/v2/eks-anywhere",
+			CACert:   "xyz",
+		},
+		wantRegistryConfigEtcd: &etcdbootstrapv1.RegistryMirrorConfiguration{
+			Endpoint: "1.2.3.4:443/v2/eks-anywhere",
+			CACert:   "xyz",
+		},
+	},
 	{
 		name: "with insecure skip",
 		registryMirrorConfig: &v1alpha1.RegistryMirrorConfiguration{
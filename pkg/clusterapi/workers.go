@@ -125,6 +125,7 @@ func KubeadmConfigTemplateEqual(new, old *kubeadmv1.KubeadmConfigTemplate) bool
 	// DeepDerivative treats empty map (length == 0) as unset field. We need to manually compare certain fields
 	// such as taints, so that setting it to empty will trigger machine recreate
 	return kubeadmConfigTemplateTaintsEqual(new, old) && kubeadmConfigTemplateExtraArgsEqual(new, old) &&
+		kubeadmConfigTemplateProxyEqual(new, old) &&
 		equality.Semantic.DeepDerivative(new.Spec, old.Spec)
 }
 
@@ -145,3 +146,14 @@ func kubeadmConfigTemplateExtraArgsEqual(new, old *kubeadmv1.KubeadmConfigTempla
 			old.Spec.Template.Spec.JoinConfiguration.NodeRegistration.KubeletExtraArgs,
 		)
 }
+
+// kubeadmConfigTemplateProxyEqual compares the bottlerocket proxy settings, so that removing
+// the proxy configuration also triggers machine recreate.
+func kubeadmConfigTemplateProxyEqual(new, old *kubeadmv1.KubeadmConfigTemplate) bool {
+	return new.Spec.Template.Spec.JoinConfiguration == nil ||
+		old.Spec.Template.Spec.JoinConfiguration == nil ||
+		equality.Semantic.DeepEqual(
+			new.Spec.Template.Spec.JoinConfiguration.Proxy,
+			old.Spec.Template.Spec.JoinConfiguration.Proxy,
+		)
+}
@@ -14,6 +14,8 @@ import (
 	eksdv1alpha1 "github.com/aws/eks-distro-build-tooling/release/api/v1alpha1"
 	etcdv1 "github.com/aws/etcdadm-controller/api/v1beta1"
 	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/utils/integer"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
 	controlplanev1 "sigs.k8s.io/cluster-api/controlplane/kubeadm/api/v1beta1"
@@ -32,6 +34,7 @@ import (
 	"github.com/aws/eks-anywhere/pkg/kubeconfig"
 	"github.com/aws/eks-anywhere/pkg/logger"
 	"github.com/aws/eks-anywhere/pkg/providers"
+	"github.com/aws/eks-anywhere/pkg/registrymirror"
 	"github.com/aws/eks-anywhere/pkg/retrier"
 	"github.com/aws/eks-anywhere/pkg/templater"
 	"github.com/aws/eks-anywhere/pkg/types"
@@ -55,9 +58,9 @@ const (
 	// DefaultEtcdWait is the default time the cluster manager will wait for ectd to be ready.
 	DefaultEtcdWait = 60 * time.Minute
 	// DefaultUnhealthyMachineTimeout is the default timeout for an unhealthy machine health check.
-	DefaultUnhealthyMachineTimeout = 5 * time.Minute
+	DefaultUnhealthyMachineTimeout = clusterapi.DefaultUnhealthyMachineTimeout
 	// DefaultNodeStartupTimeout is the default timeout for a machine without a node to be considered to have failed machine health check.
-	DefaultNodeStartupTimeout = 10 * time.Minute
+	DefaultNodeStartupTimeout = clusterapi.DefaultNodeStartupTimeout
 )
 
 var eksaClusterResourceType = fmt.Sprintf("clusters.%s", v1alpha1.GroupVersion.Group)
@@ -73,6 +76,7 @@ type ClusterManager struct {
 	machineBackoff          time.Duration
 	machinesMinWait         time.Duration
 	awsIamAuth              AwsIamAuth
+	serviceLoadBalancer     ServiceLoadBalancer
 	controlPlaneWaitTimeout time.Duration
 	externalEtcdWaitTimeout time.Duration
 	unhealthyMachineTimeout time.Duration
@@ -123,6 +127,7 @@ type ClusterClient interface {
 	GetMachineDeploymentsForCluster(ctx context.Context, clusterName string, opts ...executables.KubectlOpt) ([]clusterv1.MachineDeployment, error)
 	GetMachineDeployment(ctx context.Context, workerNodeGroupName string, opts ...executables.KubectlOpt) (*clusterv1.MachineDeployment, error)
 	GetEksdRelease(ctx context.Context, name, namespace, kubeconfigFile string) (*eksdv1alpha1.Release, error)
+	GetSecretFromNamespace(ctx context.Context, kubeconfigFile, name, namespace string) (*corev1.Secret, error)
 	ListObjects(ctx context.Context, resourceType, namespace, kubeconfig string, list kubernetes.ObjectList) error
 }
 
@@ -138,6 +143,12 @@ type AwsIamAuth interface {
 	UpgradeAWSIAMAuth(ctx context.Context, cluster *types.Cluster, spec *cluster.Spec) error
 }
 
+// ServiceLoadBalancer allows to manage the Service load balancer installation in a cluster.
+type ServiceLoadBalancer interface {
+	Install(ctx context.Context, cluster *types.Cluster, spec *cluster.Spec) error
+	Upgrade(ctx context.Context, cluster *types.Cluster, currentSpec, newSpec *cluster.Spec) error
+}
+
 // EKSAComponents allows to manage the eks-a components installation in a cluster.
 type EKSAComponents interface {
 	Install(ctx context.Context, log logr.Logger, cluster *types.Cluster, spec *cluster.Spec) error
@@ -221,6 +232,13 @@ func WithNodeStartupTimeout(timeout time.Duration) ClusterManagerOpt {
 	}
 }
 
+// WithServiceLoadBalancer sets the installer for the Service load balancer.
+func WithServiceLoadBalancer(serviceLoadBalancer ServiceLoadBalancer) ClusterManagerOpt {
+	return func(c *ClusterManager) {
+		c.serviceLoadBalancer = serviceLoadBalancer
+	}
+}
+
 func WithRetrier(retrier *retrier.Retrier) ClusterManagerOpt {
 	return func(c *ClusterManager) {
 		c.clusterClient.Retrier = retrier
@@ -374,6 +392,10 @@ func (c *ClusterManager) applyProviderManifests(
 	management *types.Cluster,
 	provider providers.Provider,
 ) error {
+	if err := c.loadRegistryCredentials(ctx, management, spec); err != nil {
+		return err
+	}
+
 	cpContent, mdContent, err := provider.GenerateCAPISpecForCreate(ctx, management, spec)
 	if err != nil {
 		return fmt.Errorf("generating capi spec: %v", err)
@@ -393,6 +415,26 @@ func (c *ClusterManager) applyProviderManifests(
 	return nil
 }
 
+// loadRegistryCredentials sets the registry mirror credentials Secret in the cluster spec, so the CAPI specs use the
+// credentials stored in the management cluster instead of the ones in the environment, which might be stale after a rotation.
+// The Secret is built from the environment when the management cluster doesn't have it yet.
+func (c *ClusterManager) loadRegistryCredentials(ctx context.Context, managementCluster *types.Cluster, clusterSpec *cluster.Spec) error {
+	if !clusterSpec.Cluster.RegistryAuth() || clusterSpec.RegistryCredentialsSecret != nil {
+		return nil
+	}
+
+	secret, err := c.clusterClient.GetSecretFromNamespace(ctx, managementCluster.KubeconfigFile, registrymirror.CredentialsSecretName, constants.EksaSystemNamespace)
+	if apierrors.IsNotFound(err) {
+		secret, err = registrymirror.CredentialsSecretFromEnv()
+	}
+	if err != nil {
+		return fmt.Errorf("reading registry mirror credentials: %v", err)
+	}
+
+	clusterSpec.RegistryCredentialsSecret = secret
+	return nil
+}
+
 func (c *ClusterManager) getWorkloadClusterKubeconfig(ctx context.Context, clusterName string, managementCluster *types.Cluster, w io.Writer) error {
 	kubeconfig, err := c.clusterClient.GetWorkloadKubeconfig(ctx, clusterName, managementCluster)
 	if err != nil {
@@ -481,6 +523,10 @@ func (c *ClusterManager) UpgradeCluster(ctx context.Context, managementCluster,
 		return fmt.Errorf("getting current cluster spec: %v", err)
 	}
 
+	if err = c.loadRegistryCredentials(ctx, eksaMgmtCluster, newClusterSpec); err != nil {
+		return err
+	}
+
 	cpContent, mdContent, err := provider.GenerateCAPISpecForUpgrade(ctx, managementCluster, eksaMgmtCluster, currentSpec, newClusterSpec)
 	if err != nil {
 		return fmt.Errorf("generating capi spec: %v", err)
@@ -596,6 +642,19 @@ func (c *ClusterManager) UpgradeCluster(ctx context.Context, managementCluster,
 		}
 	}
 
+	if machineHealthChecksChanged(currentSpec, newClusterSpec) {
+		logger.V(3).Info("Updating machine health checks")
+		if err = c.InstallMachineHealthChecks(ctx, newClusterSpec, managementCluster); err != nil {
+			return fmt.Errorf("updating machine health checks: %v", err)
+		}
+	}
+
+	if c.serviceLoadBalancer != nil {
+		if err = c.serviceLoadBalancer.Upgrade(ctx, workloadCluster, currentSpec, newClusterSpec); err != nil {
+			return fmt.Errorf("upgrading service load balancer: %v", err)
+		}
+	}
+
 	if err = c.InstallStorageClass(ctx, workloadCluster, provider); err != nil {
 		return fmt.Errorf("installing storage class during upgrade: %v", err)
 	}
@@ -723,12 +782,42 @@ func (c *ClusterManager) InstallMachineHealthChecks(ctx context.Context, cluster
 	return nil
 }
 
+// machineHealthChecksChanged returns true when the health check settings changed for any node group
+// or when worker node groups were added, since new groups need their own MachineHealthCheck.
+func machineHealthChecksChanged(currentSpec, newSpec *cluster.Spec) bool {
+	if !currentSpec.Cluster.Spec.ControlPlaneConfiguration.MachineHealthCheck.Equal(newSpec.Cluster.Spec.ControlPlaneConfiguration.MachineHealthCheck) {
+		return true
+	}
+
+	current := make(map[string]*v1alpha1.MachineHealthCheck, len(currentSpec.Cluster.Spec.WorkerNodeGroupConfigurations))
+	for _, w := range currentSpec.Cluster.Spec.WorkerNodeGroupConfigurations {
+		current[w.Name] = w.MachineHealthCheck
+	}
+
+	for _, w := range newSpec.Cluster.Spec.WorkerNodeGroupConfigurations {
+		mhc, ok := current[w.Name]
+		if !ok || !mhc.Equal(w.MachineHealthCheck) {
+			return true
+		}
+	}
+
+	return false
+}
+
 // InstallAwsIamAuth applies the aws-iam-authenticator manifest based on cluster spec inputs.
 // Generates a kubeconfig for interacting with the cluster with aws-iam-authenticator client.
 func (c *ClusterManager) InstallAwsIamAuth(ctx context.Context, management, workload *types.Cluster, spec *cluster.Spec) error {
 	return c.awsIamAuth.InstallAWSIAMAuth(ctx, management, workload, spec)
 }
 
+// InstallServiceLoadBalancer installs the Service load balancer in the workload cluster and configures its address pools.
+func (c *ClusterManager) InstallServiceLoadBalancer(ctx context.Context, workload *types.Cluster, spec *cluster.Spec) error {
+	if c.serviceLoadBalancer == nil {
+		return nil
+	}
+	return c.serviceLoadBalancer.Install(ctx, workload, spec)
+}
+
 func (c *ClusterManager) CreateAwsIamAuthCaSecret(ctx context.Context, managementCluster *types.Cluster, workloadClusterName string) error {
 	return c.awsIamAuth.CreateAndInstallAWSIAMAuthCASecret(ctx, managementCluster, workloadClusterName)
 }
@@ -1041,9 +1130,32 @@ func (c *ClusterManager) CreateEKSAResources(ctx context.Context, cluster *types
 	if err = c.applyResource(ctx, cluster, resourcesSpec); err != nil {
 		return err
 	}
+	if err = c.applyRegistryCredentials(ctx, cluster, clusterSpec); err != nil {
+		return err
+	}
 	return c.ApplyBundles(ctx, clusterSpec, cluster)
 }
 
+// applyRegistryCredentials stores the registry mirror credentials of the cluster spec in the cluster, so the EKS Anywhere
+// controller and later CLI operations read them from there.
+func (c *ClusterManager) applyRegistryCredentials(ctx context.Context, cluster *types.Cluster, clusterSpec *cluster.Spec) error {
+	if clusterSpec.RegistryCredentialsSecret == nil {
+		return nil
+	}
+
+	username, password, err := registrymirror.CredentialsFromSecret(clusterSpec.RegistryCredentialsSecret)
+	if err != nil {
+		return err
+	}
+
+	logger.V(4).Info("Applying registry mirror credentials secret to cluster")
+	if err = c.clusterClient.Apply(ctx, cluster.KubeconfigFile, registrymirror.CredentialsSecret(username, password)); err != nil {
+		return fmt.Errorf("applying registry mirror credentials secret: %v", err)
+	}
+
+	return nil
+}
+
 func (c *ClusterManager) ApplyBundles(ctx context.Context, clusterSpec *cluster.Spec, cluster *types.Cluster) error {
 	bundleObj, err := yaml.Marshal(clusterSpec.Bundles)
 	if err != nil {
@@ -61,7 +61,8 @@ func (i *EKSAInstaller) Install(ctx context.Context, log logr.Logger, cluster *t
 }
 
 // Upgrade re-installs the eksa components in a cluster if the VersionBundle defined in the
-// new spec has a different eks-a components version. Workload clusters are ignored.
+// new spec has a different eks-a components version, or if the proxy configuration of the
+// cluster changed, since the controller gets it from its env vars. Workload clusters are ignored.
 func (i *EKSAInstaller) Upgrade(ctx context.Context, log logr.Logger, cluster *types.Cluster, currentSpec, newSpec *cluster.Spec) (*types.ChangeDiff, error) {
 	log.V(1).Info("Checking for EKS-A components upgrade")
 	if !newSpec.Cluster.IsSelfManaged() {
@@ -69,7 +70,8 @@ func (i *EKSAInstaller) Upgrade(ctx context.Context, log logr.Logger, cluster *t
 		return nil, nil
 	}
 	changeDiff := EksaChangeDiff(currentSpec, newSpec)
-	if changeDiff == nil {
+	proxyChanged := !currentSpec.Cluster.Spec.ProxyConfiguration.Equal(newSpec.Cluster.Spec.ProxyConfiguration)
+	if changeDiff == nil && !proxyChanged {
 		log.V(1).Info("Nothing to upgrade for controller and CRDs")
 		return nil, nil
 	}
@@ -1,5 +1,5 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/aws/eks-anywhere/pkg/clustermanager (interfaces: ClusterClient,Networking,AwsIamAuth,EKSAComponents,KubernetesClient)
+// Source: github.com/aws/eks-anywhere/pkg/clustermanager (interfaces: ClusterClient,Networking,AwsIamAuth,EKSAComponents,KubernetesClient,ServiceLoadBalancer)
 
 // Package mocks is a generated GoMock package.
 package mocks
@@ -19,6 +19,7 @@ import (
 	v1alpha11 "github.com/aws/eks-distro-build-tooling/release/api/v1alpha1"
 	logr "github.com/go-logr/logr"
 	gomock "github.com/golang/mock/gomock"
+	v1 "k8s.io/api/core/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 	v1beta1 "sigs.k8s.io/cluster-api/api/v1beta1"
 	v1beta10 "sigs.k8s.io/cluster-api/controlplane/kubeadm/api/v1beta1"
@@ -501,6 +502,21 @@ func (mr *MockClusterClientMockRecorder) GetMachines(arg0, arg1, arg2 interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMachines", reflect.TypeOf((*MockClusterClient)(nil).GetMachines), arg0, arg1, arg2)
 }
 
+// GetSecretFromNamespace mocks base method.
+func (m *MockClusterClient) GetSecretFromNamespace(arg0 context.Context, arg1, arg2, arg3 string) (*v1.Secret, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSecretFromNamespace", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*v1.Secret)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSecretFromNamespace indicates an expected call of GetSecretFromNamespace.
+func (mr *MockClusterClientMockRecorder) GetSecretFromNamespace(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecretFromNamespace", reflect.TypeOf((*MockClusterClient)(nil).GetSecretFromNamespace), arg0, arg1, arg2, arg3)
+}
+
 // GetWorkloadKubeconfig mocks base method.
 func (m *MockClusterClient) GetWorkloadKubeconfig(arg0 context.Context, arg1 string, arg2 *types.Cluster) ([]byte, error) {
 	m.ctrl.T.Helper()
@@ -1072,3 +1088,54 @@ func (mr *MockKubernetesClientMockRecorder) WaitForDeployment(arg0, arg1, arg2,
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitForDeployment", reflect.TypeOf((*MockKubernetesClient)(nil).WaitForDeployment), arg0, arg1, arg2, arg3, arg4, arg5)
 }
+
+// MockServiceLoadBalancer is a mock of ServiceLoadBalancer interface.
+type MockServiceLoadBalancer struct {
+	ctrl     *gomock.Controller
+	recorder *MockServiceLoadBalancerMockRecorder
+}
+
+// MockServiceLoadBalancerMockRecorder is the mock recorder for MockServiceLoadBalancer.
+type MockServiceLoadBalancerMockRecorder struct {
+	mock *MockServiceLoadBalancer
+}
+
+// NewMockServiceLoadBalancer creates a new mock instance.
+func NewMockServiceLoadBalancer(ctrl *gomock.Controller) *MockServiceLoadBalancer {
+	mock := &MockServiceLoadBalancer{ctrl: ctrl}
+	mock.recorder = &MockServiceLoadBalancerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockServiceLoadBalancer) EXPECT() *MockServiceLoadBalancerMockRecorder {
+	return m.recorder
+}
+
+// Install mocks base method.
+func (m *MockServiceLoadBalancer) Install(arg0 context.Context, arg1 *types.Cluster, arg2 *cluster.Spec) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Install", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Install indicates an expected call of Install.
+func (mr *MockServiceLoadBalancerMockRecorder) Install(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Install", reflect.TypeOf((*MockServiceLoadBalancer)(nil).Install), arg0, arg1, arg2)
+}
+
+// Upgrade mocks base method.
+func (m *MockServiceLoadBalancer) Upgrade(arg0 context.Context, arg1 *types.Cluster, arg2, arg3 *cluster.Spec) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upgrade", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Upgrade indicates an expected call of Upgrade.
+func (mr *MockServiceLoadBalancerMockRecorder) Upgrade(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upgrade", reflect.TypeOf((*MockServiceLoadBalancer)(nil).Upgrade), arg0, arg1, arg2, arg3)
+}
@@ -34,6 +34,10 @@ func MarshalClusterSpec(clusterSpec *cluster.Spec, datacenterConfig providers.Da
 		marshallables = append(marshallables, clusterSpec.FluxConfig.ConvertConfigToConfigGenerateStruct())
 	}
 
+	if clusterSpec.ArgoCDConfig != nil {
+		marshallables = append(marshallables, clusterSpec.ArgoCDConfig.ConvertConfigToConfigGenerateStruct())
+	}
+
 	if clusterSpec.OIDCConfig != nil {
 		marshallables = append(marshallables, clusterSpec.OIDCConfig.ConvertConfigToConfigGenerateStruct())
 	}
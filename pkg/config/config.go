@@ -1,19 +1,37 @@
 package config
 
 const (
-	EksaGitPassphraseTokenEnv = "EKSA_GIT_SSH_KEY_PASSPHRASE"
-	EksaGitPrivateKeyTokenEnv = "EKSA_GIT_PRIVATE_KEY"
-	EksaGitKnownHostsFileEnv  = "EKSA_GIT_KNOWN_HOSTS"
-	SshKnownHostsEnv          = "SSH_KNOWN_HOSTS"
-	EksaAccessKeyIdEnv        = "EKSA_AWS_ACCESS_KEY_ID"
-	EksaSecretAccessKeyEnv    = "EKSA_AWS_SECRET_ACCESS_KEY"
-	AwsAccessKeyIdEnv         = "AWS_ACCESS_KEY_ID"
-	AwsSecretAccessKeyEnv     = "AWS_SECRET_ACCESS_KEY"
-	EksaRegionEnv             = "EKSA_AWS_REGION"
+	EksaGitPassphraseTokenEnv   = "EKSA_GIT_SSH_KEY_PASSPHRASE"
+	EksaGitPrivateKeyTokenEnv   = "EKSA_GIT_PRIVATE_KEY"
+	EksaGitKnownHostsFileEnv    = "EKSA_GIT_KNOWN_HOSTS"
+	EksaGitUsernameEnv          = "EKSA_GIT_USERNAME"
+	EksaGitPasswordEnv          = "EKSA_GIT_PASSWORD"
+	EksaGitGithubAppKeyEnv      = "EKSA_GIT_GITHUB_APP_PRIVATE_KEY"
+	EksaGitAuthorNameEnv        = "EKSA_GIT_AUTHOR_NAME"
+	EksaGitAuthorEmailEnv       = "EKSA_GIT_AUTHOR_EMAIL"
+	EksaGitSigningKeyEnv        = "EKSA_GIT_SIGNING_KEY"
+	EksaGitSigningPassphraseEnv = "EKSA_GIT_SIGNING_KEY_PASSPHRASE"
+	SshKnownHostsEnv            = "SSH_KNOWN_HOSTS"
+	EksaAccessKeyIdEnv          = "EKSA_AWS_ACCESS_KEY_ID"
+	EksaSecretAccessKeyEnv      = "EKSA_AWS_SECRET_ACCESS_KEY"
+	AwsAccessKeyIdEnv           = "AWS_ACCESS_KEY_ID"
+	AwsSecretAccessKeyEnv       = "AWS_SECRET_ACCESS_KEY"
+	EksaRegionEnv               = "EKSA_AWS_REGION"
 )
 
 type CliConfig struct {
 	GitSshKeyPassphrase string
 	GitPrivateKeyFile   string
 	GitKnownHostsFile   string
+	// GitUsername and GitPassword authenticate with HTTPS repositories. The password can be an access token.
+	GitUsername string
+	GitPassword string
+	// GitGithubAppPrivateKeyFile is the private key of the GitHub App used to authenticate with HTTPS repositories.
+	GitGithubAppPrivateKeyFile string
+	// GitAuthorName and GitAuthorEmail identify the author of the commits pushed to the repository.
+	GitAuthorName  string
+	GitAuthorEmail string
+	// GitGPGKeyRingFile and GitGPGPassphrase sign the commits made by flux bootstrap. Flux only supports GPG signing.
+	GitGPGKeyRingFile string
+	GitGPGPassphrase  string
 }
@@ -2,7 +2,10 @@ package config
 
 import (
 	"errors"
+	"fmt"
 	"os"
+	"strings"
+	"unicode"
 )
 
 func ReadCredentials() (username, password string, err error) {
@@ -18,3 +21,31 @@ func ReadCredentials() (username, password string, err error) {
 
 	return username, password, nil
 }
+
+// ReadUpstreamCredentials reads the credentials for pulling directly from an upstream registry, from the
+// REGISTRY_USERNAME_<REGISTRY> and REGISTRY_PASSWORD_<REGISTRY> env vars. <REGISTRY> is the registry host
+// in upper case, with every character other than letters and digits replaced by an underscore.
+func ReadUpstreamCredentials(registry string) (username, password string, err error) {
+	usernameKey, passwordKey := upstreamCredentialsKeys(registry)
+	username, ok := os.LookupEnv(usernameKey)
+	if !ok {
+		return "", "", fmt.Errorf("please set %s env var", usernameKey)
+	}
+
+	password, ok = os.LookupEnv(passwordKey)
+	if !ok {
+		return "", "", fmt.Errorf("please set %s env var", passwordKey)
+	}
+
+	return username, password, nil
+}
+
+func upstreamCredentialsKeys(registry string) (usernameKey, passwordKey string) {
+	suffix := strings.Map(func(r rune) rune {
+		if r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)) {
+			return unicode.ToUpper(r)
+		}
+		return '_'
+	}, registry)
+	return "REGISTRY_USERNAME_" + suffix, "REGISTRY_PASSWORD_" + suffix
+}
@@ -0,0 +1,28 @@
+package config_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/aws/eks-anywhere/pkg/config"
+)
+
+func TestReadUpstreamCredentials(t *testing.T) {
+	g := NewWithT(t)
+	t.Setenv("REGISTRY_USERNAME_REGISTRY_EXAMPLE_COM_5000", "user")
+	t.Setenv("REGISTRY_PASSWORD_REGISTRY_EXAMPLE_COM_5000", "pass")
+
+	username, password, err := config.ReadUpstreamCredentials("registry.example.com:5000")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(username).To(Equal("user"))
+	g.Expect(password).To(Equal("pass"))
+}
+
+func TestReadUpstreamCredentialsMissingPassword(t *testing.T) {
+	g := NewWithT(t)
+	t.Setenv("REGISTRY_USERNAME_DOCKER_IO", "user")
+
+	_, _, err := config.ReadUpstreamCredentials("docker.io")
+	g.Expect(err).To(MatchError("please set REGISTRY_PASSWORD_DOCKER_IO env var"))
+}
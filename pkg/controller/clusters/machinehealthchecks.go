@@ -0,0 +1,96 @@
+package clusters
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/cluster"
+	"github.com/aws/eks-anywhere/pkg/clusterapi"
+	"github.com/aws/eks-anywhere/pkg/controller/serverside"
+)
+
+// ReconcileMachineHealthChecks applies the MachineHealthChecks for the control plane and all the
+// worker node groups of an eks-a cluster. Timeouts not set in the cluster spec keep the values of the
+// existing MachineHealthChecks, which the CLI creates with the timeouts from its flags, and fall back
+// to the defaults for new node groups.
+func ReconcileMachineHealthChecks(ctx context.Context, c client.Client, eksaCluster *anywherev1.Cluster) error {
+	clusterSpec := &cluster.Spec{Config: &cluster.Config{Cluster: eksaCluster}}
+	workerMHCs := clusterapi.MachineHealthCheckForWorkers(clusterSpec, clusterapi.DefaultUnhealthyMachineTimeout, clusterapi.DefaultNodeStartupTimeout)
+
+	objs := make([]client.Object, 0, len(workerMHCs)+1)
+	for i, mhc := range workerMHCs {
+		if err := keepExistingTimeouts(ctx, c, mhc, eksaCluster.Spec.WorkerNodeGroupConfigurations[i].MachineHealthCheck); err != nil {
+			return err
+		}
+		objs = append(objs, mhc)
+	}
+
+	cpMHC := clusterapi.MachineHealthCheckForControlPlane(clusterSpec, clusterapi.DefaultUnhealthyMachineTimeout, clusterapi.DefaultNodeStartupTimeout)
+	if err := keepExistingTimeouts(ctx, c, cpMHC, eksaCluster.Spec.ControlPlaneConfiguration.MachineHealthCheck); err != nil {
+		return err
+	}
+	objs = append(objs, cpMHC)
+
+	if err := serverside.ReconcileObjects(ctx, c, objs); err != nil {
+		return errors.Wrap(err, "applying machine health checks")
+	}
+
+	return nil
+}
+
+// keepExistingTimeouts copies into mhc the timeouts of the MachineHealthCheck already in the cluster
+// for the settings that config doesn't override.
+func keepExistingTimeouts(ctx context.Context, c client.Client, mhc *clusterv1.MachineHealthCheck, config *anywherev1.MachineHealthCheck) error {
+	existing := &clusterv1.MachineHealthCheck{}
+	err := c.Get(ctx, client.ObjectKeyFromObject(mhc), existing)
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return errors.Wrapf(err, "reading machine health check %s", mhc.Name)
+	}
+
+	if (config == nil || config.NodeStartupTimeout == nil) && existing.Spec.NodeStartupTimeout != nil {
+		mhc.Spec.NodeStartupTimeout = existing.Spec.NodeStartupTimeout.DeepCopy()
+	}
+
+	if config != nil && (config.UnhealthyMachineTimeout != nil || len(config.UnhealthyConditions) > 0) {
+		return nil
+	}
+
+	for _, condition := range existing.Spec.UnhealthyConditions {
+		if condition.Type == corev1.NodeReady {
+			for i := range mhc.Spec.UnhealthyConditions {
+				mhc.Spec.UnhealthyConditions[i].Timeout = condition.Timeout
+			}
+			break
+		}
+	}
+
+	return nil
+}
+
+// MachineHealthCheckReconciler reconciles the MachineHealthChecks of eks-a clusters.
+type MachineHealthCheckReconciler struct {
+	client client.Client
+}
+
+// NewMachineHealthCheckReconciler returns a new MachineHealthCheckReconciler.
+func NewMachineHealthCheckReconciler(client client.Client) *MachineHealthCheckReconciler {
+	return &MachineHealthCheckReconciler{
+		client: client,
+	}
+}
+
+// Reconcile takes the MachineHealthChecks of a cluster to the state defined in its spec.
+func (r *MachineHealthCheckReconciler) Reconcile(ctx context.Context, log logr.Logger, eksaCluster *anywherev1.Cluster) error {
+	log.Info("Reconciling machine health checks")
+	return ReconcileMachineHealthChecks(ctx, r.client, eksaCluster)
+}
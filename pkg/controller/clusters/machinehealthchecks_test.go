@@ -0,0 +1,163 @@
+package clusters_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/aws/eks-anywhere/internal/test/envtest"
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/constants"
+	"github.com/aws/eks-anywhere/pkg/controller/clusters"
+)
+
+func TestReconcileMachineHealthChecksSuccess(t *testing.T) {
+	g := NewWithT(t)
+	c := env.Client()
+	api := envtest.NewAPIExpecter(t, c)
+	ctx := context.Background()
+	createEKSASystemNamespace(ctx, t, c)
+
+	eksaCluster := &anywherev1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "my-cluster-mhc",
+		},
+		Spec: anywherev1.ClusterSpec{
+			ControlPlaneConfiguration: anywherev1.ControlPlaneConfiguration{
+				MachineHealthCheck: &anywherev1.MachineHealthCheck{
+					DisableRemediation: true,
+				},
+			},
+			WorkerNodeGroupConfigurations: []anywherev1.WorkerNodeGroupConfiguration{
+				{
+					Name: "md-0",
+					MachineHealthCheck: &anywherev1.MachineHealthCheck{
+						NodeStartupTimeout: &metav1.Duration{Duration: 30 * time.Minute},
+					},
+				},
+			},
+		},
+	}
+
+	g.Expect(clusters.ReconcileMachineHealthChecks(ctx, c, eksaCluster)).To(Succeed())
+
+	cpMHC := &clusterv1.MachineHealthCheck{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-cluster-mhc-kcp-unhealthy",
+			Namespace: constants.EksaSystemNamespace,
+		},
+	}
+	api.ShouldEventuallyMatch(ctx, cpMHC, func(g Gomega) {
+		g.Expect(cpMHC.Spec.MaxUnhealthy).To(HaveValue(Equal(intstr.FromInt(0))))
+	})
+
+	workerMHC := &clusterv1.MachineHealthCheck{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-cluster-mhc-md-0-worker-unhealthy",
+			Namespace: constants.EksaSystemNamespace,
+		},
+	}
+	api.ShouldEventuallyMatch(ctx, workerMHC, func(g Gomega) {
+		g.Expect(workerMHC.Spec.NodeStartupTimeout).To(HaveValue(Equal(metav1.Duration{Duration: 30 * time.Minute})))
+	})
+}
+
+func TestReconcileMachineHealthChecksKeepsExistingTimeouts(t *testing.T) {
+	g := NewWithT(t)
+	c := env.Client()
+	api := envtest.NewAPIExpecter(t, c)
+	ctx := context.Background()
+	createEKSASystemNamespace(ctx, t, c)
+
+	eksaCluster := &anywherev1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "my-cluster-mhc-timeouts",
+		},
+		Spec: anywherev1.ClusterSpec{
+			WorkerNodeGroupConfigurations: []anywherev1.WorkerNodeGroupConfiguration{
+				{
+					Name: "md-0",
+				},
+				{
+					Name: "md-1",
+					MachineHealthCheck: &anywherev1.MachineHealthCheck{
+						UnhealthyMachineTimeout: &metav1.Duration{Duration: 3 * time.Minute},
+					},
+				},
+			},
+		},
+	}
+
+	// MachineHealthChecks created by the CLI with the timeouts from its flags
+	for _, name := range []string{"my-cluster-mhc-timeouts-md-0-worker-unhealthy", "my-cluster-mhc-timeouts-md-1-worker-unhealthy"} {
+		mhc := &clusterv1.MachineHealthCheck{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: constants.EksaSystemNamespace,
+			},
+			Spec: clusterv1.MachineHealthCheckSpec{
+				ClusterName: eksaCluster.Name,
+				Selector: metav1.LabelSelector{
+					MatchLabels: map[string]string{clusterv1.MachineDeploymentLabelName: name},
+				},
+				NodeStartupTimeout: &metav1.Duration{Duration: 20 * time.Minute},
+				UnhealthyConditions: []clusterv1.UnhealthyCondition{
+					{
+						Type:    corev1.NodeReady,
+						Status:  corev1.ConditionUnknown,
+						Timeout: metav1.Duration{Duration: 8 * time.Minute},
+					},
+				},
+			},
+		}
+		envtest.CreateObjs(ctx, t, c, mhc)
+	}
+
+	g.Expect(clusters.ReconcileMachineHealthChecks(ctx, c, eksaCluster)).To(Succeed())
+
+	md0MHC := &clusterv1.MachineHealthCheck{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-cluster-mhc-timeouts-md-0-worker-unhealthy",
+			Namespace: constants.EksaSystemNamespace,
+		},
+	}
+	api.ShouldEventuallyMatch(ctx, md0MHC, func(g Gomega) {
+		g.Expect(md0MHC.Spec.NodeStartupTimeout).To(HaveValue(Equal(metav1.Duration{Duration: 20 * time.Minute})))
+		for _, condition := range md0MHC.Spec.UnhealthyConditions {
+			g.Expect(condition.Timeout).To(Equal(metav1.Duration{Duration: 8 * time.Minute}))
+		}
+	})
+
+	md1MHC := &clusterv1.MachineHealthCheck{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-cluster-mhc-timeouts-md-1-worker-unhealthy",
+			Namespace: constants.EksaSystemNamespace,
+		},
+	}
+	api.ShouldEventuallyMatch(ctx, md1MHC, func(g Gomega) {
+		g.Expect(md1MHC.Spec.NodeStartupTimeout).To(HaveValue(Equal(metav1.Duration{Duration: 20 * time.Minute})))
+		for _, condition := range md1MHC.Spec.UnhealthyConditions {
+			g.Expect(condition.Timeout).To(Equal(metav1.Duration{Duration: 3 * time.Minute}))
+		}
+	})
+}
+
+func createEKSASystemNamespace(ctx context.Context, t *testing.T, c client.Client) {
+	t.Helper()
+	ns := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: constants.EksaSystemNamespace,
+		},
+	}
+	if err := c.Create(ctx, ns); err != nil && !apierrors.IsAlreadyExists(err) {
+		t.Fatal(err)
+	}
+}
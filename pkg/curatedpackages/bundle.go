@@ -73,21 +73,61 @@ func (b *BundleReader) getActiveBundleFromCluster(ctx context.Context) (*package
 }
 
 func (b *BundleReader) getPackageBundle(ctx context.Context, bundleName string) (*packagesv1.PackageBundle, error) {
-	params := []string{"get", "packageBundle", "-o", "json", "--kubeconfig", b.kubeConfig, "--namespace", constants.EksaPackagesName, bundleName}
+	data, err := b.getPackageBundleJson(ctx, bundleName)
+	if err != nil {
+		return nil, err
+	}
+	return parsePackageBundle(data)
+}
+
+func (b *BundleReader) getPackageBundleJson(ctx context.Context, bundleName string) ([]byte, error) {
 	if bundleName == "" {
 		return nil, fmt.Errorf("no bundle name specified")
 	}
+	params := []string{"get", "packageBundle", "-o", "json", "--kubeconfig", b.kubeConfig, "--namespace", constants.EksaPackagesName, bundleName}
 	stdOut, err := b.kubectl.ExecuteCommand(ctx, params...)
 	if err != nil {
 		return nil, err
 	}
+	return stdOut.Bytes(), nil
+}
+
+func parsePackageBundle(data []byte) (*packagesv1.PackageBundle, error) {
 	obj := &packagesv1.PackageBundle{}
-	if err := json.Unmarshal(stdOut.Bytes(), obj); err != nil {
+	if err := json.Unmarshal(data, obj); err != nil {
 		return nil, fmt.Errorf("unmarshaling package bundle: %w", err)
 	}
 	return obj, nil
 }
 
+// GetActiveBundleWithDependencies reads the active bundle in the cluster, which the package controller
+// installs packages from, along with the dependencies between its packages.
+func (b *BundleReader) GetActiveBundleWithDependencies(ctx context.Context) (*packagesv1.PackageBundle, *BundleDependencies, error) {
+	bundleController, err := b.GetActiveController(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	data, err := b.getPackageBundleJson(ctx, bundleController.Spec.ActiveBundle)
+	if err != nil {
+		return nil, nil, err
+	}
+	bundle, err := parsePackageBundle(data)
+	if err != nil {
+		return nil, nil, err
+	}
+	dependencies, err := ParseBundleDependencies(data)
+	if err != nil {
+		return nil, nil, err
+	}
+	return bundle, dependencies, nil
+}
+
+// GetActiveBundleDependencies reads the dependencies between the packages of the active bundle in the cluster.
+func (b *BundleReader) GetActiveBundleDependencies(ctx context.Context) (*BundleDependencies, error) {
+	_, dependencies, err := b.GetActiveBundleWithDependencies(ctx)
+	return dependencies, err
+}
+
 func (b *BundleReader) GetActiveController(ctx context.Context) (*packagesv1.PackageBundleController, error) {
 	params := []string{"get", "packageBundleController", "-o", "json", "--kubeconfig", b.kubeConfig, "--namespace", constants.EksaPackagesName, b.clusterName}
 	stdOut, err := b.kubectl.ExecuteCommand(ctx, params...)
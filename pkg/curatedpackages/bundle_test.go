@@ -97,6 +97,30 @@ func TestGetLatestBundleFromClusterFailsNoBundleName(t *testing.T) {
 	tt.Expect(result).To(BeNil())
 }
 
+func TestGetActiveBundleWithDependenciesSucceeds(t *testing.T) {
+	tt := newBundleTest(t)
+	bundle := `{"spec": {"packages": [{"name": "emissary", "source": {"versions": [{"name": "3.0.0", "digest": "sha256:abc", "dependencies": ["emissary-crds"]}]}}]}}`
+	tt.kubectl.EXPECT().ExecuteCommand(tt.ctx, "get", "packageBundleController", "-o", "json", "--kubeconfig", tt.kubeConfig, "--namespace", "eksa-packages", tt.cluster).Return(convertJsonToBytes(tt.bundleCtrl), nil)
+	tt.kubectl.EXPECT().ExecuteCommand(tt.ctx, "get", "packageBundle", "-o", "json", "--kubeconfig", tt.kubeConfig, "--namespace", "eksa-packages", tt.activeBundle).Return(*bytes.NewBufferString(bundle), nil)
+
+	tt.Command = curatedpackages.NewBundleReader(tt.kubeConfig, tt.cluster, tt.kubectl, tt.bundleManager, tt.registry)
+	result, dependencies, err := tt.Command.GetActiveBundleWithDependencies(tt.ctx)
+	tt.Expect(err).NotTo(HaveOccurred())
+	tt.Expect(result.Spec.Packages[0].Name).To(Equal("emissary"))
+	tt.Expect(dependencies.Of("emissary", "")).To(Equal([]string{"emissary-crds"}))
+}
+
+func TestGetActiveBundleWithDependenciesFailsNoBundleName(t *testing.T) {
+	tt := newBundleTest(t)
+	noActiveBundle := tt.bundleCtrl
+	noActiveBundle.Spec.ActiveBundle = ""
+	tt.kubectl.EXPECT().ExecuteCommand(tt.ctx, gomock.Any()).Return(convertJsonToBytes(noActiveBundle), nil)
+
+	tt.Command = curatedpackages.NewBundleReader(tt.kubeConfig, tt.cluster, tt.kubectl, tt.bundleManager, tt.registry)
+	_, _, err := tt.Command.GetActiveBundleWithDependencies(tt.ctx)
+	tt.Expect(err).To(MatchError(ContainSubstring("no bundle name specified")))
+}
+
 func TestGetLatestBundleFromRegistrySucceeds(t *testing.T) {
 	tt := newBundleTest(t)
 	baseRef := "test_host/test_env/test_controller"
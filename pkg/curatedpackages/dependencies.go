@@ -0,0 +1,351 @@
+package curatedpackages
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	yamlutil "k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/yaml"
+
+	packagesv1 "github.com/aws/eks-anywhere-packages/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/constants"
+	"github.com/aws/eks-anywhere/pkg/templater"
+)
+
+const dependencyInstallTimeout = 10 * time.Minute
+
+// publishedBundle holds the dependencies published for each package version of a PackageBundle. The
+// PackageBundle API version EKS-A builds with doesn't have the dependencies field, so they are dropped when
+// decoding a PackageBundle and need to be decoded separately.
+type publishedBundle struct {
+	Spec struct {
+		Packages []struct {
+			Name   string `json:"name"`
+			Source struct {
+				Versions []struct {
+					Name         string   `json:"name"`
+					Digest       string   `json:"digest"`
+					Dependencies []string `json:"dependencies,omitempty"`
+				} `json:"versions"`
+			} `json:"source"`
+		} `json:"packages"`
+	} `json:"spec"`
+}
+
+// BundleDependencies holds the dependencies between the packages of a bundle for each package version.
+// Package names are lower cased.
+type BundleDependencies struct {
+	// versions maps a package to the dependencies of each of its versions, by version name and digest.
+	versions map[string]map[string][]string
+	// defaults maps a package to the dependencies of its first version, which is installed by default.
+	defaults map[string][]string
+}
+
+// ParseBundleDependencies reads the dependencies published in a json or yaml PackageBundle.
+func ParseBundleDependencies(data []byte) (*BundleDependencies, error) {
+	bundle := &publishedBundle{}
+	if err := yaml.Unmarshal(data, bundle); err != nil {
+		return nil, fmt.Errorf("parsing package bundle dependencies: %v", err)
+	}
+
+	deps := &BundleDependencies{
+		versions: map[string]map[string][]string{},
+		defaults: map[string][]string{},
+	}
+	for _, p := range bundle.Spec.Packages {
+		name := strings.ToLower(p.Name)
+		deps.versions[name] = map[string][]string{}
+		for i, v := range p.Source.Versions {
+			var dependencies []string
+			for _, d := range v.Dependencies {
+				dependencies = append(dependencies, strings.ToLower(d))
+			}
+			deps.versions[name][v.Name] = dependencies
+			deps.versions[name][v.Digest] = dependencies
+			if i == 0 {
+				deps.defaults[name] = dependencies
+			}
+		}
+	}
+	return deps, nil
+}
+
+// Of returns the packages a version of a package depends on. An empty version, or one not in the bundle,
+// returns the dependencies of the default version.
+func (d *BundleDependencies) Of(packageName, version string) []string {
+	if d == nil {
+		return nil
+	}
+	name := strings.ToLower(packageName)
+	if dependencies, ok := d.versions[name][version]; ok && version != "" {
+		return dependencies
+	}
+	return d.defaults[name]
+}
+
+// sortByDependencies orders names so every package comes after the packages it depends on, including
+// dependencies that are not in names. The relative order of names is kept when possible.
+func sortByDependencies(names []string, deps func(name string) []string) ([]string, error) {
+	const (
+		visiting = iota + 1
+		visited
+	)
+	state := map[string]int{}
+	sorted := make([]string, 0, len(names))
+
+	var visit func(name string, path []string) error
+	visit = func(name string, path []string) error {
+		switch state[name] {
+		case visited:
+			return nil
+		case visiting:
+			return fmt.Errorf("circular package dependency: %s", strings.Join(append(path, name), " -> "))
+		}
+		state[name] = visiting
+		for _, d := range deps(name) {
+			if err := visit(d, append(path, name)); err != nil {
+				return err
+			}
+		}
+		state[name] = visited
+		sorted = append(sorted, name)
+		return nil
+	}
+
+	for _, name := range names {
+		if err := visit(strings.ToLower(name), nil); err != nil {
+			return nil, err
+		}
+	}
+	return sorted, nil
+}
+
+// ResolveDependencies returns the packages in the bundle that version of packageName depends on, directly or
+// transitively, in the order they need to be installed. packageName itself is not included. An empty version
+// resolves the dependencies of the default version. Dependencies are resolved for their default version.
+func (pc *PackageClient) ResolveDependencies(packageName, version string) ([]packagesv1.BundlePackage, error) {
+	name := strings.ToLower(packageName)
+	sorted, err := sortByDependencies([]string{name}, func(n string) []string {
+		if n == name {
+			return pc.dependencies.Of(n, version)
+		}
+		return pc.dependencies.Of(n, "")
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	packageMap := pc.packageMap()
+	dependencies := make([]packagesv1.BundlePackage, 0, len(sorted)-1)
+	for _, d := range sorted {
+		if d == name {
+			continue
+		}
+		p, ok := packageMap[d]
+		if !ok {
+			return nil, fmt.Errorf("package %s depends on %s, which is not in the package bundle", packageName, d)
+		}
+		dependencies = append(dependencies, p)
+	}
+	return dependencies, nil
+}
+
+// MissingDependencies returns the dependencies that are not installed in the cluster.
+func MissingDependencies(dependencies []packagesv1.BundlePackage, installed []packagesv1.Package) []packagesv1.BundlePackage {
+	installedNames := map[string]bool{}
+	for _, p := range installed {
+		installedNames[strings.ToLower(p.Spec.PackageName)] = true
+	}
+	var missing []packagesv1.BundlePackage
+	for _, d := range dependencies {
+		if !installedNames[strings.ToLower(d.Name)] {
+			missing = append(missing, d)
+		}
+	}
+	return missing
+}
+
+// InstallDependencies installs the dependencies in order with their default configuration, waiting for each
+// of them to be installed before installing the next one. Each package is named after the bundle package.
+func (pc *PackageClient) InstallDependencies(ctx context.Context, dependencies []packagesv1.BundlePackage, clusterName string, kubeConfig string) error {
+	for _, d := range dependencies {
+		name := strings.ToLower(d.Name)
+		p := convertBundlePackageToPackage(d, name, clusterName, pc.bundle.APIVersion, "")
+		packageYaml, err := yaml.Marshal(NewDisplayablePackage(&p))
+		if err != nil {
+			return err
+		}
+		params := []string{"create", "-f", "-", "--kubeconfig", kubeConfig}
+		stdOut, err := pc.kubectl.ExecuteFromYaml(ctx, packageYaml, params...)
+		if err != nil {
+			return fmt.Errorf("installing dependency %s: %v", d.Name, err)
+		}
+		fmt.Print(&stdOut)
+
+		if err = pc.waitForPackage(ctx, name, p.Namespace, "", kubeConfig, dependencyInstallTimeout); err != nil {
+			return fmt.Errorf("installing dependency %s: %v", d.Name, err)
+		}
+	}
+	return nil
+}
+
+// GetInstalledPackages returns the packages installed in the cluster.
+func (pc *PackageClient) GetInstalledPackages(ctx context.Context, kubeConfig string, clusterName string) ([]packagesv1.Package, error) {
+	params := []string{"get", "packages", "-o", "json", "--kubeconfig", kubeConfig, "--namespace", constants.EksaPackagesName + "-" + clusterName}
+	stdOut, err := pc.kubectl.ExecuteCommand(ctx, params...)
+	if err != nil {
+		return nil, fmt.Errorf("getting installed packages: %v", err)
+	}
+	list := &packagesv1.PackageList{}
+	if err := json.Unmarshal(stdOut.Bytes(), list); err != nil {
+		return nil, fmt.Errorf("unmarshaling installed packages: %v", err)
+	}
+	return list.Items, nil
+}
+
+// ValidateDeletion refuses to delete packages that other installed packages depend on. packages are the
+// names of the Package resources to delete.
+func (pc *PackageClient) ValidateDeletion(packages []string, installed []packagesv1.Package) error {
+	deleted := map[string]bool{}
+	for _, name := range packages {
+		deleted[name] = true
+	}
+	deletedPackageNames := map[string]string{}
+	for _, p := range installed {
+		if deleted[p.Name] {
+			deletedPackageNames[strings.ToLower(p.Spec.PackageName)] = p.Name
+		}
+	}
+
+	var errs []string
+	for _, p := range installed {
+		if deleted[p.Name] {
+			continue
+		}
+		for _, d := range pc.dependencies.Of(p.Spec.PackageName, installedVersion(&p)) {
+			if name, ok := deletedPackageNames[d]; ok {
+				errs = append(errs, fmt.Sprintf("package %s depends on %s", p.Name, name))
+			}
+		}
+	}
+	if len(errs) > 0 {
+		sort.Strings(errs)
+		return fmt.Errorf("refusing to delete packages other packages depend on: %s", strings.Join(errs, ", "))
+	}
+	return nil
+}
+
+// CreatePackagesInOrder creates the packages in fileName so every package is created after the packages
+// it depends on. Packages other packages in the file depend on are waited for until they are installed.
+func (pc *PackageClient) CreatePackagesInOrder(ctx context.Context, fileName string, kubeConfig string, dependencies *BundleDependencies) error {
+	content, err := os.ReadFile(fileName)
+	if err != nil {
+		return fmt.Errorf("reading packages file: %v", err)
+	}
+	groups, err := orderPackageDocuments(content, dependencies)
+	if err != nil {
+		return err
+	}
+
+	params := []string{"create", "-f", "-", "--kubeconfig", kubeConfig}
+	for _, g := range groups {
+		stdOut, err := pc.kubectl.ExecuteFromYaml(ctx, templater.AppendYamlResources(g.docs...), params...)
+		fmt.Print(&stdOut)
+		if err != nil {
+			return err
+		}
+
+		if !g.required {
+			continue
+		}
+		for _, p := range g.packages {
+			if err = pc.waitForPackage(ctx, p.Name, p.Namespace, p.Spec.PackageVersion, kubeConfig, dependencyInstallTimeout); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// packageDocuments are the documents of a packages file created together.
+type packageDocuments struct {
+	docs     [][]byte
+	packages []packagesv1.Package
+	// required is true when other packages in the file depend on these packages.
+	required bool
+}
+
+// orderPackageDocuments groups the Package documents of a yaml stream by package and sorts the groups by
+// their dependencies. Any other documents are kept first, in their original order.
+func orderPackageDocuments(content []byte, dependencies *BundleDependencies) ([]packageDocuments, error) {
+	others := packageDocuments{}
+	var packageNames []string
+	groups := map[string]*packageDocuments{}
+	versions := map[string]string{}
+	reader := yamlutil.NewYAMLReader(bufio.NewReader(bytes.NewReader(content)))
+	for {
+		doc, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("reading packages file: %v", err)
+		}
+		if len(bytes.TrimSpace(doc)) == 0 {
+			continue
+		}
+
+		p := packagesv1.Package{}
+		if err := yaml.Unmarshal(doc, &p); err != nil {
+			return nil, fmt.Errorf("parsing packages file: %v", err)
+		}
+		if p.Kind != kind {
+			others.docs = append(others.docs, doc)
+			continue
+		}
+		name := strings.ToLower(p.Spec.PackageName)
+		if _, ok := groups[name]; !ok {
+			packageNames = append(packageNames, name)
+			groups[name] = &packageDocuments{}
+			versions[name] = p.Spec.PackageVersion
+		}
+		groups[name].docs = append(groups[name].docs, doc)
+		groups[name].packages = append(groups[name].packages, p)
+	}
+
+	deps := func(name string) []string {
+		return dependencies.Of(name, versions[name])
+	}
+	sorted, err := sortByDependencies(packageNames, deps)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, name := range packageNames {
+		for _, d := range deps(name) {
+			if g, ok := groups[d]; ok {
+				g.required = true
+			}
+		}
+	}
+
+	var ordered []packageDocuments
+	if len(others.docs) > 0 {
+		ordered = append(ordered, others)
+	}
+	for _, name := range sorted {
+		if g, ok := groups[name]; ok {
+			ordered = append(ordered, *g)
+		}
+	}
+	return ordered, nil
+}
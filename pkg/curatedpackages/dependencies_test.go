@@ -0,0 +1,347 @@
+package curatedpackages_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	packagesv1 "github.com/aws/eks-anywhere-packages/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/constants"
+	"github.com/aws/eks-anywhere/pkg/curatedpackages"
+	"github.com/aws/eks-anywhere/pkg/curatedpackages/mocks"
+)
+
+type dependenciesTest struct {
+	*WithT
+	ctx     context.Context
+	kubectl *mocks.MockKubectlRunner
+	bundle  *packagesv1.PackageBundle
+	deps    *curatedpackages.BundleDependencies
+	client  *curatedpackages.PackageClient
+}
+
+// newDependenciesTest builds a client for a bundle whose packages publish the given dependencies for their
+// only version, 1.0.0.
+func newDependenciesTest(t *testing.T, dependencies map[string][]string) *dependenciesTest {
+	kubectl := mocks.NewMockKubectlRunner(gomock.NewController(t))
+	bundle := &packagesv1.PackageBundle{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "packages.eks.amazonaws.com/v1alpha1"},
+		ObjectMeta: metav1.ObjectMeta{Name: "v1-21-1001"},
+		Spec: packagesv1.PackageBundleSpec{
+			Packages: []packagesv1.BundlePackage{
+				{Name: "harbor"},
+				{Name: "cert-manager"},
+				{Name: "prometheus"},
+				{Name: "prometheus-adapter"},
+			},
+		},
+	}
+
+	var published strings.Builder
+	published.WriteString("spec:\n  packages:\n")
+	for _, p := range bundle.Spec.Packages {
+		published.WriteString(fmt.Sprintf("  - name: %s\n    source:\n      versions:\n      - name: 1.0.0\n        digest: sha256:%s\n", p.Name, p.Name))
+		if d, ok := dependencies[p.Name]; ok {
+			published.WriteString("        dependencies:\n")
+			for _, name := range d {
+				published.WriteString(fmt.Sprintf("        - %s\n", name))
+			}
+		}
+	}
+	deps, err := curatedpackages.ParseBundleDependencies([]byte(published.String()))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return &dependenciesTest{
+		WithT:   NewWithT(t),
+		ctx:     context.Background(),
+		kubectl: kubectl,
+		bundle:  bundle,
+		deps:    deps,
+		client:  curatedpackages.NewPackageClient(kubectl, curatedpackages.WithBundle(bundle), curatedpackages.WithBundleDependencies(deps)),
+	}
+}
+
+// expectPackageInstalled expects the package to be polled and reports it installed.
+func (tt *dependenciesTest) expectPackageInstalled(name, namespace string) {
+	tt.kubectl.EXPECT().GetObject(gomock.Any(), "package", name, namespace, "kubeconfig", gomock.Any()).
+		DoAndReturn(func(_ context.Context, _, _, _, _ string, obj runtime.Object) error {
+			obj.(*packagesv1.Package).Status.State = packagesv1.StateInstalled
+			return nil
+		})
+}
+
+func installedPackage(name, packageName string) packagesv1.Package {
+	return packagesv1.Package{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec:       packagesv1.PackageSpec{PackageName: packageName},
+	}
+}
+
+func TestParseBundleDependencies(t *testing.T) {
+	g := NewWithT(t)
+	content, err := os.ReadFile("testdata/package-bundle.yaml")
+	g.Expect(err).NotTo(HaveOccurred())
+
+	deps, err := curatedpackages.ParseBundleDependencies(content)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(deps.Of("Emissary", "")).To(Equal([]string{"emissary-crds"}))
+	g.Expect(deps.Of("metallb", "sha256:fe0b6409cd1ceebc999df470dfa0b51ae53d78fed1d29dbf0f357c9e37a9aa6c")).To(Equal([]string{"metallb-crds"}))
+	g.Expect(deps.Of("harbor", "")).To(BeEmpty())
+}
+
+func TestParseBundleDependenciesPerVersion(t *testing.T) {
+	g := NewWithT(t)
+	deps, err := curatedpackages.ParseBundleDependencies([]byte(`{"spec": {"packages": [{"name": "harbor", "source": {"versions": [
+		{"name": "2.6.0", "digest": "sha256:new", "dependencies": ["Cert-Manager"]},
+		{"name": "2.5.0", "digest": "sha256:old"}
+	]}}]}}`))
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(deps.Of("harbor", "")).To(Equal([]string{"cert-manager"}))
+	g.Expect(deps.Of("harbor", "2.5.0")).To(BeEmpty())
+	g.Expect(deps.Of("harbor", "sha256:new")).To(Equal([]string{"cert-manager"}))
+	g.Expect(deps.Of("harbor", "unknown")).To(Equal([]string{"cert-manager"}))
+}
+
+func TestParseBundleDependenciesInvalid(t *testing.T) {
+	g := NewWithT(t)
+
+	_, err := curatedpackages.ParseBundleDependencies([]byte("spec: [}"))
+	g.Expect(err).To(MatchError(ContainSubstring("parsing package bundle dependencies")))
+}
+
+func TestResolveDependencies(t *testing.T) {
+	tt := newDependenciesTest(t, map[string][]string{"prometheus-adapter": {"prometheus", "cert-manager"}, "prometheus": {"cert-manager"}})
+
+	deps, err := tt.client.ResolveDependencies("Prometheus-Adapter", "")
+	tt.Expect(err).NotTo(HaveOccurred())
+	tt.Expect(deps).To(HaveLen(2))
+	tt.Expect(deps[0].Name).To(Equal("cert-manager"))
+	tt.Expect(deps[1].Name).To(Equal("prometheus"))
+}
+
+func TestResolveDependenciesNone(t *testing.T) {
+	tt := newDependenciesTest(t, map[string][]string{"harbor": {"cert-manager"}})
+
+	deps, err := tt.client.ResolveDependencies("cert-manager", "")
+	tt.Expect(err).NotTo(HaveOccurred())
+	tt.Expect(deps).To(BeEmpty())
+}
+
+func TestResolveDependenciesWithoutBundleDependencies(t *testing.T) {
+	tt := newDependenciesTest(t, nil)
+	client := curatedpackages.NewPackageClient(tt.kubectl, curatedpackages.WithBundle(tt.bundle))
+
+	deps, err := client.ResolveDependencies("harbor", "")
+	tt.Expect(err).NotTo(HaveOccurred())
+	tt.Expect(deps).To(BeEmpty())
+}
+
+func TestResolveDependenciesCycle(t *testing.T) {
+	tt := newDependenciesTest(t, map[string][]string{"harbor": {"cert-manager"}, "cert-manager": {"prometheus"}, "prometheus": {"harbor"}})
+
+	_, err := tt.client.ResolveDependencies("harbor", "")
+	tt.Expect(err).To(MatchError("circular package dependency: harbor -> cert-manager -> prometheus -> harbor"))
+}
+
+func TestResolveDependenciesNotInBundle(t *testing.T) {
+	tt := newDependenciesTest(t, map[string][]string{"harbor": {"redis"}})
+
+	_, err := tt.client.ResolveDependencies("harbor", "")
+	tt.Expect(err).To(MatchError("package harbor depends on redis, which is not in the package bundle"))
+}
+
+func TestMissingDependencies(t *testing.T) {
+	tt := newDependenciesTest(t, nil)
+	deps := []packagesv1.BundlePackage{{Name: "cert-manager"}, {Name: "prometheus"}}
+	installed := []packagesv1.Package{installedPackage("my-prometheus", "Prometheus")}
+
+	tt.Expect(curatedpackages.MissingDependencies(deps, installed)).To(Equal([]packagesv1.BundlePackage{{Name: "cert-manager"}}))
+}
+
+func TestInstallDependencies(t *testing.T) {
+	tt := newDependenciesTest(t, nil)
+	deps := []packagesv1.BundlePackage{{Name: "cert-manager"}, {Name: "prometheus"}}
+	params := []string{"create", "-f", "-", "--kubeconfig", "kubeconfig"}
+
+	var created []string
+	create := func(_ context.Context, yaml []byte, _ ...string) {
+		created = append(created, string(yaml))
+	}
+	gomock.InOrder(
+		tt.kubectl.EXPECT().ExecuteFromYaml(tt.ctx, gomock.Any(), params).Do(create).Return(bytes.Buffer{}, nil),
+		tt.kubectl.EXPECT().GetObject(gomock.Any(), "package", "cert-manager", "eksa-packages-billy", "kubeconfig", gomock.Any()).
+			DoAndReturn(func(_ context.Context, _, _, _, _ string, obj runtime.Object) error {
+				obj.(*packagesv1.Package).Status.State = packagesv1.StateInstalled
+				return nil
+			}),
+		tt.kubectl.EXPECT().ExecuteFromYaml(tt.ctx, gomock.Any(), params).Do(create).Return(bytes.Buffer{}, nil),
+	)
+	tt.expectPackageInstalled("prometheus", "eksa-packages-billy")
+
+	tt.Expect(tt.client.InstallDependencies(tt.ctx, deps, "billy", "kubeconfig")).To(Succeed())
+	tt.Expect(created).To(HaveLen(2))
+	tt.Expect(created[0]).To(ContainSubstring("name: cert-manager"))
+	tt.Expect(created[0]).To(ContainSubstring("namespace: eksa-packages-billy"))
+	tt.Expect(created[1]).To(ContainSubstring("packageName: prometheus"))
+}
+
+func TestInstallDependenciesFail(t *testing.T) {
+	tt := newDependenciesTest(t, nil)
+	deps := []packagesv1.BundlePackage{{Name: "cert-manager"}, {Name: "prometheus"}}
+
+	tt.kubectl.EXPECT().ExecuteFromYaml(tt.ctx, gomock.Any(), gomock.Any()).Return(bytes.Buffer{}, errors.New("already exists"))
+
+	err := tt.client.InstallDependencies(tt.ctx, deps, "billy", "kubeconfig")
+	tt.Expect(err).To(MatchError("installing dependency cert-manager: already exists"))
+}
+
+func TestInstallDependenciesInstallFail(t *testing.T) {
+	tt := newDependenciesTest(t, nil)
+	deps := []packagesv1.BundlePackage{{Name: "cert-manager"}, {Name: "prometheus"}}
+
+	tt.kubectl.EXPECT().ExecuteFromYaml(tt.ctx, gomock.Any(), gomock.Any()).Return(bytes.Buffer{}, nil)
+	tt.kubectl.EXPECT().GetObject(gomock.Any(), "package", "cert-manager", "eksa-packages-billy", "kubeconfig", gomock.Any()).
+		DoAndReturn(func(_ context.Context, _, _, _, _ string, obj runtime.Object) error {
+			obj.(*packagesv1.Package).Status = packagesv1.PackageStatus{State: packagesv1.StateUnknown, Detail: "chart not found"}
+			return nil
+		})
+
+	err := tt.client.InstallDependencies(tt.ctx, deps, "billy", "kubeconfig")
+	tt.Expect(err).To(MatchError("installing dependency cert-manager: package cert-manager failed to install: chart not found"))
+}
+
+func TestGetInstalledPackages(t *testing.T) {
+	tt := newDependenciesTest(t, nil)
+	params := []string{"get", "packages", "-o", "json", "--kubeconfig", "kubeconfig", "--namespace", constants.EksaPackagesName + "-billy"}
+	list := packagesv1.PackageList{Items: []packagesv1.Package{installedPackage("my-harbor", "harbor")}}
+
+	tt.kubectl.EXPECT().ExecuteCommand(tt.ctx, params).Return(convertJsonToBytes(list), nil)
+
+	installed, err := tt.client.GetInstalledPackages(tt.ctx, "kubeconfig", "billy")
+	tt.Expect(err).NotTo(HaveOccurred())
+	tt.Expect(installed).To(HaveLen(1))
+	tt.Expect(installed[0].Spec.PackageName).To(Equal("harbor"))
+}
+
+func TestGetInstalledPackagesFail(t *testing.T) {
+	tt := newDependenciesTest(t, nil)
+
+	tt.kubectl.EXPECT().ExecuteCommand(tt.ctx, gomock.Any()).Return(bytes.Buffer{}, errors.New("connection refused"))
+
+	_, err := tt.client.GetInstalledPackages(tt.ctx, "kubeconfig", "billy")
+	tt.Expect(err).To(MatchError("getting installed packages: connection refused"))
+}
+
+func TestValidateDeletion(t *testing.T) {
+	tt := newDependenciesTest(t, map[string][]string{"harbor": {"cert-manager"}})
+	installed := []packagesv1.Package{
+		installedPackage("my-harbor", "harbor"),
+		installedPackage("my-cert-manager", "cert-manager"),
+		installedPackage("my-prometheus", "prometheus"),
+	}
+
+	tt.Expect(tt.client.ValidateDeletion([]string{"my-cert-manager"}, installed)).To(
+		MatchError("refusing to delete packages other packages depend on: package my-harbor depends on my-cert-manager"))
+	tt.Expect(tt.client.ValidateDeletion([]string{"my-cert-manager", "my-harbor"}, installed)).To(Succeed())
+	tt.Expect(tt.client.ValidateDeletion([]string{"my-prometheus"}, installed)).To(Succeed())
+}
+
+func TestCreatePackagesInOrder(t *testing.T) {
+	tt := newDependenciesTest(t, map[string][]string{"harbor": {"cert-manager"}})
+	fileName := filepath.Join(t.TempDir(), "packages.yaml")
+	content := `apiVersion: packages.eks.amazonaws.com/v1alpha1
+kind: Package
+metadata:
+  name: my-harbor
+  namespace: eksa-packages-billy
+spec:
+  packageName: harbor
+---
+apiVersion: v1
+kind: Secret
+metadata:
+  name: harbor-admin
+---
+apiVersion: packages.eks.amazonaws.com/v1alpha1
+kind: Package
+metadata:
+  name: my-cert-manager
+  namespace: eksa-packages-billy
+spec:
+  packageName: cert-manager
+`
+	tt.Expect(os.WriteFile(fileName, []byte(content), 0o644)).To(Succeed())
+	params := []string{"create", "-f", "-", "--kubeconfig", "kubeconfig"}
+
+	var created []string
+	create := func(_ context.Context, yaml []byte, _ ...string) {
+		created = append(created, string(yaml))
+	}
+	gomock.InOrder(
+		tt.kubectl.EXPECT().ExecuteFromYaml(tt.ctx, gomock.Any(), params).Do(create).Return(bytes.Buffer{}, nil),
+		tt.kubectl.EXPECT().ExecuteFromYaml(tt.ctx, gomock.Any(), params).Do(create).Return(bytes.Buffer{}, nil),
+		tt.kubectl.EXPECT().GetObject(gomock.Any(), "package", "my-cert-manager", "eksa-packages-billy", "kubeconfig", gomock.Any()).
+			DoAndReturn(func(_ context.Context, _, _, _, _ string, obj runtime.Object) error {
+				obj.(*packagesv1.Package).Status.State = packagesv1.StateInstalled
+				return nil
+			}),
+		tt.kubectl.EXPECT().ExecuteFromYaml(tt.ctx, gomock.Any(), params).Do(create).Return(bytes.Buffer{}, nil),
+	)
+
+	tt.Expect(tt.client.CreatePackagesInOrder(tt.ctx, fileName, "kubeconfig", tt.deps)).To(Succeed())
+	tt.Expect(created).To(HaveLen(3))
+	tt.Expect(created[0]).To(ContainSubstring("name: harbor-admin"))
+	tt.Expect(created[1]).To(ContainSubstring("name: my-cert-manager"))
+	tt.Expect(created[2]).To(ContainSubstring("name: my-harbor"))
+}
+
+func TestCreatePackagesInOrderDependencyFailed(t *testing.T) {
+	tt := newDependenciesTest(t, map[string][]string{"harbor": {"cert-manager"}})
+	fileName := filepath.Join(t.TempDir(), "packages.yaml")
+	content := `apiVersion: packages.eks.amazonaws.com/v1alpha1
+kind: Package
+metadata:
+  name: my-harbor
+  namespace: eksa-packages-billy
+spec:
+  packageName: harbor
+---
+apiVersion: packages.eks.amazonaws.com/v1alpha1
+kind: Package
+metadata:
+  name: my-cert-manager
+  namespace: eksa-packages-billy
+spec:
+  packageName: cert-manager
+`
+	tt.Expect(os.WriteFile(fileName, []byte(content), 0o644)).To(Succeed())
+
+	tt.kubectl.EXPECT().ExecuteFromYaml(tt.ctx, gomock.Any(), gomock.Any()).Return(bytes.Buffer{}, nil)
+	tt.kubectl.EXPECT().GetObject(gomock.Any(), "package", "my-cert-manager", "eksa-packages-billy", "kubeconfig", gomock.Any()).
+		DoAndReturn(func(_ context.Context, _, _, _, _ string, obj runtime.Object) error {
+			obj.(*packagesv1.Package).Status = packagesv1.PackageStatus{State: packagesv1.StateUnknown, Detail: "chart not found"}
+			return nil
+		})
+
+	err := tt.client.CreatePackagesInOrder(tt.ctx, fileName, "kubeconfig", tt.deps)
+	tt.Expect(err).To(MatchError("package my-cert-manager failed to install: chart not found"))
+}
+
+func TestCreatePackagesInOrderMissingFile(t *testing.T) {
+	tt := newDependenciesTest(t, nil)
+
+	err := tt.client.CreatePackagesInOrder(tt.ctx, filepath.Join(t.TempDir(), "missing.yaml"), "kubeconfig", tt.deps)
+	tt.Expect(err).To(MatchError(ContainSubstring("reading packages file")))
+}
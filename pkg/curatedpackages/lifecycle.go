@@ -0,0 +1,244 @@
+package curatedpackages
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"sigs.k8s.io/yaml"
+
+	packagesv1 "github.com/aws/eks-anywhere-packages/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/constants"
+	"github.com/aws/eks-anywhere/pkg/logger"
+)
+
+const (
+	// PackageVersionHistoryAnnotation records the versions a Package was upgraded from, oldest first, as
+	// a JSON list. It is used to roll a package back to the version installed before an upgrade.
+	PackageVersionHistoryAnnotation = "anywhere.eks.amazonaws.com/package-version-history"
+
+	packageResource = "package"
+	// maxVersionHistory bounds the number of versions kept in the history annotation.
+	maxVersionHistory = 10
+)
+
+// GetPackage gets a Package from the namespace of the cluster's packages.
+func (pc *PackageClient) GetPackage(ctx context.Context, name string, kubeConfig string, clusterName string) (*packagesv1.Package, error) {
+	return pc.getPackage(ctx, name, constants.EksaPackagesName+"-"+clusterName, kubeConfig)
+}
+
+func (pc *PackageClient) getPackage(ctx context.Context, name, namespace, kubeConfig string) (*packagesv1.Package, error) {
+	p := &packagesv1.Package{}
+	err := pc.kubectl.GetObject(ctx, packageResource, name, namespace, kubeConfig, p)
+	if err != nil {
+		return nil, fmt.Errorf("getting package %s: %v", name, err)
+	}
+	return p, nil
+}
+
+// PackageVersionHistory returns the versions recorded in the history annotation of the package, oldest first.
+func PackageVersionHistory(p *packagesv1.Package) ([]string, error) {
+	value, ok := p.Annotations[PackageVersionHistoryAnnotation]
+	if !ok || value == "" {
+		return nil, nil
+	}
+	var history []string
+	if err := json.Unmarshal([]byte(value), &history); err != nil {
+		return nil, fmt.Errorf("parsing %s annotation of package %s: %v", PackageVersionHistoryAnnotation, p.Name, err)
+	}
+	return history, nil
+}
+
+func setPackageVersionHistory(p *packagesv1.Package, history []string) error {
+	if len(history) > maxVersionHistory {
+		history = history[len(history)-maxVersionHistory:]
+	}
+	if p.Annotations == nil {
+		p.Annotations = map[string]string{}
+	}
+	if len(history) == 0 {
+		delete(p.Annotations, PackageVersionHistoryAnnotation)
+		return nil
+	}
+	value, err := json.Marshal(history)
+	if err != nil {
+		return err
+	}
+	p.Annotations[PackageVersionHistoryAnnotation] = string(value)
+	return nil
+}
+
+// installedVersion returns the version the package controller last installed, falling back to the
+// version requested in the spec.
+func installedVersion(p *packagesv1.Package) string {
+	if p.Status.CurrentVersion != "" {
+		return p.Status.CurrentVersion
+	}
+	return p.Spec.PackageVersion
+}
+
+func bundleHasVersion(bp *packagesv1.BundlePackage, version string) bool {
+	for _, v := range bp.Source.Versions {
+		if v.Name == version || v.Digest == version {
+			return true
+		}
+	}
+	return false
+}
+
+// UpgradePackage sets the version of an installed package, recording the version it is upgraded from so
+// it can be rolled back. The version must be in the bundle and the package configuration valid for it.
+func (pc *PackageClient) UpgradePackage(ctx context.Context, name string, version string, kubeConfig string, clusterName string) error {
+	p, err := pc.GetPackage(ctx, name, kubeConfig, clusterName)
+	if err != nil {
+		return err
+	}
+	bp, err := pc.GetPackageFromBundle(p.Spec.PackageName)
+	if err != nil {
+		return err
+	}
+	if !bundleHasVersion(bp, version) {
+		return fmt.Errorf("package %s does not have version %s", bp.Name, version)
+	}
+	if err = ValidateConfig(bp, version, p.Spec.Config); err != nil {
+		return err
+	}
+	if err = pc.validateUpgradeDependencies(ctx, bp.Name, version, kubeConfig, clusterName); err != nil {
+		return err
+	}
+
+	previous := installedVersion(p)
+	if previous == version {
+		return fmt.Errorf("package %s is already at version %s", name, version)
+	}
+	history, err := PackageVersionHistory(p)
+	if err != nil {
+		return err
+	}
+	if previous != "" {
+		history = append(history, previous)
+	}
+	if err = setPackageVersionHistory(p, history); err != nil {
+		return err
+	}
+
+	p.Spec.PackageVersion = version
+	return pc.applyPackage(ctx, p, kubeConfig)
+}
+
+// validateUpgradeDependencies checks the packages the new version of a package depends on are installed,
+// since a version can add dependencies the installed version didn't have.
+func (pc *PackageClient) validateUpgradeDependencies(ctx context.Context, packageName, version, kubeConfig, clusterName string) error {
+	dependencies, err := pc.ResolveDependencies(packageName, version)
+	if err != nil {
+		return err
+	}
+	if len(dependencies) == 0 {
+		return nil
+	}
+
+	installed, err := pc.GetInstalledPackages(ctx, kubeConfig, clusterName)
+	if err != nil {
+		return err
+	}
+	missing := MissingDependencies(dependencies, installed)
+	if len(missing) == 0 {
+		return nil
+	}
+
+	names := make([]string, 0, len(missing))
+	for _, m := range missing {
+		names = append(names, m.Name)
+	}
+	return fmt.Errorf("version %s of package %s depends on packages that are not installed: %s. Install them first", version, packageName, strings.Join(names, ", "))
+}
+
+// RollbackPackage restores the version a package had before its last upgrade and returns it. The
+// version must still be in the bundle.
+func (pc *PackageClient) RollbackPackage(ctx context.Context, name string, kubeConfig string, clusterName string) (string, error) {
+	p, err := pc.GetPackage(ctx, name, kubeConfig, clusterName)
+	if err != nil {
+		return "", err
+	}
+	history, err := PackageVersionHistory(p)
+	if err != nil {
+		return "", err
+	}
+	if len(history) == 0 {
+		return "", fmt.Errorf("no previous version recorded for package %s", name)
+	}
+	previous := history[len(history)-1]
+
+	bp, err := pc.GetPackageFromBundle(p.Spec.PackageName)
+	if err != nil {
+		return "", err
+	}
+	if !bundleHasVersion(bp, previous) {
+		return "", fmt.Errorf("previous version %s of package %s is not in the active package bundle", previous, name)
+	}
+
+	if err = setPackageVersionHistory(p, history[:len(history)-1]); err != nil {
+		return "", err
+	}
+	p.Spec.PackageVersion = previous
+	if err = pc.applyPackage(ctx, p, kubeConfig); err != nil {
+		return "", err
+	}
+	return previous, nil
+}
+
+func (pc *PackageClient) applyPackage(ctx context.Context, p *packagesv1.Package, kubeConfig string) error {
+	p.ManagedFields = nil
+	packageYaml, err := yaml.Marshal(NewDisplayablePackage(p))
+	if err != nil {
+		return err
+	}
+	params := []string{"apply", "-f", "-", "--kubeconfig", kubeConfig}
+	stdOut, err := pc.kubectl.ExecuteFromYaml(ctx, packageYaml, params...)
+	if err != nil {
+		return fmt.Errorf("updating package %s: %v", p.Name, err)
+	}
+	fmt.Print(&stdOut)
+	return nil
+}
+
+// WaitForPackage polls the status of a package until the controller reports it installed at version, or
+// fails to install it. An empty version accepts any installed version.
+func (pc *PackageClient) WaitForPackage(ctx context.Context, name string, version string, kubeConfig string, clusterName string, timeout time.Duration) error {
+	return pc.waitForPackage(ctx, name, constants.EksaPackagesName+"-"+clusterName, version, kubeConfig, timeout)
+}
+
+func (pc *PackageClient) waitForPackage(ctx context.Context, name, namespace, version, kubeConfig string, timeout time.Duration) error {
+	timeoutCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	for {
+		p, err := pc.getPackage(timeoutCtx, name, namespace, kubeConfig)
+		if err != nil {
+			if timeoutCtx.Err() != nil {
+				return fmt.Errorf("timed out waiting for package %s to be installed: %v", name, timeoutCtx.Err())
+			}
+			return err
+		}
+
+		status := p.Status
+		if status.State == packagesv1.StateInstalled && (version == "" || status.CurrentVersion == version || status.Source.Digest == version) {
+			logger.V(6).Info("package installed", "name", name, "version", status.CurrentVersion)
+			return nil
+		}
+		// The controller keeps the package installing or updating while it retries a failed release
+		// and reports the failure in the detail, so any detail outside the installed state is an error.
+		if status.State != packagesv1.StateInstalled && status.Detail != "" {
+			return fmt.Errorf("package %s failed to install: %s", name, status.Detail)
+		}
+
+		logger.V(6).Info("waiting for package to be installed", "name", name, "state", status.State, "detail", status.Detail)
+		select {
+		case <-timeoutCtx.Done():
+			return fmt.Errorf("timed out waiting for package %s to be installed: %v", name, timeoutCtx.Err())
+		case <-time.After(time.Second):
+		}
+	}
+}
@@ -0,0 +1,263 @@
+package curatedpackages_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/yaml"
+
+	packagesv1 "github.com/aws/eks-anywhere-packages/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/curatedpackages"
+	"github.com/aws/eks-anywhere/pkg/curatedpackages/mocks"
+)
+
+type lifecycleTest struct {
+	*WithT
+	ctx     context.Context
+	kubectl *mocks.MockKubectlRunner
+	client  *curatedpackages.PackageClient
+	pkg     *packagesv1.Package
+}
+
+func newLifecycleTest(t *testing.T) *lifecycleTest {
+	kubectl := mocks.NewMockKubectlRunner(gomock.NewController(t))
+	bundle := &packagesv1.PackageBundle{
+		Spec: packagesv1.PackageBundleSpec{
+			Packages: []packagesv1.BundlePackage{
+				{
+					Name: "harbor",
+					Source: packagesv1.BundlePackageSource{
+						Versions: []packagesv1.SourceVersion{
+							{Name: "2.5.1", Digest: "sha256:abc"},
+							{Name: "2.4.0", Digest: "sha256:def"},
+						},
+					},
+				},
+			},
+		},
+	}
+	return &lifecycleTest{
+		WithT:   NewWithT(t),
+		ctx:     context.Background(),
+		kubectl: kubectl,
+		client:  curatedpackages.NewPackageClient(kubectl, curatedpackages.WithBundle(bundle)),
+		pkg: &packagesv1.Package{
+			TypeMeta:   metav1.TypeMeta{Kind: "Package", APIVersion: "packages.eks.amazonaws.com/v1alpha1"},
+			ObjectMeta: metav1.ObjectMeta{Name: "my-harbor", Namespace: "eksa-packages-billy"},
+			Spec:       packagesv1.PackageSpec{PackageName: "harbor", PackageVersion: "2.4.0"},
+			Status:     packagesv1.PackageStatus{State: packagesv1.StateInstalled, CurrentVersion: "2.4.0"},
+		},
+	}
+}
+
+func (tt *lifecycleTest) expectGetPackage(pkgs ...*packagesv1.Package) {
+	calls := make([]*gomock.Call, 0, len(pkgs))
+	for _, p := range pkgs {
+		p := p
+		calls = append(calls, tt.kubectl.EXPECT().GetObject(gomock.Any(), "package", "my-harbor", "eksa-packages-billy", "kubeconfig", gomock.Any()).
+			DoAndReturn(func(_ context.Context, _, _, _, _ string, obj runtime.Object) error {
+				p.DeepCopyInto(obj.(*packagesv1.Package))
+				return nil
+			}))
+	}
+	gomock.InOrder(calls...)
+}
+
+func (tt *lifecycleTest) expectApply() *packagesv1.Package {
+	applied := &packagesv1.Package{}
+	params := []string{"apply", "-f", "-", "--kubeconfig", "kubeconfig"}
+	tt.kubectl.EXPECT().ExecuteFromYaml(tt.ctx, gomock.Any(), params).Do(func(_ context.Context, content []byte, _ ...string) {
+		tt.Expect(yaml.Unmarshal(content, applied)).To(Succeed())
+	}).Return(bytes.Buffer{}, nil)
+	return applied
+}
+
+func TestUpgradePackage(t *testing.T) {
+	tt := newLifecycleTest(t)
+	tt.expectGetPackage(tt.pkg)
+	applied := tt.expectApply()
+
+	tt.Expect(tt.client.UpgradePackage(tt.ctx, "my-harbor", "2.5.1", "kubeconfig", "billy")).To(Succeed())
+	tt.Expect(applied.Spec.PackageVersion).To(Equal("2.5.1"))
+	tt.Expect(applied.Annotations).To(HaveKeyWithValue(curatedpackages.PackageVersionHistoryAnnotation, `["2.4.0"]`))
+}
+
+func (tt *lifecycleTest) withDependencies(t *testing.T) {
+	deps, err := curatedpackages.ParseBundleDependencies([]byte(`spec:
+  packages:
+  - name: harbor
+    source:
+      versions:
+      - name: 2.5.1
+        digest: sha256:abc
+        dependencies:
+        - cert-manager
+      - name: 2.4.0
+        digest: sha256:def
+  - name: cert-manager
+    source:
+      versions:
+      - name: 1.9.1
+        digest: sha256:123
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	bundle := &packagesv1.PackageBundle{
+		Spec: packagesv1.PackageBundleSpec{
+			Packages: []packagesv1.BundlePackage{
+				{
+					Name: "harbor",
+					Source: packagesv1.BundlePackageSource{
+						Versions: []packagesv1.SourceVersion{
+							{Name: "2.5.1", Digest: "sha256:abc"},
+							{Name: "2.4.0", Digest: "sha256:def"},
+						},
+					},
+				},
+				{Name: "cert-manager", Source: packagesv1.BundlePackageSource{Versions: []packagesv1.SourceVersion{{Name: "1.9.1", Digest: "sha256:123"}}}},
+			},
+		},
+	}
+	tt.client = curatedpackages.NewPackageClient(tt.kubectl, curatedpackages.WithBundle(bundle), curatedpackages.WithBundleDependencies(deps))
+}
+
+func (tt *lifecycleTest) expectInstalledPackages(packages ...packagesv1.Package) {
+	params := []string{"get", "packages", "-o", "json", "--kubeconfig", "kubeconfig", "--namespace", "eksa-packages-billy"}
+	tt.kubectl.EXPECT().ExecuteCommand(tt.ctx, params).Return(convertJsonToBytes(packagesv1.PackageList{Items: packages}), nil)
+}
+
+func TestUpgradePackageNewDependencyInstalled(t *testing.T) {
+	tt := newLifecycleTest(t)
+	tt.withDependencies(t)
+	tt.expectGetPackage(tt.pkg)
+	tt.expectInstalledPackages(*tt.pkg, packagesv1.Package{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-cert-manager"},
+		Spec:       packagesv1.PackageSpec{PackageName: "cert-manager"},
+	})
+	applied := tt.expectApply()
+
+	tt.Expect(tt.client.UpgradePackage(tt.ctx, "my-harbor", "2.5.1", "kubeconfig", "billy")).To(Succeed())
+	tt.Expect(applied.Spec.PackageVersion).To(Equal("2.5.1"))
+}
+
+func TestUpgradePackageNewDependencyMissing(t *testing.T) {
+	tt := newLifecycleTest(t)
+	tt.withDependencies(t)
+	tt.expectGetPackage(tt.pkg)
+	tt.expectInstalledPackages(*tt.pkg)
+
+	err := tt.client.UpgradePackage(tt.ctx, "my-harbor", "2.5.1", "kubeconfig", "billy")
+	tt.Expect(err).To(MatchError("version 2.5.1 of package harbor depends on packages that are not installed: cert-manager. Install them first"))
+}
+
+func TestUpgradePackageUnknownVersion(t *testing.T) {
+	tt := newLifecycleTest(t)
+	tt.expectGetPackage(tt.pkg)
+
+	err := tt.client.UpgradePackage(tt.ctx, "my-harbor", "3.0.0", "kubeconfig", "billy")
+	tt.Expect(err).To(MatchError("package harbor does not have version 3.0.0"))
+}
+
+func TestUpgradePackageSameVersion(t *testing.T) {
+	tt := newLifecycleTest(t)
+	tt.expectGetPackage(tt.pkg)
+
+	err := tt.client.UpgradePackage(tt.ctx, "my-harbor", "2.4.0", "kubeconfig", "billy")
+	tt.Expect(err).To(MatchError("package my-harbor is already at version 2.4.0"))
+}
+
+func TestUpgradePackageGetFail(t *testing.T) {
+	tt := newLifecycleTest(t)
+	tt.kubectl.EXPECT().GetObject(gomock.Any(), "package", "my-harbor", "eksa-packages-billy", "kubeconfig", gomock.Any()).Return(errors.New("not found"))
+
+	err := tt.client.UpgradePackage(tt.ctx, "my-harbor", "2.5.1", "kubeconfig", "billy")
+	tt.Expect(err).To(MatchError("getting package my-harbor: not found"))
+}
+
+func TestRollbackPackage(t *testing.T) {
+	tt := newLifecycleTest(t)
+	tt.pkg.Annotations = map[string]string{curatedpackages.PackageVersionHistoryAnnotation: `["2.5.1","2.4.0"]`}
+	tt.pkg.Spec.PackageVersion = "2.5.1"
+	tt.expectGetPackage(tt.pkg)
+	applied := tt.expectApply()
+
+	version, err := tt.client.RollbackPackage(tt.ctx, "my-harbor", "kubeconfig", "billy")
+	tt.Expect(err).NotTo(HaveOccurred())
+	tt.Expect(version).To(Equal("2.4.0"))
+	tt.Expect(applied.Spec.PackageVersion).To(Equal("2.4.0"))
+	tt.Expect(applied.Annotations).To(HaveKeyWithValue(curatedpackages.PackageVersionHistoryAnnotation, `["2.5.1"]`))
+}
+
+func TestRollbackPackageNoHistory(t *testing.T) {
+	tt := newLifecycleTest(t)
+	tt.expectGetPackage(tt.pkg)
+
+	_, err := tt.client.RollbackPackage(tt.ctx, "my-harbor", "kubeconfig", "billy")
+	tt.Expect(err).To(MatchError("no previous version recorded for package my-harbor"))
+}
+
+func TestRollbackPackageVersionNotInBundle(t *testing.T) {
+	tt := newLifecycleTest(t)
+	tt.pkg.Annotations = map[string]string{curatedpackages.PackageVersionHistoryAnnotation: `["2.3.0"]`}
+	tt.expectGetPackage(tt.pkg)
+
+	_, err := tt.client.RollbackPackage(tt.ctx, "my-harbor", "kubeconfig", "billy")
+	tt.Expect(err).To(MatchError("previous version 2.3.0 of package my-harbor is not in the active package bundle"))
+}
+
+func TestWaitForPackage(t *testing.T) {
+	tt := newLifecycleTest(t)
+	updating := tt.pkg.DeepCopy()
+	updating.Status.State = packagesv1.StateUpdating
+	installed := tt.pkg.DeepCopy()
+	installed.Status.CurrentVersion = "2.5.1"
+	tt.expectGetPackage(updating, installed)
+
+	tt.Expect(tt.client.WaitForPackage(tt.ctx, "my-harbor", "2.5.1", "kubeconfig", "billy", time.Minute)).To(Succeed())
+}
+
+func TestWaitForPackageFailed(t *testing.T) {
+	tt := newLifecycleTest(t)
+	tt.pkg.Status.State = packagesv1.StateUnknown
+	tt.pkg.Status.Detail = "helm install failed"
+	tt.expectGetPackage(tt.pkg)
+
+	err := tt.client.WaitForPackage(tt.ctx, "my-harbor", "2.5.1", "kubeconfig", "billy", time.Minute)
+	tt.Expect(err).To(MatchError("package my-harbor failed to install: helm install failed"))
+}
+
+func TestWaitForPackageFailedWhileInstalling(t *testing.T) {
+	tt := newLifecycleTest(t)
+	tt.pkg.Status.State = packagesv1.StateInstalling
+	tt.pkg.Status.Detail = "chart pull failed: unauthorized"
+	tt.expectGetPackage(tt.pkg)
+
+	err := tt.client.WaitForPackage(tt.ctx, "my-harbor", "2.5.1", "kubeconfig", "billy", time.Minute)
+	tt.Expect(err).To(MatchError("package my-harbor failed to install: chart pull failed: unauthorized"))
+}
+
+func TestWaitForPackageFailedWhileUpdating(t *testing.T) {
+	tt := newLifecycleTest(t)
+	tt.pkg.Status.State = packagesv1.StateUpdating
+	tt.pkg.Status.Detail = "helm upgrade failed"
+	tt.expectGetPackage(tt.pkg)
+
+	err := tt.client.WaitForPackage(tt.ctx, "my-harbor", "2.5.1", "kubeconfig", "billy", time.Minute)
+	tt.Expect(err).To(MatchError("package my-harbor failed to install: helm upgrade failed"))
+}
+
+func TestWaitForPackageTimeout(t *testing.T) {
+	tt := newLifecycleTest(t)
+	tt.expectGetPackage(tt.pkg)
+
+	err := tt.client.WaitForPackage(tt.ctx, "my-harbor", "2.5.1", "kubeconfig", "billy", 10*time.Millisecond)
+	tt.Expect(err).To(MatchError(ContainSubstring("timed out waiting for package my-harbor to be installed")))
+}
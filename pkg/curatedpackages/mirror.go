@@ -0,0 +1,215 @@
+package curatedpackages
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/aws/eks-anywhere/pkg/logger"
+	"github.com/aws/eks-anywhere/pkg/registry"
+	releasev1 "github.com/aws/eks-anywhere/release/api/v1alpha1"
+)
+
+// Mirrored artifact states in a MirrorReport.
+const (
+	MirrorVerified = "verified"
+	MirrorFailed   = "failed"
+	MirrorSkipped  = "skipped"
+)
+
+// FilterBundlesForKubeVersions returns a copy of b with only the versions bundles of kubeVersions. All the
+// versions bundles are kept if kubeVersions is empty.
+func FilterBundlesForKubeVersions(b *releasev1.Bundles, kubeVersions []string) (*releasev1.Bundles, error) {
+	if len(kubeVersions) == 0 {
+		return b, nil
+	}
+	filtered := b.DeepCopy()
+	filtered.Spec.VersionsBundles = nil
+	for _, kubeVersion := range kubeVersions {
+		found := false
+		for _, vb := range b.Spec.VersionsBundles {
+			if vb.KubeVersion == kubeVersion {
+				filtered.Spec.VersionsBundles = append(filtered.Spec.VersionsBundles, vb)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("bundle does not support Kubernetes version %s", kubeVersion)
+		}
+	}
+	return filtered, nil
+}
+
+// ReadPackageArtifacts reads the package bundles of every Kubernetes version of b and returns the
+// artifacts needed to run curated packages: the package bundles and helm charts, and the package images.
+// Unlike ReadChartsFromBundles and ReadImagesFromBundles, it fails if a package bundle can't be read.
+func (r *PackageReader) ReadPackageArtifacts(ctx context.Context, b *releasev1.Bundles) (charts []registry.Artifact, images []registry.Artifact, err error) {
+	for _, vb := range b.Spec.VersionsBundles {
+		bundleURI, bundle, err := r.getBundle(ctx, vb)
+		if err != nil {
+			return nil, nil, fmt.Errorf("reading package bundle for Kubernetes version %s: %v", vb.KubeVersion, err)
+		}
+		charts = append(charts, registry.NewArtifactFromURI(bundleURI))
+		charts = append(charts, r.fetchPackagesHelmChart(bundleURI, bundle)...)
+		images = append(images, r.fetchImagesFromBundle(bundleURI, bundle)...)
+	}
+	return removeDuplicateImages(charts), removeDuplicateImages(images), nil
+}
+
+// MirroredArtifact is the result of mirroring an artifact.
+type MirroredArtifact struct {
+	Source      string `json:"source"`
+	Destination string `json:"destination"`
+	Digest      string `json:"digest,omitempty"`
+	Status      string `json:"status"`
+	Error       string `json:"error,omitempty"`
+}
+
+// MirrorReport lists the artifacts mirrored to a registry and whether they could be verified there.
+type MirrorReport struct {
+	Artifacts []MirroredArtifact `json:"artifacts"`
+}
+
+// Failed returns the number of artifacts that couldn't be mirrored or verified.
+func (r *MirrorReport) Failed() int {
+	failed := 0
+	for _, a := range r.Artifacts {
+		if a.Status == MirrorFailed {
+			failed++
+		}
+	}
+	return failed
+}
+
+// WriteJSON writes the report as json.
+func (r *MirrorReport) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(r)
+}
+
+// WriteTable pretty-prints the report as a table.
+func (r *MirrorReport) WriteTable(w io.Writer) error {
+	lines := append([][]string{}, mirrorReportHeaderLines...)
+	for _, a := range r.Artifacts {
+		status := a.Status
+		if a.Error != "" {
+			status += ": " + a.Error
+		}
+		lines = append(lines, []string{a.Destination, a.Digest, status})
+	}
+
+	tw := newCPTabwriter(w, nil)
+	defer tw.Flush()
+	return tw.writeTable(lines)
+}
+
+var mirrorReportHeaderLines = [][]string{
+	{"Artifact", "Digest", "Status"},
+	{"--------", "------", "------"},
+}
+
+// PackageMirror copies curated packages artifacts to a registry, preserving their digests.
+type PackageMirror struct {
+	cache           *registry.Cache
+	credentialStore *registry.CredentialStore
+	srcCertificates *x509.CertPool
+	insecure        bool
+	dryRun          bool
+}
+
+// NewPackageMirror creates a package mirror reading the artifacts from their source registries with
+// the registry clients in cache.
+func NewPackageMirror(cache *registry.Cache, credentialStore *registry.CredentialStore, srcCertificates *x509.CertPool, insecure, dryRun bool) *PackageMirror {
+	return &PackageMirror{
+		cache:           cache,
+		credentialStore: credentialStore,
+		srcCertificates: srcCertificates,
+		insecure:        insecure,
+		dryRun:          dryRun,
+	}
+}
+
+// Mirror copies the artifacts to dst by digest and verifies the destination resolves each of them, and
+// their tags, to the source digest. Artifacts without digest are resolved in their source registry first.
+// Failures are recorded in the report, so every artifact is attempted.
+func (m *PackageMirror) Mirror(ctx context.Context, dst registry.StorageClient, artifacts []registry.Artifact) *MirrorReport {
+	report := &MirrorReport{}
+	for _, artifact := range artifacts {
+		report.Artifacts = append(report.Artifacts, m.mirror(ctx, dst, artifact))
+	}
+	return report
+}
+
+func (m *PackageMirror) mirror(ctx context.Context, dst registry.StorageClient, artifact registry.Artifact) MirroredArtifact {
+	result := MirroredArtifact{
+		Source:      artifact.VersionedImage(),
+		Destination: dst.Destination(artifact),
+		Digest:      artifact.Digest,
+	}
+	if m.dryRun {
+		result.Status = MirrorSkipped
+		return result
+	}
+	fail := func(err error) MirroredArtifact {
+		result.Status = MirrorFailed
+		result.Error = err.Error()
+		return result
+	}
+
+	src, err := m.cache.Get(registry.NewStorageContext(artifact.Registry, m.credentialStore, m.srcCertificates, m.insecure))
+	if err != nil {
+		return fail(fmt.Errorf("error with repository %s: %v", artifact.Registry, err))
+	}
+
+	if artifact.Digest == "" {
+		srcStorage, err := src.GetStorage(ctx, artifact)
+		if err != nil {
+			return fail(fmt.Errorf("repository source: %v", err))
+		}
+		desc, err := src.Resolve(ctx, srcStorage, artifact.VersionedImage())
+		if err != nil {
+			return fail(fmt.Errorf("resolving source digest: %v", err))
+		}
+		artifact.Digest = desc.Digest.String()
+		result.Digest = artifact.Digest
+		result.Destination = dst.Destination(artifact)
+	}
+
+	logger.V(3).Info("Mirroring artifact", "source", result.Source, "destination", result.Destination)
+	if err = registry.Copy(ctx, src, dst, artifact); err != nil {
+		return fail(err)
+	}
+	if err = verifyMirroredArtifact(ctx, dst, artifact); err != nil {
+		return fail(err)
+	}
+	result.Status = MirrorVerified
+	return result
+}
+
+func verifyMirroredArtifact(ctx context.Context, dst registry.StorageClient, artifact registry.Artifact) error {
+	dstStorage, err := dst.GetStorage(ctx, artifact)
+	if err != nil {
+		return fmt.Errorf("repository destination: %v", err)
+	}
+
+	references := []registry.Artifact{artifact}
+	if artifact.Tag != "" {
+		tagged := artifact
+		tagged.Digest = ""
+		references = append(references, tagged)
+	}
+	for _, ref := range references {
+		desc, err := dst.Resolve(ctx, dstStorage, dst.Destination(ref))
+		if err != nil {
+			return fmt.Errorf("verifying %s: %v", dst.Destination(ref), err)
+		}
+		if desc.Digest.String() != artifact.Digest {
+			return fmt.Errorf("verifying %s: digest %s does not match source digest %s", dst.Destination(ref), desc.Digest, artifact.Digest)
+		}
+	}
+	return nil
+}
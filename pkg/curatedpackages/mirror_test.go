@@ -0,0 +1,232 @@
+package curatedpackages_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"oras.land/oras-go/v2/registry/remote"
+
+	"github.com/aws/eks-anywhere/pkg/curatedpackages"
+	"github.com/aws/eks-anywhere/pkg/registry"
+	registrymocks "github.com/aws/eks-anywhere/pkg/registry/mocks"
+	"github.com/aws/eks-anywhere/pkg/registrymirror"
+	releasev1 "github.com/aws/eks-anywhere/release/api/v1alpha1"
+)
+
+const mirrorDigest = "sha256:6efe21500abbfbb6b3e37b80dd5dea0b11a0d1b145e84298fee5d7784a77e967"
+
+type mirrorTest struct {
+	*WithT
+	ctx     context.Context
+	src     *registrymocks.MockStorageClient
+	dst     *registrymocks.MockStorageClient
+	srcRepo *remote.Repository
+	dstRepo *remote.Repository
+	mirror  *curatedpackages.PackageMirror
+}
+
+func newMirrorTest(t *testing.T, dryRun bool) *mirrorTest {
+	ctrl := gomock.NewController(t)
+	src := registrymocks.NewMockStorageClient(ctrl)
+	cache := registry.NewCache()
+	cache.Set("public.ecr.aws", src)
+	srcRepo, err := remote.NewRepository("public.ecr.aws/owner/name")
+	if err != nil {
+		t.Fatal(err)
+	}
+	dstRepo, err := remote.NewRepository("harbor.local/owner/name")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &mirrorTest{
+		WithT:   NewWithT(t),
+		ctx:     context.Background(),
+		src:     src,
+		dst:     registrymocks.NewMockStorageClient(ctrl),
+		srcRepo: srcRepo,
+		dstRepo: dstRepo,
+		mirror:  curatedpackages.NewPackageMirror(cache, registry.NewCredentialStore(), nil, false, dryRun),
+	}
+}
+
+func (tt *mirrorTest) expectCopy(artifact registry.Artifact, destination string) {
+	tt.src.EXPECT().GetStorage(tt.ctx, artifact).Return(tt.srcRepo, nil)
+	// Once to copy the artifact and once to verify it.
+	tt.dst.EXPECT().GetStorage(tt.ctx, artifact).Return(tt.dstRepo, nil).Times(2)
+	tt.src.EXPECT().CopyGraph(tt.ctx, tt.srcRepo, artifact.VersionedImage(), tt.dstRepo, destination).Return(ocispec.Descriptor{Digest: mirrorDigest}, nil)
+	if artifact.Tag != "" {
+		tt.dst.EXPECT().Tag(tt.ctx, tt.dstRepo, gomock.Any(), artifact.Tag).Return(nil)
+	}
+}
+
+func TestFilterBundlesForKubeVersions(t *testing.T) {
+	g := NewWithT(t)
+	b := &releasev1.Bundles{Spec: releasev1.BundlesSpec{VersionsBundles: []releasev1.VersionsBundle{
+		{KubeVersion: "1.22"}, {KubeVersion: "1.23"}, {KubeVersion: "1.24"},
+	}}}
+
+	filtered, err := curatedpackages.FilterBundlesForKubeVersions(b, nil)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(filtered.Spec.VersionsBundles).To(HaveLen(3))
+
+	filtered, err = curatedpackages.FilterBundlesForKubeVersions(b, []string{"1.24", "1.22"})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(filtered.Spec.VersionsBundles).To(HaveLen(2))
+	g.Expect(filtered.Spec.VersionsBundles[0].KubeVersion).To(Equal("1.24"))
+	g.Expect(b.Spec.VersionsBundles).To(HaveLen(3))
+
+	_, err = curatedpackages.FilterBundlesForKubeVersions(b, []string{"1.25"})
+	g.Expect(err).To(MatchError("bundle does not support Kubernetes version 1.25"))
+}
+
+func TestPackageReader_ReadPackageArtifacts(t *testing.T) {
+	tt := newPackageReaderTest(t)
+	repo, err := remote.NewRepository("owner/name")
+	tt.Expect(err).NotTo(HaveOccurred())
+	tt.storageClient.EXPECT().GetStorage(tt.ctx, gomock.Any()).Return(repo, nil)
+	tt.storageClient.EXPECT().FetchBytes(tt.ctx, gomock.Any(), gomock.Any()).Return(desc, imageManifest, nil)
+	tt.storageClient.EXPECT().FetchBlob(tt.ctx, gomock.Any(), gomock.Any()).Return(packageBundle, nil)
+
+	charts, images, err := tt.command.ReadPackageArtifacts(tt.ctx, tt.bundles)
+	tt.Expect(err).NotTo(HaveOccurred())
+	tt.Expect(charts).To(ContainElement(registry.NewArtifactFromURI("public.ecr.aws/l0g8r8j6/eks-anywhere-packages-bundles:v1-21-latest")))
+	tt.Expect(images).NotTo(BeEmpty())
+	for _, image := range images {
+		tt.Expect(image.Digest).NotTo(BeEmpty())
+	}
+}
+
+func TestPackageReader_ReadPackageArtifactsFromRegistryMirror(t *testing.T) {
+	tt := newPackageReaderTest(t)
+	mirrorClient := registrymocks.NewMockStorageClient(gomock.NewController(t))
+	cache := registry.NewCache()
+	cache.Set("harbor.local", mirrorClient)
+	mirror := &registrymirror.RegistryMirror{
+		BaseRegistry:          "harbor.local",
+		NamespacedRegistryMap: map[string]string{"public.ecr.aws": "harbor.local/ecr"},
+	}
+	reader := curatedpackages.NewPackageReader(cache, registry.NewCredentialStore()).WithRegistryMirror(mirror, nil, false)
+	repo, err := remote.NewRepository("harbor.local/ecr/l0g8r8j6/eks-anywhere-packages-bundles")
+	tt.Expect(err).NotTo(HaveOccurred())
+	mirrored := registry.NewArtifactFromURI("harbor.local/ecr/l0g8r8j6/eks-anywhere-packages-bundles:v1-21-latest")
+	mirrorClient.EXPECT().GetStorage(tt.ctx, mirrored).Return(repo, nil)
+	mirrorClient.EXPECT().FetchBytes(tt.ctx, repo, mirrored).Return(desc, imageManifest, nil)
+	mirrorClient.EXPECT().FetchBlob(tt.ctx, repo, gomock.Any()).Return(packageBundle, nil)
+
+	charts, _, err := reader.ReadPackageArtifacts(tt.ctx, tt.bundles)
+	tt.Expect(err).NotTo(HaveOccurred())
+	tt.Expect(charts).To(ContainElement(registry.NewArtifactFromURI("public.ecr.aws/l0g8r8j6/eks-anywhere-packages-bundles:v1-21-latest")))
+}
+
+func TestPackageReader_ReadPackageArtifactsBundlePullError(t *testing.T) {
+	tt := newPackageReaderTest(t)
+	tt.storageClient.EXPECT().GetStorage(tt.ctx, gomock.Any()).Return(nil, errors.New("no access"))
+
+	_, _, err := tt.command.ReadPackageArtifacts(tt.ctx, tt.bundles)
+	tt.Expect(err).To(MatchError(ContainSubstring("reading package bundle for Kubernetes version 1.21")))
+}
+
+func TestPackageMirror(t *testing.T) {
+	tt := newMirrorTest(t, false)
+	image := registry.NewArtifact("public.ecr.aws", "l0g8r8j6/harbor/harbor-core", "", mirrorDigest)
+	destination := "harbor.local/l0g8r8j6/harbor/harbor-core@" + mirrorDigest
+
+	tt.dst.EXPECT().Destination(image).Return(destination).Times(3)
+	tt.expectCopy(image, destination)
+	tt.dst.EXPECT().Resolve(tt.ctx, tt.dstRepo, destination).Return(ocispec.Descriptor{Digest: mirrorDigest}, nil)
+
+	report := tt.mirror.Mirror(tt.ctx, tt.dst, []registry.Artifact{image})
+	tt.Expect(report.Failed()).To(Equal(0))
+	tt.Expect(report.Artifacts).To(Equal([]curatedpackages.MirroredArtifact{{
+		Source:      image.VersionedImage(),
+		Destination: destination,
+		Digest:      mirrorDigest,
+		Status:      curatedpackages.MirrorVerified,
+	}}))
+}
+
+func TestPackageMirrorResolvesTaggedArtifact(t *testing.T) {
+	tt := newMirrorTest(t, false)
+	bundle := registry.NewArtifact("public.ecr.aws", "l0g8r8j6/eks-anywhere-packages-bundles", "v1-21-latest", "")
+	pinned := bundle
+	pinned.Digest = mirrorDigest
+	tagDestination := "harbor.local/l0g8r8j6/eks-anywhere-packages-bundles:v1-21-latest"
+	digestDestination := "harbor.local/l0g8r8j6/eks-anywhere-packages-bundles@" + mirrorDigest
+
+	tt.dst.EXPECT().Destination(bundle).Return(tagDestination).AnyTimes()
+	tt.dst.EXPECT().Destination(pinned).Return(digestDestination).AnyTimes()
+	tt.src.EXPECT().GetStorage(tt.ctx, bundle).Return(tt.srcRepo, nil)
+	tt.src.EXPECT().Resolve(tt.ctx, tt.srcRepo, bundle.VersionedImage()).Return(ocispec.Descriptor{Digest: mirrorDigest}, nil)
+	tt.expectCopy(pinned, digestDestination)
+	tt.dst.EXPECT().Resolve(tt.ctx, tt.dstRepo, digestDestination).Return(ocispec.Descriptor{Digest: mirrorDigest}, nil)
+	tt.dst.EXPECT().Resolve(tt.ctx, tt.dstRepo, tagDestination).Return(ocispec.Descriptor{Digest: mirrorDigest}, nil)
+
+	report := tt.mirror.Mirror(tt.ctx, tt.dst, []registry.Artifact{bundle})
+	tt.Expect(report.Failed()).To(Equal(0))
+	tt.Expect(report.Artifacts[0].Digest).To(Equal(mirrorDigest))
+	tt.Expect(report.Artifacts[0].Destination).To(Equal(digestDestination))
+}
+
+func TestPackageMirrorVerificationFailure(t *testing.T) {
+	tt := newMirrorTest(t, false)
+	image := registry.NewArtifact("public.ecr.aws", "l0g8r8j6/harbor/harbor-core", "", mirrorDigest)
+	destination := "harbor.local/l0g8r8j6/harbor/harbor-core@" + mirrorDigest
+
+	tt.dst.EXPECT().Destination(image).Return(destination).AnyTimes()
+	tt.expectCopy(image, destination)
+	tt.dst.EXPECT().Resolve(tt.ctx, tt.dstRepo, destination).Return(ocispec.Descriptor{}, errors.New("not found"))
+
+	report := tt.mirror.Mirror(tt.ctx, tt.dst, []registry.Artifact{image})
+	tt.Expect(report.Failed()).To(Equal(1))
+	tt.Expect(report.Artifacts[0].Status).To(Equal(curatedpackages.MirrorFailed))
+	tt.Expect(report.Artifacts[0].Error).To(Equal("verifying " + destination + ": not found"))
+}
+
+func TestPackageMirrorCopyFailureContinues(t *testing.T) {
+	tt := newMirrorTest(t, false)
+	first := registry.NewArtifact("public.ecr.aws", "l0g8r8j6/harbor/harbor-core", "", mirrorDigest)
+	second := registry.NewArtifact("public.ecr.aws", "l0g8r8j6/harbor/harbor-db", "", mirrorDigest)
+
+	tt.dst.EXPECT().Destination(first).Return("harbor.local/harbor-core").AnyTimes()
+	tt.dst.EXPECT().Destination(second).Return("harbor.local/harbor-db").AnyTimes()
+	tt.src.EXPECT().GetStorage(tt.ctx, first).Return(nil, errors.New("denied"))
+	tt.expectCopy(second, "harbor.local/harbor-db")
+	tt.dst.EXPECT().Resolve(tt.ctx, tt.dstRepo, "harbor.local/harbor-db").Return(ocispec.Descriptor{Digest: mirrorDigest}, nil)
+
+	report := tt.mirror.Mirror(tt.ctx, tt.dst, []registry.Artifact{first, second})
+	tt.Expect(report.Failed()).To(Equal(1))
+	tt.Expect(report.Artifacts[0].Error).To(Equal("repository source: denied"))
+	tt.Expect(report.Artifacts[1].Status).To(Equal(curatedpackages.MirrorVerified))
+}
+
+func TestPackageMirrorDryRun(t *testing.T) {
+	tt := newMirrorTest(t, true)
+	image := registry.NewArtifact("public.ecr.aws", "l0g8r8j6/harbor/harbor-core", "", mirrorDigest)
+	tt.dst.EXPECT().Destination(image).Return("harbor.local/harbor-core")
+
+	report := tt.mirror.Mirror(tt.ctx, tt.dst, []registry.Artifact{image})
+	tt.Expect(report.Artifacts[0].Status).To(Equal(curatedpackages.MirrorSkipped))
+}
+
+func TestMirrorReportWrite(t *testing.T) {
+	g := NewWithT(t)
+	report := &curatedpackages.MirrorReport{Artifacts: []curatedpackages.MirroredArtifact{
+		{Source: "public.ecr.aws/a@" + mirrorDigest, Destination: "harbor.local/a@" + mirrorDigest, Digest: mirrorDigest, Status: curatedpackages.MirrorVerified},
+		{Source: "public.ecr.aws/b:v1", Destination: "harbor.local/b:v1", Status: curatedpackages.MirrorFailed, Error: "denied"},
+	}}
+
+	var table bytes.Buffer
+	g.Expect(report.WriteTable(&table)).To(Succeed())
+	g.Expect(table.String()).To(ContainSubstring("harbor.local/b:v1"))
+	g.Expect(table.String()).To(ContainSubstring("failed: denied"))
+
+	var out bytes.Buffer
+	g.Expect(report.WriteJSON(&out)).To(Succeed())
+	g.Expect(out.String()).To(ContainSubstring(`"status": "verified"`))
+	g.Expect(out.String()).To(ContainSubstring(`"error": "denied"`))
+}
@@ -47,3 +47,17 @@ func (mr *MockChartInstallerMockRecorder) InstallChart(ctx, chart, ociURI, versi
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstallChart", reflect.TypeOf((*MockChartInstaller)(nil).InstallChart), ctx, chart, ociURI, version, kubeconfigFilePath, namespace, valueFilePath, values)
 }
+
+// UpgradeChart mocks base method.
+func (m *MockChartInstaller) UpgradeChart(ctx context.Context, chart, ociURI, version, kubeconfigFilePath string, values []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpgradeChart", ctx, chart, ociURI, version, kubeconfigFilePath, values)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpgradeChart indicates an expected call of UpgradeChart.
+func (mr *MockChartInstallerMockRecorder) UpgradeChart(ctx, chart, ociURI, version, kubeconfigFilePath, values interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpgradeChart", reflect.TypeOf((*MockChartInstaller)(nil).UpgradeChart), ctx, chart, ociURI, version, kubeconfigFilePath, values)
+}
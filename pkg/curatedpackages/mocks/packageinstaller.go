@@ -8,6 +8,7 @@ import (
 	context "context"
 	reflect "reflect"
 
+	curatedpackages "github.com/aws/eks-anywhere/pkg/curatedpackages"
 	gomock "github.com/golang/mock/gomock"
 )
 
@@ -62,6 +63,20 @@ func (mr *MockPackageControllerMockRecorder) IsInstalled(ctx interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsInstalled", reflect.TypeOf((*MockPackageController)(nil).IsInstalled), ctx)
 }
 
+// UpdateProxyConfiguration mocks base method.
+func (m *MockPackageController) UpdateProxyConfiguration(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateProxyConfiguration", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateProxyConfiguration indicates an expected call of UpdateProxyConfiguration.
+func (mr *MockPackageControllerMockRecorder) UpdateProxyConfiguration(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProxyConfiguration", reflect.TypeOf((*MockPackageController)(nil).UpdateProxyConfiguration), ctx)
+}
+
 // MockPackageHandler is a mock of PackageHandler interface.
 type MockPackageHandler struct {
 	ctrl     *gomock.Controller
@@ -98,3 +113,17 @@ func (mr *MockPackageHandlerMockRecorder) CreatePackages(ctx, fileName, kubeConf
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePackages", reflect.TypeOf((*MockPackageHandler)(nil).CreatePackages), ctx, fileName, kubeConfig)
 }
+
+// CreatePackagesInOrder mocks base method.
+func (m *MockPackageHandler) CreatePackagesInOrder(ctx context.Context, fileName, kubeConfig string, dependencies *curatedpackages.BundleDependencies) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePackagesInOrder", ctx, fileName, kubeConfig, dependencies)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreatePackagesInOrder indicates an expected call of CreatePackagesInOrder.
+func (mr *MockPackageHandlerMockRecorder) CreatePackagesInOrder(ctx, fileName, kubeConfig, dependencies interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePackagesInOrder", reflect.TypeOf((*MockPackageHandler)(nil).CreatePackagesInOrder), ctx, fileName, kubeConfig, dependencies)
+}
@@ -24,6 +24,7 @@ type PackageClientOpt func(*PackageClient)
 
 type PackageClient struct {
 	bundle         *packagesv1.PackageBundle
+	dependencies   *BundleDependencies
 	customPackages []string
 	kubectl        KubectlRunner
 	customConfigs  []string
@@ -122,6 +123,9 @@ func (pc *PackageClient) InstallPackage(ctx context.Context, bp *packagesv1.Bund
 	if err != nil {
 		return err
 	}
+	if err = ValidateConfig(bp, "", configString); err != nil {
+		return err
+	}
 
 	p := convertBundlePackageToPackage(*bp, customName, clusterName, pc.bundle.APIVersion, configString)
 	displayPackage := NewDisplayablePackage(&p)
@@ -219,6 +223,13 @@ func WithBundle(bundle *packagesv1.PackageBundle) func(*PackageClient) {
 	}
 }
 
+// WithBundleDependencies sets the dependencies between the packages of the bundle.
+func WithBundleDependencies(dependencies *BundleDependencies) func(*PackageClient) {
+	return func(config *PackageClient) {
+		config.dependencies = dependencies
+	}
+}
+
 func WithCustomPackages(customPackages []string) func(*PackageClient) {
 	return func(config *PackageClient) {
 		config.customPackages = customPackages
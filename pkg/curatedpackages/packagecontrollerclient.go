@@ -54,6 +54,7 @@ type PackageControllerClient struct {
 
 type ChartInstaller interface {
 	InstallChart(ctx context.Context, chart, ociURI, version, kubeconfigFilePath, namespace, valueFilePath string, values []string) error
+	UpgradeChart(ctx context.Context, chart, ociURI, version, kubeconfigFilePath string, values []string) error
 }
 
 // NewPackageControllerClient instantiates a new instance of PackageControllerClient.
@@ -91,7 +92,6 @@ func (pc *PackageControllerClient) EnableCuratedPackages(ctx context.Context) er
 		return pc.InstallPBCResources(ctx, defaultRegistry, defaultImageRegistry)
 	}
 
-	ociURI := fmt.Sprintf("%s%s", "oci://", pc.registryMirror.ReplaceRegistry(pc.chart.Image()))
 	clusterName := fmt.Sprintf("clusterName=%s", pc.clusterName)
 	sourceRegistry = fmt.Sprintf("sourceRegistry=%s", sourceRegistry)
 	defaultRegistry = fmt.Sprintf("defaultRegistry=%s", defaultRegistry)
@@ -100,12 +100,7 @@ func (pc *PackageControllerClient) EnableCuratedPackages(ctx context.Context) er
 
 	// Provide proxy details for curated packages helm chart when proxy details provided
 	if pc.httpProxy != "" {
-		httpProxy := fmt.Sprintf("proxy.HTTP_PROXY=%s", pc.httpProxy)
-		httpsProxy := fmt.Sprintf("proxy.HTTPS_PROXY=%s", pc.httpsProxy)
-
-		// Helm requires commas to be escaped: https://github.com/rancher/rancher/issues/16195
-		noProxy := fmt.Sprintf("proxy.NO_PROXY=%s", strings.Join(pc.noProxy, "\\,"))
-		values = append(values, httpProxy, httpsProxy, noProxy)
+		values = append(values, pc.proxyValues()...)
 	}
 	if (pc.eksaSecretAccessKey == "" || pc.eksaAccessKeyID == "") && pc.registryMirror == nil {
 		values = append(values, "cronjob.suspend=true")
@@ -117,13 +112,42 @@ func (pc *PackageControllerClient) EnableCuratedPackages(ctx context.Context) er
 		return err
 	}
 
-	if err := pc.chartInstaller.InstallChart(ctx, pc.chart.Name, ociURI, pc.chart.Tag(), pc.kubeConfig, "", valueFilePath, values); err != nil {
+	err = pc.withChartFailover(func(ociURI string) error {
+		return pc.chartInstaller.InstallChart(ctx, pc.chart.Name, ociURI, pc.chart.Tag(), pc.kubeConfig, "", valueFilePath, values)
+	})
+	if err != nil {
 		return err
 	}
 
 	return pc.waitForActiveBundle(ctx)
 }
 
+// withChartFailover runs f with the OCI URI of the package controller chart. With failover registry mirrors,
+// f is run with the chart in each registry mirror until it succeeds.
+func (pc *PackageControllerClient) withChartFailover(f func(ociURI string) error) error {
+	var err error
+	for _, chartURI := range pc.registryMirror.ReplaceRegistryWithFailover(pc.chart.Image()) {
+		ociURI := fmt.Sprintf("%s%s", "oci://", chartURI)
+		if err = f(ociURI); err == nil {
+			return nil
+		}
+		logger.V(4).Info("Running curated packages controller chart failed", "chart", ociURI, "error", err)
+	}
+
+	return err
+}
+
+// proxyValues returns the helm values that configure the proxy of the package controller.
+// They are empty if the cluster doesn't use a proxy.
+func (pc *PackageControllerClient) proxyValues() []string {
+	return []string{
+		fmt.Sprintf("proxy.HTTP_PROXY=%s", pc.httpProxy),
+		fmt.Sprintf("proxy.HTTPS_PROXY=%s", pc.httpsProxy),
+		// Helm requires commas to be escaped: https://github.com/rancher/rancher/issues/16195
+		fmt.Sprintf("proxy.NO_PROXY=%s", strings.Join(pc.noProxy, "\\,")),
+	}
+}
+
 // GetCuratedPackagesRegistries gets value for configurable registries from PBC.
 func (pc *PackageControllerClient) GetCuratedPackagesRegistries() (sourceRegistry, defaultRegistry, defaultImageRegistry string) {
 	sourceRegistry = publicProdECR
@@ -143,6 +167,8 @@ func (pc *PackageControllerClient) GetCuratedPackagesRegistries() (sourceRegistr
 		defaultRegistry = fmt.Sprintf("%s/%s", pc.registryMirror.CoreEKSAMirror(), accountName)
 		if gatedOCINamespace := pc.registryMirror.CuratedPackagesMirror(); gatedOCINamespace != "" {
 			defaultImageRegistry = gatedOCINamespace
+		} else if pc.registryMirror.SkipsMirror(defaultImageRegistry) {
+			defaultImageRegistry = strings.ReplaceAll(defaultImageRegistry, defaultRegion, pc.eksaRegion)
 		}
 	} else {
 		defaultImageRegistry = strings.ReplaceAll(defaultImageRegistry, defaultRegion, pc.eksaRegion)
@@ -219,6 +245,25 @@ func (pc *PackageControllerClient) InstallPBCResources(ctx context.Context, defa
 	return nil
 }
 
+// UpdateProxyConfiguration upgrades the package controller chart with the proxy configuration of the cluster,
+// clearing it if the cluster doesn't use a proxy. The rest of the chart values are kept.
+// The package controller only runs in management clusters, so this is a no-op for workload clusters.
+func (pc *PackageControllerClient) UpdateProxyConfiguration(ctx context.Context) error {
+	if pc.managementClusterName != pc.clusterName {
+		return nil
+	}
+
+	values := pc.proxyValues()
+	err := pc.withChartFailover(func(ociURI string) error {
+		return pc.chartInstaller.UpgradeChart(ctx, pc.chart.Name, ociURI, pc.chart.Tag(), pc.kubeConfig, values)
+	})
+	if err != nil {
+		return fmt.Errorf("updating package controller proxy configuration: %v", err)
+	}
+
+	return nil
+}
+
 // packageBundleControllerResource is the name of the package bundle controller
 // resource in the API.
 const packageBundleControllerResource string = "packageBundleController"
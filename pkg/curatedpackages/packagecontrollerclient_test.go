@@ -380,6 +380,63 @@ func TestEnableCuratedPackagesFail(t *testing.T) {
 	}
 }
 
+func TestEnableCuratedPackagesRegistryMirrorFailover(t *testing.T) {
+	g := NewWithT(t)
+	ctrl := gomock.NewController(t)
+	k := mocks.NewMockKubectlRunner(ctrl)
+	ci := mocks.NewMockChartInstaller(ctrl)
+	ctx := context.Background()
+	chart := &artifactsv1.Image{
+		Name: "test_controller",
+		URI:  "public.ecr.aws/eks-anywhere/eks-anywhere-packages:v1",
+	}
+	registryMirror := &registrymirror.RegistryMirror{
+		BaseRegistry:       "1.2.3.4:443",
+		FailoverRegistries: []string{"1.2.3.5:443"},
+		NamespacedRegistryMap: map[string]string{
+			constants.DefaultCoreEKSARegistry: "1.2.3.4:443/public",
+		},
+	}
+	t.Setenv("REGISTRY_USERNAME", "username")
+	t.Setenv("REGISTRY_PASSWORD", "password")
+	writer, _ := filewriter.NewWriter("billy")
+	command := curatedpackages.NewPackageControllerClient(
+		ci, k, "billy", "kubeconfig.kubeconfig", chart, registryMirror,
+		curatedpackages.WithManagementClusterName("billy"),
+		curatedpackages.WithValuesFileWriter(writer),
+	)
+
+	any := gomock.Any()
+	gomock.InOrder(
+		ci.EXPECT().InstallChart(ctx, chart.Name, "oci://1.2.3.4:443/public/eks-anywhere/eks-anywhere-packages", chart.Tag(), "kubeconfig.kubeconfig", "", any, any).Return(errors.New("connection refused")),
+		ci.EXPECT().InstallChart(ctx, chart.Name, "oci://1.2.3.5:443/public/eks-anywhere/eks-anywhere-packages", chart.Tag(), "kubeconfig.kubeconfig", "", any, any).Return(nil),
+	)
+	k.EXPECT().GetObject(any, any, any, any, any, any).DoAndReturn(getPBCSuccess(t)).AnyTimes()
+
+	g.Expect(command.EnableCuratedPackages(ctx)).To(Succeed())
+}
+
+func TestGetCuratedPackagesRegistriesSkipMirror(t *testing.T) {
+	g := NewWithT(t)
+	chart := &artifactsv1.Image{
+		Name: "test_controller",
+		URI:  "public.ecr.aws/eks-anywhere/eks-anywhere-packages:v1",
+	}
+	registryMirror := &registrymirror.RegistryMirror{
+		BaseRegistry: "1.2.3.4:443",
+		NamespacedRegistryMap: map[string]string{
+			constants.DefaultCoreEKSARegistry: "1.2.3.4:443/public",
+		},
+		SkipMirrorUpstreams: []string{"783794618700.dkr.ecr.us-west-2.amazonaws.com"},
+	}
+	command := curatedpackages.NewPackageControllerClient(nil, nil, "billy", "kubeconfig.kubeconfig", chart, registryMirror, curatedpackages.WithEksaRegion("us-east-1"))
+
+	sourceRegistry, defaultRegistry, defaultImageRegistry := command.GetCuratedPackagesRegistries()
+	g.Expect(sourceRegistry).To(Equal("1.2.3.4:443/public/eks-anywhere"))
+	g.Expect(defaultRegistry).To(Equal("1.2.3.4:443/public/eks-anywhere"))
+	g.Expect(defaultImageRegistry).To(Equal("783794618700.dkr.ecr.us-east-1.amazonaws.com"))
+}
+
 func TestEnableCuratedPackagesFailNoActiveBundle(t *testing.T) {
 	for _, tt := range newPackageControllerTests(t) {
 		clusterName := fmt.Sprintf("clusterName=%s", "billy")
@@ -741,3 +798,70 @@ func TestCreateHelmOverrideValuesYamlFailWithWriteError(t *testing.T) {
 		tt.Expect(err).NotTo(BeNil())
 	}
 }
+
+func TestUpdateProxyConfiguration(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	ci := mocks.NewMockChartInstaller(gomock.NewController(t))
+	chart := &artifactsv1.Image{Name: "eks-anywhere-packages", URI: "test_registry/eks-anywhere/eks-anywhere-packages:v1"}
+	pc := curatedpackages.NewPackageControllerClient(ci, nil, "billy", "kubeconfig.kubeconfig", chart, nil,
+		curatedpackages.WithManagementClusterName("billy"),
+		curatedpackages.WithHTTPProxy("http://1.1.1.1:3128"),
+		curatedpackages.WithHTTPSProxy("http://1.1.1.1:3128"),
+		curatedpackages.WithNoProxy([]string{"1.1.1.1/24", ".svc"}),
+	)
+	values := []string{"proxy.HTTP_PROXY=http://1.1.1.1:3128", "proxy.HTTPS_PROXY=http://1.1.1.1:3128", "proxy.NO_PROXY=1.1.1.1/24\\,.svc"}
+	ci.EXPECT().UpgradeChart(ctx, "eks-anywhere-packages", "oci://test_registry/eks-anywhere/eks-anywhere-packages", "v1", "kubeconfig.kubeconfig", values).Return(nil)
+
+	g.Expect(pc.UpdateProxyConfiguration(ctx)).To(Succeed())
+}
+
+func TestUpdateProxyConfigurationRemoveProxy(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	ci := mocks.NewMockChartInstaller(gomock.NewController(t))
+	chart := &artifactsv1.Image{Name: "eks-anywhere-packages", URI: "test_registry/eks-anywhere/eks-anywhere-packages:v1"}
+	pc := curatedpackages.NewPackageControllerClient(ci, nil, "billy", "kubeconfig.kubeconfig", chart, nil,
+		curatedpackages.WithManagementClusterName("billy"),
+	)
+	values := []string{"proxy.HTTP_PROXY=", "proxy.HTTPS_PROXY=", "proxy.NO_PROXY="}
+	ci.EXPECT().UpgradeChart(ctx, "eks-anywhere-packages", "oci://test_registry/eks-anywhere/eks-anywhere-packages", "v1", "kubeconfig.kubeconfig", values).Return(errors.New("release not found"))
+
+	g.Expect(pc.UpdateProxyConfiguration(ctx)).To(MatchError(ContainSubstring("updating package controller proxy configuration: release not found")))
+}
+
+func TestUpdateProxyConfigurationFailoverRegistryMirror(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	ci := mocks.NewMockChartInstaller(gomock.NewController(t))
+	chart := &artifactsv1.Image{Name: "eks-anywhere-packages", URI: "public.ecr.aws/eks-anywhere/eks-anywhere-packages:v1"}
+	mirror := &registrymirror.RegistryMirror{
+		BaseRegistry:       "1.2.3.4:443",
+		FailoverRegistries: []string{"5.6.7.8:443"},
+		NamespacedRegistryMap: map[string]string{
+			constants.DefaultCoreEKSARegistry: "1.2.3.4:443/public",
+		},
+	}
+	pc := curatedpackages.NewPackageControllerClient(ci, nil, "billy", "kubeconfig.kubeconfig", chart, mirror,
+		curatedpackages.WithManagementClusterName("billy"),
+	)
+	values := []string{"proxy.HTTP_PROXY=", "proxy.HTTPS_PROXY=", "proxy.NO_PROXY="}
+	gomock.InOrder(
+		ci.EXPECT().UpgradeChart(ctx, "eks-anywhere-packages", "oci://1.2.3.4:443/public/eks-anywhere/eks-anywhere-packages", "v1", "kubeconfig.kubeconfig", values).Return(errors.New("unreachable")),
+		ci.EXPECT().UpgradeChart(ctx, "eks-anywhere-packages", "oci://5.6.7.8:443/public/eks-anywhere/eks-anywhere-packages", "v1", "kubeconfig.kubeconfig", values).Return(nil),
+	)
+
+	g.Expect(pc.UpdateProxyConfiguration(ctx)).To(Succeed())
+}
+
+func TestUpdateProxyConfigurationWorkloadCluster(t *testing.T) {
+	g := NewWithT(t)
+	k := mocks.NewMockKubectlRunner(gomock.NewController(t))
+	chart := &artifactsv1.Image{Name: "eks-anywhere-packages", URI: "test_registry/eks-anywhere/eks-anywhere-packages:v1"}
+	pc := curatedpackages.NewPackageControllerClient(nil, k, "billy", "kubeconfig.kubeconfig", chart, nil,
+		curatedpackages.WithManagementClusterName("mgmt"),
+		curatedpackages.WithHTTPProxy("http://1.1.1.1:3128"),
+	)
+
+	g.Expect(pc.UpdateProxyConfiguration(context.Background())).To(Succeed())
+}
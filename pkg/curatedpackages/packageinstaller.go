@@ -10,10 +10,12 @@ import (
 type PackageController interface {
 	EnableCuratedPackages(ctx context.Context) error
 	IsInstalled(ctx context.Context) bool
+	UpdateProxyConfiguration(ctx context.Context) error
 }
 
 type PackageHandler interface {
 	CreatePackages(ctx context.Context, fileName string, kubeConfig string) error
+	CreatePackagesInOrder(ctx context.Context, fileName string, kubeConfig string, dependencies *BundleDependencies) error
 }
 
 type Installer struct {
@@ -56,6 +58,24 @@ func (pi *Installer) InstallCuratedPackages(ctx context.Context) {
 	}
 }
 
+// UpgradeCuratedPackages updates the curated packages controller as part of the cluster upgrade.
+// It propagates the changes in the cluster proxy configuration to the package controller.
+func (pi *Installer) UpgradeCuratedPackages(ctx context.Context, currentSpec *cluster.Spec) {
+	if currentSpec.Cluster.Spec.ProxyConfiguration.Equal(pi.spec.Cluster.Spec.ProxyConfiguration) {
+		return
+	}
+
+	if !pi.packageController.IsInstalled(ctx) {
+		return
+	}
+
+	logger.Info("Updating curated packages controller proxy configuration")
+	// Like during the installation, failing to update the optional package controller is only a warning.
+	if err := pi.packageController.UpdateProxyConfiguration(ctx); err != nil {
+		logger.MarkWarning("  Failed to update the proxy configuration of the EKS-A Curated Package Controller", "warning", err)
+	}
+}
+
 func (pi *Installer) installPackagesController(ctx context.Context) error {
 	logger.Info("Enabling curated packages on the cluster")
 	err := pi.packageController.EnableCuratedPackages(ctx)
@@ -69,9 +89,14 @@ func (pi *Installer) installPackages(ctx context.Context) error {
 	if pi.packagesLocation == "" {
 		return nil
 	}
-	err := pi.packageClient.CreatePackages(ctx, pi.packagesLocation, pi.mgmtKubeconfig)
+
+	// The active bundle holds the dependencies between packages, so they can be created in order.
+	bundleReader := NewBundleReader(pi.mgmtKubeconfig, pi.spec.Cluster.Name, pi.kubectl, nil, nil)
+	dependencies, err := bundleReader.GetActiveBundleDependencies(ctx)
 	if err != nil {
-		return err
+		logger.V(4).Info("Unable to read the active package bundle, creating packages in file order", "error", err)
+		return pi.packageClient.CreatePackages(ctx, pi.packagesLocation, pi.mgmtKubeconfig)
 	}
-	return nil
+
+	return pi.packageClient.CreatePackagesInOrder(ctx, pi.packagesLocation, pi.mgmtKubeconfig, dependencies)
 }
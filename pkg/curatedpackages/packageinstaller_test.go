@@ -1,6 +1,7 @@
 package curatedpackages_test
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"testing"
@@ -9,8 +10,10 @@ import (
 	. "github.com/onsi/gomega"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	packagesv1 "github.com/aws/eks-anywhere-packages/api/v1alpha1"
 	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
 	"github.com/aws/eks-anywhere/pkg/cluster"
+	"github.com/aws/eks-anywhere/pkg/constants"
 	"github.com/aws/eks-anywhere/pkg/curatedpackages"
 	"github.com/aws/eks-anywhere/pkg/curatedpackages/mocks"
 	"github.com/aws/eks-anywhere/pkg/kubeconfig"
@@ -68,9 +71,15 @@ func newPackageInstallerTest(t *testing.T) *packageInstallerTest {
 	}
 }
 
+func (tt *packageInstallerTest) expectGetActiveController() *gomock.Call {
+	params := []string{"get", "packageBundleController", "-o", "json", "--kubeconfig", tt.kubeConfigPath, "--namespace", constants.EksaPackagesName, tt.spec.Cluster.Name}
+	return tt.kubectlRunner.EXPECT().ExecuteCommand(tt.ctx, params)
+}
+
 func TestPackageInstallerSuccess(t *testing.T) {
 	tt := newPackageInstallerTest(t)
 
+	tt.expectGetActiveController().Return(bytes.Buffer{}, errors.New("no controller"))
 	tt.packageClient.EXPECT().CreatePackages(tt.ctx, tt.packagePath, tt.kubeConfigPath).Return(nil)
 	tt.packageControllerClient.EXPECT().EnableCuratedPackages(tt.ctx).Return(nil)
 
@@ -88,8 +97,61 @@ func TestPackageInstallerFailWhenControllerFails(t *testing.T) {
 func TestPackageInstallerFailWhenPackageFails(t *testing.T) {
 	tt := newPackageInstallerTest(t)
 
+	tt.expectGetActiveController().Return(bytes.Buffer{}, errors.New("no controller"))
 	tt.packageClient.EXPECT().CreatePackages(tt.ctx, tt.packagePath, tt.kubeConfigPath).Return(errors.New("path doesn't exist"))
 	tt.packageControllerClient.EXPECT().EnableCuratedPackages(tt.ctx).Return(nil)
 
 	tt.command.InstallCuratedPackages(tt.ctx)
 }
+
+func TestPackageInstallerCreatesPackagesInDependencyOrder(t *testing.T) {
+	tt := newPackageInstallerTest(t)
+	controller := packagesv1.PackageBundleController{Spec: packagesv1.PackageBundleControllerSpec{ActiveBundle: "v1-21-1001"}}
+	bundle := `{"metadata": {"name": "v1-21-1001"}, "spec": {"packages": [{"name": "harbor", "source": {"versions": [{"name": "2.5.1", "dependencies": ["cert-manager"]}]}}]}}`
+	bundleParams := []string{"get", "packageBundle", "-o", "json", "--kubeconfig", tt.kubeConfigPath, "--namespace", constants.EksaPackagesName, "v1-21-1001"}
+
+	tt.packageControllerClient.EXPECT().EnableCuratedPackages(tt.ctx).Return(nil)
+	tt.expectGetActiveController().Return(convertJsonToBytes(controller), nil)
+	tt.kubectlRunner.EXPECT().ExecuteCommand(tt.ctx, bundleParams).Return(*bytes.NewBufferString(bundle), nil)
+	tt.packageClient.EXPECT().CreatePackagesInOrder(tt.ctx, tt.packagePath, tt.kubeConfigPath, gomock.Any()).DoAndReturn(
+		func(_ context.Context, _, _ string, dependencies *curatedpackages.BundleDependencies) error {
+			tt.Expect(dependencies.Of("harbor", "")).To(Equal([]string{"cert-manager"}))
+			return nil
+		},
+	)
+
+	tt.command.InstallCuratedPackages(tt.ctx)
+}
+
+func TestPackageInstallerUpgradeCuratedPackagesProxyChanged(t *testing.T) {
+	tt := newPackageInstallerTest(t)
+	currentSpec := &cluster.Spec{Config: &cluster.Config{Cluster: tt.spec.Cluster.DeepCopy()}}
+	tt.spec.Cluster.Spec.ProxyConfiguration = &anywherev1.ProxyConfiguration{
+		HttpProxy:  "http://1.1.1.1:3128",
+		HttpsProxy: "http://1.1.1.1:3128",
+	}
+
+	tt.packageControllerClient.EXPECT().IsInstalled(tt.ctx).Return(true)
+	tt.packageControllerClient.EXPECT().UpdateProxyConfiguration(tt.ctx).Return(errors.New("failed"))
+
+	tt.command.UpgradeCuratedPackages(tt.ctx, currentSpec)
+}
+
+func TestPackageInstallerUpgradeCuratedPackagesNotInstalled(t *testing.T) {
+	tt := newPackageInstallerTest(t)
+	currentSpec := &cluster.Spec{Config: &cluster.Config{Cluster: tt.spec.Cluster.DeepCopy()}}
+	tt.spec.Cluster.Spec.ProxyConfiguration = &anywherev1.ProxyConfiguration{
+		HttpProxy:  "http://1.1.1.1:3128",
+		HttpsProxy: "http://1.1.1.1:3128",
+	}
+
+	tt.packageControllerClient.EXPECT().IsInstalled(tt.ctx).Return(false)
+
+	tt.command.UpgradeCuratedPackages(tt.ctx, currentSpec)
+}
+
+func TestPackageInstallerUpgradeCuratedPackagesProxyNotChanged(t *testing.T) {
+	tt := newPackageInstallerTest(t)
+
+	tt.command.UpgradeCuratedPackages(tt.ctx, &cluster.Spec{Config: &cluster.Config{Cluster: tt.spec.Cluster.DeepCopy()}})
+}
@@ -2,6 +2,7 @@ package curatedpackages
 
 import (
 	"context"
+	"crypto/x509"
 	"fmt"
 	"sort"
 	"strings"
@@ -11,6 +12,7 @@ import (
 	packagesv1 "github.com/aws/eks-anywhere-packages/api/v1alpha1"
 	"github.com/aws/eks-anywhere/pkg/logger"
 	"github.com/aws/eks-anywhere/pkg/registry"
+	"github.com/aws/eks-anywhere/pkg/registrymirror"
 	releasev1 "github.com/aws/eks-anywhere/release/api/v1alpha1"
 )
 
@@ -29,6 +31,9 @@ const (
 type PackageReader struct {
 	cache           *registry.Cache
 	credentialStore *registry.CredentialStore
+	registryMirror  *registrymirror.RegistryMirror
+	certificates    *x509.CertPool
+	insecure        bool
 }
 
 // NewPackageReader create a new package reader with storage client.
@@ -39,6 +44,15 @@ func NewPackageReader(cache *registry.Cache, credentialStore *registry.Credentia
 	}
 }
 
+// WithRegistryMirror makes the reader pull the package bundles from the registry mirror, with the
+// certificates of the mirror. The artifacts read from the bundles still reference their upstream registries.
+func (r *PackageReader) WithRegistryMirror(mirror *registrymirror.RegistryMirror, certificates *x509.CertPool, insecure bool) *PackageReader {
+	r.registryMirror = mirror
+	r.certificates = certificates
+	r.insecure = insecure
+	return r
+}
+
 // ReadImagesFromBundles and return a list of image artifacts.
 func (r *PackageReader) ReadImagesFromBundles(ctx context.Context, b *releasev1.Bundles) ([]registry.Artifact, error) {
 	var err error
@@ -80,12 +94,13 @@ func (r *PackageReader) getBundle(ctx context.Context, vb releasev1.VersionsBund
 	}
 
 	artifact := registry.NewArtifactFromURI(bundleURI)
-	sc, err := r.cache.Get(registry.NewStorageContext(artifact.Registry, r.credentialStore, nil, false))
+	source := registry.NewArtifactFromURI(r.registryMirror.ReplaceRegistry(bundleURI))
+	sc, err := r.cache.Get(registry.NewStorageContext(source.Registry, r.credentialStore, r.certificates, r.insecure))
 	if err != nil {
 		return "", nil, err
 	}
 
-	data, err := registry.PullBytes(ctx, sc, artifact)
+	data, err := registry.PullBytes(ctx, sc, source)
 	if err != nil {
 		return "", nil, err
 	}
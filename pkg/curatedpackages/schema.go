@@ -0,0 +1,233 @@
+package curatedpackages
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/xeipuuv/gojsonschema"
+	yamlutil "k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/yaml"
+
+	packagesv1 "github.com/aws/eks-anywhere-packages/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/constants"
+	"github.com/aws/eks-anywhere/pkg/logger"
+)
+
+// ConfigValidationError lists the problems found validating a package configuration against its schema.
+type ConfigValidationError struct {
+	Package string
+	Errors  []string
+}
+
+func (e *ConfigValidationError) Error() string {
+	return fmt.Sprintf("invalid configuration for package %s:\n- %s", e.Package, strings.Join(e.Errors, "\n- "))
+}
+
+// ValidateConfig validates a yaml package configuration against the json schema of the package version
+// in the bundle. version can be a version name or digest and defaults to the first version of the
+// package. Packages without schema accept any configuration. Unknown configuration keys are logged as
+// warnings, see CheckConfig.
+func ValidateConfig(bp *packagesv1.BundlePackage, version string, config string) error {
+	unknown, err := CheckConfig(bp, version, config)
+	for _, key := range unknown {
+		logger.MarkWarning("Unknown configuration key, check it for typos", "package", bp.Name, "key", key)
+	}
+	return err
+}
+
+// CheckConfig validates a package configuration like ValidateConfig and returns the configuration keys
+// that are not properties of the schema. Json schema only rejects them when additionalProperties is
+// false, but for packages they are almost always typos, so they are returned apart instead of failing
+// the validation. Keys under an object that sets additionalProperties or patternProperties are not
+// returned, since the schema already decides whether they are allowed.
+func CheckConfig(bp *packagesv1.BundlePackage, version string, config string) (unknownKeys []string, err error) {
+	schema, err := packageSchema(bp, version)
+	if err != nil {
+		return nil, err
+	}
+	if schema == nil {
+		return nil, nil
+	}
+
+	configJson := []byte("{}")
+	if strings.TrimSpace(config) != "" {
+		if configJson, err = yaml.YAMLToJSON([]byte(config)); err != nil {
+			return nil, fmt.Errorf("parsing configuration for package %s: %v", bp.Name, err)
+		}
+	}
+
+	result, err := gojsonschema.Validate(gojsonschema.NewBytesLoader(schema), gojsonschema.NewBytesLoader(configJson))
+	if err != nil {
+		return nil, fmt.Errorf("validating configuration for package %s: %v", bp.Name, err)
+	}
+
+	unknownKeys = findUnknownKeys(schema, configJson)
+	var errs []string
+	for _, e := range result.Errors() {
+		errs = append(errs, e.String())
+	}
+	if len(errs) > 0 {
+		sort.Strings(errs)
+		return unknownKeys, &ConfigValidationError{Package: bp.Name, Errors: errs}
+	}
+	return unknownKeys, nil
+}
+
+func findUnknownKeys(schema, config []byte) []string {
+	s := map[string]interface{}{}
+	c := map[string]interface{}{}
+	if json.Unmarshal(schema, &s) != nil || json.Unmarshal(config, &c) != nil {
+		return nil
+	}
+	var keys []string
+	collectUnknownKeys(s, c, "", &keys)
+	sort.Strings(keys)
+	return keys
+}
+
+func collectUnknownKeys(schema map[string]interface{}, config map[string]interface{}, path string, keys *[]string) {
+	properties, ok := schema["properties"].(map[string]interface{})
+	if !ok {
+		return
+	}
+	_, hasAdditional := schema["additionalProperties"]
+	_, hasPatterns := schema["patternProperties"]
+
+	for key, value := range config {
+		keyPath := key
+		if path != "" {
+			keyPath = path + "." + key
+		}
+		property, known := properties[key].(map[string]interface{})
+		if !known {
+			if !hasAdditional && !hasPatterns {
+				*keys = append(*keys, keyPath)
+			}
+			continue
+		}
+		if nested, ok := value.(map[string]interface{}); ok {
+			collectUnknownKeys(property, nested, keyPath, keys)
+		}
+	}
+}
+
+func packageSchema(bp *packagesv1.BundlePackage, version string) ([]byte, error) {
+	versions := bp.Source.Versions
+	if len(versions) == 0 {
+		return nil, fmt.Errorf("package %s does not contain any versions", bp.Name)
+	}
+
+	selected := versions[0]
+	if version != "" {
+		found := false
+		for _, v := range versions {
+			if v.Name == version || v.Digest == version {
+				selected, found = v, true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("package %s does not have version %s", bp.Name, version)
+		}
+	}
+	if selected.Schema == "" {
+		return nil, nil
+	}
+
+	// GetJsonSchema decodes the schema of the first version.
+	withVersion := packagesv1.BundlePackage{Name: bp.Name, Source: packagesv1.BundlePackageSource{Versions: []packagesv1.SourceVersion{selected}}}
+	schema, err := withVersion.GetJsonSchema()
+	if err != nil {
+		return nil, fmt.Errorf("reading configuration schema of package %s: %v", bp.Name, err)
+	}
+	return schema, nil
+}
+
+// ValidatePackages validates the configuration of the packages against the schemas in the bundle.
+func (pc *PackageClient) ValidatePackages(packages []packagesv1.Package) error {
+	var errs []string
+	for _, p := range packages {
+		bp, err := pc.GetPackageFromBundle(p.Spec.PackageName)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %v", p.Name, err))
+			continue
+		}
+		if err = ValidateConfig(bp, p.Spec.PackageVersion, p.Spec.Config); err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %v", p.Name, err))
+		}
+	}
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "\n"))
+	}
+	return nil
+}
+
+// ValidateCustomConfigs validates the custom configurations of the client against the schema of the package.
+func (pc *PackageClient) ValidateCustomConfigs(packageName string) error {
+	bp, err := pc.GetPackageFromBundle(packageName)
+	if err != nil {
+		return err
+	}
+	config, err := pc.getInstallConfigurations()
+	if err != nil {
+		return err
+	}
+	return ValidateConfig(bp, "", config)
+}
+
+// ReadPackagesFile reads the Package resources in a yaml file. Other resources are ignored.
+func ReadPackagesFile(fileName string) ([]packagesv1.Package, error) {
+	content, err := os.ReadFile(fileName)
+	if err != nil {
+		return nil, fmt.Errorf("reading packages file: %v", err)
+	}
+
+	var packages []packagesv1.Package
+	reader := yamlutil.NewYAMLReader(bufio.NewReader(bytes.NewReader(content)))
+	for {
+		doc, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("reading packages file: %v", err)
+		}
+		if len(bytes.TrimSpace(doc)) == 0 {
+			continue
+		}
+
+		p := packagesv1.Package{}
+		if err := yaml.Unmarshal(doc, &p); err != nil {
+			return nil, fmt.Errorf("parsing packages file: %v", err)
+		}
+		if p.Kind == kind {
+			packages = append(packages, p)
+		}
+	}
+	return packages, nil
+}
+
+// ClusterNameForPackages returns the cluster the packages are installed in, from their namespace. It
+// returns an empty name if the packages don't share a namespace for a single cluster.
+func ClusterNameForPackages(packages []packagesv1.Package) string {
+	prefix := constants.EksaPackagesName + "-"
+	clusterName := ""
+	for _, p := range packages {
+		if !strings.HasPrefix(p.Namespace, prefix) {
+			return ""
+		}
+		name := strings.TrimPrefix(p.Namespace, prefix)
+		if clusterName != "" && name != clusterName {
+			return ""
+		}
+		clusterName = name
+	}
+	return clusterName
+}
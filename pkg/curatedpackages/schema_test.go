@@ -0,0 +1,209 @@
+package curatedpackages_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	packagesv1 "github.com/aws/eks-anywhere-packages/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/curatedpackages"
+	"github.com/aws/eks-anywhere/pkg/curatedpackages/mocks"
+)
+
+const harborSchema = `{
+  "$schema": "http://json-schema.org/schema#",
+  "type": "object",
+  "properties": {
+    "externalURL": {"type": "string"},
+    "secretKey": {"type": "string"},
+    "expose": {
+      "type": "object",
+      "properties": {
+        "tls": {
+          "type": "object",
+          "properties": {
+            "enabled": {"type": "boolean"}
+          },
+          "additionalProperties": false
+        }
+      }
+    },
+    "labels": {
+      "type": "object",
+      "additionalProperties": {"type": "string"}
+    }
+  },
+  "required": ["secretKey"]
+}`
+
+func encodeSchema(t *testing.T, schema string) string {
+	var b bytes.Buffer
+	w := gzip.NewWriter(&b)
+	if _, err := w.Write([]byte(schema)); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return base64.StdEncoding.EncodeToString(b.Bytes())
+}
+
+func harborBundlePackage(t *testing.T) *packagesv1.BundlePackage {
+	return &packagesv1.BundlePackage{
+		Name: "harbor",
+		Source: packagesv1.BundlePackageSource{
+			Versions: []packagesv1.SourceVersion{
+				{Name: "2.5.1", Digest: "sha256:abc", Schema: encodeSchema(t, harborSchema)},
+				{Name: "2.4.0", Digest: "sha256:def"},
+			},
+		},
+	}
+}
+
+func TestValidateConfigValid(t *testing.T) {
+	g := NewWithT(t)
+	config := "secretKey: use-a-secret-key\nexpose:\n  tls:\n    enabled: false\nlabels:\n  team: platform\n"
+
+	g.Expect(curatedpackages.ValidateConfig(harborBundlePackage(t), "", config)).To(Succeed())
+}
+
+func TestValidateConfigErrors(t *testing.T) {
+	g := NewWithT(t)
+	config := "externalURL: 443\nexpose:\n  tls:\n    enabled: false\n    certSource: auto\nexposee: true\n"
+
+	unknownKeys, err := curatedpackages.CheckConfig(harborBundlePackage(t), "2.5.1", config)
+	g.Expect(unknownKeys).To(ConsistOf("exposee"))
+	g.Expect(err).To(HaveOccurred())
+	validationErr, ok := err.(*curatedpackages.ConfigValidationError)
+	g.Expect(ok).To(BeTrue())
+	g.Expect(validationErr.Package).To(Equal("harbor"))
+	g.Expect(validationErr.Errors).To(ConsistOf(
+		"(root): secretKey is required",
+		"externalURL: Invalid type. Expected: string, given: integer",
+		"expose.tls: Additional property certSource is not allowed",
+	))
+}
+
+func TestValidateConfigAllowsAdditionalProperties(t *testing.T) {
+	g := NewWithT(t)
+	config := "secretKey: use-a-secret-key\npersistence:\n  enabled: true\nexpose:\n  type: ingress\n"
+
+	g.Expect(curatedpackages.ValidateConfig(harborBundlePackage(t), "", config)).To(Succeed())
+}
+
+func TestCheckConfigUnknownKeys(t *testing.T) {
+	g := NewWithT(t)
+	config := "secretKey: use-a-secret-key\nexposee: true\nexpose:\n  type: ingress\n  tls:\n    enabled: true\nlabels:\n  team: platform\n"
+
+	unknownKeys, err := curatedpackages.CheckConfig(harborBundlePackage(t), "", config)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(unknownKeys).To(Equal([]string{"expose.type", "exposee"}))
+}
+
+func TestValidateConfigVersionWithoutSchema(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(curatedpackages.ValidateConfig(harborBundlePackage(t), "sha256:def", "anything: true")).To(Succeed())
+}
+
+func TestValidateConfigUnknownVersion(t *testing.T) {
+	g := NewWithT(t)
+
+	err := curatedpackages.ValidateConfig(harborBundlePackage(t), "1.0.0", "")
+	g.Expect(err).To(MatchError("package harbor does not have version 1.0.0"))
+}
+
+func TestValidateConfigInvalidSchema(t *testing.T) {
+	g := NewWithT(t)
+	bp := harborBundlePackage(t)
+	bp.Source.Versions[0].Schema = "not-base64"
+
+	err := curatedpackages.ValidateConfig(bp, "", "")
+	g.Expect(err).To(MatchError(ContainSubstring("reading configuration schema of package harbor")))
+}
+
+func TestValidatePackages(t *testing.T) {
+	g := NewWithT(t)
+	bundle := &packagesv1.PackageBundle{Spec: packagesv1.PackageBundleSpec{Packages: []packagesv1.BundlePackage{*harborBundlePackage(t)}}}
+	client := curatedpackages.NewPackageClient(nil, curatedpackages.WithBundle(bundle))
+	packages := []packagesv1.Package{
+		{ObjectMeta: metav1.ObjectMeta{Name: "my-harbor"}, Spec: packagesv1.PackageSpec{PackageName: "harbor", Config: "secretKey: key"}},
+	}
+
+	g.Expect(client.ValidatePackages(packages)).To(Succeed())
+
+	packages = append(packages,
+		packagesv1.Package{ObjectMeta: metav1.ObjectMeta{Name: "bad-harbor"}, Spec: packagesv1.PackageSpec{PackageName: "harbor"}},
+		packagesv1.Package{ObjectMeta: metav1.ObjectMeta{Name: "my-redis"}, Spec: packagesv1.PackageSpec{PackageName: "redis"}},
+	)
+	err := client.ValidatePackages(packages)
+	g.Expect(err).To(MatchError(ContainSubstring("bad-harbor: invalid configuration for package harbor:\n- (root): secretKey is required")))
+	g.Expect(err).To(MatchError(ContainSubstring("my-redis: package redis not found")))
+}
+
+func TestValidateCustomConfigs(t *testing.T) {
+	g := NewWithT(t)
+	bundle := &packagesv1.PackageBundle{Spec: packagesv1.PackageBundleSpec{Packages: []packagesv1.BundlePackage{*harborBundlePackage(t)}}}
+
+	client := curatedpackages.NewPackageClient(nil, curatedpackages.WithBundle(bundle), curatedpackages.WithCustomConfigs([]string{"secretKey=key", "expose.tls.enabled=true"}))
+	g.Expect(client.ValidateCustomConfigs("harbor")).To(Succeed())
+
+	client = curatedpackages.NewPackageClient(nil, curatedpackages.WithBundle(bundle), curatedpackages.WithCustomConfigs([]string{"secretKey=key", "expose.tls.enable=true"}))
+	g.Expect(client.ValidateCustomConfigs("harbor")).To(MatchError(ContainSubstring("expose.tls: Additional property enable is not allowed")))
+}
+
+func TestInstallPackageInvalidConfig(t *testing.T) {
+	g := NewWithT(t)
+	kubectl := mocks.NewMockKubectlRunner(gomock.NewController(t))
+	bundle := &packagesv1.PackageBundle{Spec: packagesv1.PackageBundleSpec{Packages: []packagesv1.BundlePackage{*harborBundlePackage(t)}}}
+	client := curatedpackages.NewPackageClient(kubectl, curatedpackages.WithBundle(bundle), curatedpackages.WithCustomConfigs([]string{"externalURL=https://harbor.example.com"}))
+
+	err := client.InstallPackage(context.Background(), &bundle.Spec.Packages[0], "my-harbor", "billy", "kubeconfig")
+	g.Expect(err).To(MatchError(ContainSubstring("secretKey is required")))
+}
+
+func TestReadPackagesFile(t *testing.T) {
+	g := NewWithT(t)
+	fileName := filepath.Join(t.TempDir(), "packages.yaml")
+	content := `apiVersion: packages.eks.amazonaws.com/v1alpha1
+kind: Package
+metadata:
+  name: my-harbor
+  namespace: eksa-packages-billy
+spec:
+  packageName: harbor
+  config: |
+    secretKey: key
+---
+apiVersion: v1
+kind: Secret
+metadata:
+  name: harbor-admin
+`
+	g.Expect(os.WriteFile(fileName, []byte(content), 0o644)).To(Succeed())
+
+	packages, err := curatedpackages.ReadPackagesFile(fileName)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(packages).To(HaveLen(1))
+	g.Expect(packages[0].Spec.Config).To(Equal("secretKey: key\n"))
+	g.Expect(curatedpackages.ClusterNameForPackages(packages)).To(Equal("billy"))
+}
+
+func TestClusterNameForPackages(t *testing.T) {
+	g := NewWithT(t)
+	pkg := func(namespace string) packagesv1.Package {
+		return packagesv1.Package{ObjectMeta: metav1.ObjectMeta{Namespace: namespace}}
+	}
+
+	g.Expect(curatedpackages.ClusterNameForPackages([]packagesv1.Package{pkg("eksa-packages-billy"), pkg("eksa-packages-susie")})).To(BeEmpty())
+	g.Expect(curatedpackages.ClusterNameForPackages([]packagesv1.Package{pkg("default")})).To(BeEmpty())
+	g.Expect(curatedpackages.ClusterNameForPackages([]packagesv1.Package{pkg("eksa-packages-billy"), pkg("eksa-packages-billy")})).To(Equal("billy"))
+}
@@ -32,12 +32,14 @@ import (
 	"github.com/aws/eks-anywhere/pkg/files"
 	"github.com/aws/eks-anywhere/pkg/filewriter"
 	gitfactory "github.com/aws/eks-anywhere/pkg/git/factory"
+	"github.com/aws/eks-anywhere/pkg/gitops/argocd"
 	"github.com/aws/eks-anywhere/pkg/gitops/flux"
 	"github.com/aws/eks-anywhere/pkg/govmomi"
 	"github.com/aws/eks-anywhere/pkg/kubeconfig"
 	"github.com/aws/eks-anywhere/pkg/logger"
 	"github.com/aws/eks-anywhere/pkg/manifests"
 	"github.com/aws/eks-anywhere/pkg/networking/cilium"
+	"github.com/aws/eks-anywhere/pkg/networking/custom"
 	"github.com/aws/eks-anywhere/pkg/networking/kindnetd"
 	"github.com/aws/eks-anywhere/pkg/networkutils"
 	"github.com/aws/eks-anywhere/pkg/providers"
@@ -50,6 +52,7 @@ import (
 	"github.com/aws/eks-anywhere/pkg/providers/validator"
 	"github.com/aws/eks-anywhere/pkg/providers/vsphere"
 	"github.com/aws/eks-anywhere/pkg/registrymirror"
+	"github.com/aws/eks-anywhere/pkg/servicelb"
 	"github.com/aws/eks-anywhere/pkg/types"
 	"github.com/aws/eks-anywhere/pkg/version"
 	"github.com/aws/eks-anywhere/pkg/workflow/task/workload"
@@ -76,9 +79,11 @@ type Dependencies struct {
 	CNIInstaller                workload.CNIInstaller
 	CiliumTemplater             *cilium.Templater
 	AwsIamAuth                  *awsiamauth.Installer
+	ServiceLoadBalancer         *servicelb.Installer
 	ClusterManager              *clustermanager.ClusterManager
 	Bootstrapper                *bootstrapper.Bootstrapper
 	GitOpsFlux                  *flux.Flux
+	GitOpsArgoCD                *argocd.ArgoCD
 	Git                         *gitfactory.GitTools
 	EksdInstaller               *eksd.Installer
 	EksdUpgrader                *eksd.Upgrader
@@ -129,6 +134,7 @@ type Factory struct {
 	proxyConfiguration       map[string]string
 	writerFolder             string
 	diagnosticCollectorImage string
+	bootstrapRegistryCache   string
 	buildSteps               []buildStep
 	dependencies             Dependencies
 }
@@ -696,6 +702,14 @@ func (f *Factory) WithNetworking(clusterConfig *v1alpha1.Cluster) *Factory {
 		networkingBuilder = func() clustermanager.Networking {
 			return kindnetd.NewKindnetd(f.dependencies.Kubectl)
 		}
+	} else if clusterConfig.Spec.ClusterNetwork.CNIConfig.Custom != nil {
+		f.WithKubectl().WithHelm()
+		networkingBuilder = func() clustermanager.Networking {
+			return custom.NewCustom(
+				custom.NewRetrier(f.dependencies.Kubectl),
+				f.dependencies.Helm,
+			)
+		}
 	} else {
 		f.WithKubectl().WithCiliumTemplater()
 		networkingBuilder = func() clustermanager.Networking {
@@ -722,6 +736,8 @@ func (f *Factory) WithNetworking(clusterConfig *v1alpha1.Cluster) *Factory {
 func (f *Factory) WithCNIInstaller(spec *cluster.Spec, provider providers.Provider) *Factory {
 	if spec.Cluster.Spec.ClusterNetwork.CNIConfig.Kindnetd != nil {
 		f.WithKubectl()
+	} else if spec.Cluster.Spec.ClusterNetwork.CNIConfig.Custom != nil {
+		f.WithKubectl().WithHelm()
 	} else {
 		f.WithKubectl().WithCiliumTemplater()
 	}
@@ -733,6 +749,12 @@ func (f *Factory) WithCNIInstaller(spec *cluster.Spec, provider providers.Provid
 
 		if spec.Cluster.Spec.ClusterNetwork.CNIConfig.Kindnetd != nil {
 			f.dependencies.CNIInstaller = kindnetd.NewInstallerForSpec(f.dependencies.Kubectl, spec)
+		} else if spec.Cluster.Spec.ClusterNetwork.CNIConfig.Custom != nil {
+			f.dependencies.CNIInstaller = custom.NewInstallerForSpec(
+				custom.NewRetrier(f.dependencies.Kubectl),
+				f.dependencies.Helm,
+				spec,
+			)
 		} else {
 			f.dependencies.CNIInstaller = cilium.NewInstallerForSpec(
 				cilium.NewRetrier(f.dependencies.Kubectl),
@@ -781,6 +803,21 @@ func (f *Factory) WithAwsIamAuth() *Factory {
 	return f
 }
 
+// WithServiceLoadBalancer builds the installer for the Service load balancer.
+func (f *Factory) WithServiceLoadBalancer() *Factory {
+	f.WithKubectl()
+
+	f.buildSteps = append(f.buildSteps, func(ctx context.Context) error {
+		if f.dependencies.ServiceLoadBalancer != nil {
+			return nil
+		}
+		f.dependencies.ServiceLoadBalancer = servicelb.NewInstaller(f.dependencies.Kubectl)
+		return nil
+	})
+
+	return f
+}
+
 // WithIPValidator builds the IPValidator for the given cluster.
 func (f *Factory) WithIPValidator() *Factory {
 	f.buildSteps = append(f.buildSteps, func(ctx context.Context) error {
@@ -798,6 +835,13 @@ type bootstrapperClient struct {
 	*executables.Kubectl
 }
 
+// WithBootstrapRegistryCache configures the bootstrapper to run a registry cache
+// alongside the bootstrap cluster, storing the cached images in cacheDir.
+func (f *Factory) WithBootstrapRegistryCache(cacheDir string) *Factory {
+	f.bootstrapRegistryCache = cacheDir
+	return f
+}
+
 func (f *Factory) WithBootstrapper() *Factory {
 	f.WithKind().WithKubectl()
 
@@ -806,7 +850,12 @@ func (f *Factory) WithBootstrapper() *Factory {
 			return nil
 		}
 
-		f.dependencies.Bootstrapper = bootstrapper.New(&bootstrapperClient{f.dependencies.Kind, f.dependencies.Kubectl})
+		var opts []bootstrapper.BootstrapperOpt
+		if f.bootstrapRegistryCache != "" {
+			opts = append(opts, bootstrapper.WithBootstrapClusterOptions(bootstrapper.WithRegistryCache(f.bootstrapRegistryCache)))
+		}
+
+		f.dependencies.Bootstrapper = bootstrapper.New(&bootstrapperClient{f.dependencies.Kind, f.dependencies.Kubectl}, opts...)
 		return nil
 	})
 
@@ -819,7 +868,7 @@ type clusterManagerClient struct {
 }
 
 func (f *Factory) WithClusterManager(clusterConfig *v1alpha1.Cluster, opts ...clustermanager.ClusterManagerOpt) *Factory {
-	f.WithClusterctl().WithKubectl().WithNetworking(clusterConfig).WithWriter().WithDiagnosticBundleFactory().WithAwsIamAuth()
+	f.WithClusterctl().WithKubectl().WithNetworking(clusterConfig).WithWriter().WithDiagnosticBundleFactory().WithAwsIamAuth().WithServiceLoadBalancer()
 
 	f.buildSteps = append(f.buildSteps, func(ctx context.Context) error {
 		if f.dependencies.ClusterManager != nil {
@@ -834,6 +883,7 @@ func (f *Factory) WithClusterManager(clusterConfig *v1alpha1.Cluster, opts ...cl
 			clustermanager.DefaultRetrier(),
 		)
 		installer := clustermanager.NewEKSAInstaller(client)
+		opts = append([]clustermanager.ClusterManagerOpt{clustermanager.WithServiceLoadBalancer(f.dependencies.ServiceLoadBalancer)}, opts...)
 
 		f.dependencies.ClusterManager = clustermanager.New(
 			client,
@@ -951,6 +1001,53 @@ func (f *Factory) WithGitOpsFlux(clusterConfig *v1alpha1.Cluster, fluxConfig *v1
 	return f
 }
 
+// WithGitArgoCD builds the git tools for the generic git repository in the ArgoCDConfig.
+func (f *Factory) WithGitArgoCD(clusterConfig *v1alpha1.Cluster, argoCDConfig *v1alpha1.ArgoCDConfig) *Factory {
+	f.WithWriter()
+	f.buildSteps = append(f.buildSteps, func(ctx context.Context) error {
+		if f.dependencies.Git != nil {
+			return nil
+		}
+
+		if argoCDConfig == nil {
+			return nil
+		}
+
+		tools, err := gitfactory.BuildForGitProvider(ctx, clusterConfig, argoCDConfig.Spec.Git, f.dependencies.Writer)
+		if err != nil {
+			return fmt.Errorf("creating Git provider: %v", err)
+		}
+
+		if err = tools.Client.ValidateRemoteExists(ctx); err != nil {
+			return err
+		}
+
+		f.dependencies.Git = tools
+		return nil
+	})
+	return f
+}
+
+func (f *Factory) WithGitOpsArgoCD(clusterConfig *v1alpha1.Cluster, argoCDConfig *v1alpha1.ArgoCDConfig, cliConfig *config.CliConfig) *Factory {
+	f.WithWriter().WithKubectl().WithGitArgoCD(clusterConfig, argoCDConfig)
+
+	f.buildSteps = append(f.buildSteps, func(ctx context.Context) error {
+		if f.dependencies.GitOpsArgoCD != nil {
+			return nil
+		}
+
+		var gitTools *gitfactory.GitTools
+		if argoCDConfig != nil {
+			gitTools = f.dependencies.Git
+		}
+		f.dependencies.GitOpsArgoCD = argocd.NewArgoCD(f.dependencies.Kubectl, gitTools, cliConfig)
+
+		return nil
+	})
+
+	return f
+}
+
 func (f *Factory) WithPackageInstaller(spec *cluster.Spec, packagesLocation, kubeConfig string) *Factory {
 	f.WithKubectl().WithPackageControllerClient(spec, kubeConfig).WithPackageClient()
 	f.buildSteps = append(f.buildSteps, func(ctx context.Context) error {
@@ -1263,7 +1360,7 @@ func (f *Factory) WithPrismClient(clusterConfigFile string) *Factory {
 func getProxyConfiguration(clusterSpec *cluster.Spec) (httpProxy, httpsProxy string, noProxy []string) {
 	proxyConfiguration := clusterSpec.Cluster.Spec.ProxyConfiguration
 	if proxyConfiguration != nil {
-		return proxyConfiguration.HttpProxy, proxyConfiguration.HttpsProxy, proxyConfiguration.NoProxy
+		return proxyConfiguration.HttpProxy, proxyConfiguration.HttpsProxy, cluster.NoProxyList(clusterSpec.Config)
 	}
 	return "", "", nil
 }
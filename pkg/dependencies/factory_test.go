@@ -185,6 +185,18 @@ func TestFactoryBuildWithClusterManagerWithoutCliConfig(t *testing.T) {
 	tt.Expect(deps.ClusterManager).NotTo(BeNil())
 }
 
+func TestFactoryBuildWithBootstrapperRegistryCache(t *testing.T) {
+	tt := newTest(t, vsphere)
+	deps, err := dependencies.NewFactory().
+		WithLocalExecutables().
+		WithBootstrapRegistryCache("registry-cache").
+		WithBootstrapper().
+		Build(context.Background())
+
+	tt.Expect(err).To(BeNil())
+	tt.Expect(deps.Bootstrapper).NotTo(BeNil())
+}
+
 func TestFactoryBuildWithMultipleDependencies(t *testing.T) {
 	configString := test.ReadFile(t, "testdata/cloudstack_config_multiple_profiles.ini")
 	encodedConfig := base64.StdEncoding.EncodeToString([]byte(configString))
@@ -198,6 +210,7 @@ func TestFactoryBuildWithMultipleDependencies(t *testing.T) {
 		WithClusterManager(tt.clusterSpec.Cluster).
 		WithProvider(tt.clusterConfigFile, tt.clusterSpec.Cluster, false, tt.hardwareConfigFile, false, tt.tinkerbellBootstrapIP).
 		WithGitOpsFlux(tt.clusterSpec.Cluster, tt.clusterSpec.FluxConfig, nil).
+		WithGitOpsArgoCD(tt.clusterSpec.Cluster, tt.clusterSpec.ArgoCDConfig, nil).
 		WithWriter().
 		WithEksdInstaller().
 		WithEksdUpgrader().
@@ -220,6 +233,7 @@ func TestFactoryBuildWithMultipleDependencies(t *testing.T) {
 	tt.Expect(deps.ClusterManager).NotTo(BeNil())
 	tt.Expect(deps.Provider).NotTo(BeNil())
 	tt.Expect(deps.GitOpsFlux).NotTo(BeNil())
+	tt.Expect(deps.GitOpsArgoCD).NotTo(BeNil())
 	tt.Expect(deps.Writer).NotTo(BeNil())
 	tt.Expect(deps.EksdInstaller).NotTo(BeNil())
 	tt.Expect(deps.EksdUpgrader).NotTo(BeNil())
@@ -215,15 +215,24 @@ func (a *analyzerFactory) eksaDockerAnalyzers() []*Analyze {
 
 // EksaLogTextAnalyzers given a slice of Collectors will check which namespaced log collectors are present
 // and return the log analyzers associated with the namespace in the namespaceLogTextAnalyzersMap.
+// It also returns the analyzers of the failure hints for the namespaced logs, crds and cluster resources collected.
 func (a *analyzerFactory) EksaLogTextAnalyzers(collectors []*Collect) []*Analyze {
 	var analyzers []*Analyze
 	analyzersMap := a.namespaceLogTextAnalyzersMap()
+	namespaceHints := namespaceFailureHints()
+	crdHints := crdFailureHints()
 	for _, collector := range collectors {
-		if collector.Logs != nil {
+		switch {
+		case collector.Logs != nil:
 			analyzer, ok := analyzersMap[collector.Logs.Namespace]
 			if ok {
 				analyzers = append(analyzers, analyzer...)
 			}
+			analyzers = append(analyzers, hintAnalyzers(namespaceHints[collector.Logs.Namespace])...)
+		case collector.RunPod != nil:
+			analyzers = append(analyzers, hintAnalyzers(crdHints[collector.RunPod.CollectorName])...)
+		case collector.ClusterResources != nil:
+			analyzers = append(analyzers, hintAnalyzers(clusterResourcesFailureHints())...)
 		}
 	}
 	return analyzers
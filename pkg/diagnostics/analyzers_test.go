@@ -83,3 +83,44 @@ func TestSnowAnalyzers(t *testing.T) {
 	analyzers := analyzerFactory.DataCenterConfigAnalyzers(datacenter)
 	g.Expect(analyzers).To(HaveLen(3), "DataCenterConfigAnalyzers() mismatch between desired analyzers and actual")
 }
+
+func TestEksaLogTextAnalyzersFailureHints(t *testing.T) {
+	g := NewGomegaWithT(t)
+	collectorFactory := diagnostics.NewDefaultCollectorFactory()
+	collectors := collectorFactory.DefaultCollectors()
+	collectors = append(collectors, collectorFactory.ManagementClusterCollectors()...)
+	collectors = append(collectors, collectorFactory.DataCenterConfigCollectors(eksav1alpha1.Ref{Kind: eksav1alpha1.TinkerbellDatacenterKind}, nil)...)
+	analyzerFactory := diagnostics.NewAnalyzerFactory()
+
+	analyzers := analyzerFactory.EksaLogTextAnalyzers(collectors)
+	var checks []string
+	for _, analyzer := range analyzers {
+		if analyzer.TextAnalyze != nil {
+			checks = append(checks, analyzer.TextAnalyze.CheckName)
+		}
+	}
+	g.Expect(checks).To(ContainElements(
+		"CAPI machines provisioning",
+		"KubeadmControlPlane ready",
+		"Tinkerbell workflows",
+		"kube-vip leader election",
+		"Expired certificates",
+		"Cilium agent",
+	))
+
+	machines := getTextAnalyzer(analyzers, "CAPI machines provisioning")
+	g.Expect(machines.TextAnalyze.FileName).To(Equal("crds/machines.cluster.x-k8s.io/machines.cluster.x-k8s.io.log"))
+	g.Expect(machines.TextAnalyze.Outcomes[0].Warn.URI).To(Equal("https://anywhere.eks.amazonaws.com/docs/tasks/troubleshoot/troubleshooting/#machines-stuck-provisioning"))
+	workflows := getTextAnalyzer(analyzers, "Tinkerbell workflows")
+	g.Expect(workflows.TextAnalyze.Outcomes[0].Fail.When).To(Equal("true"))
+}
+
+func getTextAnalyzer(analyzers []*diagnostics.Analyze, checkName string) *diagnostics.Analyze {
+	for _, analyzer := range analyzers {
+		if analyzer.TextAnalyze != nil && analyzer.TextAnalyze.CheckName == checkName {
+			return analyzer
+		}
+	}
+
+	return nil
+}
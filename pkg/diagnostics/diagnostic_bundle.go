@@ -3,6 +3,7 @@ package diagnostics
 import (
 	"context"
 	_ "embed"
+	"encoding/json"
 	"fmt"
 	"time"
 
@@ -24,12 +25,13 @@ import (
 var diagnosticCollectorRbac []byte
 
 const (
-	troubleshootApiVersion      = "troubleshoot.sh/v1beta2"
-	generatedBundleNameFormat   = "%s-%s-bundle.yaml"
-	generatedAnalysisNameFormat = "%s-%s-analysis.yaml"
-	maxRetries                  = 5
-	backOffPeriod               = 5 * time.Second
-	defaultClusterName          = "eksa-cluster"
+	troubleshootApiVersion          = "troubleshoot.sh/v1beta2"
+	generatedBundleNameFormat       = "%s-%s-bundle.yaml"
+	generatedAnalysisNameFormat     = "%s-%s-analysis.yaml"
+	generatedAnalysisJSONNameFormat = "%s-%s-analysis.json"
+	maxRetries                      = 5
+	backOffPeriod                   = 5 * time.Second
+	defaultClusterName              = "eksa-cluster"
 )
 
 type EksaDiagnosticBundle struct {
@@ -226,6 +228,23 @@ func (e *EksaDiagnosticBundle) PrintAnalysis() error {
 	return nil
 }
 
+// WriteAnalysisJSONToFile writes the analysis results as json, with the remediation of the known failures detected,
+// to a file next to the bundle. The results are not printed, so they are not mixed with the logs.
+func (e *EksaDiagnosticBundle) WriteAnalysisJSONToFile() (path string, err error) {
+	if e.analysis == nil {
+		return "", nil
+	}
+
+	jsonAnalysis, err := json.MarshalIndent(AnalysisResults(e.analysis), "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("writing analysis: %v", err)
+	}
+
+	timestamp := time.Now().Format(time.RFC3339)
+	filename := fmt.Sprintf(generatedAnalysisJSONNameFormat, e.clusterName(), timestamp)
+	return e.writer.Write(filename, jsonAnalysis)
+}
+
 func (e *EksaDiagnosticBundle) WriteAnalysisToFile() (path string, err error) {
 	if e.analysis == nil {
 		return "", nil
@@ -3,6 +3,7 @@ package diagnostics_test
 import (
 	"bytes"
 	"context"
+	"strings"
 	"testing"
 	"time"
 
@@ -17,6 +18,7 @@ import (
 	supportMocks "github.com/aws/eks-anywhere/pkg/diagnostics/interfaces/mocks"
 	"github.com/aws/eks-anywhere/pkg/executables"
 	mockexecutables "github.com/aws/eks-anywhere/pkg/executables/mocks"
+	"github.com/aws/eks-anywhere/pkg/filewriter"
 	"github.com/aws/eks-anywhere/pkg/filewriter/mocks"
 	"github.com/aws/eks-anywhere/pkg/providers"
 	providerMocks "github.com/aws/eks-anywhere/pkg/providers/mocks"
@@ -392,6 +394,26 @@ func TestBundleFromSpecComplete(t *testing.T) {
 			t.Errorf("CollectAndAnalyze() error = %v, wantErr nil", err)
 			return
 		}
+
+		w.EXPECT().Write(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(fileName string, content []byte, _ ...filewriter.FileOptionsFunc) (string, error) {
+				if !strings.HasSuffix(fileName, "-analysis.json") {
+					t.Errorf("WriteAnalysisJSONToFile() fileName = %s, want json analysis file", fileName)
+				}
+				if !strings.Contains(string(content), `"title": "itsATestYo"`) {
+					t.Errorf("WriteAnalysisJSONToFile() content = %s, want json analysis results", content)
+				}
+				return "generated/" + fileName, nil
+			},
+		)
+		path, err := b.WriteAnalysisJSONToFile()
+		if err != nil {
+			t.Errorf("WriteAnalysisJSONToFile() error = %v, wantErr nil", err)
+			return
+		}
+		if !strings.HasPrefix(path, "generated/") {
+			t.Errorf("WriteAnalysisJSONToFile() path = %s, want written file path", path)
+		}
 	})
 }
 
@@ -28,6 +28,7 @@ type DiagnosticBundle interface {
 	WriteBundleConfig() error
 	PrintAnalysis() error
 	WriteAnalysisToFile() (path string, err error)
+	WriteAnalysisJSONToFile() (path string, err error)
 	CollectAndAnalyze(ctx context.Context, sinceTimeValue *time.Time) error
 	WithDefaultAnalyzers() *EksaDiagnosticBundle
 	WithDefaultCollectors() *EksaDiagnosticBundle
@@ -357,6 +357,21 @@ func (mr *MockDiagnosticBundleMockRecorder) WithOidcConfig(config interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithOidcConfig", reflect.TypeOf((*MockDiagnosticBundle)(nil).WithOidcConfig), config)
 }
 
+// WriteAnalysisJSONToFile mocks base method.
+func (m *MockDiagnosticBundle) WriteAnalysisJSONToFile() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WriteAnalysisJSONToFile")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WriteAnalysisJSONToFile indicates an expected call of WriteAnalysisJSONToFile.
+func (mr *MockDiagnosticBundleMockRecorder) WriteAnalysisJSONToFile() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteAnalysisJSONToFile", reflect.TypeOf((*MockDiagnosticBundle)(nil).WriteAnalysisJSONToFile))
+}
+
 // WriteAnalysisToFile mocks base method.
 func (m *MockDiagnosticBundle) WriteAnalysisToFile() (string, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,221 @@
+package diagnostics
+
+import (
+	"fmt"
+	"path"
+
+	"github.com/aws/eks-anywhere/pkg/constants"
+	"github.com/aws/eks-anywhere/pkg/executables"
+)
+
+const (
+	troubleshootingDocURL = "https://anywhere.eks.amazonaws.com/docs/tasks/troubleshoot/troubleshooting/"
+
+	// Analysis result statuses.
+	AnalysisPass = "pass"
+	AnalysisWarn = "warn"
+	AnalysisFail = "fail"
+)
+
+// AnalysisResult is the result of an analyzer, with the remediation to follow and its doc
+// when the analyzer detected a known failure.
+type AnalysisResult struct {
+	Title       string `json:"title"`
+	Status      string `json:"status"`
+	Message     string `json:"message,omitempty"`
+	Remediation string `json:"remediation,omitempty"`
+	DocURL      string `json:"docURL,omitempty"`
+}
+
+// failureHint describes a known failure, detected by matching a regex in a collected file,
+// and how to remediate it.
+type failureHint struct {
+	checkName   string
+	fileName    string
+	regex       string
+	message     string
+	passMessage string
+	remediation string
+	docAnchor   string
+	// warn reports the failure as a warning, for states that can also be transient.
+	warn bool
+}
+
+func (h failureHint) docURL() string {
+	return troubleshootingDocURL + "#" + h.docAnchor
+}
+
+func (h failureHint) analyzer() *Analyze {
+	failure := &singleOutcome{
+		When:    "true",
+		Message: h.message,
+		URI:     h.docURL(),
+	}
+	detected := &outcome{Fail: failure}
+	if h.warn {
+		detected = &outcome{Warn: failure}
+	}
+	return &Analyze{
+		TextAnalyze: &textAnalyze{
+			analyzeMeta: analyzeMeta{
+				CheckName: h.checkName,
+			},
+			FileName:     h.fileName,
+			RegexPattern: h.regex,
+			Outcomes: []*outcome{
+				detected,
+				{
+					Pass: &singleOutcome{
+						When:    "false",
+						Message: h.passMessage,
+					},
+				},
+			},
+		},
+	}
+}
+
+func hintAnalyzers(hints []failureHint) []*Analyze {
+	analyzers := make([]*Analyze, 0, len(hints))
+	for _, h := range hints {
+		analyzers = append(analyzers, h.analyzer())
+	}
+	return analyzers
+}
+
+// crdFailureHints is used to associate failure hints with the custom resources collected by the crd collectors.
+// The key of the map is the crd name, and the value are the associated failure hints.
+func crdFailureHints() map[string][]failureHint {
+	machines := "machines.cluster.x-k8s.io"
+	kubeadmControlPlanes := "kubeadmcontrolplane.controlplane.cluster.x-k8s.io"
+	workflows := "workflows.tinkerbell.org"
+	return map[string][]failureHint{
+		machines: {{
+			checkName:   "CAPI machines provisioning",
+			fileName:    crdLogPath(machines),
+			regex:       `"phase":\s*"(Provisioning|Failed)"`,
+			message:     "Some CAPI machines are still provisioning or failed to provision.",
+			passMessage: "All CAPI machines are provisioned.",
+			remediation: "Check the infrastructure machines of the provisioning machines for errors, and the capacity and network of the infrastructure provider. Machines stuck provisioning are replaced by their MachineHealthCheck after its timeout.",
+			docAnchor:   "machines-stuck-provisioning",
+			warn:        true,
+		}},
+		kubeadmControlPlanes: {{
+			checkName:   "KubeadmControlPlane ready",
+			fileName:    crdLogPath(kubeadmControlPlanes),
+			regex:       `"status":\s*"False",\s*"type":\s*"Ready"`,
+			message:     "The KubeadmControlPlane is not ready.",
+			passMessage: "The KubeadmControlPlane is ready.",
+			remediation: "Check the conditions of the KubeadmControlPlane and the logs of the capi-kubeadm-control-plane-controller-manager for the control plane machines that failed to join.",
+			docAnchor:   "kubeadmcontrolplane-not-ready",
+		}},
+		workflows: {{
+			checkName:   "Tinkerbell workflows",
+			fileName:    crdLogPath(workflows),
+			regex:       `"state":\s*"STATE_(FAILED|TIMEOUT)"`,
+			message:     "Some Tinkerbell workflows failed or timed out.",
+			passMessage: "No Tinkerbell workflow failed.",
+			remediation: "Check the failed action in the workflow status and the console of its machine. Delete the workflow's machine to provision it again once the hardware issue is fixed.",
+			docAnchor:   "tinkerbell-workflow-failed",
+		}},
+	}
+}
+
+// namespaceFailureHints is used to associate failure hints with the logs collected from a specific namespace.
+// The key of the map is the namespace name, and the value are the associated failure hints.
+func namespaceFailureHints() map[string][]failureHint {
+	kubeSystemLogs := logpath(constants.KubeSystemNamespace)
+	return map[string][]failureHint{
+		constants.KubeSystemNamespace: {
+			{
+				checkName:   "kube-vip leader election",
+				fileName:    path.Join(kubeSystemLogs, "kube-vip-*.log"),
+				regex:       `leaderelection lost|failed to renew lease|error retrieving resource lock`,
+				message:     "kube-vip lost or failed to acquire the leadership of the control plane endpoint.",
+				passMessage: "kube-vip holds the leadership of the control plane endpoint.",
+				remediation: "Check the control plane endpoint IP is not used by another host and is reachable from the control plane nodes, and the kube-vip pods can reach the API server.",
+				docAnchor:   "kube-vip-leader-missing",
+				warn:        true,
+			},
+			{
+				checkName:   "Expired certificates",
+				fileName:    path.Join(kubeSystemLogs, "*.log"),
+				regex:       `x509: certificate has expired or is not yet valid`,
+				message:     "Some components use expired certificates, or certificates not valid yet.",
+				passMessage: "No expired certificate in use.",
+				remediation: "Check the time of the nodes is synchronized, and renew the certificates of the cluster.",
+				docAnchor:   "expired-certificates",
+			},
+		},
+	}
+}
+
+// clusterResourcesFailureHints are the failure hints for the resources collected by the cluster resources collector.
+func clusterResourcesFailureHints() []failureHint {
+	return []failureHint{
+		{
+			checkName:   "Cilium agent",
+			fileName:    fmt.Sprintf("cluster-resources/pods/%s.json", constants.KubeSystemNamespace),
+			regex:       `"name":\s*"cilium-agent",\s*"state":\s*\{\s*"waiting":\s*\{\s*"reason":\s*"CrashLoopBackOff"`,
+			message:     "Some cilium-agent containers are in CrashLoopBackOff.",
+			passMessage: "The cilium-agent containers are running.",
+			remediation: "Check the logs of the crashing cilium pods. The nodes need the kernel modules and mounts required by Cilium, and the cilium-operator must be running.",
+			docAnchor:   "cilium-agent-crash-loop",
+		},
+	}
+}
+
+// remediations maps the check name of the analyzers with failure hints to their hint.
+func remediations() map[string]failureHint {
+	m := map[string]failureHint{}
+	add := func(hints []failureHint) {
+		for _, h := range hints {
+			m[h.checkName] = h
+		}
+	}
+	for _, hints := range crdFailureHints() {
+		add(hints)
+	}
+	for _, hints := range namespaceFailureHints() {
+		add(hints)
+	}
+	add(clusterResourcesFailureHints())
+	return m
+}
+
+// AnalysisResults converts the support bundle analysis to results with the remediation
+// of the known failures the analyzers detected.
+func AnalysisResults(analysis []*executables.SupportBundleAnalysis) []AnalysisResult {
+	hints := remediations()
+	results := make([]AnalysisResult, 0, len(analysis))
+	for _, a := range analysis {
+		result := AnalysisResult{
+			Title:   a.Title,
+			Status:  analysisStatus(a),
+			Message: a.Message,
+			DocURL:  a.Uri,
+		}
+		if h, ok := hints[a.Title]; ok && result.Status != AnalysisPass {
+			result.Remediation = h.remediation
+			result.DocURL = h.docURL()
+		}
+		results = append(results, result)
+	}
+	return results
+}
+
+func analysisStatus(a *executables.SupportBundleAnalysis) string {
+	switch {
+	case a.IsFail:
+		return AnalysisFail
+	case a.IsWarn:
+		return AnalysisWarn
+	default:
+		return AnalysisPass
+	}
+}
+
+// crdLogPath is the path in the bundle of the custom resources collected by the crd collector.
+func crdLogPath(crdType string) string {
+	return path.Join(crdPath(crdType), crdType+".log")
+}
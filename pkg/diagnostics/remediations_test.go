@@ -0,0 +1,118 @@
+package diagnostics_test
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	eksav1alpha1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/diagnostics"
+	"github.com/aws/eks-anywhere/pkg/executables"
+)
+
+func TestAnalysisResults(t *testing.T) {
+	g := NewGomegaWithT(t)
+	analysis := []*executables.SupportBundleAnalysis{
+		{
+			Title:   "coredns Status",
+			IsPass:  true,
+			Message: "coredns is running.",
+		},
+		{
+			Title:   "Cilium agent",
+			IsFail:  true,
+			Message: "Some cilium-agent containers are in CrashLoopBackOff.",
+			Uri:     "https://anywhere.eks.amazonaws.com/docs/tasks/troubleshoot/troubleshooting/#cilium-agent-crash-loop",
+		},
+		{
+			Title:   "CAPI machines provisioning",
+			IsWarn:  true,
+			Message: "Some CAPI machines are still provisioning or failed to provision.",
+		},
+		{
+			Title:   "Expired certificates",
+			IsPass:  true,
+			Message: "No expired certificate in use.",
+		},
+	}
+
+	results := diagnostics.AnalysisResults(analysis)
+	g.Expect(results).To(HaveLen(4))
+	g.Expect(results[0]).To(Equal(diagnostics.AnalysisResult{
+		Title:   "coredns Status",
+		Status:  diagnostics.AnalysisPass,
+		Message: "coredns is running.",
+	}))
+	g.Expect(results[1].Status).To(Equal(diagnostics.AnalysisFail))
+	g.Expect(results[1].Remediation).To(ContainSubstring("Check the logs of the crashing cilium pods"))
+	g.Expect(results[1].DocURL).To(Equal("https://anywhere.eks.amazonaws.com/docs/tasks/troubleshoot/troubleshooting/#cilium-agent-crash-loop"))
+	g.Expect(results[2].Status).To(Equal(diagnostics.AnalysisWarn))
+	g.Expect(results[2].Remediation).NotTo(BeEmpty())
+	g.Expect(results[2].DocURL).To(Equal("https://anywhere.eks.amazonaws.com/docs/tasks/troubleshoot/troubleshooting/#machines-stuck-provisioning"))
+	g.Expect(results[3].Remediation).To(BeEmpty(), "passing analyzers have no remediation")
+}
+
+func TestFailureHintsMatchCollectedSamples(t *testing.T) {
+	collectorFactory := diagnostics.NewDefaultCollectorFactory()
+	collectors := collectorFactory.DefaultCollectors()
+	collectors = append(collectors, collectorFactory.ManagementClusterCollectors()...)
+	collectors = append(collectors, collectorFactory.DataCenterConfigCollectors(eksav1alpha1.Ref{Kind: eksav1alpha1.TinkerbellDatacenterKind}, nil)...)
+	analyzers := diagnostics.NewAnalyzerFactory().EksaLogTextAnalyzers(collectors)
+
+	tests := []struct {
+		checkName string
+		failing   string
+		passing   string
+	}{
+		{
+			checkName: "CAPI machines provisioning",
+			failing:   "machines-provisioning.log",
+			passing:   "machines-running.log",
+		},
+		{
+			checkName: "KubeadmControlPlane ready",
+			failing:   "kcp-not-ready.log",
+			passing:   "kcp-ready.log",
+		},
+		{
+			checkName: "Tinkerbell workflows",
+			failing:   "workflows-failed.log",
+			passing:   "workflows-success.log",
+		},
+		{
+			checkName: "kube-vip leader election",
+			failing:   "kube-vip-lost-lease.log",
+			passing:   "kube-vip-leader.log",
+		},
+		{
+			checkName: "Expired certificates",
+			failing:   "apiserver-expired-certificate.log",
+			passing:   "apiserver.log",
+		},
+		{
+			checkName: "Cilium agent",
+			failing:   "pods-cilium-crash-loop.json",
+			passing:   "pods-cilium-running.json",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.checkName, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+			analyzer := getTextAnalyzer(analyzers, tt.checkName)
+			g.Expect(analyzer).NotTo(BeNil())
+			regex, err := regexp.Compile(analyzer.TextAnalyze.RegexPattern)
+			g.Expect(err).NotTo(HaveOccurred())
+
+			failing, err := os.ReadFile(filepath.Join("testdata/hints", tt.failing))
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(regex.Match(failing)).To(BeTrue(), "the hint should detect the failure in %s", tt.failing)
+
+			passing, err := os.ReadFile(filepath.Join("testdata/hints", tt.passing))
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(regex.Match(passing)).To(BeFalse(), "the hint shouldn't detect a failure in %s", tt.passing)
+		})
+	}
+}
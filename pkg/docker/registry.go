@@ -40,7 +40,7 @@ func (d *ImageRegistryDestination) Write(ctx context.Context, images ...string)
 	logger.Info("Writing images to registry")
 	logger.V(3).Info("Starting registry write", "numberOfImages", len(images))
 	err := d.processor.Process(ctx, images, func(ctx context.Context, image string) error {
-		endpoint := getUpdatedEndpoint(d.endpoint, image)
+		endpoint := RegistryEndpointForImage(d.endpoint, image)
 		image = removeDigestReference(image)
 		if err := d.client.TagImage(ctx, image, endpoint); err != nil {
 			return err
@@ -92,9 +92,10 @@ func (s *ImageOriginalRegistrySource) Load(ctx context.Context, images ...string
 	return nil
 }
 
+// RegistryEndpointForImage returns the endpoint to push image to in a registry.
 // Currently private curated packages don't have a root level project
 // This method adds a root level projectName to the endpoint.
-func getUpdatedEndpoint(originalEndpoint, image string) string {
+func RegistryEndpointForImage(originalEndpoint, image string) string {
 	if strings.Contains(image, packageDevDomain) {
 		return originalEndpoint + "/" + publicDevECRName
 	}
@@ -29,7 +29,7 @@ func NewExecutablesBuilder(executableBuilder ExecutableBuilder) *ExecutablesBuil
 }
 
 func (b *ExecutablesBuilder) BuildKindExecutable(writer filewriter.FileWriter) *Kind {
-	return NewKind(b.executableBuilder.Build(kindPath), writer)
+	return NewKind(b.executableBuilder.Build(kindPath), writer, WithDockerExecutable(b.executableBuilder.Build(dockerPath)))
 }
 
 func (b *ExecutablesBuilder) BuildClusterAwsAdmExecutable() *Clusterawsadm {
@@ -11,6 +11,8 @@ import (
 
 	"github.com/aws/eks-anywhere/pkg/config"
 	"github.com/aws/eks-anywhere/pkg/constants"
+	"github.com/aws/eks-anywhere/pkg/git/providers/bitbucketserver"
+	"github.com/aws/eks-anywhere/pkg/git/providers/gitlab"
 	"github.com/aws/eks-anywhere/pkg/logger"
 	"github.com/aws/eks-anywhere/pkg/providers/cloudstack/decoder"
 )
@@ -27,6 +29,11 @@ var redactedEnvKeys = []string{
 	decoder.CloudStackCloudConfigB64SecretKey,
 	eksaGithubTokenEnv,
 	githubTokenEnv,
+	gitPasswordEnv,
+	gitlab.EksaGitlabTokenEnv,
+	gitlab.GitlabTokenEnv,
+	bitbucketserver.EksaBitbucketServerTokenEnv,
+	bitbucketserver.BitbucketTokenEnv,
 	config.EksaAccessKeyIdEnv,
 	config.EksaSecretAccessKeyEnv,
 	config.AwsAccessKeyIdEnv,
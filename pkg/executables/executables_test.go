@@ -5,6 +5,8 @@ import (
 
 	"github.com/aws/eks-anywhere/pkg/constants"
 	"github.com/aws/eks-anywhere/pkg/executables"
+	"github.com/aws/eks-anywhere/pkg/git/providers/bitbucketserver"
+	"github.com/aws/eks-anywhere/pkg/git/providers/gitlab"
 )
 
 func TestRedactCreds(t *testing.T) {
@@ -17,3 +19,14 @@ func TestRedactCreds(t *testing.T) {
 		t.Fatalf("executables.RedactCreds expected = %s, got = %s", expected, redactedStr)
 	}
 }
+
+func TestRedactCredsGitProviderTokens(t *testing.T) {
+	str := "docker exec -e GITLAB_TOKEN=gitlab123 -e BITBUCKET_TOKEN=bitbucket456 flux bootstrap"
+	envMap := map[string]string{gitlab.GitlabTokenEnv: "gitlab123", bitbucketserver.BitbucketTokenEnv: "bitbucket456"}
+	expected := "docker exec -e GITLAB_TOKEN=***** -e BITBUCKET_TOKEN=***** flux bootstrap"
+
+	redactedStr := executables.RedactCreds(str, envMap)
+	if redactedStr != expected {
+		t.Fatalf("executables.RedactCreds expected = %s, got = %s", expected, redactedStr)
+	}
+}
@@ -1,12 +1,16 @@
 package executables
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 
 	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
 	"github.com/aws/eks-anywhere/pkg/config"
+	"github.com/aws/eks-anywhere/pkg/git/gitclient"
+	"github.com/aws/eks-anywhere/pkg/git/providers/bitbucketserver"
 	"github.com/aws/eks-anywhere/pkg/git/providers/github"
+	"github.com/aws/eks-anywhere/pkg/git/providers/gitlab"
 	"github.com/aws/eks-anywhere/pkg/types"
 )
 
@@ -14,9 +18,13 @@ const (
 	fluxPath                   = "flux"
 	eksaGithubTokenEnv         = "EKSA_GITHUB_TOKEN"
 	githubTokenEnv             = "GITHUB_TOKEN"
+	gitPasswordEnv             = "GIT_PASSWORD"
 	githubProvider             = "github"
 	gitProvider                = "git"
+	gitlabProvider             = "gitlab"
+	bitbucketServerProvider    = "bitbucket-server"
 	defaultPrivateKeyAlgorithm = "ecdsa"
+	gpgKeyRingStdin            = "/dev/stdin"
 )
 
 type Flux struct {
@@ -32,7 +40,7 @@ func NewFlux(executable Executable) *Flux {
 // BootstrapGithub creates the GitHub repository if it doesn’t exist, and commits the toolkit
 // components manifests to the main branch. Then it configures the target cluster to synchronize with the repository.
 // If the toolkit components are present on the cluster, the bootstrap command will perform an upgrade if needed.
-func (f *Flux) BootstrapGithub(ctx context.Context, cluster *types.Cluster, fluxConfig *v1alpha1.FluxConfig) error {
+func (f *Flux) BootstrapGithub(ctx context.Context, cluster *types.Cluster, fluxConfig *v1alpha1.FluxConfig, cliConfig *config.CliConfig) error {
 	c := fluxConfig.Spec
 	params := []string{
 		"bootstrap",
@@ -48,6 +56,11 @@ func (f *Flux) BootstrapGithub(ctx context.Context, cluster *types.Cluster, flux
 		params = append(params, "--personal")
 	}
 
+	params, keyRing, err := setUpCommitParamsBootstrap(cliConfig, params)
+	if err != nil {
+		return err
+	}
+
 	token, err := github.GetGithubAccessTokenFromEnv()
 	if err != nil {
 		return fmt.Errorf("setting token env: %v", err)
@@ -56,7 +69,7 @@ func (f *Flux) BootstrapGithub(ctx context.Context, cluster *types.Cluster, flux
 	env := make(map[string]string)
 	env[githubTokenEnv] = token
 
-	_, err = f.ExecuteWithEnv(ctx, env, params...)
+	_, err = f.executeBootstrap(ctx, env, keyRing, params...)
 	if err != nil {
 		return fmt.Errorf("executing flux bootstrap github: %v", err)
 	}
@@ -64,11 +77,94 @@ func (f *Flux) BootstrapGithub(ctx context.Context, cluster *types.Cluster, flux
 	return err
 }
 
+// BootstrapGitlab creates the GitLab project if it doesn’t exist, and commits the toolkit
+// components manifests to the main branch. Then it configures the target cluster to synchronize with the repository.
+// If the toolkit components are present on the cluster, the bootstrap command will perform an upgrade if needed.
+func (f *Flux) BootstrapGitlab(ctx context.Context, cluster *types.Cluster, fluxConfig *v1alpha1.FluxConfig, cliConfig *config.CliConfig) error {
+	c := fluxConfig.Spec
+	params := []string{
+		"bootstrap",
+		gitlabProvider,
+		"--repository", c.Gitlab.Repository,
+		"--owner", c.Gitlab.Owner,
+		"--path", c.ClusterConfigPath,
+		"--ssh-key-algorithm", defaultPrivateKeyAlgorithm,
+	}
+	if c.Gitlab.Hostname != "" {
+		params = append(params, "--hostname", c.Gitlab.Hostname)
+	}
+	params = setUpCommonParamsBootstrap(cluster, fluxConfig, params)
+
+	if c.Gitlab.Personal {
+		params = append(params, "--personal")
+	}
+
+	params, keyRing, err := setUpCommitParamsBootstrap(cliConfig, params)
+	if err != nil {
+		return err
+	}
+
+	token, err := gitlab.GetGitlabAccessTokenFromEnv()
+	if err != nil {
+		return fmt.Errorf("setting token env: %v", err)
+	}
+
+	env := map[string]string{gitlab.GitlabTokenEnv: token}
+	if _, err = f.executeBootstrap(ctx, env, keyRing, params...); err != nil {
+		return fmt.Errorf("executing flux bootstrap gitlab: %v", err)
+	}
+
+	return nil
+}
+
+// BootstrapBitbucketServer creates the Bitbucket Server repository if it doesn’t exist, and commits the toolkit
+// components manifests to the main branch. Then it configures the target cluster to synchronize with the repository.
+// If the toolkit components are present on the cluster, the bootstrap command will perform an upgrade if needed.
+func (f *Flux) BootstrapBitbucketServer(ctx context.Context, cluster *types.Cluster, fluxConfig *v1alpha1.FluxConfig, cliConfig *config.CliConfig) error {
+	auth, err := bitbucketserver.GetBitbucketServerAuthFromEnv()
+	if err != nil {
+		return fmt.Errorf("setting token env: %v", err)
+	}
+
+	c := fluxConfig.Spec
+	params := []string{
+		"bootstrap",
+		bitbucketServerProvider,
+		"--repository", c.BitbucketServer.Repository,
+		"--owner", c.BitbucketServer.Owner,
+		"--username", auth.Username,
+		"--hostname", c.BitbucketServer.Hostname,
+		"--path", c.ClusterConfigPath,
+		"--ssh-key-algorithm", defaultPrivateKeyAlgorithm,
+	}
+	params = setUpCommonParamsBootstrap(cluster, fluxConfig, params)
+
+	if c.BitbucketServer.Personal {
+		params = append(params, "--personal")
+	}
+
+	params, keyRing, err := setUpCommitParamsBootstrap(cliConfig, params)
+	if err != nil {
+		return err
+	}
+
+	env := map[string]string{bitbucketserver.BitbucketTokenEnv: auth.Token}
+	if _, err = f.executeBootstrap(ctx, env, keyRing, params...); err != nil {
+		return fmt.Errorf("executing flux bootstrap bitbucket-server: %v", err)
+	}
+
+	return nil
+}
+
 // BootstrapGit commits the toolkit components manifests to the branch of a Git repository.
 // It then configures the target cluster to synchronize with the repository. If the toolkit components are present on the cluster, the
 // bootstrap command will perform an upgrade if needed.
 func (f *Flux) BootstrapGit(ctx context.Context, cluster *types.Cluster, fluxConfig *v1alpha1.FluxConfig, cliConfig *config.CliConfig) error {
 	c := fluxConfig.Spec
+	if c.Git.IsHTTPS() {
+		return f.bootstrapGitHttps(ctx, cluster, fluxConfig, cliConfig)
+	}
+
 	params := []string{
 		"bootstrap",
 		gitProvider,
@@ -79,6 +175,11 @@ func (f *Flux) BootstrapGit(ctx context.Context, cluster *types.Cluster, fluxCon
 	}
 
 	params = setUpCommonParamsBootstrap(cluster, fluxConfig, params)
+	params, keyRing, err := setUpCommitParamsBootstrap(cliConfig, params)
+	if err != nil {
+		return err
+	}
+
 	if fluxConfig.Spec.Git.SshKeyAlgorithm != "" {
 		params = append(params, "--ssh-key-algorithm", fluxConfig.Spec.Git.SshKeyAlgorithm)
 	} else {
@@ -91,13 +192,42 @@ func (f *Flux) BootstrapGit(ctx context.Context, cluster *types.Cluster, fluxCon
 
 	env := make(map[string]string)
 	env["SSH_KNOWN_HOSTS"] = cliConfig.GitKnownHostsFile
-	_, err := f.ExecuteWithEnv(ctx, env, params...)
+	_, err = f.executeBootstrap(ctx, env, keyRing, params...)
 	if err != nil {
 		return fmt.Errorf("executing flux bootstrap git: %v", err)
 	}
 	return err
 }
 
+// bootstrapGitHttps bootstraps a generic git repository over HTTPS, authenticating with the username and
+// password (or access token) in the cliConfig. Flux stores them in the source secret for the cluster.
+func (f *Flux) bootstrapGitHttps(ctx context.Context, cluster *types.Cluster, fluxConfig *v1alpha1.FluxConfig, cliConfig *config.CliConfig) error {
+	c := fluxConfig.Spec
+	params := []string{
+		"bootstrap",
+		gitProvider,
+		"--url", c.Git.RepositoryUrl,
+		"--path", c.ClusterConfigPath,
+		"--username", cliConfig.GitUsername,
+		"--token-auth",
+		"--silent",
+	}
+	params = setUpCommonParamsBootstrap(cluster, fluxConfig, params)
+	params, keyRing, err := setUpCommitParamsBootstrap(cliConfig, params)
+	if err != nil {
+		return err
+	}
+
+	// flux reads the password from the environment when the --password flag is not set, which keeps it
+	// out of the flux arguments. The docker executable still forwards it with -e on the docker command
+	// line, so it relies on the command log redaction of GIT_PASSWORD.
+	env := map[string]string{gitPasswordEnv: cliConfig.GitPassword}
+	if _, err := f.executeBootstrap(ctx, env, keyRing, params...); err != nil {
+		return fmt.Errorf("executing flux bootstrap git: %v", err)
+	}
+	return nil
+}
+
 func setUpCommonParamsBootstrap(cluster *types.Cluster, fluxConfig *v1alpha1.FluxConfig, params []string) []string {
 	c := fluxConfig.Spec
 	if cluster.KubeconfigFile != "" {
@@ -112,6 +242,40 @@ func setUpCommonParamsBootstrap(cluster *types.Cluster, fluxConfig *v1alpha1.Flu
 	return params
 }
 
+// setUpCommitParamsBootstrap sets the author and GPG signing key of the commits flux pushes while bootstrapping.
+// flux only takes the GPG passphrase as an argument, so a key ring protected with a passphrase is decrypted in
+// memory and returned, to be passed to flux on stdin instead of being written to disk.
+func setUpCommitParamsBootstrap(cliConfig *config.CliConfig, params []string) (paramsWithCommit []string, keyRing []byte, err error) {
+	if cliConfig.GitAuthorName != "" {
+		params = append(params, "--author-name", cliConfig.GitAuthorName)
+	}
+	if cliConfig.GitAuthorEmail != "" {
+		params = append(params, "--author-email", cliConfig.GitAuthorEmail)
+	}
+	if cliConfig.GitGPGKeyRingFile == "" {
+		return params, nil, nil
+	}
+
+	if cliConfig.GitGPGPassphrase == "" {
+		return append(params, "--gpg-key-ring", cliConfig.GitGPGKeyRingFile), nil, nil
+	}
+
+	keyRing, err = gitclient.DecryptGPGKeyRing(cliConfig.GitGPGKeyRingFile, cliConfig.GitGPGPassphrase)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return append(params, "--gpg-key-ring", gpgKeyRingStdin), keyRing, nil
+}
+
+// executeBootstrap runs flux bootstrap, passing the decrypted GPG key ring on stdin if there is one.
+func (f *Flux) executeBootstrap(ctx context.Context, env map[string]string, keyRing []byte, params ...string) (bytes.Buffer, error) {
+	if keyRing == nil {
+		return f.ExecuteWithEnv(ctx, env, params...)
+	}
+	return f.Command(ctx, params...).WithEnvVars(env).WithStdIn(keyRing).Run()
+}
+
 func (f *Flux) Uninstall(ctx context.Context, cluster *types.Cluster, fluxConfig *v1alpha1.FluxConfig) error {
 	c := fluxConfig.Spec
 	params := []string{
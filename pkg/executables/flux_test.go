@@ -4,14 +4,18 @@ import (
 	"bytes"
 	"context"
 	"os"
+	"path/filepath"
 	"testing"
 
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/ProtonMail/go-crypto/openpgp/packet"
 	"github.com/golang/mock/gomock"
 
 	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
 	"github.com/aws/eks-anywhere/pkg/config"
 	"github.com/aws/eks-anywhere/pkg/executables"
 	mockexecutables "github.com/aws/eks-anywhere/pkg/executables/mocks"
+	"github.com/aws/eks-anywhere/pkg/git/gitclient"
 	"github.com/aws/eks-anywhere/pkg/types"
 )
 
@@ -142,7 +146,7 @@ func TestFluxInstallGithubToolkitsSuccess(t *testing.T) {
 			).Return(bytes.Buffer{}, nil)
 
 			f := executables.NewFlux(executable)
-			if err := f.BootstrapGithub(ctx, tt.cluster, tt.fluxConfig); err != nil {
+			if err := f.BootstrapGithub(ctx, tt.cluster, tt.fluxConfig, &config.CliConfig{}); err != nil {
 				t.Errorf("flux.BootstrapGithub() error = %v, want nil", err)
 			}
 		})
@@ -557,3 +561,288 @@ func TestFluxInstallGitToolkitsSuccess(t *testing.T) {
 		})
 	}
 }
+
+func TestFluxInstallGitToolkitsHttps(t *testing.T) {
+	ctx := context.Background()
+	repoUrl := "https://git.example.com/platform/gitops.git"
+	cluster := &types.Cluster{KubeconfigFile: "f.kubeconfig"}
+	fluxConfig := &v1alpha1.FluxConfig{
+		Spec: v1alpha1.FluxConfigSpec{
+			ClusterConfigPath: "clusters/cluster-name",
+			Branch:            "main",
+			Git:               &v1alpha1.GitProviderConfig{RepositoryUrl: repoUrl},
+		},
+	}
+	cliConfig := &config.CliConfig{GitUsername: "janedoe", GitPassword: "token"}
+
+	executable := mockexecutables.NewMockExecutable(gomock.NewController(t))
+	executable.EXPECT().ExecuteWithEnv(
+		ctx,
+		map[string]string{"GIT_PASSWORD": "token"},
+		"bootstrap", "git", "--url", repoUrl, "--path", "clusters/cluster-name", "--username", "janedoe", "--token-auth", "--silent",
+		"--kubeconfig", "f.kubeconfig", "--branch", "main",
+	).Return(bytes.Buffer{}, nil)
+
+	f := executables.NewFlux(executable)
+	if err := f.BootstrapGit(ctx, cluster, fluxConfig, cliConfig); err != nil {
+		t.Errorf("flux.BootstrapGit() error = %v, want nil", err)
+	}
+}
+
+func TestFluxInstallGitToolkitsCommitAuthorAndSigning(t *testing.T) {
+	ctx := context.Background()
+	repoUrl := "https://git.example.com/platform/gitops.git"
+	cluster := &types.Cluster{}
+	fluxConfig := &v1alpha1.FluxConfig{
+		Spec: v1alpha1.FluxConfigSpec{
+			ClusterConfigPath: "clusters/cluster-name",
+			Git:               &v1alpha1.GitProviderConfig{RepositoryUrl: repoUrl},
+		},
+	}
+	cliConfig := &config.CliConfig{
+		GitUsername:       "janedoe",
+		GitPassword:       "token",
+		GitAuthorName:     "gitops-bot",
+		GitAuthorEmail:    "gitops-bot@example.com",
+		GitGPGKeyRingFile: "/keys/signing.asc",
+	}
+
+	executable := mockexecutables.NewMockExecutable(gomock.NewController(t))
+	executable.EXPECT().ExecuteWithEnv(
+		ctx,
+		map[string]string{"GIT_PASSWORD": "token"},
+		"bootstrap", "git", "--url", repoUrl, "--path", "clusters/cluster-name", "--username", "janedoe", "--token-auth", "--silent",
+		"--author-name", "gitops-bot", "--author-email", "gitops-bot@example.com", "--gpg-key-ring", "/keys/signing.asc",
+	).Return(bytes.Buffer{}, nil)
+
+	f := executables.NewFlux(executable)
+	if err := f.BootstrapGit(ctx, cluster, fluxConfig, cliConfig); err != nil {
+		t.Errorf("flux.BootstrapGit() error = %v, want nil", err)
+	}
+}
+
+func TestFluxInstallGithubToolkitsCommitAuthorAndSigning(t *testing.T) {
+	setupFluxContext(t)
+	ctx := context.Background()
+	fluxConfig := &v1alpha1.FluxConfig{
+		Spec: v1alpha1.FluxConfigSpec{
+			ClusterConfigPath: "clusters/cluster-name",
+			Github: &v1alpha1.GithubProviderConfig{
+				Owner:      "janedoe",
+				Repository: "gitops-fleet",
+			},
+		},
+	}
+	cliConfig := &config.CliConfig{
+		GitAuthorName:     "gitops-bot",
+		GitAuthorEmail:    "gitops-bot@example.com",
+		GitGPGKeyRingFile: "/keys/signing.gpg",
+	}
+
+	executable := mockexecutables.NewMockExecutable(gomock.NewController(t))
+	executable.EXPECT().ExecuteWithEnv(
+		ctx,
+		map[string]string{githubToken: validPATValue},
+		"bootstrap", "github", "--repository", "gitops-fleet", "--owner", "janedoe", "--path", "clusters/cluster-name", "--ssh-key-algorithm", "ecdsa",
+		"--author-name", "gitops-bot", "--author-email", "gitops-bot@example.com", "--gpg-key-ring", "/keys/signing.gpg",
+	).Return(bytes.Buffer{}, nil)
+
+	f := executables.NewFlux(executable)
+	if err := f.BootstrapGithub(ctx, &types.Cluster{}, fluxConfig, cliConfig); err != nil {
+		t.Errorf("flux.BootstrapGithub() error = %v, want nil", err)
+	}
+}
+
+func TestFluxInstallGitToolkitsSigningKeyWithPassphrase(t *testing.T) {
+	ctx := context.Background()
+	repoUrl := "https://git.example.com/platform/gitops.git"
+	cluster := &types.Cluster{}
+	fluxConfig := &v1alpha1.FluxConfig{
+		Spec: v1alpha1.FluxConfigSpec{
+			ClusterConfigPath: "clusters/cluster-name",
+			Git:               &v1alpha1.GitProviderConfig{RepositoryUrl: repoUrl},
+		},
+	}
+	keyDir := t.TempDir()
+	cliConfig := &config.CliConfig{
+		GitUsername:       "janedoe",
+		GitPassword:       "token",
+		GitGPGKeyRingFile: writeEncryptedGPGKeyRing(t, keyDir, "secret"),
+		GitGPGPassphrase:  "secret",
+	}
+
+	keyRing, err := gitclient.DecryptGPGKeyRing(cliConfig.GitGPGKeyRingFile, cliConfig.GitGPGPassphrase)
+	if err != nil {
+		t.Fatalf("gitclient.DecryptGPGKeyRing() error = %v", err)
+	}
+
+	executable := mockexecutables.NewMockExecutable(gomock.NewController(t))
+	expectCommand(
+		executable, ctx,
+		"bootstrap", "git", "--url", repoUrl, "--path", "clusters/cluster-name", "--username", "janedoe",
+		"--token-auth", "--silent", "--gpg-key-ring", "/dev/stdin",
+	).withEnvVars(map[string]string{"GIT_PASSWORD": "token"}).withStdIn(keyRing).to().Return(bytes.Buffer{}, nil)
+
+	f := executables.NewFlux(executable)
+	if err := f.BootstrapGit(ctx, cluster, fluxConfig, cliConfig); err != nil {
+		t.Errorf("flux.BootstrapGit() error = %v, want nil", err)
+	}
+	entries, err := os.ReadDir(keyDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 1 {
+		t.Errorf("the decrypted gpg key ring should not be written to disk, found %v in %s", entries, keyDir)
+	}
+}
+
+func writeEncryptedGPGKeyRing(t *testing.T, dir, passphrase string) string {
+	t.Helper()
+	entity, err := openpgp.NewEntity("bot", "", "bot@example.com", &packet.Config{RSABits: 2048})
+	if err != nil {
+		t.Fatalf("generating gpg key: %v", err)
+	}
+	// Identities need to be signed while the key is still decrypted.
+	if err = entity.SerializePrivate(&bytes.Buffer{}, nil); err != nil {
+		t.Fatal(err)
+	}
+	if err = entity.PrivateKey.Encrypt([]byte(passphrase)); err != nil {
+		t.Fatal(err)
+	}
+	for _, subkey := range entity.Subkeys {
+		if err = subkey.PrivateKey.Encrypt([]byte(passphrase)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var b bytes.Buffer
+	if err = entity.SerializePrivateWithoutSigning(&b, nil); err != nil {
+		t.Fatal(err)
+	}
+	keyRing := filepath.Join(dir, "signing.gpg")
+	if err = os.WriteFile(keyRing, b.Bytes(), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return keyRing
+}
+
+func TestFluxInstallGitlabToolkitsSuccess(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	t.Setenv("EKSA_GITLAB_TOKEN", "glpat-token")
+
+	owner := "platform/clusters"
+	repo := "gitops-fleet"
+	path := "clusters/cluster-name"
+
+	tests := []struct {
+		testName     string
+		cluster      *types.Cluster
+		fluxConfig   *v1alpha1.FluxConfig
+		wantExecArgs []interface{}
+	}{
+		{
+			testName: "with hostname and branch",
+			cluster: &types.Cluster{
+				KubeconfigFile: "f.kubeconfig",
+			},
+			fluxConfig: &v1alpha1.FluxConfig{
+				Spec: v1alpha1.FluxConfigSpec{
+					ClusterConfigPath: path,
+					Branch:            "main",
+					Gitlab: &v1alpha1.GitlabProviderConfig{
+						Owner:      owner,
+						Repository: repo,
+						Hostname:   "gitlab.example.com",
+					},
+				},
+			},
+			wantExecArgs: []interface{}{
+				"bootstrap", "gitlab", "--repository", repo, "--owner", owner, "--path", path, "--ssh-key-algorithm", "ecdsa", "--hostname", "gitlab.example.com", "--kubeconfig", "f.kubeconfig", "--branch", "main",
+			},
+		},
+		{
+			testName: "with personal",
+			cluster:  &types.Cluster{},
+			fluxConfig: &v1alpha1.FluxConfig{
+				Spec: v1alpha1.FluxConfigSpec{
+					ClusterConfigPath: path,
+					Gitlab: &v1alpha1.GitlabProviderConfig{
+						Owner:      "janedoe",
+						Repository: repo,
+						Personal:   true,
+					},
+				},
+			},
+			wantExecArgs: []interface{}{
+				"bootstrap", "gitlab", "--repository", repo, "--owner", "janedoe", "--path", path, "--ssh-key-algorithm", "ecdsa", "--personal",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			ctx := context.Background()
+			executable := mockexecutables.NewMockExecutable(mockCtrl)
+			env := map[string]string{"GITLAB_TOKEN": "glpat-token"}
+			executable.EXPECT().ExecuteWithEnv(
+				ctx,
+				env,
+				tt.wantExecArgs...,
+			).Return(bytes.Buffer{}, nil)
+
+			f := executables.NewFlux(executable)
+			if err := f.BootstrapGitlab(ctx, tt.cluster, tt.fluxConfig, &config.CliConfig{}); err != nil {
+				t.Errorf("flux.BootstrapGitlab() error = %v, want nil", err)
+			}
+		})
+	}
+}
+
+func TestFluxInstallBitbucketServerToolkitsSuccess(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	t.Setenv("EKSA_BITBUCKET_SERVER_TOKEN", "bbs-token")
+	t.Setenv("EKSA_BITBUCKET_SERVER_USERNAME", "janedoe")
+
+	ctx := context.Background()
+	executable := mockexecutables.NewMockExecutable(mockCtrl)
+	env := map[string]string{"BITBUCKET_TOKEN": "bbs-token"}
+	executable.EXPECT().ExecuteWithEnv(
+		ctx,
+		env,
+		"bootstrap", "bitbucket-server", "--repository", "gitops-fleet", "--owner", "OPS", "--username", "janedoe", "--hostname", "bitbucket.example.com", "--path", "clusters/cluster-name", "--ssh-key-algorithm", "ecdsa", "--namespace", "flux-system",
+	).Return(bytes.Buffer{}, nil)
+
+	fluxConfig := &v1alpha1.FluxConfig{
+		Spec: v1alpha1.FluxConfigSpec{
+			ClusterConfigPath: "clusters/cluster-name",
+			SystemNamespace:   "flux-system",
+			BitbucketServer: &v1alpha1.BitbucketServerProviderConfig{
+				Owner:      "OPS",
+				Repository: "gitops-fleet",
+				Hostname:   "bitbucket.example.com",
+			},
+		},
+	}
+
+	f := executables.NewFlux(executable)
+	if err := f.BootstrapBitbucketServer(ctx, &types.Cluster{}, fluxConfig, &config.CliConfig{}); err != nil {
+		t.Errorf("flux.BootstrapBitbucketServer() error = %v, want nil", err)
+	}
+}
+
+func TestFluxInstallBitbucketServerToolkitsMissingCredentials(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	t.Setenv("EKSA_BITBUCKET_SERVER_TOKEN", "")
+
+	executable := mockexecutables.NewMockExecutable(mockCtrl)
+	fluxConfig := &v1alpha1.FluxConfig{
+		Spec: v1alpha1.FluxConfigSpec{
+			BitbucketServer: &v1alpha1.BitbucketServerProviderConfig{},
+		},
+	}
+
+	f := executables.NewFlux(executable)
+	if err := f.BootstrapBitbucketServer(context.Background(), &types.Cluster{}, fluxConfig, &config.CliConfig{}); err == nil {
+		t.Error("flux.BootstrapBitbucketServer() error = nil, want missing credentials error")
+	}
+}
@@ -142,6 +142,20 @@ func (h *Helm) InstallChart(ctx context.Context, chart, ociURI, version, kubecon
 	return err
 }
 
+// UpgradeChart upgrades a helm chart release in the target cluster, reusing the values of the release
+// and overriding them with values.
+func (h *Helm) UpgradeChart(ctx context.Context, chart, ociURI, version, kubeconfigFilePath string, values []string) error {
+	valueArgs := GetHelmValueArgs(values)
+	params := []string{"upgrade", chart, ociURI, "--version", version, "--reuse-values"}
+	params = append(params, valueArgs...)
+	params = append(params, "--kubeconfig", kubeconfigFilePath)
+	params = h.addInsecureFlagIfProvided(params)
+
+	logger.Info("Upgrading helm chart on cluster", "chart", chart, "version", version)
+	_, err := h.executable.Command(ctx, params...).WithEnvVars(h.env).Run()
+	return err
+}
+
 // InstallChartWithValuesFile installs a helm chart with the provided values file and waits for the chart deployment to be ready
 // The default timeout for the chart to reach ready state is 5m.
 func (h *Helm) InstallChartWithValuesFile(ctx context.Context, chart, ociURI, version, kubeconfigFilePath, valuesFilePath string) error {
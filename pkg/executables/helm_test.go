@@ -198,6 +198,34 @@ func TestHelmInstallChartSuccessWithInsecureAndValuesFile(t *testing.T) {
 	tt.Expect(tt.h.InstallChart(tt.ctx, chart, url, version, kubeconfig, "eksa-packages", valuesFileName, values)).To(Succeed())
 }
 
+func TestHelmUpgradeChartSuccess(t *testing.T) {
+	tt := newHelmTest(t)
+	chart := "chart"
+	url := "url"
+	version := "1.1"
+	kubeconfig := "/root/.kube/config"
+	values := []string{"key1=value1"}
+	expectCommand(
+		tt.e, tt.ctx, "upgrade", chart, url, "--version", version, "--reuse-values", "--set", "key1=value1", "--kubeconfig", kubeconfig,
+	).withEnvVars(tt.envVars).to().Return(bytes.Buffer{}, nil)
+
+	tt.Expect(tt.h.UpgradeChart(tt.ctx, chart, url, version, kubeconfig, values)).To(Succeed())
+}
+
+func TestHelmUpgradeChartSuccessWithInsecure(t *testing.T) {
+	tt := newHelmTest(t, executables.WithInsecure())
+	chart := "chart"
+	url := "url"
+	version := "1.1"
+	kubeconfig := "/root/.kube/config"
+	values := []string{"key1=value1"}
+	expectCommand(
+		tt.e, tt.ctx, "upgrade", chart, url, "--version", version, "--reuse-values", "--set", "key1=value1", "--kubeconfig", kubeconfig, "--insecure-skip-tls-verify",
+	).withEnvVars(tt.envVars).to().Return(bytes.Buffer{}, nil)
+
+	tt.Expect(tt.h.UpgradeChart(tt.ctx, chart, url, version, kubeconfig, values)).To(Succeed())
+}
+
 func TestHelmGetValueArgs(t *testing.T) {
 	tests := []struct {
 		testName       string
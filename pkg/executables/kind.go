@@ -9,10 +9,10 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/aws/eks-anywhere/pkg/bootstrapper"
 	"github.com/aws/eks-anywhere/pkg/cluster"
-	"github.com/aws/eks-anywhere/pkg/config"
 	"github.com/aws/eks-anywhere/pkg/filewriter"
 	"github.com/aws/eks-anywhere/pkg/logger"
 	"github.com/aws/eks-anywhere/pkg/registrymirror"
@@ -28,10 +28,33 @@ var kindConfigTemplate string
 
 const configFileName = "kind_tmp.yaml"
 
+const (
+	registryCacheName     = "eksa-registry-cache"
+	registryCacheImage    = "public.ecr.aws/docker/library/registry:2"
+	registryCacheUpstream = "public.ecr.aws"
+	registryCacheEndpoint = "http://" + registryCacheName + ":5000"
+	kindNetwork           = "kind"
+)
+
 type Kind struct {
 	writer filewriter.FileWriter
 	Executable
+	docker     Executable
 	execConfig *kindExecConfig
+	// registryCacheRunning is set while the registry cache container run by CreateBootstrapCluster
+	// is up, so DeleteBootstrapCluster only removes it when it was configured.
+	registryCacheRunning bool
+}
+
+// KindOpt configures a Kind executable.
+type KindOpt func(*Kind)
+
+// WithDockerExecutable sets the docker executable used to run the containers
+// that live alongside the kind cluster, like the registry cache.
+func WithDockerExecutable(docker Executable) KindOpt {
+	return func(k *Kind) {
+		k.docker = docker
+	}
 }
 
 // kindExecConfig contains transient information for the execution of kind commands
@@ -47,22 +70,34 @@ type kindExecConfig struct {
 	CorednsRepository    string
 	CorednsVersion       string
 	KubernetesVersion    string
-	RegistryMirrorMap    map[string]string
+	RegistryMirrorMap    map[string][]string
 	MirrorBase           string
+	MirrorRegistries     []string
+	HostsConfig          bool
 	RegistryCACertPath   string
 	RegistryAuth         bool
 	RegistryUsername     string
 	RegistryPassword     string
+	UpstreamCredentials  []registrymirror.Credentials
 	ExtraPortMappings    []int
 	DockerExtraMounts    bool
 	DisableDefaultCNI    bool
+	RegistryCacheDir     string
+	CachedRegistry       string
+	RegistryCacheURL     string
 }
 
-func NewKind(executable Executable, writer filewriter.FileWriter) *Kind {
-	return &Kind{
+func NewKind(executable Executable, writer filewriter.FileWriter, opts ...KindOpt) *Kind {
+	k := &Kind{
 		writer:     writer,
 		Executable: executable,
 	}
+
+	for _, opt := range opts {
+		opt(k)
+	}
+
+	return k
 }
 
 func (k *Kind) CreateBootstrapCluster(ctx context.Context, clusterSpec *cluster.Spec, opts ...bootstrapper.BootstrapClusterClientOption) (kubeconfig string, err error) {
@@ -88,12 +123,36 @@ func (k *Kind) CreateBootstrapCluster(ctx context.Context, clusterSpec *cluster.
 	}
 	executionArgs := k.execArguments(clusterSpec.Cluster.Name, kubeconfigName)
 
+	if k.execConfig.RegistryCacheDir != "" {
+		if err = k.runRegistryCache(ctx); err != nil {
+			return "", err
+		}
+		k.registryCacheRunning = true
+		defer func() {
+			if err == nil {
+				return
+			}
+			// The bootstrap cluster is not deleted when its creation fails, so the cache is removed here.
+			if rmErr := k.removeRegistryCache(ctx); rmErr != nil {
+				logger.V(4).Info("Failed removing registry cache", "error", rmErr)
+				return
+			}
+			k.registryCacheRunning = false
+		}()
+	}
+
 	logger.V(4).Info("Creating kind cluster", "name", getInternalName(clusterSpec.Cluster.Name), "kubeconfig", kubeconfigName)
 	_, err = k.ExecuteWithEnv(ctx, k.execConfig.env, executionArgs...)
 	if err != nil {
 		return "", fmt.Errorf("executing create cluster: %v", err)
 	}
 
+	if k.execConfig.RegistryCacheDir != "" {
+		if _, err = k.docker.Execute(ctx, "network", "connect", kindNetwork, registryCacheName); err != nil {
+			return "", fmt.Errorf("connecting registry cache to kind network: %v", err)
+		}
+	}
+
 	return kubeconfigName, nil
 }
 
@@ -170,6 +229,35 @@ func (k *Kind) WithEnv(env map[string]string) bootstrapper.BootstrapClusterClien
 	}
 }
 
+// WithRegistryCache configures the kind node to pull the images from public ECR through a local
+// registry cache. The cache stores the images in cacheDir, so they are reused across runs.
+func (k *Kind) WithRegistryCache(cacheDir string) bootstrapper.BootstrapClusterClientOption {
+	return func() error {
+		if k.execConfig == nil {
+			return errors.New("kind exec config is not ready")
+		}
+
+		if k.docker == nil {
+			return errors.New("registry cache requires a docker executable")
+		}
+
+		if k.execConfig.RegistryMirrorMap != nil {
+			return errors.New("registry cache can't be used with a registry mirror configuration")
+		}
+
+		dir, err := filepath.Abs(cacheDir)
+		if err != nil {
+			return fmt.Errorf("getting registry cache directory: %v", err)
+		}
+
+		k.execConfig.RegistryCacheDir = dir
+		k.execConfig.CachedRegistry = registryCacheUpstream
+		k.execConfig.RegistryCacheURL = registryCacheEndpoint
+
+		return nil
+	}
+}
+
 func (k *Kind) DeleteBootstrapCluster(ctx context.Context, cluster *types.Cluster) error {
 	internalName := getInternalName(cluster.Name)
 	logger.V(4).Info("Deleting kind cluster", "name", internalName)
@@ -177,7 +265,56 @@ func (k *Kind) DeleteBootstrapCluster(ctx context.Context, cluster *types.Cluste
 	if err != nil {
 		return fmt.Errorf("executing delete cluster: %v", err)
 	}
-	return err
+
+	if k.registryCacheRunning {
+		if err = k.removeRegistryCache(ctx); err != nil {
+			return err
+		}
+		k.registryCacheRunning = false
+	}
+
+	return nil
+}
+
+// runRegistryCache runs the registry cache container, replacing the one left by a previous run if any.
+// The cached images are kept in the cache directory, so they survive the container.
+func (k *Kind) runRegistryCache(ctx context.Context) error {
+	if err := os.MkdirAll(k.execConfig.RegistryCacheDir, os.ModePerm); err != nil {
+		return fmt.Errorf("creating registry cache directory: %v", err)
+	}
+
+	if err := k.removeRegistryCache(ctx); err != nil {
+		return err
+	}
+
+	logger.V(4).Info("Running registry cache", "name", registryCacheName, "dir", k.execConfig.RegistryCacheDir)
+	_, err := k.docker.Execute(ctx, "run", "-d",
+		"--name", registryCacheName,
+		"-v", fmt.Sprintf("%s:/var/lib/registry", k.execConfig.RegistryCacheDir),
+		"-e", fmt.Sprintf("REGISTRY_PROXY_REMOTEURL=https://%s", k.execConfig.CachedRegistry),
+		registryCacheImage,
+	)
+	if err != nil {
+		return fmt.Errorf("running registry cache: %v", err)
+	}
+
+	return nil
+}
+
+func (k *Kind) removeRegistryCache(ctx context.Context) error {
+	if _, err := k.docker.Execute(ctx, "container", "inspect", registryCacheName); err != nil {
+		if strings.Contains(err.Error(), "No such container") {
+			return nil
+		}
+		return fmt.Errorf("checking registry cache container: %v", err)
+	}
+
+	logger.V(4).Info("Removing registry cache", "name", registryCacheName)
+	if _, err := k.docker.Execute(ctx, "rm", "-f", registryCacheName); err != nil {
+		return fmt.Errorf("removing registry cache: %v", err)
+	}
+
+	return nil
 }
 
 func (k *Kind) setupExecConfig(clusterSpec *cluster.Spec) error {
@@ -195,20 +332,34 @@ func (k *Kind) setupExecConfig(clusterSpec *cluster.Spec) error {
 	}
 	if clusterSpec.Cluster.Spec.RegistryMirrorConfiguration != nil {
 		k.execConfig.MirrorBase = registryMirror.BaseRegistry
-		k.execConfig.RegistryMirrorMap = containerd.ToAPIEndpoints(registryMirror.NamespacedRegistryMap)
+		k.execConfig.MirrorRegistries = registryMirror.Registries()
+		k.execConfig.RegistryMirrorMap = containerd.ToAPIEndpointMirrors(registryMirror)
+		certsDir := filepath.Join(clusterSpec.Cluster.Name, "generated", "certs.d")
 		if registryMirror.CACertContent != "" {
-			path := filepath.Join(clusterSpec.Cluster.Name, "generated", "certs.d", registryMirror.BaseRegistry)
+			path := filepath.Join(certsDir, registryMirror.BaseRegistry)
 			if err := os.MkdirAll(path, os.ModePerm); err != nil {
 				return err
 			}
 			if err := ioutil.WriteFile(filepath.Join(path, "ca.crt"), []byte(registryMirror.CACertContent), 0o644); err != nil {
 				return errors.New("error writing the registry certification file")
 			}
-			k.execConfig.RegistryCACertPath = filepath.Join(clusterSpec.Cluster.Name, "generated", "certs.d")
+			k.execConfig.RegistryCACertPath = certsDir
+		}
+		if len(registryMirror.FailoverRegistries) > 0 {
+			if err := writeHostsFiles(certsDir, registryMirror); err != nil {
+				return err
+			}
+			k.execConfig.HostsConfig = true
+			k.execConfig.RegistryCACertPath = certsDir
+		}
+		upstreamCredentials, err := registryMirror.UpstreamCredentials()
+		if err != nil {
+			return err
 		}
+		k.execConfig.UpstreamCredentials = upstreamCredentials
 		if registryMirror.Auth {
 			k.execConfig.RegistryAuth = registryMirror.Auth
-			username, password, err := config.ReadCredentials()
+			username, password, err := registrymirror.ReadCredentials(clusterSpec.RegistryCredentialsSecret)
 			if err != nil {
 				return err
 			}
@@ -219,6 +370,27 @@ func (k *Kind) setupExecConfig(clusterSpec *cluster.Spec) error {
 	return nil
 }
 
+// writeHostsFiles writes the containerd hosts.toml files of the registry mirror to certsDir, mounted in the kind node.
+// Without a CA certificate, the registry mirrors certificates are not verified, as with the inline mirrors config.
+func writeHostsFiles(certsDir string, registryMirror *registrymirror.RegistryMirror) error {
+	mirror := *registryMirror
+	mirror.InsecureSkipVerify = mirror.InsecureSkipVerify || mirror.CACertContent == ""
+	hostsFiles, err := containerd.HostsFiles(&mirror)
+	if err != nil {
+		return err
+	}
+	for _, hostsFile := range hostsFiles {
+		path := filepath.Join(certsDir, hostsFile.Path)
+		if err := os.MkdirAll(filepath.Dir(path), os.ModePerm); err != nil {
+			return err
+		}
+		if err := ioutil.WriteFile(path, []byte(hostsFile.Content), 0o644); err != nil {
+			return fmt.Errorf("writing containerd hosts file: %v", err)
+		}
+	}
+	return nil
+}
+
 func (k *Kind) cleanExecConfig() {
 	k.execConfig = nil
 }
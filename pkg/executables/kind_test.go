@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"net"
 	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
@@ -291,6 +292,77 @@ func TestKindCreateBootstrapClusterExecutableError(t *testing.T) {
 	}
 }
 
+func TestKindCreateBootstrapClusterSuccessWithRegistryMirrorFailover(t *testing.T) {
+	wantKindConfig, err := filepath.Abs("testdata/kind_config_registry_mirror_with_failover.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantHostsFile, err := filepath.Abs("testdata/kind_registry_mirror_hosts.toml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	dir := t.TempDir()
+	if err = os.Chdir(dir); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Chdir(wd)
+
+	t.Setenv("REGISTRY_USERNAME_DOCKER_IO", "docker-username")
+	t.Setenv("REGISTRY_PASSWORD_DOCKER_IO", "docker-password")
+	clusterSpec := test.NewClusterSpec(func(s *cluster.Spec) {
+		s.Cluster.Name = "test_cluster"
+		s.VersionsBundle = versionBundle
+		s.Cluster.Spec.RegistryMirrorConfiguration = &v1alpha1.RegistryMirrorConfiguration{
+			Endpoint: "registry-mirror.test",
+			Port:     constants.DefaultHttpsPort,
+			FailoverEndpoints: []v1alpha1.RegistryMirrorEndpoint{
+				{
+					Endpoint: "registry-mirror-2.test",
+					Port:     constants.DefaultHttpsPort,
+				},
+			},
+			OCINamespaces: []v1alpha1.OCINamespace{
+				{
+					Registry:  "public.ecr.aws",
+					Namespace: "eks-anywhere",
+				},
+			},
+			Upstreams: []v1alpha1.UpstreamRegistry{
+				{
+					Registry:     "docker.io",
+					Authenticate: true,
+				},
+			},
+		}
+	})
+
+	ctx := context.Background()
+	_, writer := test.NewWriter(t)
+	mockCtrl := gomock.NewController(t)
+	executable := mockexecutables.NewMockExecutable(mockCtrl)
+	executable.EXPECT().ExecuteWithEnv(
+		ctx,
+		map[string]string{},
+		"create", "cluster", "--name", "test_cluster-eks-a-cluster", "--kubeconfig", test.OfType("string"),
+		"--image", "registry-mirror.test:443/eks-anywhere/l0g8r8j6/kubernetes-sigs/kind/node:v1.20.2", "--config", test.OfType("string"),
+	).Return(bytes.Buffer{}, nil).Times(1).Do(
+		func(ctx context.Context, envs map[string]string, args ...string) (stdout bytes.Buffer, err error) {
+			test.AssertFilesEquals(t, args[9], wantKindConfig)
+			return bytes.Buffer{}, nil
+		},
+	)
+
+	k := executables.NewKind(executable, writer)
+	if _, err = k.CreateBootstrapCluster(ctx, clusterSpec); err != nil {
+		t.Fatalf("CreateBootstrapCluster() error = %v, wantErr %v", err, nil)
+	}
+	test.AssertFilesEquals(t, filepath.Join(dir, "test_cluster", "generated", "certs.d", "public.ecr.aws", "hosts.toml"), wantHostsFile)
+}
+
 func TestKindCreateBootstrapClusterExecutableWithRegistryMirrorError(t *testing.T) {
 	registryMirror := "registry-mirror.test"
 	clusterSpec := test.NewClusterSpec(func(s *cluster.Spec) {
@@ -326,6 +398,116 @@ func TestKindCreateBootstrapClusterExecutableWithRegistryMirrorError(t *testing.
 	}
 }
 
+func TestKindCreateBootstrapClusterSuccessWithRegistryCache(t *testing.T) {
+	_, writer := test.NewWriter(t)
+	clusterSpec := test.NewClusterSpec(func(s *cluster.Spec) {
+		s.Cluster.Name = "test_cluster"
+		s.VersionsBundle = versionBundle
+	})
+	cacheDir := filepath.Join(t.TempDir(), "registry-cache")
+
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	executable := mockexecutables.NewMockExecutable(mockCtrl)
+	docker := mockexecutables.NewMockExecutable(mockCtrl)
+	gomock.InOrder(
+		docker.EXPECT().Execute(ctx, "container", "inspect", "eksa-registry-cache").Return(bytes.Buffer{}, nil),
+		docker.EXPECT().Execute(ctx, "rm", "-f", "eksa-registry-cache").Return(bytes.Buffer{}, nil),
+		docker.EXPECT().Execute(ctx, "run", "-d",
+			"--name", "eksa-registry-cache",
+			"-v", cacheDir+":/var/lib/registry",
+			"-e", "REGISTRY_PROXY_REMOTEURL=https://public.ecr.aws",
+			"public.ecr.aws/docker/library/registry:2",
+		).Return(bytes.Buffer{}, nil),
+		executable.EXPECT().ExecuteWithEnv(
+			ctx,
+			map[string]string{},
+			"create", "cluster", "--name", "test_cluster-eks-a-cluster", "--kubeconfig", test.OfType("string"), "--image", test.OfType("string"), "--config", test.OfType("string"),
+		).Return(bytes.Buffer{}, nil).Do(
+			func(ctx context.Context, envs map[string]string, args ...string) (stdout bytes.Buffer, err error) {
+				test.AssertFilesEquals(t, args[9], "testdata/kind_config_registry_cache.yaml")
+				return bytes.Buffer{}, nil
+			},
+		),
+		docker.EXPECT().Execute(ctx, "network", "connect", "kind", "eksa-registry-cache").Return(bytes.Buffer{}, nil),
+	)
+
+	k := executables.NewKind(executable, writer, executables.WithDockerExecutable(docker))
+	if _, err := k.CreateBootstrapCluster(ctx, clusterSpec, k.WithRegistryCache(cacheDir)); err != nil {
+		t.Fatalf("CreateBootstrapCluster() error = %v, wantErr nil", err)
+	}
+
+	if _, err := os.Stat(cacheDir); err != nil {
+		t.Errorf("registry cache directory not created: %v", err)
+	}
+}
+
+func TestKindCreateBootstrapClusterRegistryCacheRemovedOnError(t *testing.T) {
+	_, writer := test.NewWriter(t)
+	clusterSpec := test.NewClusterSpec(func(s *cluster.Spec) {
+		s.Cluster.Name = "test_cluster"
+		s.VersionsBundle = versionBundle
+	})
+	cacheDir := filepath.Join(t.TempDir(), "registry-cache")
+
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	executable := mockexecutables.NewMockExecutable(mockCtrl)
+	docker := mockexecutables.NewMockExecutable(mockCtrl)
+	gomock.InOrder(
+		docker.EXPECT().Execute(ctx, "container", "inspect", "eksa-registry-cache").Return(bytes.Buffer{}, errors.New("Error: No such container: eksa-registry-cache")),
+		docker.EXPECT().Execute(ctx, "run", "-d", "--name", "eksa-registry-cache", "-v", cacheDir+":/var/lib/registry", "-e", "REGISTRY_PROXY_REMOTEURL=https://public.ecr.aws", "public.ecr.aws/docker/library/registry:2").Return(bytes.Buffer{}, nil),
+		executable.EXPECT().ExecuteWithEnv(ctx, map[string]string{}, gomock.Any()).Return(bytes.Buffer{}, errors.New("kind failed")),
+		docker.EXPECT().Execute(ctx, "container", "inspect", "eksa-registry-cache").Return(bytes.Buffer{}, nil),
+		docker.EXPECT().Execute(ctx, "rm", "-f", "eksa-registry-cache").Return(bytes.Buffer{}, nil),
+		executable.EXPECT().Execute(ctx, "delete", "cluster", "--name", "test_cluster-eks-a-cluster").Return(bytes.Buffer{}, nil),
+	)
+
+	k := executables.NewKind(executable, writer, executables.WithDockerExecutable(docker))
+	if _, err := k.CreateBootstrapCluster(ctx, clusterSpec, k.WithRegistryCache(cacheDir)); err == nil {
+		t.Fatal("CreateBootstrapCluster() error = nil, want error")
+	}
+	// The cache was already removed, so deleting the cluster doesn't try to remove it again.
+	if err := k.DeleteBootstrapCluster(ctx, &types.Cluster{Name: "test_cluster"}); err != nil {
+		t.Fatalf("Kind.DeleteBootstrapCluster() error = %v, want nil", err)
+	}
+}
+
+func TestKindCreateBootstrapClusterRegistryCacheWithoutDocker(t *testing.T) {
+	_, writer := test.NewWriter(t)
+	clusterSpec := test.NewClusterSpec(func(s *cluster.Spec) {
+		s.Cluster.Name = "test_cluster"
+		s.VersionsBundle = versionBundle
+	})
+
+	mockCtrl := gomock.NewController(t)
+	executable := mockexecutables.NewMockExecutable(mockCtrl)
+	k := executables.NewKind(executable, writer)
+	if _, err := k.CreateBootstrapCluster(context.Background(), clusterSpec, k.WithRegistryCache("registry-cache")); err == nil {
+		t.Fatal("Kind.CreateBootstrapCluster() error = nil")
+	}
+}
+
+func TestKindCreateBootstrapClusterRegistryCacheWithRegistryMirror(t *testing.T) {
+	_, writer := test.NewWriter(t)
+	clusterSpec := test.NewClusterSpec(func(s *cluster.Spec) {
+		s.Cluster.Name = "test_cluster"
+		s.VersionsBundle = versionBundle
+		s.Cluster.Spec.RegistryMirrorConfiguration = &v1alpha1.RegistryMirrorConfiguration{
+			Endpoint: "registry-mirror.test",
+			Port:     constants.DefaultHttpsPort,
+		}
+	})
+
+	mockCtrl := gomock.NewController(t)
+	executable := mockexecutables.NewMockExecutable(mockCtrl)
+	docker := mockexecutables.NewMockExecutable(mockCtrl)
+	k := executables.NewKind(executable, writer, executables.WithDockerExecutable(docker))
+	if _, err := k.CreateBootstrapCluster(context.Background(), clusterSpec, k.WithRegistryCache("registry-cache")); err == nil {
+		t.Fatal("Kind.CreateBootstrapCluster() error = nil")
+	}
+}
+
 func testOptionsToBootstrapOptions(k *executables.Kind, testOpts []testKindOption) []bootstrapper.BootstrapClusterClientOption {
 	opts := make([]bootstrapper.BootstrapClusterClientOption, 0, len(testOpts))
 	for _, opt := range testOpts {
@@ -353,6 +535,53 @@ func TestKindDeleteBootstrapClusterSuccess(t *testing.T) {
 	}
 }
 
+func TestKindDeleteBootstrapClusterRemovesRegistryCache(t *testing.T) {
+	_, writer := test.NewWriter(t)
+	clusterSpec := test.NewClusterSpec(func(s *cluster.Spec) {
+		s.Cluster.Name = "clusterName"
+		s.VersionsBundle = versionBundle
+	})
+	cacheDir := filepath.Join(t.TempDir(), "registry-cache")
+
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	executable := mockexecutables.NewMockExecutable(mockCtrl)
+	docker := mockexecutables.NewMockExecutable(mockCtrl)
+	gomock.InOrder(
+		docker.EXPECT().Execute(ctx, "container", "inspect", "eksa-registry-cache").Return(bytes.Buffer{}, errors.New("Error: No such container: eksa-registry-cache")),
+		docker.EXPECT().Execute(ctx, "run", "-d", "--name", "eksa-registry-cache", "-v", cacheDir+":/var/lib/registry", "-e", "REGISTRY_PROXY_REMOTEURL=https://public.ecr.aws", "public.ecr.aws/docker/library/registry:2").Return(bytes.Buffer{}, nil),
+		executable.EXPECT().ExecuteWithEnv(ctx, map[string]string{}, gomock.Any()).Return(bytes.Buffer{}, nil),
+		docker.EXPECT().Execute(ctx, "network", "connect", "kind", "eksa-registry-cache").Return(bytes.Buffer{}, nil),
+		executable.EXPECT().Execute(ctx, "delete", "cluster", "--name", "clusterName-eks-a-cluster").Return(bytes.Buffer{}, nil),
+		docker.EXPECT().Execute(ctx, "container", "inspect", "eksa-registry-cache").Return(bytes.Buffer{}, nil),
+		docker.EXPECT().Execute(ctx, "rm", "-f", "eksa-registry-cache").Return(bytes.Buffer{}, nil),
+	)
+	k := executables.NewKind(executable, writer, executables.WithDockerExecutable(docker))
+	if _, err := k.CreateBootstrapCluster(ctx, clusterSpec, k.WithRegistryCache(cacheDir)); err != nil {
+		t.Fatalf("CreateBootstrapCluster() error = %v, wantErr nil", err)
+	}
+	if err := k.DeleteBootstrapCluster(ctx, &types.Cluster{Name: "clusterName"}); err != nil {
+		t.Fatalf("Kind.DeleteBootstrapCluster() error = %v, want nil", err)
+	}
+}
+
+func TestKindDeleteBootstrapClusterNoRegistryCache(t *testing.T) {
+	cluster := &types.Cluster{
+		Name: "clusterName",
+	}
+	ctx := context.Background()
+	_, writer := test.NewWriter(t)
+
+	mockCtrl := gomock.NewController(t)
+	executable := mockexecutables.NewMockExecutable(mockCtrl)
+	docker := mockexecutables.NewMockExecutable(mockCtrl)
+	executable.EXPECT().Execute(ctx, "delete", "cluster", "--name", "clusterName-eks-a-cluster").Return(bytes.Buffer{}, nil)
+	k := executables.NewKind(executable, writer, executables.WithDockerExecutable(docker))
+	if err := k.DeleteBootstrapCluster(ctx, cluster); err != nil {
+		t.Fatalf("Kind.DeleteBootstrapCluster() error = %v, want nil", err)
+	}
+}
+
 func TestKindDeleteBootstrapClusterExecutableError(t *testing.T) {
 	cluster := &types.Cluster{
 		Name: "clusterName",
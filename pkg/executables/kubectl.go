@@ -659,6 +659,15 @@ func (k *Kubectl) DeleteSecret(ctx context.Context, managementCluster *types.Clu
 	return nil
 }
 
+// DeleteObjectIfExists deletes the object of resourceType with name in namespace. It doesn't fail if the object doesn't exist.
+func (k *Kubectl) DeleteObjectIfExists(ctx context.Context, resourceType, name, namespace, kubeconfig string) error {
+	params := []string{"delete", resourceType, name, "--kubeconfig", kubeconfig, "--namespace", namespace, "--ignore-not-found=true"}
+	if _, err := k.Execute(ctx, params...); err != nil {
+		return fmt.Errorf("deleting %s %s in namespace %s: %v", resourceType, name, namespace, err)
+	}
+	return nil
+}
+
 func (k *Kubectl) DeleteOIDCConfig(ctx context.Context, managementCluster *types.Cluster, oidcConfigName, oidcConfigNamespace string) error {
 	params := []string{"delete", eksaOIDCResourceType, oidcConfigName, "--kubeconfig", managementCluster.KubeconfigFile, "--namespace", oidcConfigNamespace, "--ignore-not-found=true"}
 	_, err := k.Execute(ctx, params...)
@@ -289,6 +289,15 @@ func TestKubectlDeleteSecretSuccess(t *testing.T) {
 	}
 }
 
+func TestKubectlDeleteObjectIfExistsSuccess(t *testing.T) {
+	k, ctx, _, e := newKubectl(t)
+	expectedParam := []string{"delete", "daemonset", "kube-proxy", "--kubeconfig", "kubeconfig", "--namespace", "kube-system", "--ignore-not-found=true"}
+	e.EXPECT().Execute(ctx, gomock.Eq(expectedParam)).Return(bytes.Buffer{}, nil)
+	if err := k.DeleteObjectIfExists(ctx, "daemonset", "kube-proxy", "kube-system", "kubeconfig"); err != nil {
+		t.Errorf("Kubectl.DeleteObjectIfExists() error = %v, want nil", err)
+	}
+}
+
 func TestKubectlGetNamespaceSuccess(t *testing.T) {
 	var kubeconfig, namespace string
 
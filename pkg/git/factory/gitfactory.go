@@ -19,8 +19,11 @@ import (
 	"github.com/aws/eks-anywhere/pkg/filewriter"
 	"github.com/aws/eks-anywhere/pkg/git"
 	"github.com/aws/eks-anywhere/pkg/git/gitclient"
+	"github.com/aws/eks-anywhere/pkg/git/githubapp"
 	"github.com/aws/eks-anywhere/pkg/git/gogithub"
+	"github.com/aws/eks-anywhere/pkg/git/providers/bitbucketserver"
 	"github.com/aws/eks-anywhere/pkg/git/providers/github"
+	"github.com/aws/eks-anywhere/pkg/git/providers/gitlab"
 )
 
 type GitTools struct {
@@ -54,6 +57,36 @@ func Build(ctx context.Context, cluster *v1alpha1.Cluster, fluxConfig *v1alpha1.
 		gitAuth = &http.BasicAuth{Password: githubToken, Username: fluxConfig.Spec.Github.Owner}
 		repo = fluxConfig.Spec.Github.Repository
 		repoUrl = github.RepoUrl(fluxConfig.Spec.Github.Owner, repo)
+	case fluxConfig.Spec.Gitlab != nil:
+		gitlabToken, err := gitlab.GetGitlabAccessTokenFromEnv()
+		if err != nil {
+			return nil, err
+		}
+
+		config := fluxConfig.Spec.Gitlab
+		tools.Provider = gitlab.New(config, git.TokenAuth{Token: gitlabToken, Username: config.Owner})
+		// GitLab accepts any non empty username when authenticating with an access token.
+		gitAuth = &http.BasicAuth{Password: gitlabToken, Username: "oauth2"}
+		repo = config.Repository
+		repoUrl = gitlab.RepoUrl(gitlab.Hostname(config), config.Owner, repo)
+	case fluxConfig.Spec.BitbucketServer != nil:
+		auth, err := bitbucketserver.GetBitbucketServerAuthFromEnv()
+		if err != nil {
+			return nil, err
+		}
+
+		config := fluxConfig.Spec.BitbucketServer
+		tools.Provider = bitbucketserver.New(config, auth)
+		gitAuth = &http.BasicAuth{Password: auth.Token, Username: auth.Username}
+		repo = config.Repository
+		repoUrl = bitbucketserver.RepoUrl(config.Hostname, config.Owner, repo, config.Personal)
+	case fluxConfig.Spec.Git != nil && fluxConfig.Spec.Git.IsHTTPS():
+		gitAuth, err = getHttpsAuth(ctx, fluxConfig.Spec.Git)
+		if err != nil {
+			return nil, err
+		}
+		repoUrl = fluxConfig.Spec.Git.RepositoryUrl
+		repo = path.Base(strings.TrimSuffix(repoUrl, filepath.Ext(repoUrl)))
 	case fluxConfig.Spec.Git != nil:
 		privateKeyFile := os.Getenv(config.EksaGitPrivateKeyTokenEnv)
 		privateKeyPassphrase := os.Getenv(config.EksaGitPassphraseTokenEnv)
@@ -77,7 +110,10 @@ func Build(ctx context.Context, cluster *v1alpha1.Cluster, fluxConfig *v1alpha1.
 			opt(&tools)
 		}
 	}
-	tools.Client = buildGitClient(ctx, gitAuth, repoUrl, tools.RepositoryDirectory)
+	tools.Client, err = buildGitClient(ctx, gitAuth, repoUrl, tools.RepositoryDirectory)
+	if err != nil {
+		return nil, err
+	}
 
 	tools.Writer, err = newRepositoryWriter(writer, repo)
 	if err != nil {
@@ -87,14 +123,23 @@ func Build(ctx context.Context, cluster *v1alpha1.Cluster, fluxConfig *v1alpha1.
 	return &tools, nil
 }
 
-func buildGitClient(ctx context.Context, auth transport.AuthMethod, repoUrl string, repo string) *gitclient.GitClient {
+func buildGitClient(ctx context.Context, auth transport.AuthMethod, repoUrl string, repo string) (*gitclient.GitClient, error) {
 	opts := []gitclient.Opt{
 		gitclient.WithRepositoryUrl(repoUrl),
 		gitclient.WithRepositoryDirectory(repo),
 		gitclient.WithAuth(auth),
+		gitclient.WithAuthor(os.Getenv(config.EksaGitAuthorNameEnv), os.Getenv(config.EksaGitAuthorEmailEnv)),
+	}
+
+	if signingKey := os.Getenv(config.EksaGitSigningKeyEnv); signingKey != "" {
+		signer, err := gitclient.NewSignerFromFile(signingKey, os.Getenv(config.EksaGitSigningPassphraseEnv))
+		if err != nil {
+			return nil, fmt.Errorf("building git commit signer: %v", err)
+		}
+		opts = append(opts, gitclient.WithSigner(signer))
 	}
 
-	return gitclient.New(opts...)
+	return gitclient.New(opts...), nil
 }
 
 func buildGithubProvider(ctx context.Context, githubToken string, config *v1alpha1.GithubProviderConfig) (git.ProviderClient, error) {
@@ -125,6 +170,43 @@ func WithRepositoryDirectory(repoDir string) GitToolsOpt {
 	}
 }
 
+// getHttpsAuth builds the basic auth for a generic HTTPS git repository, either from the username and
+// password (or access token) in the env or from a GitHub App installation token.
+func getHttpsAuth(ctx context.Context, gitConfig *v1alpha1.GitProviderConfig) (*http.BasicAuth, error) {
+	if gitConfig.GithubApp == nil {
+		username := os.Getenv(config.EksaGitUsernameEnv)
+		password := os.Getenv(config.EksaGitPasswordEnv)
+		if username == "" || password == "" {
+			return nil, fmt.Errorf("%s and %s must be set to authenticate with https git repositories", config.EksaGitUsernameEnv, config.EksaGitPasswordEnv)
+		}
+		return &http.BasicAuth{Username: username, Password: password}, nil
+	}
+
+	token, err := GithubAppInstallationToken(ctx, gitConfig, os.Getenv(config.EksaGitGithubAppKeyEnv))
+	if err != nil {
+		return nil, err
+	}
+	return &http.BasicAuth{Username: githubapp.TokenUsername, Password: token}, nil
+}
+
+// GithubAppInstallationToken creates an installation token for the GitHub App configured in gitConfig,
+// signing the request with the private key in privateKeyFile.
+func GithubAppInstallationToken(ctx context.Context, gitConfig *v1alpha1.GitProviderConfig, privateKeyFile string) (string, error) {
+	creds, err := githubapp.CredentialsFromFile(gitConfig.GithubApp.AppID, gitConfig.GithubApp.InstallationID, privateKeyFile)
+	if err != nil {
+		return "", err
+	}
+	client, err := githubapp.NewForRepository(gitConfig.RepositoryUrl)
+	if err != nil {
+		return "", err
+	}
+	token, err := client.InstallationToken(ctx, creds)
+	if err != nil {
+		return "", fmt.Errorf("creating github app installation token: %v", err)
+	}
+	return token, nil
+}
+
 func getSshAuthFromPrivateKey(privateKeyFile string, passphrase string) (gogitssh.AuthMethod, error) {
 	signer, err := getSignerFromPrivateKeyFile(privateKeyFile, passphrase)
 	if err != nil {
@@ -157,3 +239,10 @@ func getSignerFromPrivateKeyFile(privateKeyFile string, passphrase string) (ssh.
 	}
 	return ssh.ParsePrivateKeyWithPassphrase(sshKey, []byte(passphrase))
 }
+
+// BuildForGitProvider builds the git tools for a generic git repository, for GitOps engines that are
+// not configured through a FluxConfig.
+func BuildForGitProvider(ctx context.Context, cluster *v1alpha1.Cluster, gitConfig *v1alpha1.GitProviderConfig, writer filewriter.FileWriter, opts ...GitToolsOpt) (*GitTools, error) {
+	fluxConfig := &v1alpha1.FluxConfig{Spec: v1alpha1.FluxConfigSpec{Git: gitConfig}}
+	return Build(ctx, cluster, fluxConfig, writer, opts...)
+}
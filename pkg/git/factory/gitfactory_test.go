@@ -2,14 +2,20 @@ package gitfactory_test
 
 import (
 	"context"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/aws/eks-anywhere/internal/test"
 	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/config"
 	gitFactory "github.com/aws/eks-anywhere/pkg/git/factory"
+	"github.com/aws/eks-anywhere/pkg/git/gitclient"
+	"github.com/aws/eks-anywhere/pkg/git/providers/bitbucketserver"
 	"github.com/aws/eks-anywhere/pkg/git/providers/github"
+	"github.com/aws/eks-anywhere/pkg/git/providers/gitlab"
 )
 
 const (
@@ -65,6 +71,172 @@ func TestGitFactoryHappyPath(t *testing.T) {
 	}
 }
 
+func TestGitFactoryGitlab(t *testing.T) {
+	t.Setenv(gitlab.EksaGitlabTokenEnv, "glpat-token")
+	cluster := &v1alpha1.Cluster{ObjectMeta: v1.ObjectMeta{Name: "testCluster"}}
+	fluxConfig := &v1alpha1.FluxConfig{
+		Spec: v1alpha1.FluxConfigSpec{
+			Gitlab: &v1alpha1.GitlabProviderConfig{
+				Owner:      "platform",
+				Repository: "testRepo",
+				Hostname:   "gitlab.example.com",
+			},
+		},
+	}
+	_, w := test.NewWriter(t)
+
+	tools, err := gitFactory.Build(context.Background(), cluster, fluxConfig, w)
+	if err != nil {
+		t.Fatalf("gitfactory.Build returned err, wanted nil. err: %v", err)
+	}
+	if tools.Provider == nil {
+		t.Fatal("gitfactory.Build didn't build a gitlab provider")
+	}
+}
+
+func TestGitFactoryGitlabMissingToken(t *testing.T) {
+	t.Setenv(gitlab.EksaGitlabTokenEnv, "")
+	cluster := &v1alpha1.Cluster{ObjectMeta: v1.ObjectMeta{Name: "testCluster"}}
+	fluxConfig := &v1alpha1.FluxConfig{
+		Spec: v1alpha1.FluxConfigSpec{
+			Gitlab: &v1alpha1.GitlabProviderConfig{Owner: "platform", Repository: "testRepo"},
+		},
+	}
+	_, w := test.NewWriter(t)
+
+	if _, err := gitFactory.Build(context.Background(), cluster, fluxConfig, w); err == nil {
+		t.Fatal("gitfactory.Build returned nil err, wanted missing token error")
+	}
+}
+
+func TestGitFactoryBitbucketServer(t *testing.T) {
+	t.Setenv(bitbucketserver.EksaBitbucketServerTokenEnv, "bbs-token")
+	t.Setenv(bitbucketserver.EksaBitbucketServerUserEnv, "janedoe")
+	cluster := &v1alpha1.Cluster{ObjectMeta: v1.ObjectMeta{Name: "testCluster"}}
+	fluxConfig := &v1alpha1.FluxConfig{
+		Spec: v1alpha1.FluxConfigSpec{
+			BitbucketServer: &v1alpha1.BitbucketServerProviderConfig{
+				Owner:      "OPS",
+				Repository: "testRepo",
+				Hostname:   "bitbucket.example.com",
+			},
+		},
+	}
+	_, w := test.NewWriter(t)
+
+	tools, err := gitFactory.Build(context.Background(), cluster, fluxConfig, w)
+	if err != nil {
+		t.Fatalf("gitfactory.Build returned err, wanted nil. err: %v", err)
+	}
+	if tools.Provider == nil {
+		t.Fatal("gitfactory.Build didn't build a bitbucket server provider")
+	}
+}
+
+func TestGitFactoryGitHttpsBasicAuth(t *testing.T) {
+	t.Setenv(config.EksaGitUsernameEnv, "janedoe")
+	t.Setenv(config.EksaGitPasswordEnv, "token")
+	cluster := &v1alpha1.Cluster{ObjectMeta: v1.ObjectMeta{Name: "testCluster"}}
+	fluxConfig := &v1alpha1.FluxConfig{
+		Spec: v1alpha1.FluxConfigSpec{
+			Git: &v1alpha1.GitProviderConfig{RepositoryUrl: "https://git.example.com/platform/testRepo.git"},
+		},
+	}
+	_, w := test.NewWriter(t)
+
+	tools, err := gitFactory.Build(context.Background(), cluster, fluxConfig, w)
+	if err != nil {
+		t.Fatalf("gitfactory.Build returned err, wanted nil. err: %v", err)
+	}
+	if tools.Client == nil {
+		t.Fatal("gitfactory.Build didn't build a git client")
+	}
+}
+
+func TestGitFactoryGitHttpsMissingCredentials(t *testing.T) {
+	t.Setenv(config.EksaGitUsernameEnv, "janedoe")
+	t.Setenv(config.EksaGitPasswordEnv, "")
+	cluster := &v1alpha1.Cluster{ObjectMeta: v1.ObjectMeta{Name: "testCluster"}}
+	fluxConfig := &v1alpha1.FluxConfig{
+		Spec: v1alpha1.FluxConfigSpec{
+			Git: &v1alpha1.GitProviderConfig{RepositoryUrl: "https://git.example.com/platform/testRepo.git"},
+		},
+	}
+	_, w := test.NewWriter(t)
+
+	if _, err := gitFactory.Build(context.Background(), cluster, fluxConfig, w); err == nil {
+		t.Fatal("gitfactory.Build returned nil err, wanted missing credentials error")
+	}
+}
+
+func TestGitFactoryGitHttpsGithubAppMissingKey(t *testing.T) {
+	t.Setenv(config.EksaGitGithubAppKeyEnv, filepath.Join(t.TempDir(), "missing.pem"))
+	cluster := &v1alpha1.Cluster{ObjectMeta: v1.ObjectMeta{Name: "testCluster"}}
+	fluxConfig := &v1alpha1.FluxConfig{
+		Spec: v1alpha1.FluxConfigSpec{
+			Git: &v1alpha1.GitProviderConfig{
+				RepositoryUrl: "https://github.com/platform/testRepo.git",
+				GithubApp:     &v1alpha1.GithubAppConfig{AppID: 1234, InstallationID: 5678},
+			},
+		},
+	}
+	_, w := test.NewWriter(t)
+
+	_, err := gitFactory.Build(context.Background(), cluster, fluxConfig, w)
+	if err == nil || !strings.Contains(err.Error(), "reading github app private key") {
+		t.Fatalf("gitfactory.Build returned err %v, wanted github app private key error", err)
+	}
+}
+
+func TestGitFactoryGitCommitAuthor(t *testing.T) {
+	t.Setenv(config.EksaGitUsernameEnv, "janedoe")
+	t.Setenv(config.EksaGitPasswordEnv, "token")
+	t.Setenv(config.EksaGitAuthorNameEnv, "gitops-bot")
+	t.Setenv(config.EksaGitAuthorEmailEnv, "gitops-bot@example.com")
+	cluster := &v1alpha1.Cluster{ObjectMeta: v1.ObjectMeta{Name: "testCluster"}}
+	gitConfig := &v1alpha1.GitProviderConfig{RepositoryUrl: "https://git.example.com/platform/testRepo.git"}
+	_, w := test.NewWriter(t)
+
+	tools, err := gitFactory.BuildForGitProvider(context.Background(), cluster, gitConfig, w)
+	if err != nil {
+		t.Fatalf("gitfactory.BuildForGitProvider returned err, wanted nil. err: %v", err)
+	}
+	client := tools.Client.(*gitclient.GitClient)
+	if client.AuthorName != "gitops-bot" || client.AuthorEmail != "gitops-bot@example.com" {
+		t.Fatalf("gitfactory.BuildForGitProvider commit author = %s <%s>, wanted gitops-bot <gitops-bot@example.com>", client.AuthorName, client.AuthorEmail)
+	}
+}
+
+func TestGitFactoryGitInvalidSigningKey(t *testing.T) {
+	t.Setenv(config.EksaGitUsernameEnv, "janedoe")
+	t.Setenv(config.EksaGitPasswordEnv, "token")
+	t.Setenv(config.EksaGitSigningKeyEnv, filepath.Join(t.TempDir(), "missing.asc"))
+	cluster := &v1alpha1.Cluster{ObjectMeta: v1.ObjectMeta{Name: "testCluster"}}
+	gitConfig := &v1alpha1.GitProviderConfig{RepositoryUrl: "https://git.example.com/platform/testRepo.git"}
+	_, w := test.NewWriter(t)
+
+	_, err := gitFactory.BuildForGitProvider(context.Background(), cluster, gitConfig, w)
+	if err == nil || !strings.Contains(err.Error(), "building git commit signer") {
+		t.Fatalf("gitfactory.BuildForGitProvider returned err %v, wanted git commit signer error", err)
+	}
+}
+
+func TestGitFactoryBuildForGitProvider(t *testing.T) {
+	t.Setenv(config.EksaGitUsernameEnv, "janedoe")
+	t.Setenv(config.EksaGitPasswordEnv, "token")
+	cluster := &v1alpha1.Cluster{ObjectMeta: v1.ObjectMeta{Name: "testCluster"}}
+	gitConfig := &v1alpha1.GitProviderConfig{RepositoryUrl: "https://git.example.com/platform/testRepo.git"}
+	_, w := test.NewWriter(t)
+
+	tools, err := gitFactory.BuildForGitProvider(context.Background(), cluster, gitConfig, w)
+	if err != nil {
+		t.Fatalf("gitfactory.BuildForGitProvider returned err, wanted nil. err: %v", err)
+	}
+	if tools.RepositoryDirectory != filepath.Join("testCluster", "git", "testRepo") {
+		t.Fatalf("gitfactory.BuildForGitProvider repository directory = %s, wanted testCluster/git/testRepo", tools.RepositoryDirectory)
+	}
+}
+
 func setupContext(t *testing.T) {
 	t.Setenv(github.EksaGithubTokenEnv, validPATValue)
 	t.Setenv(github.GithubTokenEnv, validPATValue)
@@ -24,6 +24,8 @@ type ProviderClient interface {
 	AddDeployKeyToRepo(ctx context.Context, opts AddDeployKeyOpts) error
 	Validate(ctx context.Context) error
 	PathExists(ctx context.Context, owner, repo, branch, path string) (bool, error)
+	CreatePullRequest(ctx context.Context, opts CreatePullRequestOpts) (*PullRequest, error)
+	GetPullRequest(ctx context.Context, opts GetPullRequestOpts) (*PullRequest, error)
 }
 
 type CreateRepoOpts struct {
@@ -53,6 +55,39 @@ type AddDeployKeyOpts struct {
 	ReadOnly   bool
 }
 
+type CreatePullRequestOpts struct {
+	Owner       string
+	Repository  string
+	Title       string
+	Description string
+	// HeadBranch is the branch that contains the changes.
+	HeadBranch string
+	// BaseBranch is the branch the changes will be merged into.
+	BaseBranch string
+}
+
+type GetPullRequestOpts struct {
+	Owner      string
+	Repository string
+	Number     int
+}
+
+// PullRequestState is the provider agnostic state of a pull request.
+type PullRequestState string
+
+const (
+	PullRequestOpen   PullRequestState = "open"
+	PullRequestMerged PullRequestState = "merged"
+	PullRequestClosed PullRequestState = "closed"
+)
+
+// PullRequest is a pull request in GitHub and Bitbucket Server or a merge request in GitLab.
+type PullRequest struct {
+	Number int
+	Url    string
+	State  PullRequestState
+}
+
 type Repository struct {
 	Name         string
 	Owner        string
@@ -25,6 +25,7 @@ const (
 	maxRetries     = 5
 	backOffPeriod  = 5 * time.Second
 	emptyRepoError = "remote repository is empty"
+	defaultAuthor  = "EKS-A"
 )
 
 type GitClient struct {
@@ -33,6 +34,9 @@ type GitClient struct {
 	RepoUrl       string
 	RepoDirectory string
 	Retrier       *retrier.Retrier
+	AuthorName    string
+	AuthorEmail   string
+	Signer        Signer
 }
 
 type Opt func(*GitClient)
@@ -66,6 +70,21 @@ func WithRepositoryDirectory(repoDir string) Opt {
 	}
 }
 
+// WithAuthor sets the name and email used as author and committer of the commits.
+func WithAuthor(name, email string) Opt {
+	return func(c *GitClient) {
+		c.AuthorName = name
+		c.AuthorEmail = email
+	}
+}
+
+// WithSigner enables commit signing with the given Signer.
+func WithSigner(signer Signer) Opt {
+	return func(c *GitClient) {
+		c.Signer = signer
+	}
+}
+
 func (g *GitClient) Clone(ctx context.Context) error {
 	_, err := g.Client.Clone(ctx, g.RepoDirectory, g.RepoUrl, g.Auth)
 	if err != nil && strings.Contains(err.Error(), emptyRepoError) {
@@ -127,21 +146,38 @@ func (g *GitClient) Commit(message string) error {
 	}
 
 	logger.V(3).Info("Generating Commit object...")
-	commitSignature := &object.Signature{
-		Name: "EKS-A",
-		When: time.Now(),
-	}
+	commitSignature := g.commitSignature()
 	commit, err := g.Client.Commit(message, commitSignature, w)
 	if err != nil {
 		return err
 	}
 
+	if g.Signer != nil {
+		logger.V(3).Info("Signing commit", "hash", commit)
+		commit, err = g.Client.SignCommit(r, commit, g.Signer)
+		if err != nil {
+			return fmt.Errorf("signing commit: %v", err)
+		}
+	}
+
 	logger.V(3).Info("Committing Object to local repo", "repo", g.RepoDirectory)
 	finalizedCommit, err := g.Client.CommitObject(r, commit)
 	logger.Info("Finalized commit and committed to local repository", "hash", finalizedCommit.Hash)
 	return err
 }
 
+func (g *GitClient) commitSignature() *object.Signature {
+	name := g.AuthorName
+	if name == "" {
+		name = defaultAuthor
+	}
+	return &object.Signature{
+		Name:  name,
+		Email: g.AuthorEmail,
+		When:  time.Now(),
+	}
+}
+
 func (g *GitClient) Push(ctx context.Context) error {
 	logger.V(3).Info("Pushing to remote", "repo", g.RepoDirectory)
 	r, err := g.Client.OpenDir(g.RepoDirectory)
@@ -331,6 +367,7 @@ type GoGit interface {
 	ListWithContext(ctx context.Context, r *gogit.Remote, auth transport.AuthMethod) ([]*plumbing.Reference, error)
 	Remove(f string, w *gogit.Worktree) (plumbing.Hash, error)
 	SetRepositoryReference(r *gogit.Repository, p *plumbing.Reference) error
+	SignCommit(r *gogit.Repository, h plumbing.Hash, signer Signer) (plumbing.Hash, error)
 }
 
 type goGit struct{}
@@ -444,3 +481,47 @@ func (ggc *goGit) ListWithContext(ctx context.Context, r *gogit.Remote, auth tra
 func (gg *goGit) SetRepositoryReference(r *gogit.Repository, p *plumbing.Reference) error {
 	return r.Storer.SetReference(p)
 }
+
+// SignCommit replaces the commit with a signed copy and moves HEAD to it. The signature is computed over the
+// commit encoded without signature, which is what git verifies.
+func (gg *goGit) SignCommit(r *gogit.Repository, h plumbing.Hash, signer Signer) (plumbing.Hash, error) {
+	commit, err := r.CommitObject(h)
+	if err != nil {
+		return plumbing.ZeroHash, err
+	}
+
+	unsigned := &plumbing.MemoryObject{}
+	if err = commit.EncodeWithoutSignature(unsigned); err != nil {
+		return plumbing.ZeroHash, err
+	}
+	reader, err := unsigned.Reader()
+	if err != nil {
+		return plumbing.ZeroHash, err
+	}
+	defer reader.Close()
+
+	signature, err := signer.Sign(reader)
+	if err != nil {
+		return plumbing.ZeroHash, err
+	}
+	commit.PGPSignature = string(signature)
+
+	signed := r.Storer.NewEncodedObject()
+	if err = commit.Encode(signed); err != nil {
+		return plumbing.ZeroHash, err
+	}
+	signedHash, err := r.Storer.SetEncodedObject(signed)
+	if err != nil {
+		return plumbing.ZeroHash, err
+	}
+
+	head, err := r.Head()
+	if err != nil {
+		return plumbing.ZeroHash, err
+	}
+	if err = r.Storer.SetReference(plumbing.NewHashReference(head.Name(), signedHash)); err != nil {
+		return plumbing.ZeroHash, err
+	}
+
+	return signedHash, nil
+}
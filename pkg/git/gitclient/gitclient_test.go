@@ -133,6 +133,57 @@ func TestGoGitCommit(t *testing.T) {
 	}
 }
 
+func TestGoGitCommitWithAuthorAndSigner(t *testing.T) {
+	_, client := newGoGitMock(t)
+	message := "message"
+	signer := gitclient.NewSSHSigner(nil)
+	unsigned := plumbing.NewHash("1111111111111111111111111111111111111111")
+	signed := plumbing.NewHash("2222222222222222222222222222222222222222")
+
+	client.EXPECT().OpenDir(repoDir).Return(&goGit.Repository{}, nil)
+	client.EXPECT().OpenWorktree(gomock.Any()).Return(&goGit.Worktree{}, nil)
+	client.EXPECT().Commit(message, gomock.Any(), gomock.Any()).Do(func(arg0 string, arg1 *object.Signature, arg2 *goGit.Worktree) {
+		if arg1.Name != "bot" || arg1.Email != "bot@example.com" {
+			t.Errorf("Commit() signature = %s <%s>, want bot <bot@example.com>", arg1.Name, arg1.Email)
+		}
+	}).Return(unsigned, nil)
+	client.EXPECT().SignCommit(gomock.Any(), unsigned, signer).Return(signed, nil)
+	client.EXPECT().CommitObject(gomock.Any(), signed).Return(&object.Commit{}, nil)
+
+	g := gitclient.New(
+		gitclient.WithRepositoryDirectory(repoDir),
+		gitclient.WithAuthor("bot", "bot@example.com"),
+		gitclient.WithSigner(signer),
+	)
+	g.Client = client
+
+	err := g.Commit(message)
+	if err != nil {
+		t.Errorf("Commit() error = %v", err)
+		return
+	}
+}
+
+func TestGoGitCommitSignError(t *testing.T) {
+	_, client := newGoGitMock(t)
+	signer := gitclient.NewSSHSigner(nil)
+
+	client.EXPECT().OpenDir(repoDir).Return(&goGit.Repository{}, nil)
+	client.EXPECT().OpenWorktree(gomock.Any()).Return(&goGit.Worktree{}, nil)
+	client.EXPECT().Commit(gomock.Any(), gomock.Any(), gomock.Any()).Return(plumbing.Hash{}, nil)
+	client.EXPECT().SignCommit(gomock.Any(), gomock.Any(), signer).Return(plumbing.ZeroHash, fmt.Errorf("bad key"))
+
+	g := &gitclient.GitClient{
+		RepoDirectory: repoDir,
+		Client:        client,
+		Signer:        signer,
+	}
+
+	if err := g.Commit("message"); err == nil {
+		t.Errorf("Commit() error = nil, want error")
+	}
+}
+
 func TestGoGitPush(t *testing.T) {
 	ctx, client := newGoGitMock(t)
 
@@ -8,6 +8,7 @@ import (
 	context "context"
 	reflect "reflect"
 
+	gitclient "github.com/aws/eks-anywhere/pkg/git/gitclient"
 	git "github.com/go-git/go-git/v5"
 	config "github.com/go-git/go-git/v5/config"
 	plumbing "github.com/go-git/go-git/v5/plumbing"
@@ -301,3 +302,18 @@ func (mr *MockGoGitMockRecorder) SetRepositoryReference(arg0, arg1 interface{})
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRepositoryReference", reflect.TypeOf((*MockGoGit)(nil).SetRepositoryReference), arg0, arg1)
 }
+
+// SignCommit mocks base method.
+func (m *MockGoGit) SignCommit(arg0 *git.Repository, arg1 plumbing.Hash, arg2 gitclient.Signer) (plumbing.Hash, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SignCommit", arg0, arg1, arg2)
+	ret0, _ := ret[0].(plumbing.Hash)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SignCommit indicates an expected call of SignCommit.
+func (mr *MockGoGitMockRecorder) SignCommit(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignCommit", reflect.TypeOf((*MockGoGit)(nil).SignCommit), arg0, arg1, arg2)
+}
@@ -0,0 +1,183 @@
+package githubapp
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"time"
+)
+
+const (
+	// TokenUsername is the username to use with installation tokens when authenticating to git over HTTPS.
+	TokenUsername = "x-access-token"
+
+	githubHostname = "github.com"
+	githubApiUrl   = "https://api.github.com"
+	// GitHub rejects JWTs that expire more than 10 minutes in the future.
+	jwtExpiration = 9 * time.Minute
+	// Backdate the JWT to allow for clock drift between the CLI host and GitHub.
+	jwtClockDrift = time.Minute
+)
+
+// Credentials identify a GitHub App installation and the private key used to sign requests for it.
+type Credentials struct {
+	AppID          int64
+	InstallationID int64
+	PrivateKey     []byte
+}
+
+// CredentialsFromFile builds Credentials reading the PEM encoded private key of the GitHub App from a file.
+func CredentialsFromFile(appID, installationID int64, privateKeyFile string) (Credentials, error) {
+	key, err := os.ReadFile(privateKeyFile)
+	if err != nil {
+		return Credentials{}, fmt.Errorf("reading github app private key: %v", err)
+	}
+	return Credentials{AppID: appID, InstallationID: installationID, PrivateKey: key}, nil
+}
+
+// Client requests installation tokens from the GitHub API.
+type Client struct {
+	httpClient *http.Client
+	apiUrl     string
+}
+
+// Opt allows to customize the Client.
+type Opt func(*Client)
+
+// WithHTTPClient sets the http client used to talk to the GitHub API.
+func WithHTTPClient(client *http.Client) Opt {
+	return func(c *Client) {
+		c.httpClient = client
+	}
+}
+
+// New builds a Client for the GitHub API at apiUrl.
+func New(apiUrl string, opts ...Opt) *Client {
+	c := &Client{
+		httpClient: &http.Client{},
+		apiUrl:     apiUrl,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// NewForRepository builds a Client for the GitHub API serving the HTTPS repository url,
+// either github.com or a GitHub Enterprise Server instance.
+func NewForRepository(repositoryUrl string, opts ...Opt) (*Client, error) {
+	u, err := url.Parse(repositoryUrl)
+	if err != nil {
+		return nil, fmt.Errorf("parsing repository url: %v", err)
+	}
+	if u.Host == "" {
+		return nil, fmt.Errorf("repository url %s doesn't have a host", repositoryUrl)
+	}
+	if u.Host == githubHostname {
+		return New(githubApiUrl, opts...), nil
+	}
+	return New("https://"+u.Host+"/api/v3", opts...), nil
+}
+
+// InstallationToken creates a new installation access token. Tokens expire after one hour.
+func (c *Client) InstallationToken(ctx context.Context, creds Credentials) (string, error) {
+	jwt, err := signJWT(creds, time.Now())
+	if err != nil {
+		return "", fmt.Errorf("signing github app jwt: %v", err)
+	}
+
+	u := fmt.Sprintf("%s/app/installations/%d/access_tokens", c.apiUrl, creds.InstallationID)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u, bytes.NewReader(nil))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Authorization", "Bearer "+jwt)
+	req.Header.Set("Accept", "application/vnd.github+json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("requesting github app installation token: %v", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("reading github app installation token response: %v", err)
+	}
+	if resp.StatusCode != http.StatusCreated {
+		return "", fmt.Errorf("requesting github app installation token: %s: %s", resp.Status, body)
+	}
+
+	token := &struct {
+		Token string `json:"token"`
+	}{}
+	if err := json.Unmarshal(body, token); err != nil {
+		return "", fmt.Errorf("parsing github app installation token response: %v", err)
+	}
+	if token.Token == "" {
+		return "", errors.New("github app installation token response doesn't contain a token")
+	}
+	return token.Token, nil
+}
+
+func signJWT(creds Credentials, now time.Time) (string, error) {
+	key, err := parsePrivateKey(creds.PrivateKey)
+	if err != nil {
+		return "", err
+	}
+
+	header, err := json.Marshal(map[string]string{"alg": "RS256", "typ": "JWT"})
+	if err != nil {
+		return "", err
+	}
+	claims, err := json.Marshal(map[string]interface{}{
+		"iat": now.Add(-jwtClockDrift).Unix(),
+		"exp": now.Add(jwtExpiration).Unix(),
+		"iss": strconv.FormatInt(creds.AppID, 10),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	unsigned := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(claims)
+	hash := sha256.Sum256([]byte(unsigned))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, hash[:])
+	if err != nil {
+		return "", err
+	}
+	return unsigned + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
+
+// parsePrivateKey accepts both the PKCS1 keys generated by GitHub and PKCS8 keys.
+func parsePrivateKey(data []byte) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("github app private key is not PEM encoded")
+	}
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("parsing github app private key: %v", err)
+	}
+	rsaKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("github app private key is not an RSA key")
+	}
+	return rsaKey, nil
+}
@@ -0,0 +1,162 @@
+package githubapp_test
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/aws/eks-anywhere/pkg/git/githubapp"
+)
+
+func newKey(t *testing.T) (*rsa.PrivateKey, []byte) {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return key, pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+}
+
+// verifyJWT checks the bearer token is a RS256 JWT signed by key and returns its claims.
+func verifyJWT(g *WithT, key *rsa.PrivateKey, authorization string) map[string]interface{} {
+	g.Expect(authorization).To(HavePrefix("Bearer "))
+	parts := strings.Split(strings.TrimPrefix(authorization, "Bearer "), ".")
+	g.Expect(parts).To(HaveLen(3))
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	g.Expect(err).NotTo(HaveOccurred())
+	hash := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	g.Expect(rsa.VerifyPKCS1v15(&key.PublicKey, crypto.SHA256, hash[:], signature)).To(Succeed())
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	g.Expect(err).NotTo(HaveOccurred())
+	claims := map[string]interface{}{}
+	g.Expect(json.Unmarshal(payload, &claims)).To(Succeed())
+	return claims
+}
+
+func TestInstallationToken(t *testing.T) {
+	g := NewWithT(t)
+	key, pemKey := newKey(t)
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		g.Expect(r.Method).To(Equal(http.MethodPost))
+		g.Expect(r.URL.Path).To(Equal("/api/v3/app/installations/5678/access_tokens"))
+		claims := verifyJWT(g, key, r.Header.Get("Authorization"))
+		g.Expect(claims["iss"]).To(Equal("1234"))
+		g.Expect(claims["exp"].(float64) - claims["iat"].(float64)).To(BeNumerically("<=", 600))
+
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"token": "ghs_token", "expires_at": "2022-10-18T16:00:00Z"}`))
+	}))
+	defer server.Close()
+
+	c, err := githubapp.NewForRepository(server.URL+"/org/repo.git", githubapp.WithHTTPClient(server.Client()))
+	g.Expect(err).NotTo(HaveOccurred())
+	token, err := c.InstallationToken(context.Background(), githubapp.Credentials{AppID: 1234, InstallationID: 5678, PrivateKey: pemKey})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(token).To(Equal("ghs_token"))
+}
+
+func TestInstallationTokenPKCS8Key(t *testing.T) {
+	g := NewWithT(t)
+	key, _ := newKey(t)
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	g.Expect(err).NotTo(HaveOccurred())
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		verifyJWT(g, key, r.Header.Get("Authorization"))
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"token": "ghs_token"}`))
+	}))
+	defer server.Close()
+
+	c := githubapp.New(server.URL, githubapp.WithHTTPClient(server.Client()))
+	token, err := c.InstallationToken(context.Background(), githubapp.Credentials{
+		AppID:          1234,
+		InstallationID: 5678,
+		PrivateKey:     pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}),
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(token).To(Equal("ghs_token"))
+}
+
+func TestInstallationTokenErrors(t *testing.T) {
+	_, pemKey := newKey(t)
+	tests := []struct {
+		name    string
+		key     []byte
+		status  int
+		body    string
+		wantErr string
+	}{
+		{
+			name:    "invalid key",
+			key:     []byte("not a key"),
+			wantErr: "github app private key is not PEM encoded",
+		},
+		{
+			name:    "unauthorized",
+			key:     pemKey,
+			status:  http.StatusUnauthorized,
+			body:    `{"message": "A JSON web token could not be decoded"}`,
+			wantErr: "401 Unauthorized: {\"message\": \"A JSON web token could not be decoded\"}",
+		},
+		{
+			name:    "no token",
+			key:     pemKey,
+			status:  http.StatusCreated,
+			body:    `{}`,
+			wantErr: "response doesn't contain a token",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			c := githubapp.New(server.URL, githubapp.WithHTTPClient(server.Client()))
+			_, err := c.InstallationToken(context.Background(), githubapp.Credentials{AppID: 1, InstallationID: 2, PrivateKey: tt.key})
+			g.Expect(err).To(MatchError(ContainSubstring(tt.wantErr)))
+		})
+	}
+}
+
+func TestNewForRepositoryGithub(t *testing.T) {
+	g := NewWithT(t)
+	_, err := githubapp.NewForRepository("https://github.com/org/repo.git")
+	g.Expect(err).NotTo(HaveOccurred())
+
+	_, err = githubapp.NewForRepository("org/repo.git")
+	g.Expect(err).To(MatchError(ContainSubstring("doesn't have a host")))
+}
+
+func TestCredentialsFromFile(t *testing.T) {
+	g := NewWithT(t)
+	_, pemKey := newKey(t)
+	f := filepath.Join(t.TempDir(), "app.pem")
+	g.Expect(os.WriteFile(f, pemKey, 0o600)).To(Succeed())
+
+	creds, err := githubapp.CredentialsFromFile(1234, 5678, f)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(creds).To(Equal(githubapp.Credentials{AppID: 1234, InstallationID: 5678, PrivateKey: pemKey}))
+
+	_, err = githubapp.CredentialsFromFile(1234, 5678, filepath.Join(t.TempDir(), "missing.pem"))
+	g.Expect(err).To(MatchError(ContainSubstring("reading github app private key")))
+}
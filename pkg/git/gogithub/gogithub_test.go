@@ -445,6 +445,76 @@ func TestPathExistsItDoesNot(t *testing.T) {
 	tt.Expect(tt.g.PathExists(tt.ctx, owner, repo, branch, path)).To(BeTrue())
 }
 
+func TestCreatePullRequest(t *testing.T) {
+	tt := newTest(t)
+	opts := git.CreatePullRequestOpts{
+		Owner:       "owner",
+		Repository:  "repo",
+		Title:       "Upgrade cluster",
+		Description: "Upgrade cluster to the new version",
+		HeadBranch:  "eksa/mgmt-upgrade",
+		BaseBranch:  "main",
+	}
+	tt.client.EXPECT().CreatePullRequest(tt.ctx, "owner", "repo", &github.NewPullRequest{
+		Title: &opts.Title,
+		Head:  &opts.HeadBranch,
+		Base:  &opts.BaseBranch,
+		Body:  &opts.Description,
+	}).Return(&github.PullRequest{
+		Number:  github.Int(3),
+		HTMLURL: github.String("https://github.com/owner/repo/pull/3"),
+		State:   github.String("open"),
+	}, nil, nil)
+
+	tt.Expect(tt.g.CreatePullRequest(tt.ctx, opts)).To(Equal(&git.PullRequest{
+		Number: 3,
+		Url:    "https://github.com/owner/repo/pull/3",
+		State:  git.PullRequestOpen,
+	}))
+}
+
+func TestCreatePullRequestError(t *testing.T) {
+	tt := newTest(t)
+	tt.client.EXPECT().CreatePullRequest(tt.ctx, "owner", "repo", gomock.Any()).Return(nil, nil, errors.New("validation failed"))
+
+	_, err := tt.g.CreatePullRequest(tt.ctx, git.CreatePullRequestOpts{Owner: "owner", Repository: "repo"})
+	tt.Expect(err).To(MatchError(ContainSubstring("creating pull request in repository repo: validation failed")))
+}
+
+func TestGetPullRequest(t *testing.T) {
+	tests := []struct {
+		name string
+		pr   *github.PullRequest
+		want git.PullRequestState
+	}{
+		{
+			name: "open",
+			pr:   &github.PullRequest{Number: github.Int(3), State: github.String("open")},
+			want: git.PullRequestOpen,
+		},
+		{
+			name: "merged",
+			pr:   &github.PullRequest{Number: github.Int(3), State: github.String("closed"), Merged: github.Bool(true)},
+			want: git.PullRequestMerged,
+		},
+		{
+			name: "closed",
+			pr:   &github.PullRequest{Number: github.Int(3), State: github.String("closed")},
+			want: git.PullRequestClosed,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tt := newTest(t)
+			tt.client.EXPECT().GetPullRequest(tt.ctx, "owner", "repo", 3).Return(tc.pr, nil, nil)
+
+			pr, err := tt.g.GetPullRequest(tt.ctx, git.GetPullRequestOpts{Owner: "owner", Repository: "repo", Number: 3})
+			tt.Expect(err).NotTo(HaveOccurred())
+			tt.Expect(pr.State).To(Equal(tc.want))
+		})
+	}
+}
+
 type gogithubTest struct {
 	*WithT
 	g      *gogithub.GoGithub
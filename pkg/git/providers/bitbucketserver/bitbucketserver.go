@@ -0,0 +1,291 @@
+package bitbucketserver
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/git"
+	"github.com/aws/eks-anywhere/pkg/git/providers/internal/restapi"
+	"github.com/aws/eks-anywhere/pkg/logger"
+)
+
+const (
+	GitProviderName               = "bitbucket-server"
+	EksaBitbucketServerTokenEnv   = "EKSA_BITBUCKET_SERVER_TOKEN"
+	EksaBitbucketServerUserEnv    = "EKSA_BITBUCKET_SERVER_USERNAME"
+	BitbucketTokenEnv             = "BITBUCKET_TOKEN"
+	bitbucketServerUrlTemplate    = "https://%v/scm/%v/%v.git"
+	apiPath                       = "/rest/api/1.0"
+	keysApiPath                   = "/rest/keys/1.0"
+	repoReadPermission            = "REPO_READ"
+	repoWritePermission           = "REPO_WRITE"
+	personalProjectKeyPrefix      = "~"
+	personalProjectType           = "PERSONAL"
+	bitbucketServerCloneLinkProto = "http"
+)
+
+type bitbucketServerProvider struct {
+	httpClient *http.Client
+	api        *restapi.Client
+	config     *v1alpha1.BitbucketServerProviderConfig
+	auth       git.TokenAuth
+}
+
+// Opt allows to customize the Bitbucket Server provider.
+type Opt func(*bitbucketServerProvider)
+
+// WithHTTPClient sets the http client used to talk to the Bitbucket Server API.
+func WithHTTPClient(client *http.Client) Opt {
+	return func(b *bitbucketServerProvider) {
+		b.httpClient = client
+	}
+}
+
+// New builds a Bitbucket Server provider for the repository in the config.
+// The auth username is the Bitbucket Server user that owns the access token.
+func New(config *v1alpha1.BitbucketServerProviderConfig, auth git.TokenAuth, opts ...Opt) *bitbucketServerProvider {
+	b := &bitbucketServerProvider{
+		httpClient: &http.Client{},
+		config:     config,
+		auth:       auth,
+	}
+	for _, opt := range opts {
+		opt(b)
+	}
+	b.api = restapi.NewClient(b.httpClient, "https://"+config.Hostname, "bitbucket server", map[string]string{"Authorization": "Bearer " + auth.Token})
+	return b
+}
+
+type repository struct {
+	Slug    string `json:"slug"`
+	Project struct {
+		Key  string `json:"key"`
+		Type string `json:"type"`
+	} `json:"project"`
+	Links struct {
+		Clone []struct {
+			Href string `json:"href"`
+			Name string `json:"name"`
+		} `json:"clone"`
+	} `json:"links"`
+}
+
+func (r *repository) repository(owner string) *git.Repository {
+	repo := &git.Repository{
+		Name:  r.Slug,
+		Owner: owner,
+	}
+	if r.Project.Type != personalProjectType {
+		repo.Organization = r.Project.Key
+	}
+	for _, l := range r.Links.Clone {
+		if l.Name == bitbucketServerCloneLinkProto {
+			repo.CloneUrl = l.Href
+		}
+	}
+	return repo
+}
+
+// CreateRepo creates a repository in the project that matches the owner, or in the
+// personal project of the owner if the repo is personal.
+func (b *bitbucketServerProvider) CreateRepo(ctx context.Context, opts git.CreateRepoOpts) (*git.Repository, error) {
+	logger.V(3).Info("Creating Bitbucket Server repository", "name", opts.Name, "owner", opts.Owner)
+	body := map[string]interface{}{
+		"name":   opts.Name,
+		"scmId":  "git",
+		"public": !opts.Privacy,
+	}
+	r := &repository{}
+	if err := b.api.Do(ctx, http.MethodPost, apiPath+"/projects/"+projectKey(opts.Owner, opts.Personal)+"/repos", nil, body, r); err != nil {
+		return nil, fmt.Errorf("creating repository %s: %v", opts.Name, err)
+	}
+	return r.repository(opts.Owner), nil
+}
+
+// GetRepo describes a remote repository, return the repo name if it exists.
+// If the repo does not exist, a nil repo is returned.
+func (b *bitbucketServerProvider) GetRepo(ctx context.Context) (*git.Repository, error) {
+	r := b.config.Repository
+	o := b.config.Owner
+	logger.V(3).Info("Describing Bitbucket Server repository", "name", r, "owner", o)
+	repo := &repository{}
+	err := b.api.Do(ctx, http.MethodGet, repoPath(o, r, b.config.Personal), nil, nil, repo)
+	if restapi.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unexpected error when describing repository %s: %w", r, err)
+	}
+	return repo.repository(o), nil
+}
+
+// DeleteRepo schedules the deletion of a Bitbucket Server repository.
+func (b *bitbucketServerProvider) DeleteRepo(ctx context.Context, opts git.DeleteRepoOpts) error {
+	logger.V(3).Info("Deleting Bitbucket Server repository", "name", opts.Repository, "owner", opts.Owner)
+	if err := b.api.Do(ctx, http.MethodDelete, repoPath(opts.Owner, opts.Repository, b.config.Personal), nil, nil, nil); err != nil {
+		return fmt.Errorf("deleting repository %s: %v", opts.Repository, err)
+	}
+	return nil
+}
+
+// AddDeployKeyToRepo registers an access key for the repository.
+func (b *bitbucketServerProvider) AddDeployKeyToRepo(ctx context.Context, opts git.AddDeployKeyOpts) error {
+	logger.V(3).Info("Adding deploy key to repository", "repository", opts.Repository, "owner", opts.Owner)
+	permission := repoWritePermission
+	if opts.ReadOnly {
+		permission = repoReadPermission
+	}
+	body := map[string]interface{}{
+		"key": map[string]string{
+			"text":  opts.Key,
+			"label": opts.Title,
+		},
+		"permission": permission,
+	}
+	p := keysApiPath + "/projects/" + projectKey(opts.Owner, b.config.Personal) + "/repos/" + slug(opts.Repository) + "/ssh"
+	if err := b.api.Do(ctx, http.MethodPost, p, nil, body, nil); err != nil {
+		return fmt.Errorf("adding deploy key to repository %s: %v", opts.Repository, err)
+	}
+	return nil
+}
+
+// Validate checks the access token is valid and has access to the configured owner.
+func (b *bitbucketServerProvider) Validate(ctx context.Context) error {
+	if b.auth.Username == "" {
+		return fmt.Errorf("bitbucket server username is required; set it with the %s environment variable", EksaBitbucketServerUserEnv)
+	}
+
+	if err := b.api.Do(ctx, http.MethodGet, apiPath+"/users/"+url.PathEscape(b.auth.Username), nil, nil, nil); err != nil {
+		return fmt.Errorf("authenticating with bitbucket server: %v", err)
+	}
+
+	if b.config.Personal {
+		if !strings.EqualFold(b.config.Owner, b.auth.Username) {
+			return fmt.Errorf("the authenticated Bitbucket Server user and owner %s specified in the EKS-A gitops spec don't match; confirm access token owner is %s", b.config.Owner, b.config.Owner)
+		}
+		return nil
+	}
+
+	if err := b.api.Do(ctx, http.MethodGet, apiPath+"/projects/"+projectKey(b.config.Owner, false), nil, nil, nil); err != nil {
+		return fmt.Errorf("the authenticated bitbucket server user doesn't have proper access to bitbucket server project %s, %v", b.config.Owner, err)
+	}
+	return nil
+}
+
+// PathExists checks if a path exists in the remote repository. If the owner, repository or branch doesn't exist,
+// it returns false and no error.
+func (b *bitbucketServerProvider) PathExists(ctx context.Context, owner, repo, branch, path string) (bool, error) {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	for i, s := range segments {
+		segments[i] = url.PathEscape(s)
+	}
+	q := url.Values{"at": []string{"refs/heads/" + branch}, "limit": []string{"1"}}
+	err := b.api.Do(ctx, http.MethodGet, repoPath(owner, repo, b.config.Personal)+"/browse/"+strings.Join(segments, "/"), q, nil, nil)
+	if restapi.IsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed checking if path %s exists in remote bitbucket server repository: %v", path, err)
+	}
+	return true, nil
+}
+
+type pullRequest struct {
+	ID    int    `json:"id"`
+	State string `json:"state"`
+	Links struct {
+		Self []struct {
+			Href string `json:"href"`
+		} `json:"self"`
+	} `json:"links"`
+}
+
+func (p *pullRequest) pullRequest() *git.PullRequest {
+	pr := &git.PullRequest{
+		Number: p.ID,
+		State:  git.PullRequestOpen,
+	}
+	if len(p.Links.Self) > 0 {
+		pr.Url = p.Links.Self[0].Href
+	}
+	switch p.State {
+	case "MERGED":
+		pr.State = git.PullRequestMerged
+	case "DECLINED":
+		pr.State = git.PullRequestClosed
+	}
+	return pr
+}
+
+// CreatePullRequest opens a pull request to merge the head branch into the base branch.
+func (b *bitbucketServerProvider) CreatePullRequest(ctx context.Context, opts git.CreatePullRequestOpts) (*git.PullRequest, error) {
+	logger.V(3).Info("Creating Bitbucket Server pull request", "repository", opts.Repository, "owner", opts.Owner, "head", opts.HeadBranch, "base", opts.BaseBranch)
+	body := map[string]interface{}{
+		"title":       opts.Title,
+		"description": opts.Description,
+		"fromRef":     map[string]string{"id": "refs/heads/" + opts.HeadBranch},
+		"toRef":       map[string]string{"id": "refs/heads/" + opts.BaseBranch},
+	}
+	p := &pullRequest{}
+	if err := b.api.Do(ctx, http.MethodPost, repoPath(opts.Owner, opts.Repository, b.config.Personal)+"/pull-requests", nil, body, p); err != nil {
+		return nil, fmt.Errorf("creating pull request in repository %s: %v", opts.Repository, err)
+	}
+	return p.pullRequest(), nil
+}
+
+// GetPullRequest describes a pull request. Declined pull requests are reported as closed.
+func (b *bitbucketServerProvider) GetPullRequest(ctx context.Context, opts git.GetPullRequestOpts) (*git.PullRequest, error) {
+	p := &pullRequest{}
+	if err := b.api.Do(ctx, http.MethodGet, fmt.Sprintf("%s/pull-requests/%d", repoPath(opts.Owner, opts.Repository, b.config.Personal), opts.Number), nil, nil, p); err != nil {
+		return nil, fmt.Errorf("getting pull request %d in repository %s: %v", opts.Number, opts.Repository, err)
+	}
+	return p.pullRequest(), nil
+}
+
+// projectKey returns the key of the project that holds the owner repositories.
+// Personal repositories live in a project keyed by the user name prefixed with ~.
+func projectKey(owner string, personal bool) string {
+	if personal {
+		return url.PathEscape(personalProjectKeyPrefix + owner)
+	}
+	return url.PathEscape(owner)
+}
+
+// slug returns the repository slug Bitbucket Server derives from the repository name.
+func slug(repo string) string {
+	return url.PathEscape(strings.ToLower(repo))
+}
+
+func repoPath(owner, repo string, personal bool) string {
+	return apiPath + "/projects/" + projectKey(owner, personal) + "/repos/" + slug(repo)
+}
+
+// GetBitbucketServerAuthFromEnv returns the Bitbucket Server user and access token from the EKS-A environment
+// variables and exports the token in the variable the flux cli reads.
+func GetBitbucketServerAuthFromEnv() (git.TokenAuth, error) {
+	token, ok := os.LookupEnv(EksaBitbucketServerTokenEnv)
+	if !ok || len(token) == 0 {
+		return git.TokenAuth{}, fmt.Errorf("bitbucket server access token environment variable %s is invalid; could not get var from environment", EksaBitbucketServerTokenEnv)
+	}
+	username, ok := os.LookupEnv(EksaBitbucketServerUserEnv)
+	if !ok || len(username) == 0 {
+		return git.TokenAuth{}, fmt.Errorf("bitbucket server username environment variable %s is invalid; could not get var from environment", EksaBitbucketServerUserEnv)
+	}
+	if err := os.Setenv(BitbucketTokenEnv, token); err != nil {
+		return git.TokenAuth{}, fmt.Errorf("unable to set %s: %v", BitbucketTokenEnv, err)
+	}
+	return git.TokenAuth{Username: username, Token: token}, nil
+}
+
+func RepoUrl(hostname, owner, repo string, personal bool) string {
+	key := strings.ToLower(owner)
+	if personal {
+		key = personalProjectKeyPrefix + key
+	}
+	return fmt.Sprintf(bitbucketServerUrlTemplate, hostname, key, strings.ToLower(repo))
+}
@@ -0,0 +1,370 @@
+package bitbucketserver_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/git"
+	"github.com/aws/eks-anywhere/pkg/git/providers/bitbucketserver"
+)
+
+const (
+	token    = "bbs-token"
+	username = "janedoe"
+)
+
+// fakeBitbucketServer serves canned responses keyed by method and escaped path, and records the requests it gets.
+type fakeBitbucketServer struct {
+	responses map[string]fakeResponse
+	requests  map[string]map[string]interface{}
+}
+
+type fakeResponse struct {
+	status int
+	body   string
+}
+
+func newFakeBitbucketServer(responses map[string]fakeResponse) *fakeBitbucketServer {
+	return &fakeBitbucketServer{responses: responses, requests: map[string]map[string]interface{}{}}
+}
+
+func (f *fakeBitbucketServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get("Authorization") != "Bearer "+token {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	key := r.Method + " " + r.URL.EscapedPath()
+	if r.URL.RawQuery != "" {
+		key += "?" + r.URL.RawQuery
+	}
+	body := map[string]interface{}{}
+	_ = json.NewDecoder(r.Body).Decode(&body)
+	f.requests[key] = body
+
+	resp, ok := f.responses[key]
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"errors":[{"message":"not found"}]}`))
+		return
+	}
+	w.WriteHeader(resp.status)
+	_, _ = w.Write([]byte(resp.body))
+}
+
+func newProvider(t *testing.T, f *fakeBitbucketServer, config *v1alpha1.BitbucketServerProviderConfig) git.ProviderClient {
+	server := httptest.NewTLSServer(f)
+	t.Cleanup(server.Close)
+	config.Hostname = strings.TrimPrefix(server.URL, "https://")
+	return bitbucketserver.New(config, git.TokenAuth{Token: token, Username: username}, bitbucketserver.WithHTTPClient(server.Client()))
+}
+
+const repoResponse = `{
+	"slug": "fleet",
+	"project": {"key": "OPS", "type": "NORMAL"},
+	"links": {"clone": [
+		{"href": "ssh://git@bitbucket.example.com:7999/ops/fleet.git", "name": "ssh"},
+		{"href": "https://bitbucket.example.com/scm/ops/fleet.git", "name": "http"}
+	]}
+}`
+
+func TestGetRepoExists(t *testing.T) {
+	g := NewWithT(t)
+	f := newFakeBitbucketServer(map[string]fakeResponse{
+		"GET /rest/api/1.0/projects/OPS/repos/fleet": {status: http.StatusOK, body: repoResponse},
+	})
+	p := newProvider(t, f, &v1alpha1.BitbucketServerProviderConfig{Owner: "OPS", Repository: "Fleet"})
+
+	repo, err := p.GetRepo(context.Background())
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(repo).To(Equal(&git.Repository{
+		Name:         "fleet",
+		Owner:        "OPS",
+		Organization: "OPS",
+		CloneUrl:     "https://bitbucket.example.com/scm/ops/fleet.git",
+	}))
+}
+
+func TestGetRepoPersonalNotFound(t *testing.T) {
+	g := NewWithT(t)
+	f := newFakeBitbucketServer(nil)
+	p := newProvider(t, f, &v1alpha1.BitbucketServerProviderConfig{Owner: username, Repository: "fleet", Personal: true})
+
+	repo, err := p.GetRepo(context.Background())
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(repo).To(BeNil())
+	g.Expect(f.requests).To(HaveKey("GET /rest/api/1.0/projects/~janedoe/repos/fleet"))
+}
+
+func TestGetRepoError(t *testing.T) {
+	g := NewWithT(t)
+	f := newFakeBitbucketServer(map[string]fakeResponse{
+		"GET /rest/api/1.0/projects/OPS/repos/fleet": {status: http.StatusInternalServerError, body: "boom"},
+	})
+	p := newProvider(t, f, &v1alpha1.BitbucketServerProviderConfig{Owner: "OPS", Repository: "fleet"})
+
+	_, err := p.GetRepo(context.Background())
+	g.Expect(err).To(MatchError(ContainSubstring("unexpected error when describing repository fleet: GET /rest/api/1.0/projects/OPS/repos/fleet: 500 boom")))
+}
+
+func TestCreateRepo(t *testing.T) {
+	g := NewWithT(t)
+	f := newFakeBitbucketServer(map[string]fakeResponse{
+		"POST /rest/api/1.0/projects/OPS/repos": {status: http.StatusCreated, body: repoResponse},
+	})
+	p := newProvider(t, f, &v1alpha1.BitbucketServerProviderConfig{Owner: "OPS", Repository: "fleet"})
+
+	repo, err := p.CreateRepo(context.Background(), git.CreateRepoOpts{Name: "fleet", Owner: "OPS", Privacy: true})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(repo.CloneUrl).To(Equal("https://bitbucket.example.com/scm/ops/fleet.git"))
+	g.Expect(f.requests["POST /rest/api/1.0/projects/OPS/repos"]).To(Equal(map[string]interface{}{
+		"name":   "fleet",
+		"scmId":  "git",
+		"public": false,
+	}))
+}
+
+func TestCreateRepoPersonal(t *testing.T) {
+	g := NewWithT(t)
+	f := newFakeBitbucketServer(map[string]fakeResponse{
+		"POST /rest/api/1.0/projects/~janedoe/repos": {status: http.StatusCreated, body: `{"slug": "fleet", "project": {"key": "~JANEDOE", "type": "PERSONAL"}}`},
+	})
+	p := newProvider(t, f, &v1alpha1.BitbucketServerProviderConfig{Owner: username, Repository: "fleet", Personal: true})
+
+	repo, err := p.CreateRepo(context.Background(), git.CreateRepoOpts{Name: "fleet", Owner: username, Personal: true})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(repo).To(Equal(&git.Repository{Name: "fleet", Owner: username}))
+}
+
+func TestDeleteRepo(t *testing.T) {
+	g := NewWithT(t)
+	f := newFakeBitbucketServer(map[string]fakeResponse{
+		"DELETE /rest/api/1.0/projects/OPS/repos/fleet": {status: http.StatusAccepted},
+	})
+	p := newProvider(t, f, &v1alpha1.BitbucketServerProviderConfig{Owner: "OPS", Repository: "fleet"})
+
+	g.Expect(p.DeleteRepo(context.Background(), git.DeleteRepoOpts{Owner: "OPS", Repository: "fleet"})).To(Succeed())
+}
+
+func TestAddDeployKeyToRepo(t *testing.T) {
+	g := NewWithT(t)
+	f := newFakeBitbucketServer(map[string]fakeResponse{
+		"POST /rest/keys/1.0/projects/OPS/repos/fleet/ssh": {status: http.StatusCreated, body: `{}`},
+	})
+	p := newProvider(t, f, &v1alpha1.BitbucketServerProviderConfig{Owner: "OPS", Repository: "fleet"})
+
+	err := p.AddDeployKeyToRepo(context.Background(), git.AddDeployKeyOpts{
+		Owner:      "OPS",
+		Repository: "fleet",
+		Key:        "ssh-ed25519 AAAA",
+		Title:      "flux",
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(f.requests["POST /rest/keys/1.0/projects/OPS/repos/fleet/ssh"]).To(Equal(map[string]interface{}{
+		"key":        map[string]interface{}{"text": "ssh-ed25519 AAAA", "label": "flux"},
+		"permission": "REPO_WRITE",
+	}))
+}
+
+func TestCreatePullRequest(t *testing.T) {
+	g := NewWithT(t)
+	f := newFakeBitbucketServer(map[string]fakeResponse{
+		"POST /rest/api/1.0/projects/OPS/repos/fleet/pull-requests": {status: http.StatusCreated, body: `{
+			"id": 5,
+			"state": "OPEN",
+			"links": {"self": [{"href": "https://bitbucket.example.com/projects/OPS/repos/fleet/pull-requests/5"}]}
+		}`},
+	})
+	p := newProvider(t, f, &v1alpha1.BitbucketServerProviderConfig{Owner: "OPS", Repository: "fleet"})
+
+	pr, err := p.CreatePullRequest(context.Background(), git.CreatePullRequestOpts{
+		Owner:       "OPS",
+		Repository:  "fleet",
+		Title:       "Upgrade cluster",
+		Description: "Upgrade cluster to the new version",
+		HeadBranch:  "eksa/mgmt-upgrade",
+		BaseBranch:  "main",
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(pr).To(Equal(&git.PullRequest{
+		Number: 5,
+		Url:    "https://bitbucket.example.com/projects/OPS/repos/fleet/pull-requests/5",
+		State:  git.PullRequestOpen,
+	}))
+	g.Expect(f.requests["POST /rest/api/1.0/projects/OPS/repos/fleet/pull-requests"]).To(Equal(map[string]interface{}{
+		"title":       "Upgrade cluster",
+		"description": "Upgrade cluster to the new version",
+		"fromRef":     map[string]interface{}{"id": "refs/heads/eksa/mgmt-upgrade"},
+		"toRef":       map[string]interface{}{"id": "refs/heads/main"},
+	}))
+}
+
+func TestCreatePullRequestError(t *testing.T) {
+	g := NewWithT(t)
+	f := newFakeBitbucketServer(map[string]fakeResponse{})
+	p := newProvider(t, f, &v1alpha1.BitbucketServerProviderConfig{Owner: "OPS", Repository: "fleet"})
+
+	_, err := p.CreatePullRequest(context.Background(), git.CreatePullRequestOpts{Owner: "OPS", Repository: "fleet"})
+	g.Expect(err).To(MatchError(ContainSubstring("creating pull request in repository fleet")))
+}
+
+func TestGetPullRequest(t *testing.T) {
+	tests := []struct {
+		state string
+		want  git.PullRequestState
+	}{
+		{state: "OPEN", want: git.PullRequestOpen},
+		{state: "MERGED", want: git.PullRequestMerged},
+		{state: "DECLINED", want: git.PullRequestClosed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.state, func(t *testing.T) {
+			g := NewWithT(t)
+			f := newFakeBitbucketServer(map[string]fakeResponse{
+				"GET /rest/api/1.0/projects/~ADMIN/repos/fleet/pull-requests/5": {status: http.StatusOK, body: `{"id": 5, "state": "` + tt.state + `"}`},
+			})
+			p := newProvider(t, f, &v1alpha1.BitbucketServerProviderConfig{Owner: "admin", Repository: "fleet", Personal: true})
+
+			pr, err := p.GetPullRequest(context.Background(), git.GetPullRequestOpts{Owner: "ADMIN", Repository: "fleet", Number: 5})
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(pr.Number).To(Equal(5))
+			g.Expect(pr.State).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name      string
+		config    *v1alpha1.BitbucketServerProviderConfig
+		responses map[string]fakeResponse
+		wantErr   string
+	}{
+		{
+			name:   "personal repo",
+			config: &v1alpha1.BitbucketServerProviderConfig{Owner: "JaneDoe", Repository: "fleet", Personal: true},
+			responses: map[string]fakeResponse{
+				"GET /rest/api/1.0/users/janedoe": {status: http.StatusOK, body: `{"name": "janedoe"}`},
+			},
+		},
+		{
+			name:   "project repo",
+			config: &v1alpha1.BitbucketServerProviderConfig{Owner: "OPS", Repository: "fleet"},
+			responses: map[string]fakeResponse{
+				"GET /rest/api/1.0/users/janedoe": {status: http.StatusOK, body: `{"name": "janedoe"}`},
+				"GET /rest/api/1.0/projects/OPS":  {status: http.StatusOK, body: `{"key": "OPS"}`},
+			},
+		},
+		{
+			name:    "invalid token",
+			config:  &v1alpha1.BitbucketServerProviderConfig{Owner: "OPS", Repository: "fleet"},
+			wantErr: "authenticating with bitbucket server: GET /rest/api/1.0/users/janedoe: 404",
+		},
+		{
+			name:   "personal repo owner mismatch",
+			config: &v1alpha1.BitbucketServerProviderConfig{Owner: "johndoe", Repository: "fleet", Personal: true},
+			responses: map[string]fakeResponse{
+				"GET /rest/api/1.0/users/janedoe": {status: http.StatusOK, body: `{"name": "janedoe"}`},
+			},
+			wantErr: "the authenticated Bitbucket Server user and owner johndoe specified in the EKS-A gitops spec don't match",
+		},
+		{
+			name:   "no access to project",
+			config: &v1alpha1.BitbucketServerProviderConfig{Owner: "OPS", Repository: "fleet"},
+			responses: map[string]fakeResponse{
+				"GET /rest/api/1.0/users/janedoe": {status: http.StatusOK, body: `{"name": "janedoe"}`},
+			},
+			wantErr: "the authenticated bitbucket server user doesn't have proper access to bitbucket server project OPS",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			p := newProvider(t, newFakeBitbucketServer(tt.responses), tt.config)
+
+			err := p.Validate(context.Background())
+			if tt.wantErr == "" {
+				g.Expect(err).NotTo(HaveOccurred())
+			} else {
+				g.Expect(err).To(MatchError(ContainSubstring(tt.wantErr)))
+			}
+		})
+	}
+}
+
+func TestPathExists(t *testing.T) {
+	browsePath := "GET /rest/api/1.0/projects/OPS/repos/fleet/browse/clusters/mgmt?at=refs%2Fheads%2Fmain&limit=1"
+	tests := []struct {
+		name      string
+		responses map[string]fakeResponse
+		want      bool
+		wantErr   string
+	}{
+		{
+			name: "path exists",
+			responses: map[string]fakeResponse{
+				browsePath: {status: http.StatusOK, body: `{"children": {"values": []}}`},
+			},
+			want: true,
+		},
+		{
+			name: "path, repo or branch doesn't exist",
+			want: false,
+		},
+		{
+			name: "api error",
+			responses: map[string]fakeResponse{
+				browsePath: {status: http.StatusForbidden, body: "forbidden"},
+			},
+			wantErr: "failed checking if path clusters/mgmt exists in remote bitbucket server repository",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			p := newProvider(t, newFakeBitbucketServer(tt.responses), &v1alpha1.BitbucketServerProviderConfig{Owner: "OPS", Repository: "fleet"})
+
+			exists, err := p.PathExists(context.Background(), "OPS", "fleet", "main", "clusters/mgmt")
+			if tt.wantErr != "" {
+				g.Expect(err).To(MatchError(ContainSubstring(tt.wantErr)))
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(exists).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestGetBitbucketServerAuthFromEnv(t *testing.T) {
+	g := NewWithT(t)
+	t.Setenv(bitbucketserver.EksaBitbucketServerTokenEnv, token)
+	t.Setenv(bitbucketserver.EksaBitbucketServerUserEnv, username)
+	t.Setenv(bitbucketserver.BitbucketTokenEnv, "")
+
+	auth, err := bitbucketserver.GetBitbucketServerAuthFromEnv()
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(auth).To(Equal(git.TokenAuth{Username: username, Token: token}))
+	g.Expect(os.Getenv(bitbucketserver.BitbucketTokenEnv)).To(Equal(token))
+}
+
+func TestGetBitbucketServerAuthFromEnvMissingUsername(t *testing.T) {
+	g := NewWithT(t)
+	t.Setenv(bitbucketserver.EksaBitbucketServerTokenEnv, token)
+	t.Setenv(bitbucketserver.EksaBitbucketServerUserEnv, "")
+
+	_, err := bitbucketserver.GetBitbucketServerAuthFromEnv()
+	g.Expect(err).To(MatchError(ContainSubstring(bitbucketserver.EksaBitbucketServerUserEnv)))
+}
+
+func TestRepoUrl(t *testing.T) {
+	g := NewWithT(t)
+	g.Expect(bitbucketserver.RepoUrl("bitbucket.example.com", "OPS", "Fleet", false)).To(Equal("https://bitbucket.example.com/scm/ops/fleet.git"))
+	g.Expect(bitbucketserver.RepoUrl("bitbucket.example.com", "JaneDoe", "fleet", true)).To(Equal("https://bitbucket.example.com/scm/~janedoe/fleet.git"))
+}
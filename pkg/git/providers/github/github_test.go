@@ -237,3 +237,34 @@ func TestGetNonExistantRepoSucceeds(t *testing.T) {
 		})
 	}
 }
+
+func TestCreateAndGetPullRequestSucceeds(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	ctx := context.Background()
+	githubproviderclient := mocks.NewMockGithubClient(mockCtrl)
+	config := &v1alpha1.GithubProviderConfig{Owner: "Jeff", Repository: "testRepo"}
+	auth := git.TokenAuth{Token: validPATValue, Username: "Jeff"}
+
+	createOpts := git.CreatePullRequestOpts{Owner: "Jeff", Repository: "testRepo", Title: "Upgrade", HeadBranch: "eksa/upgrade", BaseBranch: "main"}
+	getOpts := git.GetPullRequestOpts{Owner: "Jeff", Repository: "testRepo", Number: 1}
+	open := &git.PullRequest{Number: 1, Url: "https://github.com/Jeff/testRepo/pull/1", State: git.PullRequestOpen}
+	merged := &git.PullRequest{Number: 1, Url: "https://github.com/Jeff/testRepo/pull/1", State: git.PullRequestMerged}
+	githubproviderclient.EXPECT().CreatePullRequest(ctx, createOpts).Return(open, nil)
+	githubproviderclient.EXPECT().GetPullRequest(ctx, getOpts).Return(merged, nil)
+
+	githubProvider, err := github.New(githubproviderclient, config, auth)
+	if err != nil {
+		t.Errorf("instantiating github provider: %v, wanted nil", err)
+	}
+	pr, err := githubProvider.CreatePullRequest(ctx, createOpts)
+	if err != nil {
+		t.Errorf("calling CreatePullRequest %v, wanted nil", err)
+	}
+	assert.Equal(t, open, pr)
+
+	pr, err = githubProvider.GetPullRequest(ctx, getOpts)
+	if err != nil {
+		t.Errorf("calling GetPullRequest %v, wanted nil", err)
+	}
+	assert.Equal(t, merged, pr)
+}
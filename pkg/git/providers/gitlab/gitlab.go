@@ -0,0 +1,293 @@
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/git"
+	"github.com/aws/eks-anywhere/pkg/git/providers/internal/restapi"
+	"github.com/aws/eks-anywhere/pkg/logger"
+)
+
+const (
+	GitProviderName    = "gitlab"
+	EksaGitlabTokenEnv = "EKSA_GITLAB_TOKEN"
+	GitlabTokenEnv     = "GITLAB_TOKEN"
+	DefaultHostname    = "gitlab.com"
+	gitlabUrlTemplate  = "https://%v/%v/%v.git"
+	apiPath            = "/api/v4"
+	apiScope           = "api"
+)
+
+type gitlabProvider struct {
+	httpClient *http.Client
+	api        *restapi.Client
+	config     *v1alpha1.GitlabProviderConfig
+	auth       git.TokenAuth
+}
+
+// Opt allows to customize the GitLab provider.
+type Opt func(*gitlabProvider)
+
+// WithHTTPClient sets the http client used to talk to the GitLab API.
+func WithHTTPClient(client *http.Client) Opt {
+	return func(g *gitlabProvider) {
+		g.httpClient = client
+	}
+}
+
+// New builds a GitLab provider for the repository in the config.
+// It talks directly to the GitLab REST API, so it works with both gitlab.com and self-managed instances.
+func New(config *v1alpha1.GitlabProviderConfig, auth git.TokenAuth, opts ...Opt) *gitlabProvider {
+	g := &gitlabProvider{
+		httpClient: &http.Client{},
+		config:     config,
+		auth:       auth,
+	}
+	for _, opt := range opts {
+		opt(g)
+	}
+	g.api = restapi.NewClient(g.httpClient, "https://"+g.hostname()+apiPath, GitProviderName, map[string]string{"PRIVATE-TOKEN": auth.Token})
+	return g
+}
+
+type namespace struct {
+	ID       int    `json:"id"`
+	FullPath string `json:"full_path"`
+	Kind     string `json:"kind"`
+}
+
+type project struct {
+	ID            int       `json:"id"`
+	Path          string    `json:"path"`
+	HTTPURLToRepo string    `json:"http_url_to_repo"`
+	Namespace     namespace `json:"namespace"`
+}
+
+func (p *project) repository() *git.Repository {
+	r := &git.Repository{
+		Name:     p.Path,
+		Owner:    p.Namespace.FullPath,
+		CloneUrl: p.HTTPURLToRepo,
+	}
+	if p.Namespace.Kind == "group" {
+		r.Organization = p.Namespace.FullPath
+	}
+	return r
+}
+
+// CreateRepo creates a GitLab project. If the repo is not personal, the project is created
+// in the group that matches the owner.
+func (g *gitlabProvider) CreateRepo(ctx context.Context, opts git.CreateRepoOpts) (*git.Repository, error) {
+	logger.V(3).Info("Creating GitLab repository", "name", opts.Name, "owner", opts.Owner)
+	visibility := "public"
+	if opts.Privacy {
+		visibility = "private"
+	}
+	body := map[string]interface{}{
+		"name":                   opts.Name,
+		"path":                   opts.Name,
+		"description":            opts.Description,
+		"visibility":             visibility,
+		"initialize_with_readme": opts.AutoInit,
+	}
+	if !opts.Personal {
+		ns := &namespace{}
+		if err := g.api.Do(ctx, http.MethodGet, "/namespaces/"+restapi.EscapePathSegment(opts.Owner), nil, nil, ns); err != nil {
+			return nil, fmt.Errorf("getting gitlab namespace %s: %v", opts.Owner, err)
+		}
+		body["namespace_id"] = ns.ID
+	}
+
+	p := &project{}
+	if err := g.api.Do(ctx, http.MethodPost, "/projects", nil, body, p); err != nil {
+		return nil, fmt.Errorf("creating repository %s: %v", opts.Name, err)
+	}
+	return p.repository(), nil
+}
+
+// GetRepo describes a remote repository, return the repo name if it exists.
+// If the repo does not exist, a nil repo is returned.
+func (g *gitlabProvider) GetRepo(ctx context.Context) (*git.Repository, error) {
+	r := g.config.Repository
+	o := g.config.Owner
+	logger.V(3).Info("Describing GitLab repository", "name", r, "owner", o)
+	p := &project{}
+	err := g.api.Do(ctx, http.MethodGet, projectPath(o, r), nil, nil, p)
+	if restapi.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unexpected error when describing repository %s: %w", r, err)
+	}
+	return p.repository(), nil
+}
+
+// DeleteRepo deletes a GitLab project.
+func (g *gitlabProvider) DeleteRepo(ctx context.Context, opts git.DeleteRepoOpts) error {
+	logger.V(3).Info("Deleting GitLab repository", "name", opts.Repository, "owner", opts.Owner)
+	if err := g.api.Do(ctx, http.MethodDelete, projectPath(opts.Owner, opts.Repository), nil, nil, nil); err != nil {
+		return fmt.Errorf("deleting repository %s: %v", opts.Repository, err)
+	}
+	return nil
+}
+
+func (g *gitlabProvider) AddDeployKeyToRepo(ctx context.Context, opts git.AddDeployKeyOpts) error {
+	logger.V(3).Info("Adding deploy key to repository", "repository", opts.Repository, "owner", opts.Owner)
+	body := map[string]interface{}{
+		"title":    opts.Title,
+		"key":      opts.Key,
+		"can_push": !opts.ReadOnly,
+	}
+	if err := g.api.Do(ctx, http.MethodPost, projectPath(opts.Owner, opts.Repository)+"/deploy_keys", nil, body, nil); err != nil {
+		return fmt.Errorf("adding deploy key to repository %s: %v", opts.Repository, err)
+	}
+	return nil
+}
+
+// Validate checks the access token is valid, has the api scope and has access to the configured owner.
+func (g *gitlabProvider) Validate(ctx context.Context) error {
+	user := &struct {
+		Username string `json:"username"`
+	}{}
+	if err := g.api.Do(ctx, http.MethodGet, "/user", nil, nil, user); err != nil {
+		return fmt.Errorf("authenticating with gitlab: %v", err)
+	}
+
+	token := &struct {
+		Scopes []string `json:"scopes"`
+	}{}
+	if err := g.api.Do(ctx, http.MethodGet, "/personal_access_tokens/self", nil, nil, token); err != nil {
+		return fmt.Errorf("getting gitlab access token scopes: %v", err)
+	}
+	if !restapi.Contains(token.Scopes, apiScope) {
+		return fmt.Errorf("gitlab access token doesn't have the required %s scope; token scopes are %s", apiScope, strings.Join(token.Scopes, ", "))
+	}
+	logger.MarkPass("GitLab access token has the required api scope")
+
+	if g.config.Personal {
+		if !strings.EqualFold(g.config.Owner, user.Username) {
+			return fmt.Errorf("the authenticated GitLab user and owner %s specified in the EKS-A gitops spec don't match; confirm access token owner is %s", g.config.Owner, g.config.Owner)
+		}
+		return nil
+	}
+
+	if err := g.api.Do(ctx, http.MethodGet, "/groups/"+restapi.EscapePathSegment(g.config.Owner), nil, nil, nil); err != nil {
+		return fmt.Errorf("the authenticated gitlab user doesn't have proper access to gitlab group %s, %v", g.config.Owner, err)
+	}
+	return nil
+}
+
+// PathExists checks if a path exists in the remote repository. If the owner, repository or branch doesn't exist,
+// it returns false and no error.
+func (g *gitlabProvider) PathExists(ctx context.Context, owner, repo, branch, path string) (bool, error) {
+	var tree []struct{}
+	q := url.Values{"path": []string{path}, "ref": []string{branch}, "per_page": []string{"1"}}
+	err := g.api.Do(ctx, http.MethodGet, projectPath(owner, repo)+"/repository/tree", q, nil, &tree)
+	if restapi.IsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed checking if path %s exists in remote gitlab repository: %v", path, err)
+	}
+	if len(tree) > 0 {
+		return true, nil
+	}
+
+	// The tree is empty both for paths that don't exist and for files, so fall back to the files api.
+	q = url.Values{"ref": []string{branch}}
+	err = g.api.Do(ctx, http.MethodHead, projectPath(owner, repo)+"/repository/files/"+restapi.EscapePathSegment(path), q, nil, nil)
+	if restapi.IsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed checking if path %s exists in remote gitlab repository: %v", path, err)
+	}
+	return true, nil
+}
+
+type mergeRequest struct {
+	IID    int    `json:"iid"`
+	WebURL string `json:"web_url"`
+	State  string `json:"state"`
+}
+
+func (m *mergeRequest) pullRequest() *git.PullRequest {
+	p := &git.PullRequest{
+		Number: m.IID,
+		Url:    m.WebURL,
+		State:  git.PullRequestOpen,
+	}
+	switch m.State {
+	case "merged":
+		p.State = git.PullRequestMerged
+	case "closed":
+		p.State = git.PullRequestClosed
+	}
+	return p
+}
+
+// CreatePullRequest opens a merge request to merge the head branch into the base branch.
+// The head branch is removed once the merge request is merged.
+func (g *gitlabProvider) CreatePullRequest(ctx context.Context, opts git.CreatePullRequestOpts) (*git.PullRequest, error) {
+	logger.V(3).Info("Creating GitLab merge request", "repository", opts.Repository, "owner", opts.Owner, "head", opts.HeadBranch, "base", opts.BaseBranch)
+	body := map[string]interface{}{
+		"source_branch":        opts.HeadBranch,
+		"target_branch":        opts.BaseBranch,
+		"title":                opts.Title,
+		"description":          opts.Description,
+		"remove_source_branch": true,
+	}
+	m := &mergeRequest{}
+	if err := g.api.Do(ctx, http.MethodPost, projectPath(opts.Owner, opts.Repository)+"/merge_requests", nil, body, m); err != nil {
+		return nil, fmt.Errorf("creating merge request in repository %s: %v", opts.Repository, err)
+	}
+	return m.pullRequest(), nil
+}
+
+// GetPullRequest describes a merge request.
+func (g *gitlabProvider) GetPullRequest(ctx context.Context, opts git.GetPullRequestOpts) (*git.PullRequest, error) {
+	m := &mergeRequest{}
+	if err := g.api.Do(ctx, http.MethodGet, fmt.Sprintf("%s/merge_requests/%d", projectPath(opts.Owner, opts.Repository), opts.Number), nil, nil, m); err != nil {
+		return nil, fmt.Errorf("getting merge request %d in repository %s: %v", opts.Number, opts.Repository, err)
+	}
+	return m.pullRequest(), nil
+}
+
+func (g *gitlabProvider) hostname() string {
+	return Hostname(g.config)
+}
+
+func projectPath(owner, repo string) string {
+	return "/projects/" + restapi.EscapePathSegment(owner+"/"+repo)
+}
+
+// GetGitlabAccessTokenFromEnv returns the GitLab access token from the EKS-A environment variable
+// and exports it in the variable the flux cli reads.
+func GetGitlabAccessTokenFromEnv() (string, error) {
+	val, ok := os.LookupEnv(EksaGitlabTokenEnv)
+	if !ok || len(val) == 0 {
+		return "", fmt.Errorf("gitlab access token environment variable %s is invalid; could not get var from environment", EksaGitlabTokenEnv)
+	}
+	if err := os.Setenv(GitlabTokenEnv, val); err != nil {
+		return "", fmt.Errorf("unable to set %s: %v", GitlabTokenEnv, err)
+	}
+	return val, nil
+}
+
+// Hostname returns the GitLab instance hostname, defaulting to gitlab.com.
+func Hostname(config *v1alpha1.GitlabProviderConfig) string {
+	if config.Hostname != "" {
+		return config.Hostname
+	}
+	return DefaultHostname
+}
+
+func RepoUrl(hostname, owner, repo string) string {
+	return fmt.Sprintf(gitlabUrlTemplate, hostname, owner, repo)
+}
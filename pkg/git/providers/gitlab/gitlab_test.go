@@ -0,0 +1,415 @@
+package gitlab_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/git"
+	"github.com/aws/eks-anywhere/pkg/git/providers/gitlab"
+)
+
+const token = "glpat-token"
+
+// fakeGitlab serves canned responses keyed by method and escaped path, and records the requests it gets.
+type fakeGitlab struct {
+	t         *testing.T
+	responses map[string]fakeResponse
+	requests  map[string]map[string]interface{}
+}
+
+type fakeResponse struct {
+	status int
+	body   string
+}
+
+func newFakeGitlab(t *testing.T, responses map[string]fakeResponse) *fakeGitlab {
+	return &fakeGitlab{t: t, responses: responses, requests: map[string]map[string]interface{}{}}
+}
+
+func (f *fakeGitlab) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get("PRIVATE-TOKEN") != token {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	key := r.Method + " " + strings.TrimPrefix(r.URL.EscapedPath(), "/api/v4")
+	if r.URL.RawQuery != "" {
+		key += "?" + r.URL.RawQuery
+	}
+	body := map[string]interface{}{}
+	_ = json.NewDecoder(r.Body).Decode(&body)
+	f.requests[key] = body
+
+	resp, ok := f.responses[key]
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"message":"404 Not Found"}`))
+		return
+	}
+	w.WriteHeader(resp.status)
+	_, _ = w.Write([]byte(resp.body))
+}
+
+func newProvider(t *testing.T, f *fakeGitlab, config *v1alpha1.GitlabProviderConfig) git.ProviderClient {
+	server := httptest.NewTLSServer(f)
+	t.Cleanup(server.Close)
+	config.Hostname = strings.TrimPrefix(server.URL, "https://")
+	return gitlab.New(config, git.TokenAuth{Token: token, Username: config.Owner}, gitlab.WithHTTPClient(server.Client()))
+}
+
+const projectResponse = `{
+	"id": 42,
+	"path": "fleet",
+	"http_url_to_repo": "https://gitlab.example.com/platform/clusters/fleet.git",
+	"namespace": {"id": 7, "full_path": "platform/clusters", "kind": "group"}
+}`
+
+func TestGetRepoExists(t *testing.T) {
+	g := NewWithT(t)
+	f := newFakeGitlab(t, map[string]fakeResponse{
+		"GET /projects/platform%2Fclusters%2Ffleet": {status: http.StatusOK, body: projectResponse},
+	})
+	p := newProvider(t, f, &v1alpha1.GitlabProviderConfig{Owner: "platform/clusters", Repository: "fleet"})
+
+	repo, err := p.GetRepo(context.Background())
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(repo).To(Equal(&git.Repository{
+		Name:         "fleet",
+		Owner:        "platform/clusters",
+		Organization: "platform/clusters",
+		CloneUrl:     "https://gitlab.example.com/platform/clusters/fleet.git",
+	}))
+}
+
+func TestGetRepoNotFound(t *testing.T) {
+	g := NewWithT(t)
+	f := newFakeGitlab(t, nil)
+	p := newProvider(t, f, &v1alpha1.GitlabProviderConfig{Owner: "platform", Repository: "fleet"})
+
+	repo, err := p.GetRepo(context.Background())
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(repo).To(BeNil())
+}
+
+func TestGetRepoError(t *testing.T) {
+	g := NewWithT(t)
+	f := newFakeGitlab(t, map[string]fakeResponse{
+		"GET /projects/platform%2Ffleet": {status: http.StatusInternalServerError, body: "boom"},
+	})
+	p := newProvider(t, f, &v1alpha1.GitlabProviderConfig{Owner: "platform", Repository: "fleet"})
+
+	_, err := p.GetRepo(context.Background())
+	g.Expect(err).To(MatchError(ContainSubstring("unexpected error when describing repository fleet: GET /projects/platform%2Ffleet: 500 boom")))
+}
+
+func TestCreateRepoInGroup(t *testing.T) {
+	g := NewWithT(t)
+	f := newFakeGitlab(t, map[string]fakeResponse{
+		"GET /namespaces/platform%2Fclusters": {status: http.StatusOK, body: `{"id": 7, "full_path": "platform/clusters", "kind": "group"}`},
+		"POST /projects":                      {status: http.StatusCreated, body: projectResponse},
+	})
+	p := newProvider(t, f, &v1alpha1.GitlabProviderConfig{Owner: "platform/clusters", Repository: "fleet"})
+
+	repo, err := p.CreateRepo(context.Background(), git.CreateRepoOpts{
+		Name:        "fleet",
+		Owner:       "platform/clusters",
+		Description: "EKS-A cluster configuration repository",
+		Privacy:     true,
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(repo.Name).To(Equal("fleet"))
+	g.Expect(f.requests["POST /projects"]).To(Equal(map[string]interface{}{
+		"name":                   "fleet",
+		"path":                   "fleet",
+		"description":            "EKS-A cluster configuration repository",
+		"visibility":             "private",
+		"initialize_with_readme": false,
+		"namespace_id":           float64(7),
+	}))
+}
+
+func TestCreateRepoPersonal(t *testing.T) {
+	g := NewWithT(t)
+	f := newFakeGitlab(t, map[string]fakeResponse{
+		"POST /projects": {status: http.StatusCreated, body: `{"id": 1, "path": "fleet", "namespace": {"full_path": "janedoe", "kind": "user"}}`},
+	})
+	p := newProvider(t, f, &v1alpha1.GitlabProviderConfig{Owner: "janedoe", Repository: "fleet", Personal: true})
+
+	repo, err := p.CreateRepo(context.Background(), git.CreateRepoOpts{Name: "fleet", Owner: "janedoe", Personal: true})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(repo).To(Equal(&git.Repository{Name: "fleet", Owner: "janedoe"}))
+	g.Expect(f.requests["POST /projects"]).NotTo(HaveKey("namespace_id"))
+	g.Expect(f.requests["POST /projects"]).To(HaveKeyWithValue("visibility", "public"))
+}
+
+func TestCreateRepoNamespaceNotFound(t *testing.T) {
+	g := NewWithT(t)
+	f := newFakeGitlab(t, nil)
+	p := newProvider(t, f, &v1alpha1.GitlabProviderConfig{Owner: "platform", Repository: "fleet"})
+
+	_, err := p.CreateRepo(context.Background(), git.CreateRepoOpts{Name: "fleet", Owner: "platform"})
+	g.Expect(err).To(MatchError(ContainSubstring("getting gitlab namespace platform")))
+}
+
+func TestDeleteRepo(t *testing.T) {
+	g := NewWithT(t)
+	f := newFakeGitlab(t, map[string]fakeResponse{
+		"DELETE /projects/platform%2Ffleet": {status: http.StatusAccepted},
+	})
+	p := newProvider(t, f, &v1alpha1.GitlabProviderConfig{Owner: "platform", Repository: "fleet"})
+
+	g.Expect(p.DeleteRepo(context.Background(), git.DeleteRepoOpts{Owner: "platform", Repository: "fleet"})).To(Succeed())
+}
+
+func TestAddDeployKeyToRepo(t *testing.T) {
+	g := NewWithT(t)
+	f := newFakeGitlab(t, map[string]fakeResponse{
+		"POST /projects/platform%2Ffleet/deploy_keys": {status: http.StatusCreated, body: `{"id": 3}`},
+	})
+	p := newProvider(t, f, &v1alpha1.GitlabProviderConfig{Owner: "platform", Repository: "fleet"})
+
+	err := p.AddDeployKeyToRepo(context.Background(), git.AddDeployKeyOpts{
+		Owner:      "platform",
+		Repository: "fleet",
+		Key:        "ssh-ed25519 AAAA",
+		Title:      "flux",
+		ReadOnly:   true,
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(f.requests["POST /projects/platform%2Ffleet/deploy_keys"]).To(Equal(map[string]interface{}{
+		"title":    "flux",
+		"key":      "ssh-ed25519 AAAA",
+		"can_push": false,
+	}))
+}
+
+func TestCreatePullRequest(t *testing.T) {
+	g := NewWithT(t)
+	f := newFakeGitlab(t, map[string]fakeResponse{
+		"POST /projects/platform%2Ffleet/merge_requests": {status: http.StatusCreated, body: `{"iid": 12, "web_url": "https://gitlab.com/platform/fleet/-/merge_requests/12", "state": "opened"}`},
+	})
+	p := newProvider(t, f, &v1alpha1.GitlabProviderConfig{Owner: "platform", Repository: "fleet"})
+
+	pr, err := p.CreatePullRequest(context.Background(), git.CreatePullRequestOpts{
+		Owner:       "platform",
+		Repository:  "fleet",
+		Title:       "Upgrade cluster",
+		Description: "Upgrade cluster to the new version",
+		HeadBranch:  "eksa/mgmt-upgrade",
+		BaseBranch:  "main",
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(pr).To(Equal(&git.PullRequest{
+		Number: 12,
+		Url:    "https://gitlab.com/platform/fleet/-/merge_requests/12",
+		State:  git.PullRequestOpen,
+	}))
+	g.Expect(f.requests["POST /projects/platform%2Ffleet/merge_requests"]).To(Equal(map[string]interface{}{
+		"source_branch":        "eksa/mgmt-upgrade",
+		"target_branch":        "main",
+		"title":                "Upgrade cluster",
+		"description":          "Upgrade cluster to the new version",
+		"remove_source_branch": true,
+	}))
+}
+
+func TestCreatePullRequestError(t *testing.T) {
+	g := NewWithT(t)
+	f := newFakeGitlab(t, map[string]fakeResponse{
+		"POST /projects/platform%2Ffleet/merge_requests": {status: http.StatusConflict, body: `{"message":"Another open merge request already exists for this source branch"}`},
+	})
+	p := newProvider(t, f, &v1alpha1.GitlabProviderConfig{Owner: "platform", Repository: "fleet"})
+
+	_, err := p.CreatePullRequest(context.Background(), git.CreatePullRequestOpts{Owner: "platform", Repository: "fleet"})
+	g.Expect(err).To(MatchError(ContainSubstring("creating merge request in repository fleet")))
+}
+
+func TestGetPullRequest(t *testing.T) {
+	tests := []struct {
+		state string
+		want  git.PullRequestState
+	}{
+		{state: "opened", want: git.PullRequestOpen},
+		{state: "locked", want: git.PullRequestOpen},
+		{state: "merged", want: git.PullRequestMerged},
+		{state: "closed", want: git.PullRequestClosed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.state, func(t *testing.T) {
+			g := NewWithT(t)
+			f := newFakeGitlab(t, map[string]fakeResponse{
+				"GET /projects/platform%2Ffleet/merge_requests/12": {status: http.StatusOK, body: `{"iid": 12, "state": "` + tt.state + `"}`},
+			})
+			p := newProvider(t, f, &v1alpha1.GitlabProviderConfig{Owner: "platform", Repository: "fleet"})
+
+			pr, err := p.GetPullRequest(context.Background(), git.GetPullRequestOpts{Owner: "platform", Repository: "fleet", Number: 12})
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(pr.Number).To(Equal(12))
+			g.Expect(pr.State).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name      string
+		config    *v1alpha1.GitlabProviderConfig
+		responses map[string]fakeResponse
+		wantErr   string
+	}{
+		{
+			name:   "personal repo",
+			config: &v1alpha1.GitlabProviderConfig{Owner: "JaneDoe", Repository: "fleet", Personal: true},
+			responses: map[string]fakeResponse{
+				"GET /user":                        {status: http.StatusOK, body: `{"username": "janedoe"}`},
+				"GET /personal_access_tokens/self": {status: http.StatusOK, body: `{"scopes": ["read_user", "api"]}`},
+			},
+		},
+		{
+			name:   "group repo",
+			config: &v1alpha1.GitlabProviderConfig{Owner: "platform/clusters", Repository: "fleet"},
+			responses: map[string]fakeResponse{
+				"GET /user":                        {status: http.StatusOK, body: `{"username": "janedoe"}`},
+				"GET /personal_access_tokens/self": {status: http.StatusOK, body: `{"scopes": ["api"]}`},
+				"GET /groups/platform%2Fclusters":  {status: http.StatusOK, body: `{"id": 7}`},
+			},
+		},
+		{
+			name:    "invalid token",
+			config:  &v1alpha1.GitlabProviderConfig{Owner: "janedoe", Repository: "fleet", Personal: true},
+			wantErr: "authenticating with gitlab: GET /user: 404",
+		},
+		{
+			name:   "missing api scope",
+			config: &v1alpha1.GitlabProviderConfig{Owner: "janedoe", Repository: "fleet", Personal: true},
+			responses: map[string]fakeResponse{
+				"GET /user":                        {status: http.StatusOK, body: `{"username": "janedoe"}`},
+				"GET /personal_access_tokens/self": {status: http.StatusOK, body: `{"scopes": ["read_repository"]}`},
+			},
+			wantErr: "gitlab access token doesn't have the required api scope; token scopes are read_repository",
+		},
+		{
+			name:   "personal repo owner mismatch",
+			config: &v1alpha1.GitlabProviderConfig{Owner: "johndoe", Repository: "fleet", Personal: true},
+			responses: map[string]fakeResponse{
+				"GET /user":                        {status: http.StatusOK, body: `{"username": "janedoe"}`},
+				"GET /personal_access_tokens/self": {status: http.StatusOK, body: `{"scopes": ["api"]}`},
+			},
+			wantErr: "the authenticated GitLab user and owner johndoe specified in the EKS-A gitops spec don't match",
+		},
+		{
+			name:   "no access to group",
+			config: &v1alpha1.GitlabProviderConfig{Owner: "platform", Repository: "fleet"},
+			responses: map[string]fakeResponse{
+				"GET /user":                        {status: http.StatusOK, body: `{"username": "janedoe"}`},
+				"GET /personal_access_tokens/self": {status: http.StatusOK, body: `{"scopes": ["api"]}`},
+			},
+			wantErr: "the authenticated gitlab user doesn't have proper access to gitlab group platform",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			p := newProvider(t, newFakeGitlab(t, tt.responses), tt.config)
+
+			err := p.Validate(context.Background())
+			if tt.wantErr == "" {
+				g.Expect(err).NotTo(HaveOccurred())
+			} else {
+				g.Expect(err).To(MatchError(ContainSubstring(tt.wantErr)))
+			}
+		})
+	}
+}
+
+func TestPathExists(t *testing.T) {
+	treePath := "GET /projects/platform%2Ffleet/repository/tree?path=clusters%2Fmgmt&per_page=1&ref=main"
+	filePath := "HEAD /projects/platform%2Ffleet/repository/files/clusters%2Fmgmt?ref=main"
+	tests := []struct {
+		name      string
+		responses map[string]fakeResponse
+		want      bool
+		wantErr   string
+	}{
+		{
+			name: "directory exists",
+			responses: map[string]fakeResponse{
+				treePath: {status: http.StatusOK, body: `[{"name": "eksa-system"}]`},
+			},
+			want: true,
+		},
+		{
+			name: "file exists",
+			responses: map[string]fakeResponse{
+				treePath: {status: http.StatusOK, body: `[]`},
+				filePath: {status: http.StatusOK},
+			},
+			want: true,
+		},
+		{
+			name: "path doesn't exist",
+			responses: map[string]fakeResponse{
+				treePath: {status: http.StatusOK, body: `[]`},
+			},
+			want: false,
+		},
+		{
+			name: "repo or branch doesn't exist",
+			want: false,
+		},
+		{
+			name: "api error",
+			responses: map[string]fakeResponse{
+				treePath: {status: http.StatusForbidden, body: "forbidden"},
+			},
+			wantErr: "failed checking if path clusters/mgmt exists in remote gitlab repository",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			p := newProvider(t, newFakeGitlab(t, tt.responses), &v1alpha1.GitlabProviderConfig{Owner: "platform", Repository: "fleet"})
+
+			exists, err := p.PathExists(context.Background(), "platform", "fleet", "main", "clusters/mgmt")
+			if tt.wantErr != "" {
+				g.Expect(err).To(MatchError(ContainSubstring(tt.wantErr)))
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(exists).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestGetGitlabAccessTokenFromEnv(t *testing.T) {
+	g := NewWithT(t)
+	t.Setenv(gitlab.EksaGitlabTokenEnv, token)
+	t.Setenv(gitlab.GitlabTokenEnv, "")
+
+	got, err := gitlab.GetGitlabAccessTokenFromEnv()
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(got).To(Equal(token))
+	g.Expect(os.Getenv(gitlab.GitlabTokenEnv)).To(Equal(token))
+}
+
+func TestGetGitlabAccessTokenFromEnvMissing(t *testing.T) {
+	g := NewWithT(t)
+	t.Setenv(gitlab.EksaGitlabTokenEnv, "")
+
+	_, err := gitlab.GetGitlabAccessTokenFromEnv()
+	g.Expect(err).To(MatchError(ContainSubstring(gitlab.EksaGitlabTokenEnv)))
+}
+
+func TestHostnameAndRepoUrl(t *testing.T) {
+	g := NewWithT(t)
+	g.Expect(gitlab.Hostname(&v1alpha1.GitlabProviderConfig{})).To(Equal("gitlab.com"))
+	g.Expect(gitlab.Hostname(&v1alpha1.GitlabProviderConfig{Hostname: "gitlab.example.com"})).To(Equal("gitlab.example.com"))
+	g.Expect(gitlab.RepoUrl("gitlab.com", "platform/clusters", "fleet")).To(Equal("https://gitlab.com/platform/clusters/fleet.git"))
+}
@@ -0,0 +1,117 @@
+// Package restapi implements the JSON REST calls shared by the git providers
+// that talk directly to their server API.
+package restapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// Client makes authenticated JSON requests to a git provider REST API.
+type Client struct {
+	httpClient *http.Client
+	baseURL    string
+	headers    map[string]string
+	name       string
+}
+
+// NewClient builds a Client for the API at baseURL. headers are added to every request,
+// usually to authenticate it, and name identifies the provider in errors.
+func NewClient(httpClient *http.Client, baseURL, name string, headers map[string]string) *Client {
+	return &Client{
+		httpClient: httpClient,
+		baseURL:    baseURL,
+		headers:    headers,
+		name:       name,
+	}
+}
+
+// Do sends a request to path, relative to the client base url, with body marshalled as JSON
+// and decodes the response into out when it's not nil.
+// Responses with a status code of 300 or above are returned as an *APIError.
+func (c *Client) Do(ctx context.Context, method, path string, query url.Values, body, out interface{}) error {
+	u := c.baseURL + path
+	if len(query) > 0 {
+		u = u + "?" + query.Encode()
+	}
+
+	var reqBody io.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("marshalling request body: %v", err)
+		}
+		reqBody = bytes.NewReader(b)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, u, reqBody)
+	if err != nil {
+		return err
+	}
+	for k, v := range c.headers {
+		req.Header.Set(k, v)
+	}
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusMultipleChoices {
+		msg, _ := io.ReadAll(resp.Body)
+		return &APIError{Method: method, Path: path, StatusCode: resp.StatusCode, Message: strings.TrimSpace(string(msg))}
+	}
+
+	if out == nil || method == http.MethodHead {
+		return nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("decoding %s response for %s %s: %v", c.name, method, path, err)
+	}
+	return nil
+}
+
+// APIError is an error response from the provider API.
+type APIError struct {
+	Method     string
+	Path       string
+	StatusCode int
+	Message    string
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("%s %s: %d %s", e.Method, e.Path, e.StatusCode, e.Message)
+}
+
+// IsNotFound returns true if err is an *APIError with a 404 status code.
+func IsNotFound(err error) bool {
+	var e *APIError
+	return errors.As(err, &e) && e.StatusCode == http.StatusNotFound
+}
+
+// EscapePathSegment encodes s as a single url path segment, including any "/".
+func EscapePathSegment(s string) string {
+	return strings.ReplaceAll(url.PathEscape(s), "/", "%2F")
+}
+
+// Contains returns true if v is in s.
+func Contains(s []string, v string) bool {
+	for _, e := range s {
+		if e == v {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,69 @@
+package restapi_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/aws/eks-anywhere/pkg/git/providers/internal/restapi"
+)
+
+func TestClientDoSuccess(t *testing.T) {
+	g := NewWithT(t)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		g.Expect(r.URL.Path).To(Equal("/api/repos"))
+		g.Expect(r.URL.Query().Get("page")).To(Equal("2"))
+		g.Expect(r.Header.Get("PRIVATE-TOKEN")).To(Equal("token"))
+		g.Expect(r.Header.Get("Content-Type")).To(Equal("application/json"))
+		_, _ = w.Write([]byte(`{"name":"repo"}`))
+	}))
+	defer server.Close()
+
+	c := restapi.NewClient(server.Client(), server.URL+"/api", "test", map[string]string{"PRIVATE-TOKEN": "token"})
+	out := &struct {
+		Name string `json:"name"`
+	}{}
+	g.Expect(c.Do(context.Background(), http.MethodPost, "/repos", map[string][]string{"page": {"2"}}, map[string]string{"name": "repo"}, out)).To(Succeed())
+	g.Expect(out.Name).To(Equal("repo"))
+}
+
+func TestClientDoNotFound(t *testing.T) {
+	g := NewWithT(t)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "repo not found", http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	c := restapi.NewClient(server.Client(), server.URL, "test", nil)
+	err := c.Do(context.Background(), http.MethodGet, "/repos/repo", nil, nil, nil)
+	g.Expect(err).To(MatchError("GET /repos/repo: 404 repo not found"))
+	g.Expect(restapi.IsNotFound(err)).To(BeTrue())
+}
+
+func TestClientDoInvalidResponse(t *testing.T) {
+	g := NewWithT(t)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`not json`))
+	}))
+	defer server.Close()
+
+	c := restapi.NewClient(server.Client(), server.URL, "test", nil)
+	out := map[string]string{}
+	err := c.Do(context.Background(), http.MethodGet, "/repos", nil, nil, &out)
+	g.Expect(err).To(MatchError(ContainSubstring("decoding test response for GET /repos")))
+	g.Expect(restapi.IsNotFound(err)).To(BeFalse())
+}
+
+func TestEscapePathSegment(t *testing.T) {
+	g := NewWithT(t)
+	g.Expect(restapi.EscapePathSegment("group/sub group/repo")).To(Equal("group%2Fsub%20group%2Frepo"))
+}
+
+func TestContains(t *testing.T) {
+	g := NewWithT(t)
+	g.Expect(restapi.Contains([]string{"read_api", "api"}, "api")).To(BeTrue())
+	g.Expect(restapi.Contains([]string{"read_api"}, "api")).To(BeFalse())
+}
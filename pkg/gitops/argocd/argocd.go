@@ -0,0 +1,418 @@
+package argocd
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"time"
+
+	"github.com/aws/eks-anywhere/pkg/cluster"
+	"github.com/aws/eks-anywhere/pkg/config"
+	"github.com/aws/eks-anywhere/pkg/executables"
+	"github.com/aws/eks-anywhere/pkg/filewriter"
+	gitFactory "github.com/aws/eks-anywhere/pkg/git/factory"
+	"github.com/aws/eks-anywhere/pkg/logger"
+	"github.com/aws/eks-anywhere/pkg/providers"
+	"github.com/aws/eks-anywhere/pkg/retrier"
+	"github.com/aws/eks-anywhere/pkg/types"
+	"github.com/aws/eks-anywhere/pkg/validations"
+)
+
+const (
+	maxRetries    = 5
+	backOffPeriod = 5 * time.Second
+
+	applicationResourceType = "applications.argoproj.io"
+	// skipReconcileAnnotation stops the Argo CD application controller from reconciling an Application.
+	skipReconcileAnnotation = "argocd.argoproj.io/skip-reconcile"
+	// refreshAnnotation makes the Argo CD application controller refresh an Application from git.
+	refreshAnnotation = "argocd.argoproj.io/refresh"
+
+	deploymentWaitTimeout = "5m"
+
+	initialClusterconfigCommitMessage = "Initial commit of cluster configuration; generated by EKS-A CLI"
+	updateClusterconfigCommitMessage  = "Update commit of cluster configuration; generated by EKS-A CLI"
+	deleteClusterconfigCommitMessage  = "Delete commit of cluster configuration; generated by EKS-A CLI"
+)
+
+// deployments are the Argo CD components that need to be available before an Application can be synced.
+var deployments = []string{"argocd-repo-server", "argocd-server"}
+
+// KubeClient is an interface that abstracts the basic commands of kubectl executable.
+type KubeClient interface {
+	CreateNamespaceIfNotPresent(ctx context.Context, kubeconfig string, namespace string) error
+	DeleteNamespace(ctx context.Context, kubeconfig string, namespace string) error
+	ApplyKubeSpecFromBytes(ctx context.Context, cluster *types.Cluster, data []byte) error
+	ApplyKubeSpecFromBytesWithNamespace(ctx context.Context, cluster *types.Cluster, data []byte, namespace string) error
+	WaitForDeployment(ctx context.Context, cluster *types.Cluster, timeout string, condition string, target string, namespace string) error
+	UpdateAnnotation(ctx context.Context, resourceType, objectName string, annotations map[string]string, opts ...executables.KubectlOpt) error
+	RemoveAnnotation(ctx context.Context, resourceType, objectName string, key string, opts ...executables.KubectlOpt) error
+}
+
+// GitClient is an interface that abstracts the git operations on the local clone of the GitOps repository.
+type GitClient interface {
+	Clone(ctx context.Context) error
+	Push(ctx context.Context) error
+	Pull(ctx context.Context, branch string) error
+	Add(filename string) error
+	Remove(filename string) error
+	Commit(message string) error
+	Branch(name string) error
+	Init() error
+}
+
+// ArgoCD is a GitOps manager that installs Argo CD in the cluster and syncs the cluster configuration
+// from a git repository with an Argo CD Application.
+type ArgoCD struct {
+	kube      KubeClient
+	git       GitClient
+	writer    filewriter.FileWriter
+	cliConfig *config.CliConfig
+	*retrier.Retrier
+}
+
+func NewArgoCD(kube KubeClient, gitTools *gitFactory.GitTools, cliConfig *config.CliConfig) *ArgoCD {
+	var w filewriter.FileWriter
+	var g GitClient
+	if gitTools != nil {
+		w = gitTools.Writer
+		g = gitTools.Client
+	}
+
+	return NewArgoCDFromClients(kube, g, w, cliConfig)
+}
+
+func NewArgoCDFromClients(kube KubeClient, git GitClient, writer filewriter.FileWriter, cliConfig *config.CliConfig) *ArgoCD {
+	return &ArgoCD{
+		kube:      kube,
+		git:       git,
+		writer:    writer,
+		cliConfig: cliConfig,
+		Retrier:   retrier.NewWithMaxRetries(maxRetries, backOffPeriod),
+	}
+}
+
+func (a *ArgoCD) InstallGitOps(ctx context.Context, cluster *types.Cluster, clusterSpec *cluster.Spec, datacenterConfig providers.DatacenterConfig, machineConfigs []providers.MachineConfig) error {
+	if a.shouldSkipArgoCD() {
+		logger.Info("GitOps field not specified, install Argo CD skipped")
+		return nil
+	}
+
+	ac := newArgoCDForCluster(a, clusterSpec)
+
+	if err := ac.syncGitRepo(ctx); err != nil {
+		return err
+	}
+
+	if err := ac.validateLocalConfigPathDoesNotExist(); err != nil {
+		return err
+	}
+
+	logger.Info("Adding cluster configuration files to Git")
+	if err := ac.writeEksaFiles(datacenterConfig, machineConfigs); err != nil {
+		return err
+	}
+
+	if err := ac.pushChanges(ctx, ac.eksaSystemDir(), initialClusterconfigCommitMessage); err != nil {
+		return err
+	}
+
+	if !cluster.ExistingManagement {
+		if err := a.installComponents(ctx, cluster, clusterSpec); err != nil {
+			_ = a.Uninstall(ctx, cluster, clusterSpec)
+			return fmt.Errorf("installing Argo CD: %v", err)
+		}
+	}
+
+	if err := a.applyApplication(ctx, cluster, ac); err != nil {
+		return err
+	}
+
+	logger.V(3).Info("Finished installing Argo CD and pushing cluster config to git", "repository", ac.repositoryUrl())
+	return nil
+}
+
+// installComponents applies the Argo CD manifest from the bundle and configures the credentials it needs
+// to access the git repository.
+func (a *ArgoCD) installComponents(ctx context.Context, cluster *types.Cluster, clusterSpec *cluster.Spec) error {
+	namespace := clusterSpec.ArgoCDConfig.Spec.SystemNamespace
+	logger.Info("Installing Argo CD", "namespace", namespace)
+
+	if err := a.Retry(func() error {
+		return a.kube.CreateNamespaceIfNotPresent(ctx, cluster.KubeconfigFile, namespace)
+	}); err != nil {
+		return fmt.Errorf("creating namespace %s: %v", namespace, err)
+	}
+
+	if err := a.applyComponents(ctx, cluster, clusterSpec); err != nil {
+		return err
+	}
+
+	credentials, err := repositoryCredentials(clusterSpec.ArgoCDConfig.Spec.Git, a.cliConfig)
+	if err != nil {
+		return err
+	}
+
+	secret, err := generateRepositorySecret(clusterSpec.ArgoCDConfig, credentials)
+	if err != nil {
+		return err
+	}
+
+	if err := a.Retry(func() error {
+		return a.kube.ApplyKubeSpecFromBytes(ctx, cluster, secret)
+	}); err != nil {
+		return fmt.Errorf("applying Argo CD repository secret: %v", err)
+	}
+
+	if a.cliConfig == nil || a.cliConfig.GitKnownHostsFile == "" || clusterSpec.ArgoCDConfig.Spec.Git.IsHTTPS() {
+		return nil
+	}
+
+	knownHosts, err := generateSshKnownHosts(clusterSpec.ArgoCDConfig, a.cliConfig.GitKnownHostsFile)
+	if err != nil {
+		return err
+	}
+
+	if err := a.Retry(func() error {
+		return a.kube.ApplyKubeSpecFromBytes(ctx, cluster, knownHosts)
+	}); err != nil {
+		return fmt.Errorf("applying Argo CD ssh known hosts: %v", err)
+	}
+
+	return nil
+}
+
+// applyComponents applies the Argo CD components manifest from the bundle and waits for them to be available.
+func (a *ArgoCD) applyComponents(ctx context.Context, cluster *types.Cluster, clusterSpec *cluster.Spec) error {
+	namespace := clusterSpec.ArgoCDConfig.Spec.SystemNamespace
+	manifest, err := clusterSpec.LoadManifest(clusterSpec.VersionsBundle.ArgoCD.Manifest)
+	if err != nil {
+		return fmt.Errorf("loading Argo CD manifest: %v", err)
+	}
+
+	components, err := setComponentsNamespace(manifest.Content, namespace)
+	if err != nil {
+		return err
+	}
+
+	if err := a.Retry(func() error {
+		return a.kube.ApplyKubeSpecFromBytesWithNamespace(ctx, cluster, components, namespace)
+	}); err != nil {
+		return fmt.Errorf("applying Argo CD manifest: %v", err)
+	}
+
+	for _, d := range deployments {
+		if err := a.kube.WaitForDeployment(ctx, cluster, deploymentWaitTimeout, "Available", d, namespace); err != nil {
+			return fmt.Errorf("waiting for Argo CD deployment %s: %v", d, err)
+		}
+	}
+
+	return nil
+}
+
+func (a *ArgoCD) applyApplication(ctx context.Context, cluster *types.Cluster, ac *argoCDForCluster) error {
+	application, err := generateApplication(ac.clusterSpec.ArgoCDConfig, ac.applicationName(), ac.eksaSystemDir(), ac.clusterNamespace())
+	if err != nil {
+		return err
+	}
+
+	if err := a.Retry(func() error {
+		return a.kube.ApplyKubeSpecFromBytes(ctx, cluster, application)
+	}); err != nil {
+		return fmt.Errorf("applying Argo CD application %s: %v", ac.applicationName(), err)
+	}
+
+	return nil
+}
+
+// Uninstall removes the Argo CD components from the cluster. The Applications don't have the resources
+// finalizer, so the cluster resources they manage are left untouched.
+func (a *ArgoCD) Uninstall(ctx context.Context, cluster *types.Cluster, clusterSpec *cluster.Spec) error {
+	if err := a.kube.DeleteNamespace(ctx, cluster.KubeconfigFile, clusterSpec.ArgoCDConfig.Spec.SystemNamespace); err != nil {
+		logger.Info("Could not uninstall Argo CD components", "error", err)
+		return err
+	}
+	return nil
+}
+
+func (a *ArgoCD) PauseClusterResourcesReconcile(ctx context.Context, cluster *types.Cluster, clusterSpec *cluster.Spec, provider providers.Provider) error {
+	if a.shouldSkipArgoCD() {
+		logger.V(4).Info("GitOps field not specified, pause cluster resources reconcile skipped")
+		return nil
+	}
+
+	logger.V(3).Info("Pause Argo CD EKS-A resources reconcile")
+
+	ac := newArgoCDForCluster(a, clusterSpec)
+	annotations := map[string]string{
+		skipReconcileAnnotation: "true",
+	}
+
+	if err := a.Retry(func() error {
+		return a.kube.UpdateAnnotation(ctx, applicationResourceType, ac.applicationName(), annotations, executables.WithOverwrite(), executables.WithCluster(cluster), executables.WithNamespace(ac.namespace()))
+	}); err != nil {
+		return fmt.Errorf("disable Argo CD application %s reconcile: %v", ac.applicationName(), err)
+	}
+
+	return nil
+}
+
+func (a *ArgoCD) ResumeClusterResourcesReconcile(ctx context.Context, cluster *types.Cluster, clusterSpec *cluster.Spec, provider providers.Provider) error {
+	if a.shouldSkipArgoCD() {
+		logger.V(4).Info("GitOps field not specified, resume cluster resources reconcile skipped")
+		return nil
+	}
+
+	logger.V(3).Info("Resume Argo CD EKS-A resources reconcile")
+
+	ac := newArgoCDForCluster(a, clusterSpec)
+	if err := a.Retry(func() error {
+		return a.kube.RemoveAnnotation(ctx, applicationResourceType, ac.applicationName(), skipReconcileAnnotation, executables.WithOverwrite(), executables.WithCluster(cluster), executables.WithNamespace(ac.namespace()))
+	}); err != nil {
+		return fmt.Errorf("enable Argo CD application %s reconcile: %v", ac.applicationName(), err)
+	}
+
+	return nil
+}
+
+func (a *ArgoCD) ForceReconcileGitRepo(ctx context.Context, cluster *types.Cluster, clusterSpec *cluster.Spec) error {
+	if a.shouldSkipArgoCD() {
+		logger.Info("GitOps not configured, force refresh Argo CD application skipped")
+		return nil
+	}
+
+	ac := newArgoCDForCluster(a, clusterSpec)
+	annotations := map[string]string{
+		refreshAnnotation: "hard",
+	}
+
+	return a.Retry(func() error {
+		return a.kube.UpdateAnnotation(ctx, applicationResourceType, ac.applicationName(), annotations, executables.WithOverwrite(), executables.WithCluster(cluster), executables.WithNamespace(ac.namespace()))
+	})
+}
+
+func (a *ArgoCD) UpdateGitEksaSpec(ctx context.Context, clusterSpec *cluster.Spec, datacenterConfig providers.DatacenterConfig, machineConfigs []providers.MachineConfig) error {
+	if a.shouldSkipArgoCD() {
+		logger.Info("GitOps field not specified, update git repo skipped")
+		return nil
+	}
+
+	ac := newArgoCDForCluster(a, clusterSpec)
+
+	if err := ac.syncGitRepo(ctx); err != nil {
+		return err
+	}
+
+	if err := ac.writeEksaFiles(datacenterConfig, machineConfigs); err != nil {
+		return err
+	}
+
+	if err := ac.pushChanges(ctx, ac.eksaSystemDir(), updateClusterconfigCommitMessage); err != nil {
+		return err
+	}
+
+	logger.V(3).Info("Finished pushing updated cluster config file to git", "repository", ac.repositoryUrl())
+	return nil
+}
+
+func (a *ArgoCD) Validations(ctx context.Context, clusterSpec *cluster.Spec) []validations.Validation {
+	if a.shouldSkipArgoCD() {
+		return nil
+	}
+
+	ac := newArgoCDForCluster(a, clusterSpec)
+
+	return []validations.Validation{
+		func() *validations.ValidationResult {
+			return &validations.ValidationResult{
+				Name:        "Argo CD path",
+				Remediation: "Please provide a different path or different cluster name",
+				Err:         ac.validateRemoteConfigPathDoesNotExist(ctx),
+			}
+		},
+	}
+}
+
+func (a *ArgoCD) CleanupGitRepo(ctx context.Context, clusterSpec *cluster.Spec) error {
+	if a.shouldSkipArgoCD() {
+		logger.Info("GitOps field not specified, clean up git repo skipped")
+		return nil
+	}
+
+	ac := newArgoCDForCluster(a, clusterSpec)
+
+	if err := ac.syncGitRepo(ctx); err != nil {
+		return err
+	}
+
+	p := path.Dir(ac.eksaSystemDir())
+	if !validations.FileExists(path.Join(a.writer.Dir(), p)) {
+		logger.V(3).Info("cluster dir does not exist in git, skip clean up")
+		return nil
+	}
+
+	if err := a.git.Remove(p); err != nil {
+		return fmt.Errorf("removing %s in git: %v", p, err)
+	}
+
+	if err := ac.commitAndPush(ctx, p, deleteClusterconfigCommitMessage); err != nil {
+		return err
+	}
+
+	logger.V(3).Info("Finished cleaning up cluster files in git", "repository", ac.repositoryUrl())
+	return nil
+}
+
+func (a *ArgoCD) Install(ctx context.Context, cluster *types.Cluster, oldSpec, newSpec *cluster.Spec) error {
+	if oldSpec.Cluster.Spec.GitOpsRef == nil && newSpec.Cluster.Spec.GitOpsRef != nil {
+		return a.InstallGitOps(ctx, cluster, newSpec, nil, nil)
+	}
+	return nil
+}
+
+func (a *ArgoCD) Upgrade(ctx context.Context, managementCluster *types.Cluster, currentSpec *cluster.Spec, newSpec *cluster.Spec) (*types.ChangeDiff, error) {
+	logger.V(1).Info("Checking for Argo CD upgrades")
+
+	changeDiff := ArgoCDChangeDiff(currentSpec, newSpec)
+	if changeDiff == nil {
+		logger.V(1).Info("Nothing to upgrade for Argo CD")
+		return nil, nil
+	}
+
+	logger.V(1).Info("Starting Argo CD upgrades")
+	if err := a.applyComponents(ctx, managementCluster, newSpec); err != nil {
+		return nil, fmt.Errorf("upgrading Argo CD from bundles %d to bundles %d: %v", currentSpec.Bundles.Spec.Number, newSpec.Bundles.Spec.Number, err)
+	}
+
+	return changeDiff, nil
+}
+
+func ArgoCDChangeDiff(currentSpec, newSpec *cluster.Spec) *types.ChangeDiff {
+	if !newSpec.Cluster.IsSelfManaged() {
+		logger.V(1).Info("Skipping Argo CD upgrades, not a self-managed cluster")
+		return nil
+	}
+	if currentSpec.ArgoCDConfig == nil || newSpec.ArgoCDConfig == nil {
+		logger.V(1).Info("Skipping Argo CD upgrades, Argo CD not enabled")
+		return nil
+	}
+	oldVersion := currentSpec.VersionsBundle.ArgoCD.Version
+	newVersion := newSpec.VersionsBundle.ArgoCD.Version
+	if oldVersion != newVersion {
+		logger.V(1).Info("Argo CD change diff ", "oldVersion ", oldVersion, "newVersion ", newVersion)
+		return &types.ChangeDiff{
+			ComponentReports: []types.ComponentChangeDiff{
+				{
+					ComponentName: "Argo CD",
+					NewVersion:    newVersion,
+					OldVersion:    oldVersion,
+				},
+			},
+		}
+	}
+	return nil
+}
+
+func (a *ArgoCD) shouldSkipArgoCD() bool {
+	return a.writer == nil
+}
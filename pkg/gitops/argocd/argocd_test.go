@@ -0,0 +1,312 @@
+package argocd_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/aws/eks-anywhere/internal/test"
+	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/cluster"
+	"github.com/aws/eks-anywhere/pkg/config"
+	"github.com/aws/eks-anywhere/pkg/filewriter"
+	"github.com/aws/eks-anywhere/pkg/gitops/argocd"
+	"github.com/aws/eks-anywhere/pkg/gitops/argocd/mocks"
+	"github.com/aws/eks-anywhere/pkg/providers"
+	"github.com/aws/eks-anywhere/pkg/retrier"
+	"github.com/aws/eks-anywhere/pkg/types"
+	releasev1alpha1 "github.com/aws/eks-anywhere/release/api/v1alpha1"
+)
+
+const eksaSystemDir = "clusters/management-cluster/management-cluster/eksa-system"
+
+type argoCDTest struct {
+	*WithT
+	*testing.T
+	ctx         context.Context
+	kube        *mocks.MockKubeClient
+	git         *mocks.MockGitClient
+	argoCD      *argocd.ArgoCD
+	writer      filewriter.FileWriter
+	cluster     *types.Cluster
+	clusterSpec *cluster.Spec
+}
+
+func newArgoCDTest(t *testing.T) *argoCDTest {
+	ctrl := gomock.NewController(t)
+	kube := mocks.NewMockKubeClient(ctrl)
+	git := mocks.NewMockGitClient(ctrl)
+	_, w := test.NewWriter(t)
+
+	keyFile := filepath.Join(t.TempDir(), "id_ecdsa")
+	if err := os.WriteFile(keyFile, []byte("private-key\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	a := argocd.NewArgoCDFromClients(kube, git, w, &config.CliConfig{GitPrivateKeyFile: keyFile})
+	a.Retrier = retrier.NewWithMaxRetries(1, 0)
+
+	clusterConfig := v1alpha1.NewCluster("management-cluster")
+	clusterConfig.Spec.GitOpsRef = &v1alpha1.Ref{Kind: v1alpha1.ArgoCDConfigKind, Name: "test-gitops"}
+	clusterSpec := test.NewClusterSpec(func(s *cluster.Spec) {
+		s.Cluster = clusterConfig
+		s.ArgoCDConfig = &v1alpha1.ArgoCDConfig{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       v1alpha1.ArgoCDConfigKind,
+				APIVersion: v1alpha1.SchemeBuilder.GroupVersion.String(),
+			},
+			ObjectMeta: metav1.ObjectMeta{Name: "test-gitops"},
+			Spec: v1alpha1.ArgoCDConfigSpec{
+				Git: &v1alpha1.GitProviderConfig{RepositoryUrl: "git@github.com:aws/eksa-gitops.git"},
+			},
+		}
+		s.VersionsBundle.ArgoCD = releasev1alpha1.ArgoCDBundle{
+			Version:  "v2.8.4",
+			Manifest: releasev1alpha1.Manifest{URI: "testdata/argocd.yaml"},
+		}
+	})
+	if err := cluster.SetConfigDefaults(clusterSpec.Config); err != nil {
+		t.Fatal(err)
+	}
+
+	return &argoCDTest{
+		WithT:       NewWithT(t),
+		T:           t,
+		ctx:         context.Background(),
+		kube:        kube,
+		git:         git,
+		argoCD:      a,
+		writer:      w,
+		cluster:     &types.Cluster{Name: "management-cluster", KubeconfigFile: "k.kubeconfig"},
+		clusterSpec: clusterSpec,
+	}
+}
+
+func (tt *argoCDTest) expectPushEksaFiles(msg string) {
+	tt.git.EXPECT().Clone(tt.ctx)
+	tt.git.EXPECT().Branch("main")
+	tt.git.EXPECT().Add(eksaSystemDir)
+	tt.git.EXPECT().Commit(msg)
+	tt.git.EXPECT().Push(tt.ctx)
+}
+
+func datacenterAndMachineConfigs() (providers.DatacenterConfig, []providers.MachineConfig) {
+	dc := &v1alpha1.VSphereDatacenterConfig{
+		TypeMeta:   metav1.TypeMeta{Kind: v1alpha1.VSphereDatacenterKind},
+		ObjectMeta: metav1.ObjectMeta{Name: "management-cluster"},
+	}
+	mc := &v1alpha1.VSphereMachineConfig{
+		TypeMeta:   metav1.TypeMeta{Kind: v1alpha1.VSphereMachineConfigKind},
+		ObjectMeta: metav1.ObjectMeta{Name: "management-cluster"},
+	}
+	return dc, []providers.MachineConfig{mc}
+}
+
+func TestInstallGitOpsSuccess(t *testing.T) {
+	tt := newArgoCDTest(t)
+	dc, mcs := datacenterAndMachineConfigs()
+	tt.expectPushEksaFiles("Initial commit of cluster configuration; generated by EKS-A CLI")
+	tt.kube.EXPECT().CreateNamespaceIfNotPresent(tt.ctx, "k.kubeconfig", "argocd")
+	tt.kube.EXPECT().ApplyKubeSpecFromBytesWithNamespace(tt.ctx, tt.cluster, gomock.Any(), "argocd")
+	tt.kube.EXPECT().WaitForDeployment(tt.ctx, tt.cluster, "5m", "Available", "argocd-repo-server", "argocd")
+	tt.kube.EXPECT().WaitForDeployment(tt.ctx, tt.cluster, "5m", "Available", "argocd-server", "argocd")
+
+	var secret, application []byte
+	gomock.InOrder(
+		tt.kube.EXPECT().ApplyKubeSpecFromBytes(tt.ctx, tt.cluster, gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ *types.Cluster, data []byte) error {
+				secret = data
+				return nil
+			},
+		),
+		tt.kube.EXPECT().ApplyKubeSpecFromBytes(tt.ctx, tt.cluster, gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ *types.Cluster, data []byte) error {
+				application = data
+				return nil
+			},
+		),
+	)
+
+	tt.Expect(tt.argoCD.InstallGitOps(tt.ctx, tt.cluster, tt.clusterSpec, dc, mcs)).To(Succeed())
+	test.AssertContentToFile(t, string(secret), "testdata/expected_repository_secret.yaml")
+	test.AssertContentToFile(t, string(application), "testdata/expected_application.yaml")
+	tt.Expect(path.Join(tt.writer.Dir(), eksaSystemDir, "eksa-cluster.yaml")).To(BeAnExistingFile())
+}
+
+func TestInstallGitOpsCustomNamespace(t *testing.T) {
+	tt := newArgoCDTest(t)
+	tt.clusterSpec.ArgoCDConfig.Spec.SystemNamespace = "gitops"
+	dc, mcs := datacenterAndMachineConfigs()
+	tt.expectPushEksaFiles("Initial commit of cluster configuration; generated by EKS-A CLI")
+	tt.kube.EXPECT().CreateNamespaceIfNotPresent(tt.ctx, "k.kubeconfig", "gitops")
+	var components []byte
+	tt.kube.EXPECT().ApplyKubeSpecFromBytesWithNamespace(tt.ctx, tt.cluster, gomock.Any(), "gitops").DoAndReturn(
+		func(_ context.Context, _ *types.Cluster, data []byte, _ string) error {
+			components = data
+			return nil
+		},
+	)
+	tt.kube.EXPECT().WaitForDeployment(tt.ctx, tt.cluster, "5m", "Available", gomock.Any(), "gitops").Times(2)
+	tt.kube.EXPECT().ApplyKubeSpecFromBytes(tt.ctx, tt.cluster, gomock.Any()).Times(2)
+
+	tt.Expect(tt.argoCD.InstallGitOps(tt.ctx, tt.cluster, tt.clusterSpec, dc, mcs)).To(Succeed())
+	tt.Expect(string(components)).To(ContainSubstring("namespace: gitops"))
+	tt.Expect(string(components)).NotTo(ContainSubstring("namespace: argocd"))
+}
+
+func TestInstallGitOpsExistingManagementCluster(t *testing.T) {
+	tt := newArgoCDTest(t)
+	tt.cluster.ExistingManagement = true
+	dc, mcs := datacenterAndMachineConfigs()
+	tt.expectPushEksaFiles("Initial commit of cluster configuration; generated by EKS-A CLI")
+	tt.kube.EXPECT().ApplyKubeSpecFromBytes(tt.ctx, tt.cluster, gomock.Any())
+
+	tt.Expect(tt.argoCD.InstallGitOps(tt.ctx, tt.cluster, tt.clusterSpec, dc, mcs)).To(Succeed())
+}
+
+func TestInstallGitOpsComponentsError(t *testing.T) {
+	tt := newArgoCDTest(t)
+	dc, mcs := datacenterAndMachineConfigs()
+	tt.expectPushEksaFiles("Initial commit of cluster configuration; generated by EKS-A CLI")
+	tt.kube.EXPECT().CreateNamespaceIfNotPresent(tt.ctx, "k.kubeconfig", "argocd")
+	tt.kube.EXPECT().ApplyKubeSpecFromBytesWithNamespace(tt.ctx, tt.cluster, gomock.Any(), "argocd").Return(errors.New("error from apply"))
+	tt.kube.EXPECT().DeleteNamespace(tt.ctx, "k.kubeconfig", "argocd")
+
+	tt.Expect(tt.argoCD.InstallGitOps(tt.ctx, tt.cluster, tt.clusterSpec, dc, mcs)).To(MatchError(ContainSubstring("installing Argo CD: applying Argo CD manifest: error from apply")))
+}
+
+func TestInstallGitOpsPassphraseNotSupported(t *testing.T) {
+	tt := newArgoCDTest(t)
+	tt.argoCD = argocd.NewArgoCDFromClients(tt.kube, tt.git, tt.writer, &config.CliConfig{GitSshKeyPassphrase: "passphrase"})
+	tt.argoCD.Retrier = retrier.NewWithMaxRetries(1, 0)
+	dc, mcs := datacenterAndMachineConfigs()
+	tt.expectPushEksaFiles("Initial commit of cluster configuration; generated by EKS-A CLI")
+	tt.kube.EXPECT().CreateNamespaceIfNotPresent(tt.ctx, "k.kubeconfig", "argocd")
+	tt.kube.EXPECT().ApplyKubeSpecFromBytesWithNamespace(tt.ctx, tt.cluster, gomock.Any(), "argocd")
+	tt.kube.EXPECT().WaitForDeployment(tt.ctx, tt.cluster, "5m", "Available", gomock.Any(), "argocd").Times(2)
+	tt.kube.EXPECT().DeleteNamespace(tt.ctx, "k.kubeconfig", "argocd")
+
+	tt.Expect(tt.argoCD.InstallGitOps(tt.ctx, tt.cluster, tt.clusterSpec, dc, mcs)).To(MatchError(ContainSubstring("ssh private keys protected with a passphrase")))
+}
+
+func TestInstallGitOpsCloneError(t *testing.T) {
+	tt := newArgoCDTest(t)
+	tt.git.EXPECT().Clone(tt.ctx).Return(errors.New("error from clone"))
+
+	tt.Expect(tt.argoCD.InstallGitOps(tt.ctx, tt.cluster, tt.clusterSpec, nil, nil)).To(MatchError(ContainSubstring("cloning git repo: error from clone")))
+}
+
+func TestInstallGitOpsSkip(t *testing.T) {
+	tt := newArgoCDTest(t)
+	a := argocd.NewArgoCD(tt.kube, nil, nil)
+
+	tt.Expect(a.InstallGitOps(tt.ctx, tt.cluster, tt.clusterSpec, nil, nil)).To(Succeed())
+}
+
+func TestPauseClusterResourcesReconcile(t *testing.T) {
+	tt := newArgoCDTest(t)
+	tt.kube.EXPECT().UpdateAnnotation(tt.ctx, "applications.argoproj.io", "eksa-management-cluster", map[string]string{"argocd.argoproj.io/skip-reconcile": "true"}, gomock.Any())
+
+	tt.Expect(tt.argoCD.PauseClusterResourcesReconcile(tt.ctx, tt.cluster, tt.clusterSpec, nil)).To(Succeed())
+}
+
+func TestResumeClusterResourcesReconcileError(t *testing.T) {
+	tt := newArgoCDTest(t)
+	tt.kube.EXPECT().RemoveAnnotation(tt.ctx, "applications.argoproj.io", "eksa-management-cluster", "argocd.argoproj.io/skip-reconcile", gomock.Any()).Return(errors.New("error from kubectl"))
+
+	tt.Expect(tt.argoCD.ResumeClusterResourcesReconcile(tt.ctx, tt.cluster, tt.clusterSpec, nil)).To(MatchError(ContainSubstring("enable Argo CD application eksa-management-cluster reconcile")))
+}
+
+func TestForceReconcileGitRepo(t *testing.T) {
+	tt := newArgoCDTest(t)
+	tt.kube.EXPECT().UpdateAnnotation(tt.ctx, "applications.argoproj.io", "eksa-management-cluster", map[string]string{"argocd.argoproj.io/refresh": "hard"}, gomock.Any())
+
+	tt.Expect(tt.argoCD.ForceReconcileGitRepo(tt.ctx, tt.cluster, tt.clusterSpec)).To(Succeed())
+}
+
+func TestUpdateGitEksaSpec(t *testing.T) {
+	tt := newArgoCDTest(t)
+	dc, mcs := datacenterAndMachineConfigs()
+	tt.expectPushEksaFiles("Update commit of cluster configuration; generated by EKS-A CLI")
+
+	tt.Expect(tt.argoCD.UpdateGitEksaSpec(tt.ctx, tt.clusterSpec, dc, mcs)).To(Succeed())
+	tt.Expect(path.Join(tt.writer.Dir(), eksaSystemDir, "eksa-cluster.yaml")).To(BeAnExistingFile())
+}
+
+func TestCleanupGitRepoSkipNoClusterDir(t *testing.T) {
+	tt := newArgoCDTest(t)
+	tt.git.EXPECT().Clone(tt.ctx)
+	tt.git.EXPECT().Branch("main")
+
+	tt.Expect(tt.argoCD.CleanupGitRepo(tt.ctx, tt.clusterSpec)).To(Succeed())
+}
+
+func TestCleanupGitRepo(t *testing.T) {
+	tt := newArgoCDTest(t)
+	clusterDir := path.Dir(eksaSystemDir)
+	tt.Expect(os.MkdirAll(path.Join(tt.writer.Dir(), clusterDir), 0o755)).To(Succeed())
+	tt.git.EXPECT().Clone(tt.ctx)
+	tt.git.EXPECT().Branch("main")
+	tt.git.EXPECT().Remove(clusterDir)
+	tt.git.EXPECT().Commit("Delete commit of cluster configuration; generated by EKS-A CLI")
+	tt.git.EXPECT().Push(tt.ctx)
+
+	tt.Expect(tt.argoCD.CleanupGitRepo(tt.ctx, tt.clusterSpec)).To(Succeed())
+}
+
+func TestValidationsPathExists(t *testing.T) {
+	tt := newArgoCDTest(t)
+	tt.Expect(os.MkdirAll(path.Join(tt.writer.Dir(), eksaSystemDir), 0o755)).To(Succeed())
+	tt.Expect(os.WriteFile(path.Join(tt.writer.Dir(), eksaSystemDir, "eksa-cluster.yaml"), []byte{}, 0o644)).To(Succeed())
+	tt.git.EXPECT().Clone(tt.ctx)
+	tt.git.EXPECT().Branch("main")
+	tt.git.EXPECT().Pull(tt.ctx, "main")
+
+	validations := tt.argoCD.Validations(tt.ctx, tt.clusterSpec)
+	tt.Expect(validations).To(HaveLen(1))
+	tt.Expect(validations[0]().Err).To(MatchError(ContainSubstring("already exists in remote repository")))
+}
+
+func TestUpgrade(t *testing.T) {
+	tt := newArgoCDTest(t)
+	currentSpec := tt.clusterSpec.DeepCopy()
+	currentSpec.VersionsBundle.ArgoCD.Version = "v2.7.0"
+	tt.kube.EXPECT().ApplyKubeSpecFromBytesWithNamespace(tt.ctx, tt.cluster, gomock.Any(), "argocd")
+	tt.kube.EXPECT().WaitForDeployment(tt.ctx, tt.cluster, "5m", "Available", gomock.Any(), "argocd").Times(2)
+
+	diff, err := tt.argoCD.Upgrade(tt.ctx, tt.cluster, currentSpec, tt.clusterSpec)
+	tt.Expect(err).NotTo(HaveOccurred())
+	tt.Expect(diff.ComponentReports).To(ConsistOf(types.ComponentChangeDiff{
+		ComponentName: "Argo CD",
+		OldVersion:    "v2.7.0",
+		NewVersion:    "v2.8.4",
+	}))
+}
+
+func TestUpgradeNoChanges(t *testing.T) {
+	tt := newArgoCDTest(t)
+
+	diff, err := tt.argoCD.Upgrade(tt.ctx, tt.cluster, tt.clusterSpec.DeepCopy(), tt.clusterSpec)
+	tt.Expect(err).NotTo(HaveOccurred())
+	tt.Expect(diff).To(BeNil())
+}
+
+func TestArgoCDChangeDiffNotEnabled(t *testing.T) {
+	tt := newArgoCDTest(t)
+	currentSpec := tt.clusterSpec.DeepCopy()
+	currentSpec.ArgoCDConfig = nil
+
+	tt.Expect(argocd.ArgoCDChangeDiff(currentSpec, tt.clusterSpec)).To(BeNil())
+}
+
+func TestInstallNoGitOpsChange(t *testing.T) {
+	tt := newArgoCDTest(t)
+
+	tt.Expect(tt.argoCD.Install(tt.ctx, tt.cluster, tt.clusterSpec.DeepCopy(), tt.clusterSpec)).To(Succeed())
+}
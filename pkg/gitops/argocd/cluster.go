@@ -0,0 +1,179 @@
+package argocd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+
+	"github.com/aws/eks-anywhere/pkg/cluster"
+	"github.com/aws/eks-anywhere/pkg/clustermarshaller"
+	"github.com/aws/eks-anywhere/pkg/constants"
+	"github.com/aws/eks-anywhere/pkg/filewriter"
+	"github.com/aws/eks-anywhere/pkg/git"
+	"github.com/aws/eks-anywhere/pkg/logger"
+	"github.com/aws/eks-anywhere/pkg/providers"
+	"github.com/aws/eks-anywhere/pkg/validations"
+)
+
+const (
+	eksaSystemDirName     = "eksa-system"
+	clusterConfigFileName = "eksa-cluster.yaml"
+)
+
+// argoCDForCluster bundles the ArgoCD struct with a specific clusterSpec, so that all the git and file write
+// operations for the clusterSpec can be done in each structure method.
+type argoCDForCluster struct {
+	*ArgoCD
+	clusterSpec *cluster.Spec
+}
+
+func newArgoCDForCluster(argoCD *ArgoCD, clusterSpec *cluster.Spec) *argoCDForCluster {
+	return &argoCDForCluster{
+		ArgoCD:      argoCD,
+		clusterSpec: clusterSpec,
+	}
+}
+
+// syncGitRepo clones the repository if there is no local copy yet, otherwise it makes sure the local copy
+// is on the configured branch and up-to-date with the remote.
+func (ac *argoCDForCluster) syncGitRepo(ctx context.Context) error {
+	if !validations.FileExists(path.Join(ac.writer.Dir(), ".git")) {
+		logger.V(3).Info("Cloning remote repository")
+		err := ac.Retry(func() error { return ac.git.Clone(ctx) })
+		var repoEmptyErr *git.RepositoryIsEmptyError
+		if errors.As(err, &repoEmptyErr) {
+			logger.V(3).Info("remote repository is empty and can't be cloned; will initialize locally")
+			return ac.initializeLocalRepository()
+		}
+		if err != nil {
+			return fmt.Errorf("cloning git repo: %v", err)
+		}
+	}
+
+	if err := ac.git.Branch(ac.branch()); err != nil {
+		return fmt.Errorf("switching to git branch %s: %v", ac.branch(), err)
+	}
+	return nil
+}
+
+// writeEksaFiles marshals the cluster configuration into the cluster directory of the repository. Argo CD
+// syncs the directory as plain manifests, so no kustomization file is generated.
+func (ac *argoCDForCluster) writeEksaFiles(datacenterConfig providers.DatacenterConfig, machineConfigs []providers.MachineConfig) error {
+	if datacenterConfig == nil && machineConfigs == nil {
+		return nil
+	}
+
+	w, err := ac.writer.WithDir(ac.eksaSystemDir())
+	if err != nil {
+		return fmt.Errorf("initializing eks-a system writer: %v", err)
+	}
+	w.CleanUpTemp()
+
+	specs, err := clustermarshaller.MarshalClusterSpec(ac.clusterSpec, datacenterConfig, machineConfigs)
+	if err != nil {
+		return err
+	}
+
+	if filePath, err := w.Write(clusterConfigFileName, specs, filewriter.PersistentFile); err != nil {
+		return fmt.Errorf("writing eks-a cluster config file into %s: %v", filePath, err)
+	}
+
+	return nil
+}
+
+// initializeLocalRepository will git init the local repository directory and change to the configured branch.
+func (ac *argoCDForCluster) initializeLocalRepository() error {
+	if err := ac.git.Init(); err != nil {
+		return fmt.Errorf("initializing repository: %v", err)
+	}
+
+	// git requires at least one commit in the repo to branch from
+	if err := ac.git.Commit("initializing repository"); err != nil {
+		return fmt.Errorf("committing to repository: %v", err)
+	}
+
+	if err := ac.git.Branch(ac.branch()); err != nil {
+		return fmt.Errorf("creating branch: %v", err)
+	}
+	return nil
+}
+
+func (ac *argoCDForCluster) pushChanges(ctx context.Context, p, msg string) error {
+	if err := ac.git.Add(p); err != nil {
+		return fmt.Errorf("adding %s to git: %v", p, err)
+	}
+
+	return ac.commitAndPush(ctx, p, msg)
+}
+
+func (ac *argoCDForCluster) commitAndPush(ctx context.Context, p, msg string) error {
+	if err := ac.git.Commit(msg); err != nil {
+		return fmt.Errorf("committing %s to git: %v", p, err)
+	}
+
+	if err := ac.Retry(func() error { return ac.git.Push(ctx) }); err != nil {
+		return fmt.Errorf("pushing %s to git: %v", p, err)
+	}
+	return nil
+}
+
+// validateLocalConfigPathDoesNotExist returns an error if the cluster configuration file exists.
+// This is done so that we avoid clobbering existing cluster configurations in the user-provided git repository.
+func (ac *argoCDForCluster) validateLocalConfigPathDoesNotExist() error {
+	p := path.Join(ac.writer.Dir(), ac.eksaSystemDir(), clusterConfigFileName)
+	if validations.FileExists(p) {
+		return fmt.Errorf("a cluster configuration file already exists at path %s", p)
+	}
+	return nil
+}
+
+// validateRemoteConfigPathDoesNotExist checks the cluster directory in a fresh clone of the repository,
+// since generic git repositories have no API to query paths remotely.
+func (ac *argoCDForCluster) validateRemoteConfigPathDoesNotExist(ctx context.Context) error {
+	if ac.git == nil {
+		return nil
+	}
+
+	if err := ac.syncGitRepo(ctx); err != nil {
+		return fmt.Errorf("failed validating remote Argo CD config path: %v", err)
+	}
+
+	if err := ac.Retry(func() error { return ac.git.Pull(ctx, ac.branch()) }); err != nil {
+		return fmt.Errorf("failed validating remote Argo CD config path: %v", err)
+	}
+
+	if err := ac.validateLocalConfigPathDoesNotExist(); err != nil {
+		return fmt.Errorf("Argo CD path %s already exists in remote repository", ac.eksaSystemDir())
+	}
+
+	return nil
+}
+
+func (ac *argoCDForCluster) namespace() string {
+	return ac.clusterSpec.ArgoCDConfig.Spec.SystemNamespace
+}
+
+// clusterNamespace returns the namespace of the EKS-A cluster objects, which the Application syncs to.
+func (ac *argoCDForCluster) clusterNamespace() string {
+	if ns := ac.clusterSpec.Cluster.GetNamespace(); ns != "" {
+		return ns
+	}
+	return constants.DefaultNamespace
+}
+
+func (ac *argoCDForCluster) branch() string {
+	return ac.clusterSpec.ArgoCDConfig.Spec.Branch
+}
+
+func (ac *argoCDForCluster) repositoryUrl() string {
+	return ac.clusterSpec.ArgoCDConfig.Spec.Git.RepositoryUrl
+}
+
+func (ac *argoCDForCluster) applicationName() string {
+	return "eksa-" + ac.clusterSpec.Cluster.GetName()
+}
+
+func (ac *argoCDForCluster) eksaSystemDir() string {
+	return path.Join(ac.clusterSpec.ArgoCDConfig.Spec.ClusterConfigPath, ac.clusterSpec.Cluster.GetName(), eksaSystemDirName)
+}
@@ -0,0 +1,159 @@
+package argocd
+
+import (
+	_ "embed"
+	"fmt"
+	"os"
+	"strconv"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/config"
+	"github.com/aws/eks-anywhere/pkg/templater"
+	unstructuredutil "github.com/aws/eks-anywhere/pkg/utils/unstructured"
+)
+
+const repositorySecretName = "eksa-repository"
+
+//go:embed manifests/application.yaml
+var applicationContent string
+
+//go:embed manifests/repository-secret.yaml
+var repositorySecretContent string
+
+//go:embed manifests/ssh-known-hosts.yaml
+var sshKnownHostsContent string
+
+func generateApplication(argoCDConfig *v1alpha1.ArgoCDConfig, name, path, destinationNamespace string) ([]byte, error) {
+	values := map[string]string{
+		"Name":                 name,
+		"Namespace":            argoCDConfig.Spec.SystemNamespace,
+		"DestinationNamespace": destinationNamespace,
+		"RepositoryUrl":        argoCDConfig.Spec.Git.RepositoryUrl,
+		"Branch":               argoCDConfig.Spec.Branch,
+		"Path":                 path,
+	}
+
+	b, err := templater.Execute(applicationContent, values)
+	if err != nil {
+		return nil, fmt.Errorf("generating Argo CD application: %v", err)
+	}
+	return b, nil
+}
+
+// setComponentsNamespace points the service account subjects of the role bindings in the Argo CD components
+// manifest to the given namespace. The upstream manifest hardcodes them to the argocd namespace, which
+// kubectl apply --namespace doesn't override.
+func setComponentsNamespace(manifest []byte, namespace string) ([]byte, error) {
+	objs, err := unstructuredutil.YamlToUnstructured(manifest)
+	if err != nil {
+		return nil, fmt.Errorf("parsing Argo CD manifest: %v", err)
+	}
+
+	for i := range objs {
+		if kind := objs[i].GetKind(); kind != "ClusterRoleBinding" && kind != "RoleBinding" {
+			continue
+		}
+
+		subjects, found, err := unstructured.NestedSlice(objs[i].Object, "subjects")
+		if err != nil {
+			return nil, fmt.Errorf("reading subjects from %s %s: %v", objs[i].GetKind(), objs[i].GetName(), err)
+		}
+		if !found {
+			continue
+		}
+
+		for _, s := range subjects {
+			subject, ok := s.(map[string]interface{})
+			if !ok || subject["kind"] != "ServiceAccount" {
+				continue
+			}
+			subject["namespace"] = namespace
+		}
+
+		if err := unstructured.SetNestedSlice(objs[i].Object, subjects, "subjects"); err != nil {
+			return nil, fmt.Errorf("setting subjects in %s %s: %v", objs[i].GetKind(), objs[i].GetName(), err)
+		}
+	}
+
+	b, err := unstructuredutil.UnstructuredToYaml(objs)
+	if err != nil {
+		return nil, fmt.Errorf("marshalling Argo CD manifest: %v", err)
+	}
+	return b, nil
+}
+
+func generateRepositorySecret(argoCDConfig *v1alpha1.ArgoCDConfig, credentials map[string]string) ([]byte, error) {
+	values := map[string]interface{}{
+		"Name":          repositorySecretName,
+		"Namespace":     argoCDConfig.Spec.SystemNamespace,
+		"RepositoryUrl": argoCDConfig.Spec.Git.RepositoryUrl,
+		"Credentials":   credentials,
+	}
+
+	b, err := templater.Execute(repositorySecretContent, values)
+	if err != nil {
+		return nil, fmt.Errorf("generating Argo CD repository secret: %v", err)
+	}
+	return b, nil
+}
+
+// generateSshKnownHosts generates the Argo CD known hosts config map from the known hosts file. It replaces
+// the default known hosts shipped with Argo CD.
+func generateSshKnownHosts(argoCDConfig *v1alpha1.ArgoCDConfig, knownHostsFile string) ([]byte, error) {
+	knownHosts, err := os.ReadFile(knownHostsFile)
+	if err != nil {
+		return nil, fmt.Errorf("reading git known hosts file: %v", err)
+	}
+
+	values := map[string]string{
+		"Namespace":  argoCDConfig.Spec.SystemNamespace,
+		"KnownHosts": string(knownHosts),
+	}
+
+	b, err := templater.Execute(sshKnownHostsContent, values)
+	if err != nil {
+		return nil, fmt.Errorf("generating Argo CD ssh known hosts: %v", err)
+	}
+	return b, nil
+}
+
+// repositoryCredentials returns the Argo CD repository secret fields used to authenticate with the git repository,
+// matching the authentication method used by the CLI: a GitHub App, a username and password for HTTPS urls or an
+// ssh private key.
+func repositoryCredentials(gitConfig *v1alpha1.GitProviderConfig, cliConfig *config.CliConfig) (map[string]string, error) {
+	if cliConfig == nil {
+		cliConfig = &config.CliConfig{}
+	}
+
+	switch {
+	case gitConfig.GithubApp != nil:
+		key, err := os.ReadFile(cliConfig.GitGithubAppPrivateKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("reading github app private key: %v", err)
+		}
+		return map[string]string{
+			"githubAppID":             strconv.FormatInt(gitConfig.GithubApp.AppID, 10),
+			"githubAppInstallationID": strconv.FormatInt(gitConfig.GithubApp.InstallationID, 10),
+			"githubAppPrivateKey":     string(key),
+		}, nil
+	case gitConfig.IsHTTPS():
+		return map[string]string{
+			"username": cliConfig.GitUsername,
+			"password": cliConfig.GitPassword,
+		}, nil
+	default:
+		// Argo CD can't decrypt private keys, so only keys without a passphrase are supported.
+		if cliConfig.GitSshKeyPassphrase != "" {
+			return nil, fmt.Errorf("Argo CD doesn't support ssh private keys protected with a passphrase, unset %s and use a key without passphrase", config.EksaGitPassphraseTokenEnv)
+		}
+		key, err := os.ReadFile(cliConfig.GitPrivateKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("reading git ssh private key: %v", err)
+		}
+		return map[string]string{
+			"sshPrivateKey": string(key),
+		}, nil
+	}
+}
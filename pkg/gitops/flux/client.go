@@ -2,9 +2,17 @@ package flux
 
 import (
 	"context"
+	"fmt"
 	"strconv"
 	"time"
 
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/yaml"
+
 	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
 	"github.com/aws/eks-anywhere/pkg/cluster"
 	"github.com/aws/eks-anywhere/pkg/config"
@@ -17,12 +25,16 @@ const (
 	maxRetries          = 5
 	backOffPeriod       = 5 * time.Second
 	reconcileAnnotation = "kustomize.toolkit.fluxcd.io/reconcile"
+	// fluxSystemSecretName is the secret created by flux bootstrap with the git credentials.
+	fluxSystemSecretName = "flux-system"
 )
 
 // FluxClient is an interface that abstracts the basic commands of flux executable.
 type FluxClient interface {
-	BootstrapGithub(ctx context.Context, cluster *types.Cluster, fluxConfig *v1alpha1.FluxConfig) error
+	BootstrapGithub(ctx context.Context, cluster *types.Cluster, fluxConfig *v1alpha1.FluxConfig, cliConfig *config.CliConfig) error
 	BootstrapGit(ctx context.Context, cluster *types.Cluster, fluxConfig *v1alpha1.FluxConfig, cliConfig *config.CliConfig) error
+	BootstrapGitlab(ctx context.Context, cluster *types.Cluster, fluxConfig *v1alpha1.FluxConfig, cliConfig *config.CliConfig) error
+	BootstrapBitbucketServer(ctx context.Context, cluster *types.Cluster, fluxConfig *v1alpha1.FluxConfig, cliConfig *config.CliConfig) error
 	Uninstall(ctx context.Context, cluster *types.Cluster, fluxConfig *v1alpha1.FluxConfig) error
 	Reconcile(ctx context.Context, cluster *types.Cluster, fluxConfig *v1alpha1.FluxConfig) error
 }
@@ -33,6 +45,8 @@ type KubeClient interface {
 	UpdateAnnotation(ctx context.Context, resourceType, objectName string, annotations map[string]string, opts ...executables.KubectlOpt) error
 	RemoveAnnotation(ctx context.Context, resourceType, objectName string, key string, opts ...executables.KubectlOpt) error
 	DeleteSecret(ctx context.Context, managementCluster *types.Cluster, secretName, namespace string) error
+	ApplyKubeSpecFromBytes(ctx context.Context, cluster *types.Cluster, data []byte) error
+	GetObject(ctx context.Context, resourceType, name, namespace, kubeconfig string, obj runtime.Object) error
 }
 
 type fluxClient struct {
@@ -49,10 +63,10 @@ func newFluxClient(flux FluxClient, kube KubeClient) *fluxClient {
 	}
 }
 
-func (c *fluxClient) BootstrapGithub(ctx context.Context, cluster *types.Cluster, fluxConfig *v1alpha1.FluxConfig) error {
+func (c *fluxClient) BootstrapGithub(ctx context.Context, cluster *types.Cluster, fluxConfig *v1alpha1.FluxConfig, cliConfig *config.CliConfig) error {
 	return c.Retry(
 		func() error {
-			return c.flux.BootstrapGithub(ctx, cluster, fluxConfig)
+			return c.flux.BootstrapGithub(ctx, cluster, fluxConfig, cliConfig)
 		},
 	)
 }
@@ -65,6 +79,22 @@ func (c *fluxClient) BootstrapGit(ctx context.Context, cluster *types.Cluster, f
 	)
 }
 
+func (c *fluxClient) BootstrapGitlab(ctx context.Context, cluster *types.Cluster, fluxConfig *v1alpha1.FluxConfig, cliConfig *config.CliConfig) error {
+	return c.Retry(
+		func() error {
+			return c.flux.BootstrapGitlab(ctx, cluster, fluxConfig, cliConfig)
+		},
+	)
+}
+
+func (c *fluxClient) BootstrapBitbucketServer(ctx context.Context, cluster *types.Cluster, fluxConfig *v1alpha1.FluxConfig, cliConfig *config.CliConfig) error {
+	return c.Retry(
+		func() error {
+			return c.flux.BootstrapBitbucketServer(ctx, cluster, fluxConfig, cliConfig)
+		},
+	)
+}
+
 func (c *fluxClient) Uninstall(ctx context.Context, cluster *types.Cluster, fluxConfig *v1alpha1.FluxConfig) error {
 	return c.Retry(
 		func() error {
@@ -116,11 +146,61 @@ func (c *fluxClient) EnableResourceReconcile(ctx context.Context, cluster *types
 func (c *fluxClient) DeleteSystemSecret(ctx context.Context, cluster *types.Cluster, namespace string) error {
 	return c.Retry(
 		func() error {
-			return c.kube.DeleteSecret(ctx, cluster, "flux-system", namespace)
+			return c.kube.DeleteSecret(ctx, cluster, fluxSystemSecretName, namespace)
+		},
+	)
+}
+
+// ApplySystemSecret creates or replaces the flux-system secret used by the source controller to authenticate
+// with the git repository.
+func (c *fluxClient) ApplySystemSecret(ctx context.Context, cluster *types.Cluster, namespace string, data map[string]string) error {
+	secret := &corev1.Secret{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "v1",
+			Kind:       "Secret",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fluxSystemSecretName,
+			Namespace: namespace,
+		},
+		Type:       corev1.SecretTypeOpaque,
+		StringData: data,
+	}
+	manifest, err := yaml.Marshal(secret)
+	if err != nil {
+		return fmt.Errorf("marshalling flux system secret: %v", err)
+	}
+
+	return c.Retry(
+		func() error {
+			return c.kube.ApplyKubeSpecFromBytes(ctx, cluster, manifest)
 		},
 	)
 }
 
+// GetFluxObject gets a Flux object from the cluster. It returns nil if the object doesn't exist.
+func (c *fluxClient) GetFluxObject(ctx context.Context, cluster *types.Cluster, resourceType, name, namespace string) (*unstructured.Unstructured, error) {
+	obj := &unstructured.Unstructured{}
+	notFound := false
+	err := c.Retry(
+		func() error {
+			err := c.kube.GetObject(ctx, resourceType, name, namespace, cluster.KubeconfigFile, obj)
+			if apierrors.IsNotFound(err) {
+				notFound = true
+				return nil
+			}
+			return err
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("getting %s %s/%s: %v", resourceType, namespace, name, err)
+	}
+	if notFound {
+		return nil, nil
+	}
+	return obj, nil
+}
+
 func (c *fluxClient) GetCluster(ctx context.Context, cluster *types.Cluster, clusterSpec *cluster.Spec) (eksaCluster *v1alpha1.Cluster, err error) {
 	err = c.Retry(
 		func() error {
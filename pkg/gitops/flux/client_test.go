@@ -7,6 +7,10 @@ import (
 
 	"github.com/golang/mock/gomock"
 	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	"github.com/aws/eks-anywhere/internal/test"
 	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
@@ -44,18 +48,18 @@ func newFluxClientTest(t *testing.T) *fluxClientTest {
 
 func TestFluxClientBootstrapGithubSuccess(t *testing.T) {
 	tt := newFluxClientTest(t)
-	tt.f.EXPECT().BootstrapGithub(tt.ctx, tt.cluster, tt.fluxConfig).Return(errors.New("error in bootstrap github")).Times(4)
-	tt.f.EXPECT().BootstrapGithub(tt.ctx, tt.cluster, tt.fluxConfig).Return(nil).Times(1)
+	tt.f.EXPECT().BootstrapGithub(tt.ctx, tt.cluster, tt.fluxConfig, nil).Return(errors.New("error in bootstrap github")).Times(4)
+	tt.f.EXPECT().BootstrapGithub(tt.ctx, tt.cluster, tt.fluxConfig, nil).Return(nil).Times(1)
 
-	tt.Expect(tt.c.BootstrapGithub(tt.ctx, tt.cluster, tt.fluxConfig)).To(Succeed(), "fluxClient.BootstrapGithub() should succeed with 5 tries")
+	tt.Expect(tt.c.BootstrapGithub(tt.ctx, tt.cluster, tt.fluxConfig, nil)).To(Succeed(), "fluxClient.BootstrapGithub() should succeed with 5 tries")
 }
 
 func TestFluxClientBootstrapGithubError(t *testing.T) {
 	tt := newFluxClientTest(t)
-	tt.f.EXPECT().BootstrapGithub(tt.ctx, tt.cluster, tt.fluxConfig).Return(errors.New("error in bootstrap github")).Times(5)
-	tt.f.EXPECT().BootstrapGithub(tt.ctx, tt.cluster, tt.fluxConfig).Return(nil).AnyTimes()
+	tt.f.EXPECT().BootstrapGithub(tt.ctx, tt.cluster, tt.fluxConfig, nil).Return(errors.New("error in bootstrap github")).Times(5)
+	tt.f.EXPECT().BootstrapGithub(tt.ctx, tt.cluster, tt.fluxConfig, nil).Return(nil).AnyTimes()
 
-	tt.Expect(tt.c.BootstrapGithub(tt.ctx, tt.cluster, tt.fluxConfig)).To(MatchError(ContainSubstring("error in bootstrap github")), "fluxClient.BootstrapGithub() should fail after 5 tries")
+	tt.Expect(tt.c.BootstrapGithub(tt.ctx, tt.cluster, tt.fluxConfig, nil)).To(MatchError(ContainSubstring("error in bootstrap github")), "fluxClient.BootstrapGithub() should fail after 5 tries")
 }
 
 func TestFluxClientBootstrapGitSuccess(t *testing.T) {
@@ -176,6 +180,31 @@ func TestFluxClientDeleteSystemSecretError(t *testing.T) {
 	tt.Expect(tt.c.DeleteSystemSecret(tt.ctx, tt.cluster, "custom-namespace")).To(MatchError(ContainSubstring("error in delete secret")), "fluxClient.DeleteSystemSecret() should fail after 5 tries")
 }
 
+func TestFluxClientApplySystemSecretSuccess(t *testing.T) {
+	tt := newFluxClientTest(t)
+	wantSecret := []byte(`apiVersion: v1
+kind: Secret
+metadata:
+  creationTimestamp: null
+  name: flux-system
+  namespace: custom-namespace
+stringData:
+  githubAppID: "1234"
+type: Opaque
+`)
+	tt.k.EXPECT().ApplyKubeSpecFromBytes(tt.ctx, tt.cluster, wantSecret).Return(errors.New("error in apply")).Times(4)
+	tt.k.EXPECT().ApplyKubeSpecFromBytes(tt.ctx, tt.cluster, wantSecret).Return(nil).Times(1)
+
+	tt.Expect(tt.c.ApplySystemSecret(tt.ctx, tt.cluster, "custom-namespace", map[string]string{"githubAppID": "1234"})).To(Succeed(), "fluxClient.ApplySystemSecret() should succeed with 5 tries")
+}
+
+func TestFluxClientApplySystemSecretError(t *testing.T) {
+	tt := newFluxClientTest(t)
+	tt.k.EXPECT().ApplyKubeSpecFromBytes(tt.ctx, tt.cluster, gomock.Any()).Return(errors.New("error in apply")).Times(5)
+
+	tt.Expect(tt.c.ApplySystemSecret(tt.ctx, tt.cluster, "custom-namespace", nil)).To(MatchError(ContainSubstring("error in apply")), "fluxClient.ApplySystemSecret() should fail after 5 tries")
+}
+
 func TestFluxClientGetClusterSuccess(t *testing.T) {
 	tt := newFluxClientTest(t)
 	tt.k.EXPECT().GetEksaCluster(tt.ctx, tt.cluster, "fluxTestCluster").Return(nil, errors.New("error in get eksa cluster")).Times(4)
@@ -195,3 +224,39 @@ func TestFluxClientGetClusterError(t *testing.T) {
 
 	tt.Expect(err).To(MatchError(ContainSubstring("error in get eksa cluster")), "fluxClient.GetCluster() should fail after 5 tries")
 }
+
+func TestFluxClientGetFluxObjectSuccess(t *testing.T) {
+	tt := newFluxClientTest(t)
+	tt.k.EXPECT().GetObject(tt.ctx, GitRepositoryResourceType, "flux-system", "flux-system", tt.cluster.KubeconfigFile, gomock.Any()).Return(errors.New("error in get object")).Times(4)
+	tt.k.EXPECT().GetObject(tt.ctx, GitRepositoryResourceType, "flux-system", "flux-system", tt.cluster.KubeconfigFile, gomock.Any()).DoAndReturn(
+		func(_ context.Context, _, _, _, _ string, obj runtime.Object) error {
+			obj.(*unstructured.Unstructured).SetName("flux-system")
+			return nil
+		},
+	).Times(1)
+
+	obj, err := tt.c.GetFluxObject(tt.ctx, tt.cluster, GitRepositoryResourceType, "flux-system", "flux-system")
+
+	tt.Expect(err).To(Succeed(), "fluxClient.GetFluxObject() should succeed with 5 tries")
+	tt.Expect(obj.GetName()).To(Equal("flux-system"))
+}
+
+func TestFluxClientGetFluxObjectNotFound(t *testing.T) {
+	tt := newFluxClientTest(t)
+	notFound := apierrors.NewNotFound(schema.GroupResource{Group: "source.toolkit.fluxcd.io", Resource: "gitrepositories"}, "flux-system")
+	tt.k.EXPECT().GetObject(tt.ctx, GitRepositoryResourceType, "flux-system", "flux-system", tt.cluster.KubeconfigFile, gomock.Any()).Return(notFound).Times(1)
+
+	obj, err := tt.c.GetFluxObject(tt.ctx, tt.cluster, GitRepositoryResourceType, "flux-system", "flux-system")
+
+	tt.Expect(err).To(Succeed())
+	tt.Expect(obj).To(BeNil())
+}
+
+func TestFluxClientGetFluxObjectError(t *testing.T) {
+	tt := newFluxClientTest(t)
+	tt.k.EXPECT().GetObject(tt.ctx, KustomizationResourceType, "flux-system", "flux-system", tt.cluster.KubeconfigFile, gomock.Any()).Return(errors.New("error in get object")).Times(5)
+
+	_, err := tt.c.GetFluxObject(tt.ctx, tt.cluster, KustomizationResourceType, "flux-system", "flux-system")
+
+	tt.Expect(err).To(MatchError(ContainSubstring("error in get object")), "fluxClient.GetFluxObject() should fail after 5 tries")
+}
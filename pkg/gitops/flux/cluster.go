@@ -22,6 +22,11 @@ type fluxForCluster struct {
 	clusterSpec      *cluster.Spec
 	datacenterConfig providers.DatacenterConfig
 	machineConfigs   []providers.MachineConfig
+	// newRepository is set when the repository was created or initialized locally, in which case
+	// there is no branch to open a pull request against and changes are pushed directly.
+	newRepository bool
+	// headBranch is the branch holding the changes for the pull request, if one is being prepared.
+	headBranch string
 }
 
 func newFluxForCluster(flux *Flux, clusterSpec *cluster.Spec, datacenterConfig providers.DatacenterConfig, machineConfigs []providers.MachineConfig) *fluxForCluster {
@@ -45,6 +50,10 @@ func (fc *fluxForCluster) commitFluxAndClusterConfigToGit(ctx context.Context) e
 		return err
 	}
 
+	if err := fc.startChanges(createPullRequestPurpose); err != nil {
+		return err
+	}
+
 	g := NewFileGenerator()
 	if err := g.Init(fc.writer, fc.eksaSystemDir(), fc.fluxSystemDir()); err != nil {
 		return err
@@ -65,7 +74,7 @@ func (fc *fluxForCluster) commitFluxAndClusterConfigToGit(ctx context.Context) e
 		return fmt.Errorf("adding %s to git: %v", p, err)
 	}
 
-	if err := fc.Flux.pushToRemoteRepo(ctx, p, initialClusterconfigCommitMessage); err != nil {
+	if err := fc.pushChanges(ctx, p, initialClusterconfigCommitMessage); err != nil {
 		return err
 	}
 
@@ -109,6 +118,7 @@ func (fc *fluxForCluster) initializeProviderRepositoryIfNotExists(ctx context.Co
 	if err = fc.initializeLocalRepository(); err != nil {
 		return nil, err
 	}
+	fc.newRepository = true
 
 	return nil, nil
 }
@@ -133,6 +143,7 @@ func (fc *fluxForCluster) setupRepository(ctx context.Context) (err error) {
 		if initErr := fc.initializeLocalRepository(); initErr != nil {
 			return fmt.Errorf("initializing local repository: %v", initErr)
 		}
+		fc.newRepository = true
 		return nil
 	}
 
@@ -151,7 +162,7 @@ func (fc *fluxForCluster) clone(ctx context.Context) error {
 
 // createRemoteRepository will create a repository in the remote git provider with the user-provided configuration.
 func (fc *fluxForCluster) createRemoteRepository(ctx context.Context) error {
-	logger.V(3).Info("Remote Github repo does not exist; will create and initialize", "repo", fc.repository(), "owner", fc.owner())
+	logger.V(3).Info("Remote repo does not exist; will create and initialize", "repo", fc.repository(), "owner", fc.owner())
 
 	opts := git.CreateRepoOpts{
 		Name:        fc.repository(),
@@ -161,7 +172,7 @@ func (fc *fluxForCluster) createRemoteRepository(ctx context.Context) error {
 		Privacy:     true,
 	}
 
-	logger.V(4).Info("Creating remote Github repo", "options", opts)
+	logger.V(4).Info("Creating remote repo", "options", opts)
 	if err := fc.gitClient.CreateRepo(ctx, opts); err != nil {
 		return fmt.Errorf("creating repo: %v", err)
 	}
@@ -224,6 +235,12 @@ func (fc *fluxForCluster) repository() string {
 	if fc.clusterSpec.FluxConfig.Spec.Github != nil {
 		return fc.clusterSpec.FluxConfig.Spec.Github.Repository
 	}
+	if fc.clusterSpec.FluxConfig.Spec.Gitlab != nil {
+		return fc.clusterSpec.FluxConfig.Spec.Gitlab.Repository
+	}
+	if fc.clusterSpec.FluxConfig.Spec.BitbucketServer != nil {
+		return fc.clusterSpec.FluxConfig.Spec.BitbucketServer.Repository
+	}
 	if fc.clusterSpec.FluxConfig.Spec.Git != nil {
 		r := fc.clusterSpec.FluxConfig.Spec.Git.RepositoryUrl
 		return path.Base(strings.TrimSuffix(r, filepath.Ext(r)))
@@ -235,6 +252,12 @@ func (fc *fluxForCluster) owner() string {
 	if fc.clusterSpec.FluxConfig.Spec.Github != nil {
 		return fc.clusterSpec.FluxConfig.Spec.Github.Owner
 	}
+	if fc.clusterSpec.FluxConfig.Spec.Gitlab != nil {
+		return fc.clusterSpec.FluxConfig.Spec.Gitlab.Owner
+	}
+	if fc.clusterSpec.FluxConfig.Spec.BitbucketServer != nil {
+		return fc.clusterSpec.FluxConfig.Spec.BitbucketServer.Owner
+	}
 	return ""
 }
 
@@ -246,6 +269,12 @@ func (fc *fluxForCluster) personal() bool {
 	if fc.clusterSpec.FluxConfig.Spec.Github != nil {
 		return fc.clusterSpec.FluxConfig.Spec.Github.Personal
 	}
+	if fc.clusterSpec.FluxConfig.Spec.Gitlab != nil {
+		return fc.clusterSpec.FluxConfig.Spec.Gitlab.Personal
+	}
+	if fc.clusterSpec.FluxConfig.Spec.BitbucketServer != nil {
+		return fc.clusterSpec.FluxConfig.Spec.BitbucketServer.Personal
+	}
 	return false
 }
 
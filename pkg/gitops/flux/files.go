@@ -3,6 +3,7 @@ package flux
 import (
 	_ "embed"
 	"fmt"
+	"strings"
 
 	"github.com/aws/eks-anywhere/pkg/cluster"
 	"github.com/aws/eks-anywhere/pkg/clustermarshaller"
@@ -157,6 +158,11 @@ func (g *FileGenerator) WriteFluxPatch(clusterSpec *cluster.Spec) error {
 		"HelmControllerImage":         clusterSpec.VersionsBundle.Flux.HelmController.VersionedImage(),
 		"NotificationControllerImage": clusterSpec.VersionsBundle.Flux.NotificationController.VersionedImage(),
 	}
+	if proxy := clusterSpec.Cluster.Spec.ProxyConfiguration; proxy != nil {
+		values["HttpProxy"] = proxy.HttpProxy
+		values["HttpsProxy"] = proxy.HttpsProxy
+		values["NoProxy"] = strings.Join(cluster.NoProxyList(clusterSpec.Config), ",")
+	}
 	if path, err := g.fluxTemplater.WriteToFile(fluxPatchContent, values, fluxPatchFileName, filewriter.PersistentFile); err != nil {
 		return fmt.Errorf("creating flux-system patch manifest file into %s: %v", path, err)
 	}
@@ -104,6 +104,15 @@ spec:
       containers:
       - image: {{.SourceControllerImage}}
         name: manager
+{{- if .HttpProxy }}
+        env:
+        - name: HTTP_PROXY
+          value: "{{.HttpProxy}}"
+        - name: HTTPS_PROXY
+          value: "{{.HttpsProxy}}"
+        - name: NO_PROXY
+          value: "{{.NoProxy}}"
+{{- end }}
 ---
 apiVersion: apps/v1
 kind: Deployment
@@ -116,6 +125,15 @@ spec:
       containers:
       - image: {{.KustomizeControllerImage}}
         name: manager
+{{- if .HttpProxy }}
+        env:
+        - name: HTTP_PROXY
+          value: "{{.HttpProxy}}"
+        - name: HTTPS_PROXY
+          value: "{{.HttpsProxy}}"
+        - name: NO_PROXY
+          value: "{{.NoProxy}}"
+{{- end }}
 ---
 apiVersion: apps/v1
 kind: Deployment
@@ -128,6 +146,15 @@ spec:
       containers:
       - image: {{.HelmControllerImage}}
         name: manager
+{{- if .HttpProxy }}
+        env:
+        - name: HTTP_PROXY
+          value: "{{.HttpProxy}}"
+        - name: HTTPS_PROXY
+          value: "{{.HttpsProxy}}"
+        - name: NO_PROXY
+          value: "{{.NoProxy}}"
+{{- end }}
 ---
 apiVersion: apps/v1
 kind: Deployment
@@ -139,7 +166,16 @@ spec:
     spec:
       containers:
       - image: {{.NotificationControllerImage}}
-        name: manager`
+        name: manager
+{{- if .HttpProxy }}
+        env:
+        - name: HTTP_PROXY
+          value: "{{.HttpProxy}}"
+        - name: HTTPS_PROXY
+          value: "{{.HttpsProxy}}"
+        - name: NO_PROXY
+          value: "{{.NoProxy}}"
+{{- end }}`
 
 var wantPatchesValues = map[string]string{
 	"Namespace":                   "flux-system",
@@ -273,3 +309,27 @@ func TestFileGeneratorWriteFluxSystemFilesWriteFluxPatchesError(t *testing.T) {
 
 	tt.Expect(tt.g.WriteFluxSystemFiles(tt.clusterSpec)).To(MatchError(ContainSubstring("error in write patches")))
 }
+
+func TestFileGeneratorWriteFluxPatchProxyConfiguration(t *testing.T) {
+	tt := newFileGeneratorTest(t)
+	tt.clusterSpec.Cluster.Spec.ProxyConfiguration = &v1alpha1.ProxyConfiguration{
+		HttpProxy:  "http://1.2.3.4:3128",
+		HttpsProxy: "http://1.2.3.4:3128",
+		NoProxy:    []string{"internal.example.com"},
+	}
+	tt.clusterSpec.Cluster.Spec.ClusterNetwork.Pods.CidrBlocks = []string{"192.168.0.0/16"}
+	tt.clusterSpec.Cluster.Spec.ClusterNetwork.Services.CidrBlocks = []string{"10.96.0.0/12"}
+	tt.clusterSpec.Cluster.Spec.ControlPlaneConfiguration.Endpoint = &v1alpha1.Endpoint{Host: "1.2.3.5"}
+	wantValues := map[string]string{
+		"HttpProxy":  "http://1.2.3.4:3128",
+		"HttpsProxy": "http://1.2.3.4:3128",
+		"NoProxy":    "192.168.0.0/16,10.96.0.0/12,internal.example.com,localhost,127.0.0.1,.svc,1.2.3.5",
+	}
+	for k, v := range wantPatchesValues {
+		wantValues[k] = v
+	}
+
+	tt.t.EXPECT().WriteToFile(wantFluxPatches, wantValues, "gotk-patches.yaml", gomock.Any()).Return("", nil)
+
+	tt.Expect(tt.g.WriteFluxPatch(tt.clusterSpec)).To(Succeed())
+}
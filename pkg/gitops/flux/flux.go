@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"path"
 
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
 	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
 	"github.com/aws/eks-anywhere/pkg/cluster"
 	"github.com/aws/eks-anywhere/pkg/config"
@@ -26,8 +28,10 @@ const (
 )
 
 type GitOpsFluxClient interface {
-	BootstrapGithub(ctx context.Context, cluster *types.Cluster, fluxConfig *v1alpha1.FluxConfig) error
+	BootstrapGithub(ctx context.Context, cluster *types.Cluster, fluxConfig *v1alpha1.FluxConfig, cliConfig *config.CliConfig) error
 	BootstrapGit(ctx context.Context, cluster *types.Cluster, fluxConfig *v1alpha1.FluxConfig, cliConfig *config.CliConfig) error
+	BootstrapGitlab(ctx context.Context, cluster *types.Cluster, fluxConfig *v1alpha1.FluxConfig, cliConfig *config.CliConfig) error
+	BootstrapBitbucketServer(ctx context.Context, cluster *types.Cluster, fluxConfig *v1alpha1.FluxConfig, cliConfig *config.CliConfig) error
 	Uninstall(ctx context.Context, cluster *types.Cluster, fluxConfig *v1alpha1.FluxConfig) error
 	GetCluster(ctx context.Context, cluster *types.Cluster, clusterSpec *cluster.Spec) (eksaCluster *v1alpha1.Cluster, err error)
 	DisableResourceReconcile(ctx context.Context, cluster *types.Cluster, resourceType, objectName, namespace string) error
@@ -35,6 +39,8 @@ type GitOpsFluxClient interface {
 	Reconcile(ctx context.Context, cluster *types.Cluster, fluxConfig *v1alpha1.FluxConfig) error
 	ForceReconcile(ctx context.Context, cluster *types.Cluster, namespace string) error
 	DeleteSystemSecret(ctx context.Context, cluster *types.Cluster, namespace string) error
+	ApplySystemSecret(ctx context.Context, cluster *types.Cluster, namespace string, data map[string]string) error
+	GetFluxObject(ctx context.Context, cluster *types.Cluster, resourceType, name, namespace string) (*unstructured.Unstructured, error)
 }
 
 type GitClient interface {
@@ -44,6 +50,8 @@ type GitClient interface {
 	Push(ctx context.Context) error
 	Pull(ctx context.Context, branch string) error
 	PathExists(ctx context.Context, owner, repo, branch, path string) (exists bool, err error)
+	CreatePullRequest(ctx context.Context, opts git.CreatePullRequestOpts) (*git.PullRequest, error)
+	GetPullRequest(ctx context.Context, opts git.GetPullRequestOpts) (*git.PullRequest, error)
 	Add(filename string) error
 	Remove(filename string) error
 	Commit(message string) error
@@ -122,6 +130,16 @@ func (f *Flux) Bootstrap(ctx context.Context, cluster *types.Cluster, clusterSpe
 		return fmt.Errorf("installing generic git gitops: %v", err)
 	}
 
+	if err := f.BootstrapGitlab(ctx, cluster, clusterSpec); err != nil {
+		_ = f.Uninstall(ctx, cluster, clusterSpec)
+		return fmt.Errorf("installing GitLab gitops: %v", err)
+	}
+
+	if err := f.BootstrapBitbucketServer(ctx, cluster, clusterSpec); err != nil {
+		_ = f.Uninstall(ctx, cluster, clusterSpec)
+		return fmt.Errorf("installing Bitbucket Server gitops: %v", err)
+	}
+
 	return nil
 }
 
@@ -130,7 +148,7 @@ func (f *Flux) BootstrapGithub(ctx context.Context, cluster *types.Cluster, clus
 		return nil
 	}
 
-	return f.fluxClient.BootstrapGithub(ctx, cluster, clusterSpec.FluxConfig)
+	return f.fluxClient.BootstrapGithub(ctx, cluster, clusterSpec.FluxConfig, f.cliConfig)
 }
 
 func (f *Flux) BootstrapGit(ctx context.Context, cluster *types.Cluster, clusterSpec *cluster.Spec) error {
@@ -138,9 +156,29 @@ func (f *Flux) BootstrapGit(ctx context.Context, cluster *types.Cluster, cluster
 		return nil
 	}
 
+	if clusterSpec.FluxConfig.Spec.Git.GithubApp != nil {
+		return f.bootstrapGitWithGithubApp(ctx, cluster, clusterSpec)
+	}
+
 	return f.fluxClient.BootstrapGit(ctx, cluster, clusterSpec.FluxConfig, f.cliConfig)
 }
 
+func (f *Flux) BootstrapGitlab(ctx context.Context, cluster *types.Cluster, clusterSpec *cluster.Spec) error {
+	if cluster.ExistingManagement || clusterSpec.FluxConfig.Spec.Gitlab == nil {
+		return nil
+	}
+
+	return f.fluxClient.BootstrapGitlab(ctx, cluster, clusterSpec.FluxConfig, f.cliConfig)
+}
+
+func (f *Flux) BootstrapBitbucketServer(ctx context.Context, cluster *types.Cluster, clusterSpec *cluster.Spec) error {
+	if cluster.ExistingManagement || clusterSpec.FluxConfig.Spec.BitbucketServer == nil {
+		return nil
+	}
+
+	return f.fluxClient.BootstrapBitbucketServer(ctx, cluster, clusterSpec.FluxConfig, f.cliConfig)
+}
+
 func (f *Flux) Uninstall(ctx context.Context, cluster *types.Cluster, clusterSpec *cluster.Spec) error {
 	if err := f.fluxClient.Uninstall(ctx, cluster, clusterSpec.FluxConfig); err != nil {
 		logger.Info("Could not uninstall flux components", "error", err)
@@ -209,7 +247,16 @@ func (f *Flux) ForceReconcileGitRepo(ctx context.Context, cluster *types.Cluster
 		return nil
 	}
 
-	return f.fluxClient.ForceReconcile(ctx, cluster, clusterSpec.FluxConfig.Spec.SystemNamespace)
+	if err := f.fluxClient.ForceReconcile(ctx, cluster, clusterSpec.FluxConfig.Spec.SystemNamespace); err != nil {
+		return err
+	}
+
+	// In pull request mode the changes only reach the cluster once the merged commit is fetched by flux,
+	// so wait for the git source to be reconciled before moving on.
+	if clusterSpec.FluxConfig.Spec.PullRequest != nil {
+		return f.fluxClient.Reconcile(ctx, cluster, clusterSpec.FluxConfig)
+	}
+	return nil
 }
 
 func (f *Flux) UpdateGitEksaSpec(ctx context.Context, clusterSpec *cluster.Spec, datacenterConfig providers.DatacenterConfig, machineConfigs []providers.MachineConfig) error {
@@ -224,6 +271,10 @@ func (f *Flux) UpdateGitEksaSpec(ctx context.Context, clusterSpec *cluster.Spec,
 		return err
 	}
 
+	if err := fc.startChanges(upgradePullRequestPurpose); err != nil {
+		return err
+	}
+
 	g := NewFileGenerator()
 	if err := g.Init(f.writer, fc.eksaSystemDir(), fc.fluxSystemDir()); err != nil {
 		return err
@@ -238,7 +289,7 @@ func (f *Flux) UpdateGitEksaSpec(ctx context.Context, clusterSpec *cluster.Spec,
 		return fmt.Errorf("adding %s to git: %v", path, err)
 	}
 
-	if err := f.pushToRemoteRepo(ctx, path, updateClusterconfigCommitMessage); err != nil {
+	if err := fc.pushChanges(ctx, path, updateClusterconfigCommitMessage); err != nil {
 		return err
 	}
 	logger.V(3).Info("Finished pushing updated cluster config file to git", "repository", fc.repository())
@@ -287,11 +338,15 @@ func (f *Flux) CleanupGitRepo(ctx context.Context, clusterSpec *cluster.Spec) er
 		return nil
 	}
 
+	if err := fc.startChanges(deletePullRequestPurpose); err != nil {
+		return err
+	}
+
 	if err := f.gitClient.Remove(p); err != nil {
 		return fmt.Errorf("removing %s in git: %v", p, err)
 	}
 
-	if err := f.pushToRemoteRepo(ctx, p, deleteClusterconfigCommitMessage); err != nil {
+	if err := fc.pushChanges(ctx, p, deleteClusterconfigCommitMessage); err != nil {
 		return err
 	}
 
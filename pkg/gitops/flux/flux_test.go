@@ -240,7 +240,7 @@ func TestInstallGitOpsOnManagementClusterWithPrexistingRepo(t *testing.T) {
 			g := newFluxTest(t)
 			clusterSpec := newClusterSpec(t, clusterConfig, tt.fluxpath)
 
-			g.flux.EXPECT().BootstrapGithub(g.ctx, cluster, clusterSpec.FluxConfig)
+			g.flux.EXPECT().BootstrapGithub(g.ctx, cluster, clusterSpec.FluxConfig, nil)
 
 			g.git.EXPECT().GetRepo(g.ctx).Return(&git.Repository{Name: clusterSpec.FluxConfig.Spec.Github.Repository}, nil)
 
@@ -306,7 +306,7 @@ func TestInstallGitOpsOnManagementClusterWithoutClusterSpec(t *testing.T) {
 			g := newFluxTest(t)
 			clusterSpec := newClusterSpec(t, clusterConfig, tt.fluxpath)
 
-			g.flux.EXPECT().BootstrapGithub(g.ctx, cluster, clusterSpec.FluxConfig)
+			g.flux.EXPECT().BootstrapGithub(g.ctx, cluster, clusterSpec.FluxConfig, nil)
 
 			g.git.EXPECT().GetRepo(g.ctx).Return(&git.Repository{Name: clusterSpec.FluxConfig.Spec.Github.Repository}, nil)
 
@@ -342,7 +342,7 @@ func TestInstallGitOpsOnWorkloadClusterWithPrexistingRepo(t *testing.T) {
 	g := newFluxTest(t)
 	clusterSpec := newClusterSpec(t, clusterConfig, "")
 
-	g.flux.EXPECT().BootstrapGithub(g.ctx, cluster, clusterSpec.FluxConfig)
+	g.flux.EXPECT().BootstrapGithub(g.ctx, cluster, clusterSpec.FluxConfig, nil)
 
 	g.git.EXPECT().GetRepo(g.ctx).Return(&git.Repository{Name: clusterSpec.FluxConfig.Spec.Github.Repository}, nil)
 
@@ -403,7 +403,7 @@ func TestInstallGitOpsBootstrapError(t *testing.T) {
 	g.git.EXPECT().Add(path.Dir("clusters/management-cluster")).Return(nil)
 	g.git.EXPECT().Commit(test.OfType("string")).Return(nil)
 	g.git.EXPECT().Push(g.ctx).Return(nil)
-	g.flux.EXPECT().BootstrapGithub(g.ctx, cluster, clusterSpec.FluxConfig).Return(errors.New("error in bootstrap"))
+	g.flux.EXPECT().BootstrapGithub(g.ctx, cluster, clusterSpec.FluxConfig, nil).Return(errors.New("error in bootstrap"))
 	g.flux.EXPECT().Uninstall(g.ctx, cluster, clusterSpec.FluxConfig).Return(nil)
 
 	g.Expect(g.gitOpsFlux.InstallGitOps(g.ctx, cluster, clusterSpec, nil, nil)).To(MatchError(ContainSubstring("error in bootstrap")))
@@ -495,7 +495,7 @@ func TestInstallGitOpsNoPrexistingRepo(t *testing.T) {
 			g := newFluxTest(t)
 			clusterSpec := newClusterSpec(t, clusterConfig, tt.fluxpath)
 
-			g.flux.EXPECT().BootstrapGithub(g.ctx, cluster, clusterSpec.FluxConfig)
+			g.flux.EXPECT().BootstrapGithub(g.ctx, cluster, clusterSpec.FluxConfig, nil)
 
 			n := clusterSpec.FluxConfig.Spec.Github.Repository
 			o := clusterSpec.FluxConfig.Spec.Github.Owner
@@ -570,7 +570,7 @@ func TestInstallGitOpsToolkitsBareRepo(t *testing.T) {
 			g := newFluxTest(t)
 			clusterSpec := newClusterSpec(t, clusterConfig, tt.fluxpath)
 
-			g.flux.EXPECT().BootstrapGithub(g.ctx, cluster, clusterSpec.FluxConfig)
+			g.flux.EXPECT().BootstrapGithub(g.ctx, cluster, clusterSpec.FluxConfig, nil)
 
 			g.git.EXPECT().GetRepo(g.ctx).MaxTimes(2).Return(&git.Repository{Name: clusterSpec.FluxConfig.Spec.Github.Repository}, nil)
 
@@ -1051,7 +1051,7 @@ func TestBootstrapGithubError(t *testing.T) {
 	clusterConfig := v1alpha1.NewCluster("management-cluster")
 	clusterSpec := newClusterSpec(t, clusterConfig, "")
 
-	g.flux.EXPECT().BootstrapGithub(g.ctx, c, clusterSpec.FluxConfig).Return(errors.New("error in bootstrap github"))
+	g.flux.EXPECT().BootstrapGithub(g.ctx, c, clusterSpec.FluxConfig, nil).Return(errors.New("error in bootstrap github"))
 	g.flux.EXPECT().Uninstall(g.ctx, c, clusterSpec.FluxConfig).Return(nil)
 
 	g.Expect(g.gitOpsFlux.Bootstrap(g.ctx, c, clusterSpec)).To(MatchError(ContainSubstring("error in bootstrap github")))
@@ -1064,13 +1064,81 @@ func TestBootstrapGitError(t *testing.T) {
 	clusterSpec := newClusterSpec(t, clusterConfig, "")
 	clusterSpec.FluxConfig.Spec.Git = &v1alpha1.GitProviderConfig{RepositoryUrl: "abc"}
 
-	g.flux.EXPECT().BootstrapGithub(g.ctx, c, clusterSpec.FluxConfig).Return(nil)
+	g.flux.EXPECT().BootstrapGithub(g.ctx, c, clusterSpec.FluxConfig, nil).Return(nil)
 	g.flux.EXPECT().BootstrapGit(g.ctx, c, clusterSpec.FluxConfig, nil).Return(errors.New("error in bootstrap git"))
 	g.flux.EXPECT().Uninstall(g.ctx, c, clusterSpec.FluxConfig).Return(nil)
 
 	g.Expect(g.gitOpsFlux.Bootstrap(g.ctx, c, clusterSpec)).To(MatchError(ContainSubstring("error in bootstrap git")))
 }
 
+func TestBootstrapGitGithubAppMissingKey(t *testing.T) {
+	g := newFluxTest(t)
+	c := &types.Cluster{}
+	clusterConfig := v1alpha1.NewCluster("management-cluster")
+	clusterSpec := newClusterSpec(t, clusterConfig, "")
+	clusterSpec.FluxConfig.Spec.Git = &v1alpha1.GitProviderConfig{
+		RepositoryUrl: "https://github.com/mFolwer/testRepo.git",
+		GithubApp:     &v1alpha1.GithubAppConfig{AppID: 1234, InstallationID: 5678},
+	}
+
+	g.flux.EXPECT().BootstrapGithub(g.ctx, c, clusterSpec.FluxConfig, nil).Return(nil)
+	g.flux.EXPECT().Uninstall(g.ctx, c, clusterSpec.FluxConfig).Return(nil)
+
+	g.Expect(g.gitOpsFlux.Bootstrap(g.ctx, c, clusterSpec)).To(MatchError(ContainSubstring("reading github app private key")))
+}
+
+func TestBootstrapGitlabSuccess(t *testing.T) {
+	g := newFluxTest(t)
+	c := &types.Cluster{}
+	clusterConfig := v1alpha1.NewCluster("management-cluster")
+	clusterSpec := newClusterSpec(t, clusterConfig, "")
+	clusterSpec.FluxConfig.Spec.Github = nil
+	clusterSpec.FluxConfig.Spec.Gitlab = &v1alpha1.GitlabProviderConfig{Owner: "platform", Repository: "fleet"}
+
+	g.flux.EXPECT().BootstrapGitlab(g.ctx, c, clusterSpec.FluxConfig, nil).Return(nil)
+
+	g.Expect(g.gitOpsFlux.Bootstrap(g.ctx, c, clusterSpec)).To(Succeed())
+}
+
+func TestBootstrapGitlabError(t *testing.T) {
+	g := newFluxTest(t)
+	c := &types.Cluster{}
+	clusterConfig := v1alpha1.NewCluster("management-cluster")
+	clusterSpec := newClusterSpec(t, clusterConfig, "")
+	clusterSpec.FluxConfig.Spec.Github = nil
+	clusterSpec.FluxConfig.Spec.Gitlab = &v1alpha1.GitlabProviderConfig{Owner: "platform", Repository: "fleet"}
+
+	g.flux.EXPECT().BootstrapGitlab(g.ctx, c, clusterSpec.FluxConfig, nil).Return(errors.New("error in bootstrap gitlab"))
+	g.flux.EXPECT().Uninstall(g.ctx, c, clusterSpec.FluxConfig).Return(nil)
+
+	g.Expect(g.gitOpsFlux.Bootstrap(g.ctx, c, clusterSpec)).To(MatchError(ContainSubstring("error in bootstrap gitlab")))
+}
+
+func TestBootstrapBitbucketServerError(t *testing.T) {
+	g := newFluxTest(t)
+	c := &types.Cluster{}
+	clusterConfig := v1alpha1.NewCluster("management-cluster")
+	clusterSpec := newClusterSpec(t, clusterConfig, "")
+	clusterSpec.FluxConfig.Spec.Github = nil
+	clusterSpec.FluxConfig.Spec.BitbucketServer = &v1alpha1.BitbucketServerProviderConfig{Owner: "OPS", Repository: "fleet", Hostname: "bitbucket.example.com"}
+
+	g.flux.EXPECT().BootstrapBitbucketServer(g.ctx, c, clusterSpec.FluxConfig, nil).Return(errors.New("error in bootstrap bitbucket server"))
+	g.flux.EXPECT().Uninstall(g.ctx, c, clusterSpec.FluxConfig).Return(nil)
+
+	g.Expect(g.gitOpsFlux.Bootstrap(g.ctx, c, clusterSpec)).To(MatchError(ContainSubstring("error in bootstrap bitbucket server")))
+}
+
+func TestBootstrapSkipExistingManagementGitlab(t *testing.T) {
+	g := newFluxTest(t)
+	c := &types.Cluster{ExistingManagement: true}
+	clusterConfig := v1alpha1.NewCluster("management-cluster")
+	clusterSpec := newClusterSpec(t, clusterConfig, "")
+	clusterSpec.FluxConfig.Spec.Github = nil
+	clusterSpec.FluxConfig.Spec.Gitlab = &v1alpha1.GitlabProviderConfig{Owner: "platform", Repository: "fleet"}
+
+	g.Expect(g.gitOpsFlux.Bootstrap(g.ctx, c, clusterSpec)).To(Succeed())
+}
+
 func TestUninstallError(t *testing.T) {
 	g := newFluxTest(t)
 	c := &types.Cluster{}
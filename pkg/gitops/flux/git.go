@@ -2,6 +2,7 @@ package flux
 
 import (
 	"context"
+	"errors"
 
 	"github.com/aws/eks-anywhere/pkg/git"
 	gitFactory "github.com/aws/eks-anywhere/pkg/git/factory"
@@ -91,6 +92,34 @@ func (c *gitClient) PathExists(ctx context.Context, owner, repo, branch, path st
 	return exists, err
 }
 
+func (c *gitClient) CreatePullRequest(ctx context.Context, opts git.CreatePullRequestOpts) (pr *git.PullRequest, err error) {
+	if c.gitProvider == nil {
+		return nil, errors.New("creating pull request: a git provider is required")
+	}
+
+	err = c.Retry(
+		func() error {
+			pr, err = c.gitProvider.CreatePullRequest(ctx, opts)
+			return err
+		},
+	)
+	return pr, err
+}
+
+func (c *gitClient) GetPullRequest(ctx context.Context, opts git.GetPullRequestOpts) (pr *git.PullRequest, err error) {
+	if c.gitProvider == nil {
+		return nil, errors.New("getting pull request: a git provider is required")
+	}
+
+	err = c.Retry(
+		func() error {
+			pr, err = c.gitProvider.GetPullRequest(ctx, opts)
+			return err
+		},
+	)
+	return pr, err
+}
+
 func (c *gitClient) Add(filename string) error {
 	return c.git.Add(filename)
 }
@@ -0,0 +1,62 @@
+package flux
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/aws/eks-anywhere/pkg/cluster"
+	"github.com/aws/eks-anywhere/pkg/config"
+	gitFactory "github.com/aws/eks-anywhere/pkg/git/factory"
+	"github.com/aws/eks-anywhere/pkg/git/githubapp"
+	"github.com/aws/eks-anywhere/pkg/types"
+)
+
+const (
+	// GithubAppPrivateKeySecretKey is the key of the GitHub App private key in the flux system secret.
+	GithubAppPrivateKeySecretKey = "githubAppPrivateKey"
+	// GithubAppTokenCreatedAtAnnotation records in the flux system secret when its installation token was created.
+	GithubAppTokenCreatedAtAnnotation = "anywhere.eks.amazonaws.com/github-app-token-created-at"
+)
+
+// bootstrapGitWithGithubApp bootstraps Flux with a GitHub App installation token and then adds the App
+// credentials to the flux-system secret, so the FluxConfig controller can renew the short lived token.
+func (f *Flux) bootstrapGitWithGithubApp(ctx context.Context, cluster *types.Cluster, clusterSpec *cluster.Spec) error {
+	gitConfig := clusterSpec.FluxConfig.Spec.Git
+	var keyFile string
+	if f.cliConfig != nil {
+		keyFile = f.cliConfig.GitGithubAppPrivateKeyFile
+	}
+
+	token, err := gitFactory.GithubAppInstallationToken(ctx, gitConfig, keyFile)
+	if err != nil {
+		return err
+	}
+
+	cliConfig := &config.CliConfig{
+		GitUsername:                githubapp.TokenUsername,
+		GitPassword:                token,
+		GitGithubAppPrivateKeyFile: keyFile,
+	}
+	if err := f.fluxClient.BootstrapGit(ctx, cluster, clusterSpec.FluxConfig, cliConfig); err != nil {
+		return err
+	}
+
+	key, err := os.ReadFile(keyFile)
+	if err != nil {
+		return fmt.Errorf("reading github app private key: %v", err)
+	}
+
+	data := map[string]string{
+		"username":                   githubapp.TokenUsername,
+		"password":                   token,
+		"githubAppID":                strconv.FormatInt(gitConfig.GithubApp.AppID, 10),
+		"githubAppInstallationID":    strconv.FormatInt(gitConfig.GithubApp.InstallationID, 10),
+		GithubAppPrivateKeySecretKey: string(key),
+	}
+	if err := f.fluxClient.ApplySystemSecret(ctx, cluster, clusterSpec.FluxConfig.Spec.SystemNamespace, data); err != nil {
+		return fmt.Errorf("applying github app credentials to flux system secret: %v", err)
+	}
+	return nil
+}
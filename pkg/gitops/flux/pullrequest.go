@@ -0,0 +1,139 @@
+package flux
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/eks-anywhere/pkg/git"
+	"github.com/aws/eks-anywhere/pkg/logger"
+	"github.com/aws/eks-anywhere/pkg/retrier"
+)
+
+const (
+	defaultPullRequestBranchPrefix = "eksa/"
+	defaultPullRequestMergeTimeout = time.Hour
+	pullRequestPollInterval        = 15 * time.Second
+	pullRequestBranchTimeFormat    = "20060102150405"
+
+	createPullRequestPurpose  = "create"
+	upgradePullRequestPurpose = "upgrade"
+	deletePullRequestPurpose  = "delete"
+)
+
+type pullRequestClosedError struct {
+	pr *git.PullRequest
+}
+
+func (e *pullRequestClosedError) Error() string {
+	return fmt.Sprintf("pull request %s was closed without being merged", e.pr.Url)
+}
+
+func (fc *fluxForCluster) pullRequestMode() bool {
+	return fc.clusterSpec.FluxConfig.Spec.PullRequest != nil
+}
+
+func (fc *fluxForCluster) pullRequestBranchPrefix() string {
+	if p := fc.clusterSpec.FluxConfig.Spec.PullRequest.BranchPrefix; p != "" {
+		return p
+	}
+	return defaultPullRequestBranchPrefix
+}
+
+func (fc *fluxForCluster) pullRequestMergeTimeout() time.Duration {
+	if t := fc.clusterSpec.FluxConfig.Spec.PullRequest.MergeTimeout; t != nil {
+		return t.Duration
+	}
+	return defaultPullRequestMergeTimeout
+}
+
+// startChanges prepares the local repository for a new set of changes. In pull request mode it creates and
+// checks out a new branch from the configured branch, so the changes can be proposed through a pull request.
+// It must be called after the local repository is synced with the remote and before any file is written.
+func (fc *fluxForCluster) startChanges(purpose string) error {
+	if !fc.pullRequestMode() || fc.newRepository {
+		return nil
+	}
+
+	b := fmt.Sprintf("%s%s-%s-%s", fc.pullRequestBranchPrefix(), fc.clusterSpec.Cluster.Name, purpose, time.Now().UTC().Format(pullRequestBranchTimeFormat))
+	logger.V(3).Info("Creating branch for pull request", "branch", b)
+	if err := fc.gitClient.Branch(b); err != nil {
+		return fmt.Errorf("creating git branch %s for pull request: %v", b, err)
+	}
+	fc.headBranch = b
+	return nil
+}
+
+// pushChanges commits and pushes the changes to the remote repository. If a pull request branch was created
+// by startChanges, it opens a pull request against the configured branch, waits for it to be merged and
+// switches back to the configured branch, pulling the merged changes so later changes start from them.
+func (fc *fluxForCluster) pushChanges(ctx context.Context, path, msg string) error {
+	if err := fc.Flux.pushToRemoteRepo(ctx, path, msg); err != nil {
+		return err
+	}
+
+	if fc.headBranch == "" {
+		return nil
+	}
+
+	pr, err := fc.gitClient.CreatePullRequest(ctx, git.CreatePullRequestOpts{
+		Owner:       fc.owner(),
+		Repository:  fc.repository(),
+		Title:       msg,
+		Description: fmt.Sprintf("Changes to cluster %s in %s; generated by EKS-A CLI", fc.clusterSpec.Cluster.Name, path),
+		HeadBranch:  fc.headBranch,
+		BaseBranch:  fc.branch(),
+	})
+	if err != nil {
+		return fmt.Errorf("opening pull request for %s: %v", path, err)
+	}
+
+	if err := fc.waitForPullRequestMerge(ctx, pr); err != nil {
+		return err
+	}
+
+	if err := fc.gitClient.Branch(fc.branch()); err != nil {
+		return fmt.Errorf("switching to git branch %s: %v", fc.branch(), err)
+	}
+	fc.headBranch = ""
+	if err := fc.gitClient.Pull(ctx, fc.branch()); err != nil {
+		return fmt.Errorf("pulling merged changes from git branch %s: %v", fc.branch(), err)
+	}
+	return nil
+}
+
+func (fc *fluxForCluster) waitForPullRequestMerge(ctx context.Context, pr *git.PullRequest) error {
+	timeout := fc.pullRequestMergeTimeout()
+	logger.Info("Pull request opened with the cluster changes, waiting for it to be merged", "url", pr.Url, "timeout", timeout)
+
+	r := retrier.New(timeout, retrier.WithRetryPolicy(func(_ int, err error) (bool, time.Duration) {
+		var closedErr *pullRequestClosedError
+		return !errors.As(err, &closedErr), pullRequestPollInterval
+	}))
+
+	err := r.Retry(func() error {
+		p, err := fc.gitClient.GetPullRequest(ctx, git.GetPullRequestOpts{
+			Owner:      fc.owner(),
+			Repository: fc.repository(),
+			Number:     pr.Number,
+		})
+		if err != nil {
+			return err
+		}
+		switch p.State {
+		case git.PullRequestMerged:
+			return nil
+		case git.PullRequestClosed:
+			return &pullRequestClosedError{pr: pr}
+		default:
+			return fmt.Errorf("pull request %s is not merged yet", pr.Url)
+		}
+	})
+	if err != nil {
+		return fmt.Errorf("waiting for pull request to be merged: %v", err)
+	}
+
+	logger.V(3).Info("Pull request merged", "url", pr.Url)
+	return nil
+}
@@ -0,0 +1,145 @@
+package flux_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/aws/eks-anywhere/internal/test"
+	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/git"
+	"github.com/aws/eks-anywhere/pkg/providers"
+	"github.com/aws/eks-anywhere/pkg/types"
+)
+
+type hasPrefix string
+
+func (p hasPrefix) Matches(x interface{}) bool {
+	s, ok := x.(string)
+	return ok && strings.HasPrefix(s, string(p))
+}
+
+func (p hasPrefix) String() string {
+	return "has prefix " + string(p)
+}
+
+const (
+	pullRequestEksaSystemDirPath = "clusters/management-cluster/management-cluster/eksa-system"
+	pullRequestUrl               = "https://github.com/mFolwer/testRepo/pull/7"
+)
+
+func expectPullRequestChanges(g fluxTest, baseBranch string, headBranchPrefix string) *gomock.Call {
+	g.git.EXPECT().Clone(g.ctx).Return(nil)
+	g.git.EXPECT().Branch(baseBranch).Return(nil)
+	g.git.EXPECT().Branch(hasPrefix(headBranchPrefix)).Return(nil)
+	g.git.EXPECT().Add(pullRequestEksaSystemDirPath).Return(nil)
+	g.git.EXPECT().Commit(test.OfType("string")).Return(nil)
+	g.git.EXPECT().Push(g.ctx).Return(nil)
+	return g.git.EXPECT().CreatePullRequest(g.ctx, gomock.Any()).DoAndReturn(
+		func(_ context.Context, opts git.CreatePullRequestOpts) (*git.PullRequest, error) {
+			g.Expect(opts.Owner).To(Equal("mFolwer"))
+			g.Expect(opts.Repository).To(Equal("testRepo"))
+			g.Expect(opts.BaseBranch).To(Equal(baseBranch))
+			g.Expect(opts.HeadBranch).To(HavePrefix(headBranchPrefix))
+			return &git.PullRequest{Number: 7, Url: pullRequestUrl, State: git.PullRequestOpen}, nil
+		},
+	)
+}
+
+func updateGitEksaSpec(g fluxTest) error {
+	clusterName := g.clusterSpec.Cluster.Name
+	return g.gitOpsFlux.UpdateGitEksaSpec(g.ctx, g.clusterSpec, datacenterConfig(clusterName), []providers.MachineConfig{machineConfig(clusterName)})
+}
+
+func newPullRequestFluxTest(t *testing.T, config *v1alpha1.PullRequestConfig) fluxTest {
+	g := newFluxTest(t)
+	g.clusterSpec = newClusterSpec(t, v1alpha1.NewCluster("management-cluster"), "")
+	g.clusterSpec.FluxConfig.Spec.PullRequest = config
+	return g
+}
+
+func TestUpdateGitEksaSpecPullRequestMerged(t *testing.T) {
+	g := newPullRequestFluxTest(t, &v1alpha1.PullRequestConfig{})
+	getOpts := git.GetPullRequestOpts{Owner: "mFolwer", Repository: "testRepo", Number: 7}
+
+	expectPullRequestChanges(g, "testBranch", "eksa/management-cluster-upgrade-")
+	g.git.EXPECT().GetPullRequest(g.ctx, getOpts).Return(&git.PullRequest{Number: 7, Url: pullRequestUrl, State: git.PullRequestMerged}, nil)
+	g.git.EXPECT().Branch("testBranch").Return(nil)
+	g.git.EXPECT().Pull(g.ctx, "testBranch").Return(nil)
+
+	g.Expect(updateGitEksaSpec(g)).To(Succeed())
+}
+
+func TestUpdateGitEksaSpecPullRequestCustomBranchPrefix(t *testing.T) {
+	g := newPullRequestFluxTest(t, &v1alpha1.PullRequestConfig{BranchPrefix: "clusters/"})
+
+	expectPullRequestChanges(g, "testBranch", "clusters/management-cluster-upgrade-")
+	g.git.EXPECT().GetPullRequest(g.ctx, gomock.Any()).Return(&git.PullRequest{Number: 7, Url: pullRequestUrl, State: git.PullRequestMerged}, nil)
+	g.git.EXPECT().Branch("testBranch").Return(nil)
+	g.git.EXPECT().Pull(g.ctx, "testBranch").Return(nil)
+
+	g.Expect(updateGitEksaSpec(g)).To(Succeed())
+}
+
+func TestUpdateGitEksaSpecPullRequestPullMergedError(t *testing.T) {
+	g := newPullRequestFluxTest(t, &v1alpha1.PullRequestConfig{})
+
+	expectPullRequestChanges(g, "testBranch", "eksa/")
+	g.git.EXPECT().GetPullRequest(g.ctx, gomock.Any()).Return(&git.PullRequest{Number: 7, Url: pullRequestUrl, State: git.PullRequestMerged}, nil)
+	g.git.EXPECT().Branch("testBranch").Return(nil)
+	g.git.EXPECT().Pull(g.ctx, "testBranch").Return(errors.New("connection refused"))
+
+	g.Expect(updateGitEksaSpec(g)).To(MatchError(ContainSubstring("pulling merged changes from git branch testBranch: connection refused")))
+}
+
+func TestUpdateGitEksaSpecPullRequestClosed(t *testing.T) {
+	g := newPullRequestFluxTest(t, &v1alpha1.PullRequestConfig{})
+
+	expectPullRequestChanges(g, "testBranch", "eksa/")
+	g.git.EXPECT().GetPullRequest(g.ctx, gomock.Any()).Return(&git.PullRequest{Number: 7, Url: pullRequestUrl, State: git.PullRequestClosed}, nil).Times(1)
+
+	g.Expect(updateGitEksaSpec(g)).To(MatchError(ContainSubstring("pull request " + pullRequestUrl + " was closed without being merged")))
+}
+
+func TestUpdateGitEksaSpecPullRequestMergeTimeout(t *testing.T) {
+	g := newPullRequestFluxTest(t, &v1alpha1.PullRequestConfig{MergeTimeout: &metav1.Duration{Duration: time.Millisecond}})
+
+	expectPullRequestChanges(g, "testBranch", "eksa/")
+	g.git.EXPECT().GetPullRequest(g.ctx, gomock.Any()).Return(&git.PullRequest{Number: 7, Url: pullRequestUrl, State: git.PullRequestOpen}, nil)
+
+	g.Expect(updateGitEksaSpec(g)).To(MatchError(ContainSubstring("pull request " + pullRequestUrl + " is not merged yet")))
+}
+
+func TestUpdateGitEksaSpecPullRequestCreateError(t *testing.T) {
+	g := newPullRequestFluxTest(t, &v1alpha1.PullRequestConfig{})
+
+	expectPullRequestChanges(g, "testBranch", "eksa/").Return(nil, errors.New("no permissions"))
+
+	g.Expect(updateGitEksaSpec(g)).To(MatchError(ContainSubstring("opening pull request for " + pullRequestEksaSystemDirPath + ": no permissions")))
+}
+
+func TestUpdateGitEksaSpecPullRequestBranchError(t *testing.T) {
+	g := newPullRequestFluxTest(t, &v1alpha1.PullRequestConfig{})
+
+	g.git.EXPECT().Clone(g.ctx).Return(nil)
+	g.git.EXPECT().Branch("testBranch").Return(nil)
+	g.git.EXPECT().Branch(hasPrefix("eksa/")).Return(errors.New("invalid reference"))
+
+	g.Expect(updateGitEksaSpec(g)).To(MatchError(ContainSubstring("invalid reference")))
+}
+
+func TestForceReconcileGitRepoPullRequest(t *testing.T) {
+	cluster := &types.Cluster{}
+	g := newPullRequestFluxTest(t, &v1alpha1.PullRequestConfig{})
+
+	g.flux.EXPECT().ForceReconcile(g.ctx, cluster, "flux-system")
+	g.flux.EXPECT().Reconcile(g.ctx, cluster, g.clusterSpec.FluxConfig)
+
+	g.Expect(g.gitOpsFlux.ForceReconcileGitRepo(g.ctx, cluster, g.clusterSpec)).To(Succeed())
+}
@@ -0,0 +1,155 @@
+package reconciler
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/controller"
+	"github.com/aws/eks-anywhere/pkg/git/githubapp"
+	"github.com/aws/eks-anywhere/pkg/gitops/flux"
+)
+
+const (
+	// Flux objects are not watched, so the status is refreshed periodically.
+	requeueAfter = time.Minute
+	// GitHub App installation tokens expire after one hour, so they are renewed well before that.
+	githubAppTokenRefreshInterval = 30 * time.Minute
+)
+
+var (
+	gitRepositoryGVK = schema.GroupVersionKind{Group: "source.toolkit.fluxcd.io", Version: "v1beta2", Kind: "GitRepository"}
+	kustomizationGVK = schema.GroupVersionKind{Group: "kustomize.toolkit.fluxcd.io", Version: "v1beta2", Kind: "Kustomization"}
+)
+
+// GithubAppClient creates GitHub App installation tokens.
+type GithubAppClient interface {
+	InstallationToken(ctx context.Context, creds githubapp.Credentials) (string, error)
+}
+
+// GithubAppClientBuilder builds a GithubAppClient for the GitHub API that serves a repository url.
+type GithubAppClientBuilder func(repositoryUrl string) (GithubAppClient, error)
+
+// NewGithubAppClient builds a GithubAppClient for github.com or the GitHub Enterprise Server of the repository url.
+func NewGithubAppClient(repositoryUrl string) (GithubAppClient, error) {
+	return githubapp.NewForRepository(repositoryUrl)
+}
+
+// Reconciler allows to reconcile the status of a FluxConfig.
+type Reconciler struct {
+	client          client.Client
+	secretReader    client.Reader
+	githubAppClient GithubAppClientBuilder
+}
+
+// New returns a new Reconciler. secretReader is used to read the flux system secret, so it can be
+// a non cached reader that doesn't require to watch secrets in every namespace.
+func New(client client.Client, secretReader client.Reader, githubAppClient GithubAppClientBuilder) *Reconciler {
+	return &Reconciler{
+		client:          client,
+		secretReader:    secretReader,
+		githubAppClient: githubAppClient,
+	}
+}
+
+// Reconcile updates the FluxConfig status with the state of the Flux GitRepository and Kustomization
+// that sync its repository to the cluster. It always requeues to keep the status up to date.
+func (r *Reconciler) Reconcile(ctx context.Context, log logr.Logger, fluxConfig *anywherev1.FluxConfig) (controller.Result, error) {
+	namespace := fluxConfig.Spec.SystemNamespace
+	if namespace == "" {
+		namespace = anywherev1.FluxDefaultNamespace
+	}
+
+	gitRepository, err := r.getFluxObject(ctx, gitRepositoryGVK, namespace)
+	if err != nil {
+		return controller.Result{}, err
+	}
+
+	kustomization, err := r.getFluxObject(ctx, kustomizationGVK, namespace)
+	if err != nil {
+		return controller.Result{}, err
+	}
+
+	flux.SetFluxConfigStatus(fluxConfig, gitRepository, kustomization)
+	log.V(4).Info("Updated FluxConfig status", "lastAppliedRevision", fluxConfig.Status.LastAppliedRevision)
+
+	if err := r.refreshGithubAppToken(ctx, log, fluxConfig, namespace); err != nil {
+		return controller.Result{}, err
+	}
+
+	return controller.ResultWithRequeue(requeueAfter), nil
+}
+
+// refreshGithubAppToken replaces the installation token in the flux system secret before it expires,
+// since the Flux source controller only authenticates with the username and password in the secret.
+func (r *Reconciler) refreshGithubAppToken(ctx context.Context, log logr.Logger, fluxConfig *anywherev1.FluxConfig, namespace string) error {
+	git := fluxConfig.Spec.Git
+	if git == nil || git.GithubApp == nil {
+		return nil
+	}
+
+	secret := &corev1.Secret{}
+	err := r.secretReader.Get(ctx, client.ObjectKey{Name: flux.SystemObjectName, Namespace: namespace}, secret)
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return errors.Wrapf(err, "getting flux system secret %s/%s", namespace, flux.SystemObjectName)
+	}
+
+	if createdAt, err := time.Parse(time.RFC3339, secret.Annotations[flux.GithubAppTokenCreatedAtAnnotation]); err == nil &&
+		time.Since(createdAt) < githubAppTokenRefreshInterval {
+		return nil
+	}
+
+	githubAppClient, err := r.githubAppClient(git.RepositoryUrl)
+	if err != nil {
+		return err
+	}
+
+	creds := githubapp.Credentials{
+		AppID:          git.GithubApp.AppID,
+		InstallationID: git.GithubApp.InstallationID,
+		PrivateKey:     secret.Data[flux.GithubAppPrivateKeySecretKey],
+	}
+	token, err := githubAppClient.InstallationToken(ctx, creds)
+	if err != nil {
+		return errors.Wrap(err, "refreshing github app installation token")
+	}
+
+	if secret.Annotations == nil {
+		secret.Annotations = map[string]string{}
+	}
+	secret.Annotations[flux.GithubAppTokenCreatedAtAnnotation] = time.Now().UTC().Format(time.RFC3339)
+	if secret.Data == nil {
+		secret.Data = map[string][]byte{}
+	}
+	secret.Data["password"] = []byte(token)
+	if err := r.client.Update(ctx, secret); err != nil {
+		return errors.Wrapf(err, "updating flux system secret %s/%s", namespace, flux.SystemObjectName)
+	}
+	log.Info("Refreshed GitHub App installation token", "namespace", namespace, "secret", flux.SystemObjectName)
+
+	return nil
+}
+
+func (r *Reconciler) getFluxObject(ctx context.Context, gvk schema.GroupVersionKind, namespace string) (*unstructured.Unstructured, error) {
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(gvk)
+	err := r.client.Get(ctx, client.ObjectKey{Name: flux.SystemObjectName, Namespace: namespace}, obj)
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "getting flux %s %s/%s", gvk.Kind, namespace, flux.SystemObjectName)
+	}
+	return obj, nil
+}
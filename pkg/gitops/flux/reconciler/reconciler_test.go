@@ -0,0 +1,199 @@
+package reconciler_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/cluster-api/util/conditions"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/aws/eks-anywhere/internal/test"
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/git/githubapp"
+	"github.com/aws/eks-anywhere/pkg/gitops/flux"
+	"github.com/aws/eks-anywhere/pkg/gitops/flux/reconciler"
+)
+
+type fakeGithubAppClient struct {
+	token string
+	err   error
+	creds []githubapp.Credentials
+}
+
+func (f *fakeGithubAppClient) InstallationToken(_ context.Context, creds githubapp.Credentials) (string, error) {
+	f.creds = append(f.creds, creds)
+	return f.token, f.err
+}
+
+func newReconciler(c client.Client) *reconciler.Reconciler {
+	return reconciler.New(c, c, reconciler.NewGithubAppClient)
+}
+
+func newReconcilerWithGithubApp(c client.Client, githubAppClient *fakeGithubAppClient) *reconciler.Reconciler {
+	return reconciler.New(c, c, func(string) (reconciler.GithubAppClient, error) {
+		return githubAppClient, nil
+	})
+}
+
+func newGithubAppFluxConfig() *anywherev1.FluxConfig {
+	fluxConfig := newFluxConfig()
+	fluxConfig.Spec.Git = &anywherev1.GitProviderConfig{
+		RepositoryUrl: "https://github.com/my-org/my-repo.git",
+		GithubApp:     &anywherev1.GithubAppConfig{AppID: 1234, InstallationID: 5678},
+	}
+	return fluxConfig
+}
+
+func fluxSystemSecret(annotations map[string]string) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "flux-system",
+			Namespace:   "flux-system",
+			Annotations: annotations,
+		},
+		Data: map[string][]byte{
+			"username":                []byte("x-access-token"),
+			"password":                []byte("old-token"),
+			"githubAppPrivateKey":     []byte("private-key"),
+			"githubAppID":             []byte("1234"),
+			"githubAppInstallationID": []byte("5678"),
+		},
+	}
+}
+
+func fluxObject(apiVersion, kind string, status map[string]interface{}) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": apiVersion,
+		"kind":       kind,
+		"metadata": map[string]interface{}{
+			"name":      "flux-system",
+			"namespace": "flux-system",
+		},
+		"status": status,
+	}}
+}
+
+func readyCondition(status, reason, message string) map[string]interface{} {
+	return map[string]interface{}{
+		"conditions": []interface{}{
+			map[string]interface{}{"type": "Ready", "status": status, "reason": reason, "message": message},
+		},
+	}
+}
+
+func newFluxConfig() *anywherev1.FluxConfig {
+	return &anywherev1.FluxConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-flux", Namespace: "default"},
+		Spec:       anywherev1.FluxConfigSpec{SystemNamespace: "flux-system"},
+	}
+}
+
+func TestReconcilerReconcileReady(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	kustomizationStatus := readyCondition("True", "ReconciliationSucceeded", "Applied revision: main/abc123")
+	kustomizationStatus["lastAppliedRevision"] = "main/abc123"
+	c := fake.NewClientBuilder().WithObjects(
+		fluxObject("source.toolkit.fluxcd.io/v1beta2", "GitRepository", readyCondition("True", "Succeeded", "stored artifact")),
+		fluxObject("kustomize.toolkit.fluxcd.io/v1beta2", "Kustomization", kustomizationStatus),
+	).Build()
+	fluxConfig := newFluxConfig()
+
+	result, err := newReconciler(c).Reconcile(ctx, test.NewNullLogger(), fluxConfig)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(result.Result.RequeueAfter).NotTo(BeZero())
+	g.Expect(fluxConfig.Status.LastAppliedRevision).To(Equal("main/abc123"))
+	g.Expect(fluxConfig.Status.FailureMessage).To(BeNil())
+	g.Expect(conditions.IsTrue(fluxConfig, anywherev1.GitRepositoryReadyCondition)).To(BeTrue())
+	g.Expect(conditions.IsTrue(fluxConfig, anywherev1.KustomizationReadyCondition)).To(BeTrue())
+}
+
+func TestReconcilerReconcileKustomizationFailed(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	c := fake.NewClientBuilder().WithObjects(
+		fluxObject("source.toolkit.fluxcd.io/v1beta2", "GitRepository", readyCondition("True", "Succeeded", "stored artifact")),
+		fluxObject("kustomize.toolkit.fluxcd.io/v1beta2", "Kustomization", readyCondition("False", "BuildFailed", "kustomize build failed")),
+	).Build()
+	fluxConfig := newFluxConfig()
+
+	_, err := newReconciler(c).Reconcile(ctx, test.NewNullLogger(), fluxConfig)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(fluxConfig.Status.FailureMessage).To(HaveValue(Equal("Kustomization: kustomize build failed")))
+	g.Expect(conditions.IsTrue(fluxConfig, anywherev1.GitRepositoryReadyCondition)).To(BeTrue())
+	g.Expect(conditions.IsFalse(fluxConfig, anywherev1.KustomizationReadyCondition)).To(BeTrue())
+	g.Expect(conditions.GetReason(fluxConfig, anywherev1.KustomizationReadyCondition)).To(Equal("BuildFailed"))
+}
+
+func TestReconcilerReconcileNotBootstrapped(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	c := fake.NewClientBuilder().Build()
+	fluxConfig := newFluxConfig()
+
+	_, err := newReconciler(c).Reconcile(ctx, test.NewNullLogger(), fluxConfig)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(fluxConfig.Status.FailureMessage).To(HaveValue(Equal("GitRepository flux-system/flux-system not found")))
+	g.Expect(conditions.IsFalse(fluxConfig, anywherev1.GitRepositoryReadyCondition)).To(BeTrue())
+	g.Expect(conditions.IsFalse(fluxConfig, anywherev1.KustomizationReadyCondition)).To(BeTrue())
+}
+
+func TestReconcilerReconcileRefreshesGithubAppToken(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	c := fake.NewClientBuilder().WithObjects(fluxSystemSecret(nil)).Build()
+	githubAppClient := &fakeGithubAppClient{token: "new-token"}
+
+	_, err := newReconcilerWithGithubApp(c, githubAppClient).Reconcile(ctx, test.NewNullLogger(), newGithubAppFluxConfig())
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(githubAppClient.creds).To(ConsistOf(githubapp.Credentials{AppID: 1234, InstallationID: 5678, PrivateKey: []byte("private-key")}))
+
+	secret := &corev1.Secret{}
+	g.Expect(c.Get(ctx, client.ObjectKey{Name: "flux-system", Namespace: "flux-system"}, secret)).To(Succeed())
+	g.Expect(string(secret.Data["password"])).To(Equal("new-token"))
+	g.Expect(secret.Annotations).To(HaveKey(flux.GithubAppTokenCreatedAtAnnotation))
+}
+
+func TestReconcilerReconcileGithubAppTokenStillValid(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	createdAt := time.Now().Add(-10 * time.Minute).UTC().Format(time.RFC3339)
+	c := fake.NewClientBuilder().WithObjects(fluxSystemSecret(map[string]string{flux.GithubAppTokenCreatedAtAnnotation: createdAt})).Build()
+	githubAppClient := &fakeGithubAppClient{token: "new-token"}
+
+	_, err := newReconcilerWithGithubApp(c, githubAppClient).Reconcile(ctx, test.NewNullLogger(), newGithubAppFluxConfig())
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(githubAppClient.creds).To(BeEmpty())
+
+	secret := &corev1.Secret{}
+	g.Expect(c.Get(ctx, client.ObjectKey{Name: "flux-system", Namespace: "flux-system"}, secret)).To(Succeed())
+	g.Expect(string(secret.Data["password"])).To(Equal("old-token"))
+}
+
+func TestReconcilerReconcileGithubAppTokenError(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	c := fake.NewClientBuilder().WithObjects(fluxSystemSecret(nil)).Build()
+	githubAppClient := &fakeGithubAppClient{err: errors.New("401 Unauthorized")}
+
+	_, err := newReconcilerWithGithubApp(c, githubAppClient).Reconcile(ctx, test.NewNullLogger(), newGithubAppFluxConfig())
+	g.Expect(err).To(MatchError(ContainSubstring("refreshing github app installation token: 401 Unauthorized")))
+}
+
+func TestReconcilerReconcileGithubAppNotBootstrapped(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	c := fake.NewClientBuilder().Build()
+	githubAppClient := &fakeGithubAppClient{token: "new-token"}
+
+	_, err := newReconcilerWithGithubApp(c, githubAppClient).Reconcile(ctx, test.NewNullLogger(), newGithubAppFluxConfig())
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(githubAppClient.creds).To(BeEmpty())
+}
@@ -0,0 +1,241 @@
+package flux
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"reflect"
+	"sort"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
+
+	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/cluster"
+	"github.com/aws/eks-anywhere/pkg/types"
+)
+
+const (
+	// GitRepositoryResourceType is the kubectl resource type of the Flux GitRepository objects.
+	GitRepositoryResourceType = "gitrepositories.source.toolkit.fluxcd.io"
+	// KustomizationResourceType is the kubectl resource type of the Flux Kustomization objects.
+	KustomizationResourceType = "kustomizations.kustomize.toolkit.fluxcd.io"
+	// SystemObjectName is the name of the GitRepository and Kustomization created by flux bootstrap.
+	SystemObjectName = "flux-system"
+
+	fluxReadyCondition     = "Ready"
+	fluxObjectNotFound     = "NotFound"
+	fluxReadyConditionNone = "ReadyConditionNotReported"
+)
+
+// Status is the state of the GitOps sync of a cluster.
+type Status struct {
+	// FluxConfig of the cluster with its status populated from the Flux objects in the cluster.
+	FluxConfig *v1alpha1.FluxConfig `json:"fluxConfig"`
+	// Drift lists the fields of the cluster spec in the git repository that don't match the live cluster.
+	Drift []SpecDrift `json:"drift"`
+}
+
+// SpecDrift is a field of the cluster spec that has a different value in the git repository and in the cluster.
+type SpecDrift struct {
+	Path       string `json:"path"`
+	Repository string `json:"repository"`
+	Cluster    string `json:"cluster"`
+}
+
+// Status returns the state of the Flux objects that sync the git repository to the cluster and the
+// differences between the cluster config in the git repository and the live EKS-A Cluster object.
+func (f *Flux) Status(ctx context.Context, cluster *types.Cluster, clusterSpec *cluster.Spec) (*Status, error) {
+	if f.shouldSkipFlux() {
+		return nil, errors.New("GitOps is not configured for this cluster")
+	}
+
+	fc := newFluxForCluster(f, clusterSpec, nil, nil)
+	if err := fc.syncGitRepo(ctx); err != nil {
+		return nil, err
+	}
+	if err := f.gitClient.Pull(ctx, fc.branch()); err != nil {
+		return nil, fmt.Errorf("pulling branch %s from remote repository: %v", fc.branch(), err)
+	}
+
+	configFile := path.Join(f.writer.Dir(), fc.eksaSystemDir(), clusterConfigFileName)
+	content, err := os.ReadFile(configFile)
+	if err != nil {
+		return nil, fmt.Errorf("reading cluster config from git repository: %v", err)
+	}
+	repoCluster, err := v1alpha1.GetClusterConfigFromContent(content)
+	if err != nil {
+		return nil, fmt.Errorf("parsing cluster config from git repository: %v", err)
+	}
+
+	liveCluster, err := f.fluxClient.GetCluster(ctx, cluster, clusterSpec)
+	if err != nil {
+		return nil, fmt.Errorf("getting cluster from management cluster: %v", err)
+	}
+
+	gitRepository, err := f.fluxClient.GetFluxObject(ctx, cluster, GitRepositoryResourceType, SystemObjectName, fc.namespace())
+	if err != nil {
+		return nil, err
+	}
+	kustomization, err := f.fluxClient.GetFluxObject(ctx, cluster, KustomizationResourceType, SystemObjectName, fc.namespace())
+	if err != nil {
+		return nil, err
+	}
+
+	fluxConfig := clusterSpec.FluxConfig.DeepCopy()
+	SetFluxConfigStatus(fluxConfig, gitRepository, kustomization)
+
+	drift, err := ClusterSpecDrift(repoCluster, liveCluster)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Status{FluxConfig: fluxConfig, Drift: drift}, nil
+}
+
+// SetFluxConfigStatus populates the status of the FluxConfig with the Ready conditions and the last applied
+// revision of the Flux GitRepository and Kustomization. Nil objects are reported as not found.
+func SetFluxConfigStatus(fluxConfig *v1alpha1.FluxConfig, gitRepository, kustomization *unstructured.Unstructured) {
+	repoMessage := setReadyCondition(fluxConfig, v1alpha1.GitRepositoryReadyCondition, "GitRepository", gitRepository)
+	kustomizationMessage := setReadyCondition(fluxConfig, v1alpha1.KustomizationReadyCondition, "Kustomization", kustomization)
+
+	fluxConfig.Status.LastAppliedRevision = ""
+	if kustomization != nil {
+		revision, _, _ := unstructured.NestedString(kustomization.Object, "status", "lastAppliedRevision")
+		fluxConfig.Status.LastAppliedRevision = revision
+	}
+
+	switch {
+	case repoMessage != "":
+		fluxConfig.Status.FailureMessage = &repoMessage
+	case kustomizationMessage != "":
+		fluxConfig.Status.FailureMessage = &kustomizationMessage
+	default:
+		fluxConfig.Status.FailureMessage = nil
+	}
+}
+
+// setReadyCondition mirrors the Ready condition of the Flux object into the FluxConfig condition and
+// returns the failure message when the object is not ready.
+func setReadyCondition(fluxConfig *v1alpha1.FluxConfig, t clusterv1.ConditionType, kind string, obj *unstructured.Unstructured) string {
+	if obj == nil {
+		namespace := fluxConfig.Spec.SystemNamespace
+		if namespace == "" {
+			namespace = v1alpha1.FluxDefaultNamespace
+		}
+		message := fmt.Sprintf("%s %s/%s not found", kind, namespace, SystemObjectName)
+		conditions.MarkFalse(fluxConfig, t, fluxObjectNotFound, clusterv1.ConditionSeverityWarning, "%s", message)
+		return message
+	}
+
+	ready := fluxReadyConditionFor(obj)
+	if ready == nil {
+		conditions.MarkUnknown(fluxConfig, t, fluxReadyConditionNone, "%s has not reported a Ready condition yet", kind)
+		return ""
+	}
+
+	switch ready["status"] {
+	case "True":
+		conditions.MarkTrue(fluxConfig, t)
+		return ""
+	case "False":
+		message := fmt.Sprintf("%s: %s", kind, ready["message"])
+		conditions.MarkFalse(fluxConfig, t, ready["reason"], clusterv1.ConditionSeverityError, "%s", ready["message"])
+		return message
+	default:
+		conditions.MarkUnknown(fluxConfig, t, ready["reason"], "%s", ready["message"])
+		return ""
+	}
+}
+
+func fluxReadyConditionFor(obj *unstructured.Unstructured) map[string]string {
+	conds, _, _ := unstructured.NestedSlice(obj.Object, "status", "conditions")
+	for _, c := range conds {
+		cond, ok := c.(map[string]interface{})
+		if !ok || cond["type"] != fluxReadyCondition {
+			continue
+		}
+		ready := map[string]string{}
+		for _, k := range []string{"status", "reason", "message"} {
+			if v, ok := cond[k].(string); ok {
+				ready[k] = v
+			}
+		}
+		return ready
+	}
+	return nil
+}
+
+// ClusterSpecDrift compares the spec of the cluster in the git repository with the live cluster. Only the fields
+// set in the git repository are compared, so values defaulted in the live cluster are not reported.
+func ClusterSpecDrift(repoCluster, liveCluster *v1alpha1.Cluster) ([]SpecDrift, error) {
+	repoSpec, err := toJSONValue(repoCluster.Spec)
+	if err != nil {
+		return nil, fmt.Errorf("converting git repository cluster spec: %v", err)
+	}
+	liveSpec, err := toJSONValue(liveCluster.Spec)
+	if err != nil {
+		return nil, fmt.Errorf("converting live cluster spec: %v", err)
+	}
+
+	drift := []SpecDrift{}
+	collectDrift("spec", repoSpec, liveSpec, &drift)
+	sort.Slice(drift, func(i, j int) bool { return drift[i].Path < drift[j].Path })
+	return drift, nil
+}
+
+func collectDrift(p string, repo, live interface{}, drift *[]SpecDrift) {
+	switch r := repo.(type) {
+	case map[string]interface{}:
+		l, ok := live.(map[string]interface{})
+		if !ok {
+			break
+		}
+		for k, v := range r {
+			collectDrift(p+"."+k, v, l[k], drift)
+		}
+		return
+	case []interface{}:
+		l, ok := live.([]interface{})
+		if !ok || len(l) != len(r) {
+			break
+		}
+		for i := range r {
+			collectDrift(fmt.Sprintf("%s[%d]", p, i), r[i], l[i], drift)
+		}
+		return
+	default:
+		if reflect.DeepEqual(repo, live) {
+			return
+		}
+	}
+
+	*drift = append(*drift, SpecDrift{Path: p, Repository: jsonString(repo), Cluster: jsonString(live)})
+}
+
+func toJSONValue(obj interface{}) (interface{}, error) {
+	b, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+	var v interface{}
+	if err := json.Unmarshal(b, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+func jsonString(v interface{}) string {
+	if v == nil {
+		return "<unset>"
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprintf("%v", v)
+	}
+	return string(b)
+}
@@ -0,0 +1,227 @@
+package flux_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/cluster-api/util/conditions"
+	"sigs.k8s.io/yaml"
+
+	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/gitops/flux"
+	"github.com/aws/eks-anywhere/pkg/types"
+)
+
+func fluxObject(kind, status, reason, message, revision string) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{Object: map[string]interface{}{
+		"kind": kind,
+		"status": map[string]interface{}{
+			"conditions": []interface{}{
+				map[string]interface{}{
+					"type":    "Ready",
+					"status":  status,
+					"reason":  reason,
+					"message": message,
+				},
+			},
+		},
+	}}
+	if revision != "" {
+		obj.Object["status"].(map[string]interface{})["lastAppliedRevision"] = revision
+	}
+	return obj
+}
+
+func TestSetFluxConfigStatusReady(t *testing.T) {
+	g := NewWithT(t)
+	fluxConfig := &v1alpha1.FluxConfig{}
+	message := "old failure"
+	fluxConfig.Status.FailureMessage = &message
+
+	flux.SetFluxConfigStatus(fluxConfig,
+		fluxObject("GitRepository", "True", "Succeeded", "stored artifact", ""),
+		fluxObject("Kustomization", "True", "ReconciliationSucceeded", "applied revision", "main@sha1:abc"),
+	)
+
+	g.Expect(conditions.IsTrue(fluxConfig, v1alpha1.GitRepositoryReadyCondition)).To(BeTrue())
+	g.Expect(conditions.IsTrue(fluxConfig, v1alpha1.KustomizationReadyCondition)).To(BeTrue())
+	g.Expect(fluxConfig.Status.LastAppliedRevision).To(Equal("main@sha1:abc"))
+	g.Expect(fluxConfig.Status.FailureMessage).To(BeNil())
+}
+
+func TestSetFluxConfigStatusKustomizationFailed(t *testing.T) {
+	g := NewWithT(t)
+	fluxConfig := &v1alpha1.FluxConfig{}
+
+	flux.SetFluxConfigStatus(fluxConfig,
+		fluxObject("GitRepository", "True", "Succeeded", "stored artifact", ""),
+		fluxObject("Kustomization", "False", "BuildFailed", "kustomize build failed", "main@sha1:abc"),
+	)
+
+	g.Expect(conditions.IsFalse(fluxConfig, v1alpha1.KustomizationReadyCondition)).To(BeTrue())
+	g.Expect(conditions.GetReason(fluxConfig, v1alpha1.KustomizationReadyCondition)).To(Equal("BuildFailed"))
+	g.Expect(fluxConfig.Status.FailureMessage).To(HaveValue(Equal("Kustomization: kustomize build failed")))
+}
+
+func TestSetFluxConfigStatusObjectsNotFound(t *testing.T) {
+	g := NewWithT(t)
+	fluxConfig := &v1alpha1.FluxConfig{}
+
+	flux.SetFluxConfigStatus(fluxConfig, nil, nil)
+
+	g.Expect(conditions.IsFalse(fluxConfig, v1alpha1.GitRepositoryReadyCondition)).To(BeTrue())
+	g.Expect(conditions.IsFalse(fluxConfig, v1alpha1.KustomizationReadyCondition)).To(BeTrue())
+	g.Expect(fluxConfig.Status.LastAppliedRevision).To(BeEmpty())
+	g.Expect(fluxConfig.Status.FailureMessage).To(HaveValue(Equal("GitRepository flux-system/flux-system not found")))
+}
+
+func TestSetFluxConfigStatusNoReadyCondition(t *testing.T) {
+	g := NewWithT(t)
+	fluxConfig := &v1alpha1.FluxConfig{}
+
+	flux.SetFluxConfigStatus(fluxConfig,
+		&unstructured.Unstructured{Object: map[string]interface{}{}},
+		&unstructured.Unstructured{Object: map[string]interface{}{}},
+	)
+
+	g.Expect(conditions.IsUnknown(fluxConfig, v1alpha1.GitRepositoryReadyCondition)).To(BeTrue())
+	g.Expect(conditions.IsUnknown(fluxConfig, v1alpha1.KustomizationReadyCondition)).To(BeTrue())
+	g.Expect(fluxConfig.Status.FailureMessage).To(BeNil())
+}
+
+func TestClusterSpecDrift(t *testing.T) {
+	g := NewWithT(t)
+	repoCluster := v1alpha1.NewCluster("test-cluster")
+	repoCluster.Spec.KubernetesVersion = v1alpha1.Kube124
+	repoCluster.Spec.WorkerNodeGroupConfigurations = []v1alpha1.WorkerNodeGroupConfiguration{
+		{Name: "md-0", Count: intPtr(3)},
+	}
+
+	liveCluster := repoCluster.DeepCopy()
+	liveCluster.Spec.KubernetesVersion = v1alpha1.Kube123
+	liveCluster.Spec.WorkerNodeGroupConfigurations[0].Count = intPtr(2)
+	liveCluster.Spec.ClusterNetwork.DNS.ResolvConf = &v1alpha1.ResolvConf{Path: "/etc/resolv.conf"}
+
+	drift, err := flux.ClusterSpecDrift(repoCluster, liveCluster)
+
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(drift).To(Equal([]flux.SpecDrift{
+		{Path: "spec.kubernetesVersion", Repository: `"1.24"`, Cluster: `"1.23"`},
+		{Path: "spec.workerNodeGroupConfigurations[0].count", Repository: "3", Cluster: "2"},
+	}))
+}
+
+func TestClusterSpecDriftNoDrift(t *testing.T) {
+	g := NewWithT(t)
+	repoCluster := v1alpha1.NewCluster("test-cluster")
+	repoCluster.Spec.KubernetesVersion = v1alpha1.Kube124
+
+	drift, err := flux.ClusterSpecDrift(repoCluster, repoCluster.DeepCopy())
+
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(drift).To(BeEmpty())
+}
+
+func writeRepoFile(repoDir, file string, content []byte) error {
+	if err := os.MkdirAll(path.Join(repoDir, path.Dir(file)), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(path.Join(repoDir, file), content, 0o644)
+}
+
+func intPtr(i int) *int {
+	return &i
+}
+
+func TestStatus(t *testing.T) {
+	cluster := &types.Cluster{}
+	clusterConfig := v1alpha1.NewCluster("management-cluster")
+	clusterSpec := newClusterSpec(t, clusterConfig, "")
+	eksaSystemDirPath := "clusters/management-cluster/management-cluster/eksa-system"
+	g := newFluxTest(t)
+
+	repoCluster := clusterSpec.Cluster.DeepCopy()
+	repoCluster.Spec.KubernetesVersion = v1alpha1.Kube124
+	liveCluster := clusterSpec.Cluster.DeepCopy()
+	liveCluster.Spec.KubernetesVersion = v1alpha1.Kube123
+
+	g.git.EXPECT().Clone(g.ctx).DoAndReturn(func(_ context.Context) error {
+		content, err := yaml.Marshal(repoCluster)
+		if err != nil {
+			return err
+		}
+		return writeRepoFile(g.writer.Dir(), path.Join(eksaSystemDirPath, defaultEksaClusterConfigFileName), content)
+	})
+	g.git.EXPECT().Branch(clusterSpec.FluxConfig.Spec.Branch).Return(nil)
+	g.git.EXPECT().Pull(g.ctx, clusterSpec.FluxConfig.Spec.Branch).Return(nil)
+	g.flux.EXPECT().GetCluster(g.ctx, cluster, clusterSpec).Return(liveCluster, nil)
+	g.flux.EXPECT().GetFluxObject(g.ctx, cluster, flux.GitRepositoryResourceType, "flux-system", "flux-system").Return(
+		fluxObject("GitRepository", "True", "Succeeded", "stored artifact", ""), nil,
+	)
+	g.flux.EXPECT().GetFluxObject(g.ctx, cluster, flux.KustomizationResourceType, "flux-system", "flux-system").Return(
+		fluxObject("Kustomization", "True", "ReconciliationSucceeded", "applied revision", "main@sha1:abc"), nil,
+	)
+
+	status, err := g.gitOpsFlux.Status(g.ctx, cluster, clusterSpec)
+
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(status.FluxConfig.Status.LastAppliedRevision).To(Equal("main@sha1:abc"))
+	g.Expect(conditions.IsTrue(status.FluxConfig, v1alpha1.GitRepositoryReadyCondition)).To(BeTrue())
+	g.Expect(clusterSpec.FluxConfig.Status.Conditions).To(BeEmpty(), "Status() should not modify the cluster spec FluxConfig")
+	g.Expect(status.Drift).To(Equal([]flux.SpecDrift{
+		{Path: "spec.kubernetesVersion", Repository: `"1.24"`, Cluster: `"1.23"`},
+	}))
+}
+
+func TestStatusGetFluxObjectError(t *testing.T) {
+	cluster := &types.Cluster{}
+	clusterConfig := v1alpha1.NewCluster("management-cluster")
+	clusterSpec := newClusterSpec(t, clusterConfig, "")
+	eksaSystemDirPath := "clusters/management-cluster/management-cluster/eksa-system"
+	g := newFluxTest(t)
+
+	g.git.EXPECT().Clone(g.ctx).DoAndReturn(func(_ context.Context) error {
+		content, err := yaml.Marshal(clusterSpec.Cluster)
+		if err != nil {
+			return err
+		}
+		return writeRepoFile(g.writer.Dir(), path.Join(eksaSystemDirPath, defaultEksaClusterConfigFileName), content)
+	})
+	g.git.EXPECT().Branch(clusterSpec.FluxConfig.Spec.Branch).Return(nil)
+	g.git.EXPECT().Pull(g.ctx, clusterSpec.FluxConfig.Spec.Branch).Return(nil)
+	g.flux.EXPECT().GetCluster(g.ctx, cluster, clusterSpec).Return(clusterSpec.Cluster, nil)
+	g.flux.EXPECT().GetFluxObject(g.ctx, cluster, flux.GitRepositoryResourceType, "flux-system", "flux-system").Return(nil, errors.New("error in get object"))
+
+	_, err := g.gitOpsFlux.Status(g.ctx, cluster, clusterSpec)
+
+	g.Expect(err).To(MatchError(ContainSubstring("error in get object")))
+}
+
+func TestStatusPullError(t *testing.T) {
+	cluster := &types.Cluster{}
+	clusterConfig := v1alpha1.NewCluster("management-cluster")
+	clusterSpec := newClusterSpec(t, clusterConfig, "")
+	g := newFluxTest(t)
+
+	g.git.EXPECT().Clone(g.ctx).Return(nil)
+	g.git.EXPECT().Branch(clusterSpec.FluxConfig.Spec.Branch).Return(nil)
+	g.git.EXPECT().Pull(g.ctx, clusterSpec.FluxConfig.Spec.Branch).Return(errors.New("error in pull"))
+
+	_, err := g.gitOpsFlux.Status(g.ctx, cluster, clusterSpec)
+
+	g.Expect(err).To(MatchError(ContainSubstring("error in pull")))
+}
+
+func TestStatusSkip(t *testing.T) {
+	g := newFluxTest(t)
+	f := flux.NewFlux(nil, nil, nil, nil)
+
+	_, err := f.Status(g.ctx, &types.Cluster{}, g.clusterSpec)
+
+	g.Expect(err).To(MatchError(ContainSubstring("GitOps is not configured")))
+}
@@ -15,7 +15,7 @@ func (f *Flux) Upgrade(ctx context.Context, managementCluster *types.Cluster, cu
 	logger.V(1).Info("Checking for Flux upgrades")
 
 	changeDiff := FluxChangeDiff(currentSpec, newSpec)
-	if changeDiff == nil {
+	if changeDiff == nil && !proxyConfigurationChanged(currentSpec, newSpec) {
 		logger.V(1).Info("Nothing to upgrade for Flux")
 		return nil, nil
 	}
@@ -33,6 +33,12 @@ func (f *Flux) Upgrade(ctx context.Context, managementCluster *types.Cluster, cu
 	if err := f.BootstrapGit(ctx, managementCluster, newSpec); err != nil {
 		return nil, fmt.Errorf("upgrading Flux components with git provider: %v", err)
 	}
+	if err := f.BootstrapGitlab(ctx, managementCluster, newSpec); err != nil {
+		return nil, fmt.Errorf("upgrading Flux components with gitlab provider: %v", err)
+	}
+	if err := f.BootstrapBitbucketServer(ctx, managementCluster, newSpec); err != nil {
+		return nil, fmt.Errorf("upgrading Flux components with bitbucket server provider: %v", err)
+	}
 	if err := f.fluxClient.Reconcile(ctx, managementCluster, newSpec.FluxConfig); err != nil {
 		return nil, fmt.Errorf("reconciling Flux components: %v", err)
 	}
@@ -53,6 +59,10 @@ func FluxChangeDiff(currentSpec, newSpec *cluster.Spec) *types.ChangeDiff {
 		logger.V(1).Info("Skipping Flux upgrades, GitOps not enabled")
 		return nil
 	}
+	if newSpec.FluxConfig == nil {
+		logger.V(1).Info("Skipping Flux upgrades, GitOps not managed by Flux")
+		return nil
+	}
 	oldVersion := currentSpec.VersionsBundle.Flux.Version
 	newVersion := newSpec.VersionsBundle.Flux.Version
 	if oldVersion != newVersion {
@@ -70,6 +80,17 @@ func FluxChangeDiff(currentSpec, newSpec *cluster.Spec) *types.ChangeDiff {
 	return nil
 }
 
+// proxyConfigurationChanged returns true if the proxy configuration of a cluster managed by Flux changed.
+// The Flux controllers get it from the flux-system patches, so they have to be bootstrapped again
+// even if their version didn't change.
+func proxyConfigurationChanged(currentSpec, newSpec *cluster.Spec) bool {
+	if !newSpec.Cluster.IsSelfManaged() || currentSpec.Cluster.Spec.GitOpsRef == nil || newSpec.FluxConfig == nil {
+		return false
+	}
+
+	return !currentSpec.Cluster.Spec.ProxyConfiguration.Equal(newSpec.Cluster.Spec.ProxyConfiguration)
+}
+
 func (f *Flux) Install(ctx context.Context, cluster *types.Cluster, oldSpec, newSpec *cluster.Spec) error {
 	if oldSpec.Cluster.Spec.GitOpsRef == nil && newSpec.Cluster.Spec.GitOpsRef != nil {
 		return f.InstallGitOps(ctx, cluster, newSpec, nil, nil)
@@ -87,6 +108,10 @@ func (f *Flux) upgradeFilesAndCommit(ctx context.Context, newSpec *cluster.Spec)
 		return err
 	}
 
+	if err := fc.startChanges(upgradePullRequestPurpose); err != nil {
+		return err
+	}
+
 	if err := fc.commitFluxUpgradeFilesToGit(ctx); err != nil {
 		return err
 	}
@@ -110,7 +135,7 @@ func (fc *fluxForCluster) commitFluxUpgradeFilesToGit(ctx context.Context) error
 		return fmt.Errorf("adding %s to git: %v", fc.path(), err)
 	}
 
-	if err := fc.Flux.pushToRemoteRepo(ctx, fc.path(), upgradeFluxconfigCommitMessage); err != nil {
+	if err := fc.pushChanges(ctx, fc.path(), upgradeFluxconfigCommitMessage); err != nil {
 		return err
 	}
 	logger.V(3).Info("Finished pushing flux custom manifest files to git",
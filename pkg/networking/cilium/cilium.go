@@ -1,6 +1,8 @@
 package cilium
 
 import (
+	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/cluster"
 	"github.com/aws/eks-anywhere/pkg/constants"
 )
 
@@ -25,3 +27,10 @@ func NewCilium(client KubernetesClient, templater InstallUpgradeTemplater) *Cili
 		Upgrader:  NewUpgrader(client, templater),
 	}
 }
+
+// ReplacesKubeProxy returns true if Cilium runs with strict kube-proxy replacement, in which case
+// the kube-proxy installed by kubeadm needs to be removed from the cluster.
+func ReplacesKubeProxy(spec *cluster.Spec) bool {
+	cni := spec.Cluster.Spec.ClusterNetwork.CNIConfig
+	return cni != nil && cni.Cilium != nil && cni.Cilium.KubeProxyReplacement == v1alpha1.CiliumKubeProxyReplacementStrict
+}
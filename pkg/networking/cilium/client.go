@@ -18,15 +18,25 @@ const (
 	PreflightDaemonSetName  = "cilium-pre-flight-check"
 	DeploymentName          = "cilium-operator"
 	PreflightDeploymentName = "cilium-pre-flight-check"
+	// HubbleRelayDeploymentName is the name of the Hubble Relay Deployment, only installed
+	// when enabled in the cluster spec.
+	HubbleRelayDeploymentName = "hubble-relay"
+	// HubbleUIDeploymentName is the name of the Hubble UI Deployment, only installed
+	// when enabled in the cluster spec.
+	HubbleUIDeploymentName = "hubble-ui"
 	// ConfigMapName is the default name for the Cilium ConfigMap
 	// containing Cilium's configuration.
 	ConfigMapName = "cilium-config"
+	// KubeProxyName is the name of the kube-proxy DaemonSet and ConfigMap installed by kubeadm.
+	// They are removed when Cilium fully replaces kube-proxy.
+	KubeProxyName = "kube-proxy"
 )
 
 // Client allows to interact with the Kubernetes API.
 type Client interface {
 	ApplyKubeSpecFromBytes(ctx context.Context, cluster *types.Cluster, data []byte) error
 	DeleteKubeSpecFromBytes(ctx context.Context, cluster *types.Cluster, data []byte) error
+	DeleteObjectIfExists(ctx context.Context, resourceType, name, namespace, kubeconfig string) error
 	GetDaemonSet(ctx context.Context, name, namespace, kubeconfig string) (*v1.DaemonSet, error)
 	GetDeployment(ctx context.Context, name, namespace, kubeconfig string) (*v1.Deployment, error)
 	RolloutRestartDaemonSet(ctx context.Context, name, namespace, kubeconfig string) error
@@ -120,7 +130,7 @@ func (c *RetrierClient) checkPreflightDeploymentReady(ctx context.Context, clust
 }
 
 // WaitForCiliumDaemonSet blocks until the Cilium DS installed as part of the default
-// Cilium installation becomes ready or until the timeout expires.
+// Cilium installation is rolled out and ready or until the timeout expires.
 func (c *RetrierClient) WaitForCiliumDaemonSet(ctx context.Context, cluster *types.Cluster) error {
 	return c.Retry(
 		func() error {
@@ -139,13 +149,26 @@ func (c *RetrierClient) RolloutRestartCiliumDaemonSet(ctx context.Context, clust
 	)
 }
 
+// DeleteKubeProxy removes the kube-proxy DaemonSet and ConfigMap installed by kubeadm, so they don't
+// handle services alongside Cilium when it runs with strict kube-proxy replacement.
+func (c *RetrierClient) DeleteKubeProxy(ctx context.Context, cluster *types.Cluster) error {
+	return c.Retry(
+		func() error {
+			if err := c.DeleteObjectIfExists(ctx, "daemonset", KubeProxyName, namespace, cluster.KubeconfigFile); err != nil {
+				return err
+			}
+			return c.DeleteObjectIfExists(ctx, "configmap", KubeProxyName, namespace, cluster.KubeconfigFile)
+		},
+	)
+}
+
 func (c *RetrierClient) checkCiliumDaemonSetReady(ctx context.Context, cluster *types.Cluster) error {
 	daemonSet, err := c.GetDaemonSet(ctx, DaemonSetName, namespace, cluster.KubeconfigFile)
 	if err != nil {
 		return err
 	}
 
-	if err := CheckDaemonSetReady(daemonSet); err != nil {
+	if err := CheckDaemonSetRolledOut(daemonSet); err != nil {
 		return err
 	}
 
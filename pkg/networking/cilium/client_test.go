@@ -76,6 +76,25 @@ func TestRetrierClientDeleteError(t *testing.T) {
 	tt.Expect(tt.r.Delete(tt.ctx, tt.cluster, data)).To(MatchError(ContainSubstring("error in delete")), "retrierClient.Delete() should fail after 5 tries")
 }
 
+func TestRetrierClientDeleteKubeProxySuccess(t *testing.T) {
+	tt := newRetrierTest(t)
+	gomock.InOrder(
+		tt.c.EXPECT().DeleteObjectIfExists(tt.ctx, "daemonset", "kube-proxy", "kube-system", "kubeconfig").Return(errors.New("error in delete")),
+		tt.c.EXPECT().DeleteObjectIfExists(tt.ctx, "daemonset", "kube-proxy", "kube-system", "kubeconfig"),
+		tt.c.EXPECT().DeleteObjectIfExists(tt.ctx, "configmap", "kube-proxy", "kube-system", "kubeconfig"),
+	)
+
+	tt.Expect(tt.r.DeleteKubeProxy(tt.ctx, tt.cluster)).To(Succeed(), "retrierClient.DeleteKubeProxy() should succeed after 2 tries")
+}
+
+func TestRetrierClientDeleteKubeProxyError(t *testing.T) {
+	tt := newRetrierTest(t)
+	tt.r.Retrier = retrier.NewWithMaxRetries(5, 0)
+	tt.c.EXPECT().DeleteObjectIfExists(tt.ctx, "daemonset", "kube-proxy", "kube-system", "kubeconfig").Return(errors.New("error in delete")).Times(5)
+
+	tt.Expect(tt.r.DeleteKubeProxy(tt.ctx, tt.cluster)).To(MatchError(ContainSubstring("error in delete")), "retrierClient.DeleteKubeProxy() should fail after 5 tries")
+}
+
 type waitForCiliumTest struct {
 	*retrierTest
 	ciliumDaemonSet, preflightDaemonSet   *v1.DaemonSet
@@ -92,6 +111,7 @@ func newWaitForCiliumTest(t *testing.T) *waitForCiliumTest {
 			Status: v1.DaemonSetStatus{
 				DesiredNumberScheduled: 5,
 				NumberReady:            5,
+				UpdatedNumberScheduled: 5,
 			},
 		},
 		preflightDaemonSet: &v1.DaemonSet{
@@ -10,6 +10,9 @@ type Installation struct {
 	DaemonSet *appsv1.DaemonSet
 	Operator  *appsv1.Deployment
 	ConfigMap *corev1.ConfigMap
+	// HubbleRelay and HubbleUI are only installed when enabled in the cluster spec.
+	HubbleRelay *appsv1.Deployment
+	HubbleUI    *appsv1.Deployment
 }
 
 // Installed determines if all Cilium components are present.
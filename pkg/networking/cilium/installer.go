@@ -61,5 +61,15 @@ func (i *Installer) Install(ctx context.Context, cluster *types.Cluster, spec *c
 		return fmt.Errorf("applying Cilium manifest for install: %v", err)
 	}
 
+	if ReplacesKubeProxy(spec) {
+		// Services are handled by kube-proxy until Cilium is up.
+		if err = i.k8s.WaitForCiliumDaemonSet(ctx, cluster); err != nil {
+			return fmt.Errorf("waiting for Cilium before removing kube-proxy: %v", err)
+		}
+		if err = i.k8s.DeleteKubeProxy(ctx, cluster); err != nil {
+			return fmt.Errorf("removing kube-proxy: %v", err)
+		}
+	}
+
 	return nil
 }
@@ -7,6 +7,7 @@ import (
 	"github.com/golang/mock/gomock"
 	. "github.com/onsi/gomega"
 
+	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
 	"github.com/aws/eks-anywhere/pkg/networking/cilium"
 )
 
@@ -56,6 +57,44 @@ func TestInstallerInstallSuccess(t *testing.T) {
 	).To(Succeed())
 }
 
+func TestInstallerInstallStrictKubeProxyReplacement(t *testing.T) {
+	tt := newCiliumTest(t)
+	tt.spec.Cluster.Spec.ClusterNetwork.CNIConfig.Cilium.KubeProxyReplacement = v1alpha1.CiliumKubeProxyReplacementStrict
+	installer := cilium.NewInstaller(tt.client, tt.installTemplater)
+	tt.installTemplater.EXPECT().GenerateManifest(
+		tt.ctx, tt.spec, gomock.Not(gomock.Nil()),
+	).Return(tt.ciliumValues, nil)
+
+	gomock.InOrder(
+		tt.client.EXPECT().Apply(tt.ctx, tt.cluster, tt.ciliumValues),
+		tt.client.EXPECT().WaitForCiliumDaemonSet(tt.ctx, tt.cluster),
+		tt.client.EXPECT().DeleteKubeProxy(tt.ctx, tt.cluster),
+	)
+
+	tt.Expect(
+		installer.Install(tt.ctx, tt.cluster, tt.spec, nil),
+	).To(Succeed())
+}
+
+func TestInstallerInstallStrictKubeProxyReplacementErrorDeletingKubeProxy(t *testing.T) {
+	tt := newCiliumTest(t)
+	tt.spec.Cluster.Spec.ClusterNetwork.CNIConfig.Cilium.KubeProxyReplacement = v1alpha1.CiliumKubeProxyReplacementStrict
+	installer := cilium.NewInstaller(tt.client, tt.installTemplater)
+	tt.installTemplater.EXPECT().GenerateManifest(
+		tt.ctx, tt.spec, gomock.Not(gomock.Nil()),
+	).Return(tt.ciliumValues, nil)
+
+	tt.client.EXPECT().Apply(tt.ctx, tt.cluster, tt.ciliumValues)
+	tt.client.EXPECT().WaitForCiliumDaemonSet(tt.ctx, tt.cluster)
+	tt.client.EXPECT().DeleteKubeProxy(tt.ctx, tt.cluster).Return(errors.New("deleting"))
+
+	tt.Expect(
+		installer.Install(tt.ctx, tt.cluster, tt.spec, nil),
+	).To(
+		MatchError(ContainSubstring("removing kube-proxy: deleting")),
+	)
+}
+
 func TestInstallForSpecInstallSuccess(t *testing.T) {
 	tt := newCiliumTest(t)
 	config := cilium.Config{
@@ -64,6 +64,20 @@ func (mr *MockClientMockRecorder) DeleteKubeSpecFromBytes(ctx, cluster, data int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteKubeSpecFromBytes", reflect.TypeOf((*MockClient)(nil).DeleteKubeSpecFromBytes), ctx, cluster, data)
 }
 
+// DeleteObjectIfExists mocks base method.
+func (m *MockClient) DeleteObjectIfExists(ctx context.Context, resourceType, name, namespace, kubeconfig string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteObjectIfExists", ctx, resourceType, name, namespace, kubeconfig)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteObjectIfExists indicates an expected call of DeleteObjectIfExists.
+func (mr *MockClientMockRecorder) DeleteObjectIfExists(ctx, resourceType, name, namespace, kubeconfig interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteObjectIfExists", reflect.TypeOf((*MockClient)(nil).DeleteObjectIfExists), ctx, resourceType, name, namespace, kubeconfig)
+}
+
 // GetDaemonSet mocks base method.
 func (m *MockClient) GetDaemonSet(ctx context.Context, name, namespace, kubeconfig string) (*v1.DaemonSet, error) {
 	m.ctrl.T.Helper()
@@ -65,6 +65,20 @@ func (mr *MockKubernetesClientMockRecorder) Delete(ctx, cluster, data interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockKubernetesClient)(nil).Delete), ctx, cluster, data)
 }
 
+// DeleteKubeProxy mocks base method.
+func (m *MockKubernetesClient) DeleteKubeProxy(ctx context.Context, cluster *types.Cluster) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteKubeProxy", ctx, cluster)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteKubeProxy indicates an expected call of DeleteKubeProxy.
+func (mr *MockKubernetesClientMockRecorder) DeleteKubeProxy(ctx, cluster interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteKubeProxy", reflect.TypeOf((*MockKubernetesClient)(nil).DeleteKubeProxy), ctx, cluster)
+}
+
 // RolloutRestartCiliumDaemonSet mocks base method.
 func (m *MockKubernetesClient) RolloutRestartCiliumDaemonSet(ctx context.Context, cluster *types.Cluster) error {
 	m.ctrl.T.Helper()
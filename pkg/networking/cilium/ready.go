@@ -17,6 +17,19 @@ func CheckDaemonSetReady(daemonSet *v1.DaemonSet) error {
 	return nil
 }
 
+// CheckDaemonSetRolledOut returns an error if the DaemonSet is not ready or if some of its pods
+// don't run the latest version of its pod template yet.
+func CheckDaemonSetRolledOut(daemonSet *v1.DaemonSet) error {
+	if err := CheckDaemonSetReady(daemonSet); err != nil {
+		return err
+	}
+
+	if daemonSet.Status.DesiredNumberScheduled != daemonSet.Status.UpdatedNumberScheduled {
+		return fmt.Errorf("daemonSet %s is not rolled out: %d/%d updated", daemonSet.Name, daemonSet.Status.UpdatedNumberScheduled, daemonSet.Status.DesiredNumberScheduled)
+	}
+	return nil
+}
+
 func CheckPreflightDaemonSetReady(ciliumDaemonSet, preflightDaemonSet *v1.DaemonSet) error {
 	if err := checkDaemonSetObservedGeneration(ciliumDaemonSet); err != nil {
 		return err
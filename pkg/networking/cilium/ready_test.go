@@ -69,6 +69,67 @@ func TestCheckDaemonSetReady(t *testing.T) {
 	}
 }
 
+func TestCheckDaemonSetRolledOut(t *testing.T) {
+	tests := []struct {
+		name      string
+		daemonSet *v1.DaemonSet
+		wantErr   error
+	}{
+		{
+			name: "not ready",
+			daemonSet: &v1.DaemonSet{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "ds",
+				},
+				Status: v1.DaemonSetStatus{
+					DesiredNumberScheduled: 5,
+					NumberReady:            4,
+					UpdatedNumberScheduled: 5,
+				},
+			},
+			wantErr: errors.New("daemonSet ds is not ready: 4/5 ready"),
+		},
+		{
+			name: "rollout in progress",
+			daemonSet: &v1.DaemonSet{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "ds",
+				},
+				Status: v1.DaemonSetStatus{
+					DesiredNumberScheduled: 5,
+					NumberReady:            5,
+					UpdatedNumberScheduled: 3,
+				},
+			},
+			wantErr: errors.New("daemonSet ds is not rolled out: 3/5 updated"),
+		},
+		{
+			name: "rolled out",
+			daemonSet: &v1.DaemonSet{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "ds",
+				},
+				Status: v1.DaemonSetStatus{
+					DesiredNumberScheduled: 5,
+					NumberReady:            5,
+					UpdatedNumberScheduled: 5,
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			err := CheckDaemonSetRolledOut(tt.daemonSet)
+			if tt.wantErr != nil {
+				g.Expect(err).To(MatchError(tt.wantErr))
+				return
+			}
+			g.Expect(err).To(Succeed())
+		})
+	}
+}
+
 func TestCheckPreflightDaemonSetReady(t *testing.T) {
 	tests := []struct {
 		name              string
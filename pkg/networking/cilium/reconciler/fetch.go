@@ -33,10 +33,22 @@ func getInstallation(ctx context.Context, client client.Client) (*cilium.Install
 		return nil, err
 	}
 
+	relay, err := getDeployment(ctx, client, cilium.HubbleRelayDeploymentName, "kube-system")
+	if err != nil {
+		return nil, err
+	}
+
+	ui, err := getDeployment(ctx, client, cilium.HubbleUIDeploymentName, "kube-system")
+	if err != nil {
+		return nil, err
+	}
+
 	return &cilium.Installation{
-		DaemonSet: ds,
-		Operator:  operator,
-		ConfigMap: cm,
+		DaemonSet:   ds,
+		Operator:    operator,
+		ConfigMap:   cm,
+		HubbleRelay: relay,
+		HubbleUI:    ui,
 	}, nil
 }
 
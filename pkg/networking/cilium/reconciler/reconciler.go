@@ -6,10 +6,15 @@ import (
 
 	"github.com/go-logr/logr"
 	"github.com/pkg/errors"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/aws/eks-anywhere/pkg/cluster"
+	"github.com/aws/eks-anywhere/pkg/constants"
 	"github.com/aws/eks-anywhere/pkg/controller"
 	"github.com/aws/eks-anywhere/pkg/controller/clientutil"
 	"github.com/aws/eks-anywhere/pkg/controller/serverside"
@@ -65,16 +70,48 @@ func (r *Reconciler) Reconcile(ctx context.Context, logger logr.Logger, client c
 		}
 	} else if upgradeInfo.ConfigUpdateNeeded() {
 		logger.Info("Cilium config update needed", "reason", upgradeInfo.Reason())
-		if err := r.updateConfig(ctx, client, spec); err != nil {
+		if err := r.updateConfig(ctx, client, installation, spec); err != nil {
 			return controller.Result{}, err
 		}
+
+		// The manifest rolls out the Cilium pods when the config changes, wait for it before continuing.
+		logger.Info("Cilium config updated, waiting for the Cilium DS to be rolled out")
+		return controller.Result{Result: &ctrl.Result{
+			RequeueAfter: defaultRequeueTime,
+		}}, nil
+	} else if err := cilium.CheckDaemonSetRolledOut(installation.DaemonSet); err != nil {
+		logger.Info("Cilium DS is not rolled out yet, requeueing", "reason", err.Error())
+		return controller.Result{Result: &ctrl.Result{
+			RequeueAfter: defaultRequeueTime,
+		}}, nil
 	} else {
 		logger.Info("Cilium is already up to date")
+		// Services are handled by kube-proxy until Cilium is rolled out with strict replacement.
+		if cilium.ReplacesKubeProxy(spec) {
+			if err := deleteKubeProxy(ctx, client); err != nil {
+				return controller.Result{}, err
+			}
+		}
 	}
 
 	return r.deletePreflightIfExists(ctx, client, spec)
 }
 
+// deleteKubeProxy removes the kube-proxy installed by kubeadm once Cilium replaces it.
+func deleteKubeProxy(ctx context.Context, c client.Client) error {
+	objs := []client.Object{
+		&appsv1.DaemonSet{ObjectMeta: metav1.ObjectMeta{Name: cilium.KubeProxyName, Namespace: constants.KubeSystemNamespace}},
+		&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: cilium.KubeProxyName, Namespace: constants.KubeSystemNamespace}},
+	}
+	for _, obj := range objs {
+		if err := deleteIfExists(ctx, c, obj); err != nil {
+			return errors.Wrap(err, "deleting kube-proxy")
+		}
+	}
+
+	return nil
+}
+
 func (r *Reconciler) install(ctx context.Context, log logr.Logger, client client.Client, spec *cluster.Spec) (controller.Result, error) {
 	log.Info("Installing Cilium")
 	if err := r.applyFullManifest(ctx, client, spec); err != nil {
@@ -153,11 +190,32 @@ func (r *Reconciler) upgrade(ctx context.Context, logger logr.Logger, client cli
 	return controller.Result{}, nil
 }
 
-func (r *Reconciler) updateConfig(ctx context.Context, client client.Client, spec *cluster.Spec) error {
+func (r *Reconciler) updateConfig(ctx context.Context, client client.Client, installation *cilium.Installation, spec *cluster.Spec) error {
 	if err := r.applyFullManifest(ctx, client, spec); err != nil {
 		return errors.Wrap(err, "updating cilium config")
 	}
 
+	// Applying the manifest doesn't remove the Hubble components that were disabled.
+	hubble := spec.Cluster.Spec.ClusterNetwork.CNIConfig.Cilium.Hubble
+	if installation.HubbleRelay != nil && (hubble == nil || !hubble.Relay) {
+		if err := deleteIfExists(ctx, client, installation.HubbleRelay); err != nil {
+			return errors.Wrap(err, "deleting hubble relay")
+		}
+	}
+	if installation.HubbleUI != nil && (hubble == nil || !hubble.UI) {
+		if err := deleteIfExists(ctx, client, installation.HubbleUI); err != nil {
+			return errors.Wrap(err, "deleting hubble ui")
+		}
+	}
+
+	return nil
+}
+
+func deleteIfExists(ctx context.Context, c client.Client, obj client.Object) error {
+	if err := c.Delete(ctx, obj); err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+
 	return nil
 }
 
@@ -66,6 +66,7 @@ func TestReconcilerReconcileAlreadyUpToDate(t *testing.T) {
 	operator := ciliumOperator()
 	cm := ciliumConfigMap()
 	tt := newReconcileTest(t).withObjects(ds, operator, cm)
+	tt.makeCiliumDaemonSetReady()
 
 	tt.Expect(tt.reconciler.Reconcile(tt.ctx, test.NewNullLogger(), tt.client, tt.spec)).To(
 		Equal(controller.Result{}),
@@ -74,6 +75,23 @@ func TestReconcilerReconcileAlreadyUpToDate(t *testing.T) {
 	tt.expectOperatorSemanticallyEqual(operator)
 }
 
+func TestReconcilerReconcileAlreadyUpToDateStrictKubeProxyReplacement(t *testing.T) {
+	ds := ciliumDaemonSet()
+	operator := ciliumOperator()
+	cm := ciliumConfigMap()
+	cm.Data[cilium.KubeProxyReplacementConfigMapKey] = "strict"
+	kubeProxy := simpleDaemonSet(cilium.KubeProxyName, "kube-proxy:v1.23.7-eks-1-23-4")
+	tt := newReconcileTest(t).withObjects(ds, operator, cm, kubeProxy)
+	tt.spec.Cluster.Spec.ClusterNetwork.CNIConfig.Cilium.KubeProxyReplacement = anywherev1.CiliumKubeProxyReplacementStrict
+	tt.makeCiliumDaemonSetReady()
+
+	tt.Expect(tt.reconciler.Reconcile(tt.ctx, test.NewNullLogger(), tt.client, tt.spec)).To(
+		Equal(controller.Result{}),
+	)
+	tt.expectDSToNotExist(cilium.KubeProxyName, "kube-system")
+	tt.expectDaemonSetSemanticallyEqual(ds)
+}
+
 func TestReconcilerReconcileAlreadyInDesiredVersionWithPreflight(t *testing.T) {
 	ds := ciliumDaemonSet()
 	operator := ciliumOperator()
@@ -87,6 +105,7 @@ func TestReconcilerReconcileAlreadyInDesiredVersionWithPreflight(t *testing.T) {
 	tt.templater.EXPECT().GenerateUpgradePreflightManifest(tt.ctx, tt.spec).Return(preflightManifest, nil)
 
 	tt.withObjects(ds, operator, preflightDS, preflightDeployment, cm)
+	tt.makeCiliumDaemonSetReady()
 
 	tt.Expect(tt.reconciler.Reconcile(tt.ctx, test.NewNullLogger(), tt.client, tt.spec)).To(
 		Equal(controller.Result{}),
@@ -109,6 +128,7 @@ func TestReconcilerReconcileAlreadyInDesiredVersionWithPreflightErrorFromTemplat
 	tt.templater.EXPECT().GenerateUpgradePreflightManifest(tt.ctx, tt.spec).Return(nil, errors.New("generating preflight"))
 
 	tt.withObjects(ds, operator, cm, preflightDS, preflightDeployment)
+	tt.makeCiliumDaemonSetReady()
 
 	result, err := tt.reconciler.Reconcile(tt.ctx, test.NewNullLogger(), tt.client, tt.spec)
 	tt.Expect(result).To(Equal(controller.Result{}))
@@ -127,6 +147,7 @@ func TestReconcilerReconcileAlreadyInDesiredVersionWithPreflightErrorDeletingYam
 	tt.templater.EXPECT().GenerateUpgradePreflightManifest(tt.ctx, tt.spec).Return([]byte("invalid yaml"), nil)
 
 	tt.withObjects(ds, operator, cm, preflightDS, preflightDeployment)
+	tt.makeCiliumDaemonSetReady()
 
 	result, err := tt.reconciler.Reconcile(tt.ctx, test.NewNullLogger(), tt.client, tt.spec)
 	tt.Expect(result).To(Equal(controller.Result{}))
@@ -403,7 +424,18 @@ func TestReconcilerReconcileUpdateConfigConfigMapEnablePolicyChange(t *testing.T
 	tt.templater.EXPECT().GenerateManifest(tt.ctx, tt.spec, gomock.Not(gomock.Nil())).Return(upgradeManifest, nil)
 
 	tt.Expect(tt.reconciler.Reconcile(tt.ctx, test.NewNullLogger(), tt.client, tt.spec)).To(
-		Equal(controller.Result{}),
+		Equal(controller.ResultWithRequeue(10 * time.Second)),
+	)
+}
+
+func TestReconcilerReconcileUpToDateButCiliumDaemonSetNotRolledOut(t *testing.T) {
+	ds := ciliumDaemonSet()
+	operator := ciliumOperator()
+	cm := ciliumConfigMap()
+	tt := newReconcileTest(t).withObjects(ds, operator, cm)
+
+	tt.Expect(tt.reconciler.Reconcile(tt.ctx, test.NewNullLogger(), tt.client, tt.spec)).To(
+		Equal(controller.ResultWithRequeue(10 * time.Second)),
 	)
 }
 
@@ -10,7 +10,7 @@ import (
 
 	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
 	"github.com/aws/eks-anywhere/pkg/cluster"
-	"github.com/aws/eks-anywhere/pkg/config"
+	"github.com/aws/eks-anywhere/pkg/registrymirror"
 	"github.com/aws/eks-anywhere/pkg/retrier"
 	"github.com/aws/eks-anywhere/pkg/semver"
 	"github.com/aws/eks-anywhere/pkg/templater"
@@ -22,6 +22,8 @@ var networkPolicyAllowAll string
 const (
 	maxRetries           = 10
 	defaultBackOffPeriod = 5 * time.Second
+
+	controlPlaneEndpointPort = 6443
 )
 
 type Helm interface {
@@ -124,7 +126,7 @@ func (t *Templater) GenerateManifest(ctx context.Context, spec *cluster.Spec, op
 
 	if spec.Cluster.Spec.RegistryMirrorConfiguration != nil {
 		if spec.Cluster.Spec.RegistryMirrorConfiguration.Authenticate {
-			username, password, err := config.ReadCredentials()
+			username, password, err := registrymirror.ReadCredentials(spec.RegistryCredentialsSecret)
 			if err != nil {
 				return nil, err
 			}
@@ -220,9 +222,55 @@ func templateValues(spec *cluster.Spec) values {
 		val["operator"].(values)["replicas"] = 1
 	}
 
-	if spec.Cluster.Spec.ClusterNetwork.CNIConfig.Cilium.PolicyEnforcementMode != "" {
-		val["policyEnforcementMode"] = spec.Cluster.Spec.ClusterNetwork.CNIConfig.Cilium.PolicyEnforcementMode
+	clusterNetwork := spec.Cluster.Spec.ClusterNetwork
+	if clusterNetwork.IPv6Enabled() {
+		val.set(true, "ipv6", "enabled")
+		if !clusterNetwork.IPv4Enabled() {
+			val.set(false, "ipv4", "enabled")
+		}
+	}
+
+	ciliumConfig := clusterNetwork.CNIConfig.Cilium
+	if ciliumConfig.PolicyEnforcementMode != "" {
+		val["policyEnforcementMode"] = ciliumConfig.PolicyEnforcementMode
+	}
+
+	if ciliumConfig.RoutingMode == anywherev1.CiliumRoutingModeNative {
+		val["tunnel"] = "disabled"
+		val["autoDirectNodeRoutes"] = true
+		val["ipv4NativeRoutingCIDR"] = ciliumConfig.IPv4NativeRoutingCIDR
+	}
+
+	if ciliumConfig.KubeProxyReplacement != "" {
+		val["kubeProxyReplacement"] = ciliumConfig.KubeProxyReplacement
+		if ciliumConfig.KubeProxyReplacement != anywherev1.CiliumKubeProxyReplacementDisabled {
+			// Without kube-proxy, Cilium can't rely on the kubernetes service to reach the API server
+			if endpoint := spec.Cluster.Spec.ControlPlaneConfiguration.Endpoint; endpoint != nil && endpoint.Host != "" {
+				val["k8sServiceHost"] = endpoint.Host
+				val["k8sServicePort"] = controlPlaneEndpointPort
+			}
+		}
 	}
+
+	if ciliumConfig.EgressMasqueradeInterfaces != "" {
+		val["egressMasqueradeInterfaces"] = ciliumConfig.EgressMasqueradeInterfaces
+	}
+
+	if ciliumConfig.EgressGateway {
+		val.set(true, "egressGateway", "enabled")
+		val.set(true, "bpf", "masquerade")
+	}
+
+	if ciliumConfig.BGPControlPlane {
+		val.set(true, "bgpControlPlane", "enabled")
+	}
+
+	if ciliumConfig.Hubble != nil {
+		val.set(true, "hubble", "enabled")
+		val.set(ciliumConfig.Hubble.Relay, "hubble", "relay", "enabled")
+		val.set(ciliumConfig.Hubble.UI, "hubble", "ui", "enabled")
+	}
+
 	return val
 }
 
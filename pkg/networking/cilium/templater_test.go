@@ -190,6 +190,76 @@ func TestTemplaterGenerateManifestSuccess(t *testing.T) {
 	tt.Expect(tt.t.GenerateManifest(tt.ctx, tt.spec)).To(Equal(tt.manifest), "templater.GenerateManifest() should return right manifest")
 }
 
+func TestTemplaterGenerateManifestIPv6Success(t *testing.T) {
+	tests := []struct {
+		name          string
+		podCidrBlocks []string
+		wantIPValues  map[string]interface{}
+	}{
+		{
+			name:          "dual-stack",
+			podCidrBlocks: []string{"192.168.0.0/16", "fd00:100:64::/48"},
+			wantIPValues: map[string]interface{}{
+				"ipv6": map[string]interface{}{
+					"enabled": true,
+				},
+			},
+		},
+		{
+			name:          "ipv6 only",
+			podCidrBlocks: []string{"fd00:100:64::/48"},
+			wantIPValues: map[string]interface{}{
+				"ipv4": map[string]interface{}{
+					"enabled": false,
+				},
+				"ipv6": map[string]interface{}{
+					"enabled": true,
+				},
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			wantValues := map[string]interface{}{
+				"cni": map[string]interface{}{
+					"chainingMode": "portmap",
+				},
+				"ipam": map[string]interface{}{
+					"mode": "kubernetes",
+				},
+				"identityAllocationMode": "crd",
+				"prometheus": map[string]interface{}{
+					"enabled": true,
+				},
+				"rollOutCiliumPods": true,
+				"tunnel":            "geneve",
+				"image": map[string]interface{}{
+					"repository": "public.ecr.aws/isovalent/cilium",
+					"tag":        "v1.9.11-eksa.1",
+				},
+				"operator": map[string]interface{}{
+					"image": map[string]interface{}{
+						"repository": "public.ecr.aws/isovalent/operator",
+						"tag":        "v1.9.11-eksa.1",
+					},
+					"prometheus": map[string]interface{}{
+						"enabled": true,
+					},
+				},
+			}
+			for k, v := range tc.wantIPValues {
+				wantValues[k] = v
+			}
+
+			tt := newtemplaterTest(t)
+			tt.spec.Cluster.Spec.ClusterNetwork.Pods.CidrBlocks = tc.podCidrBlocks
+			tt.expectHelmTemplateWith(eqMap(wantValues), "1.22").Return(tt.manifest, nil)
+
+			tt.Expect(tt.t.GenerateManifest(tt.ctx, tt.spec)).To(Equal(tt.manifest), "templater.GenerateManifest() should return right manifest")
+		})
+	}
+}
+
 func TestTemplaterGenerateManifestPolicyEnforcementModeSuccess(t *testing.T) {
 	wantValues := map[string]interface{}{
 		"cni": map[string]interface{}{
@@ -230,6 +300,77 @@ func TestTemplaterGenerateManifestPolicyEnforcementModeSuccess(t *testing.T) {
 	test.AssertContentToFile(t, string(gotManifest), "testdata/manifest_network_policy.yaml")
 }
 
+func TestTemplaterGenerateManifestCiliumAdvancedConfigSuccess(t *testing.T) {
+	wantValues := map[string]interface{}{
+		"cni": map[string]interface{}{
+			"chainingMode": "portmap",
+		},
+		"ipam": map[string]interface{}{
+			"mode": "kubernetes",
+		},
+		"identityAllocationMode": "crd",
+		"prometheus": map[string]interface{}{
+			"enabled": true,
+		},
+		"rollOutCiliumPods":     true,
+		"tunnel":                "disabled",
+		"autoDirectNodeRoutes":  true,
+		"ipv4NativeRoutingCIDR": "10.0.0.0/8",
+		"image": map[string]interface{}{
+			"repository": "public.ecr.aws/isovalent/cilium",
+			"tag":        "v1.9.11-eksa.1",
+		},
+		"operator": map[string]interface{}{
+			"image": map[string]interface{}{
+				"repository": "public.ecr.aws/isovalent/operator",
+				"tag":        "v1.9.11-eksa.1",
+			},
+			"prometheus": map[string]interface{}{
+				"enabled": true,
+			},
+		},
+		"kubeProxyReplacement":       "strict",
+		"k8sServiceHost":             "1.2.3.4",
+		"k8sServicePort":             float64(6443),
+		"egressMasqueradeInterfaces": "eth0",
+		"egressGateway": map[string]interface{}{
+			"enabled": true,
+		},
+		"bpf": map[string]interface{}{
+			"masquerade": true,
+		},
+		"bgpControlPlane": map[string]interface{}{
+			"enabled": true,
+		},
+		"hubble": map[string]interface{}{
+			"enabled": true,
+			"relay": map[string]interface{}{
+				"enabled": true,
+			},
+			"ui": map[string]interface{}{
+				"enabled": false,
+			},
+		},
+	}
+
+	tt := newtemplaterTest(t)
+	tt.spec.Cluster.Spec.ControlPlaneConfiguration.Endpoint = &v1alpha1.Endpoint{Host: "1.2.3.4"}
+	tt.spec.Cluster.Spec.ClusterNetwork.CNIConfig.Cilium = &v1alpha1.CiliumConfig{
+		RoutingMode:                v1alpha1.CiliumRoutingModeNative,
+		IPv4NativeRoutingCIDR:      "10.0.0.0/8",
+		KubeProxyReplacement:       v1alpha1.CiliumKubeProxyReplacementStrict,
+		EgressMasqueradeInterfaces: "eth0",
+		EgressGateway:              true,
+		BGPControlPlane:            true,
+		Hubble: &v1alpha1.CiliumHubbleConfig{
+			Relay: true,
+		},
+	}
+	tt.expectHelmTemplateWith(eqMap(wantValues), "1.22").Return(tt.manifest, nil)
+
+	tt.Expect(tt.t.GenerateManifest(tt.ctx, tt.spec)).To(Equal(tt.manifest), "templater.GenerateManifest() should return right manifest")
+}
+
 func TestTemplaterGenerateManifestError(t *testing.T) {
 	expectedAttempts := 2
 	tt := newtemplaterTest(t)
@@ -2,11 +2,13 @@ package cilium
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
 	"github.com/aws/eks-anywhere/pkg/cluster"
 )
 
@@ -18,6 +20,66 @@ const (
 	// PolicyEnforcementComponentName is the ConfigComponentUpdatePlan name for the
 	// PolicyEnforcement configuration component.
 	PolicyEnforcementComponentName = "PolicyEnforcementMode"
+
+	// TunnelConfigMapKey is the key used in the "cilium-config" ConfigMap to
+	// store the tunnel mode, which reflects the RoutingMode.
+	TunnelConfigMapKey = "tunnel"
+	// IPv4NativeRoutingCIDRConfigMapKey is the key used in the "cilium-config" ConfigMap to
+	// store the value for the IPv4NativeRoutingCIDR.
+	IPv4NativeRoutingCIDRConfigMapKey = "ipv4-native-routing-cidr"
+	// KubeProxyReplacementConfigMapKey is the key used in the "cilium-config" ConfigMap to
+	// store the value for the KubeProxyReplacement.
+	KubeProxyReplacementConfigMapKey = "kube-proxy-replacement"
+	// EgressMasqueradeInterfacesConfigMapKey is the key used in the "cilium-config" ConfigMap to
+	// store the value for the EgressMasqueradeInterfaces.
+	EgressMasqueradeInterfacesConfigMapKey = "egress-masquerade-interfaces"
+	// EgressGatewayConfigMapKey is the key used in the "cilium-config" ConfigMap to
+	// store if the egress gateway is enabled.
+	EgressGatewayConfigMapKey = "enable-ipv4-egress-gateway"
+	// BGPControlPlaneConfigMapKey is the key used in the "cilium-config" ConfigMap to
+	// store if the BGP control plane is enabled.
+	BGPControlPlaneConfigMapKey = "enable-bgp-control-plane"
+	// HubbleConfigMapKey is the key used in the "cilium-config" ConfigMap to
+	// store if Hubble is enabled.
+	HubbleConfigMapKey = "enable-hubble"
+
+	// RoutingModeComponentName is the ConfigComponentUpdatePlan name for the
+	// RoutingMode configuration component.
+	RoutingModeComponentName = "RoutingMode"
+	// IPv4NativeRoutingCIDRComponentName is the ConfigComponentUpdatePlan name for the
+	// IPv4NativeRoutingCIDR configuration component.
+	IPv4NativeRoutingCIDRComponentName = "IPv4NativeRoutingCIDR"
+	// KubeProxyReplacementComponentName is the ConfigComponentUpdatePlan name for the
+	// KubeProxyReplacement configuration component.
+	KubeProxyReplacementComponentName = "KubeProxyReplacement"
+	// EgressMasqueradeInterfacesComponentName is the ConfigComponentUpdatePlan name for the
+	// EgressMasqueradeInterfaces configuration component.
+	EgressMasqueradeInterfacesComponentName = "EgressMasqueradeInterfaces"
+	// EgressGatewayComponentName is the ConfigComponentUpdatePlan name for the
+	// EgressGateway configuration component.
+	EgressGatewayComponentName = "EgressGateway"
+	// BGPControlPlaneComponentName is the ConfigComponentUpdatePlan name for the
+	// BGPControlPlane configuration component.
+	BGPControlPlaneComponentName = "BGPControlPlane"
+	// HubbleComponentName is the ConfigComponentUpdatePlan name for the
+	// Hubble configuration component.
+	HubbleComponentName = "Hubble"
+	// HubbleRelayComponentName is the ConfigComponentUpdatePlan name for the
+	// Hubble Relay deployment.
+	HubbleRelayComponentName = "HubbleRelay"
+	// HubbleUIComponentName is the ConfigComponentUpdatePlan name for the
+	// Hubble UI deployment.
+	HubbleUIComponentName = "HubbleUI"
+)
+
+// Values used by the Cilium chart when the matching field is not set in the cluster spec.
+// Installations rendered by older EKS-A versions don't have all the keys in their ConfigMap,
+// so a missing key is compared as its default value.
+const (
+	defaultTunnel               = "geneve"
+	defaultKubeProxyReplacement = "probe"
+	defaultEnabled              = "true"
+	defaultDisabled             = "false"
 )
 
 // UpgradePlan contains information about a Cilium installation upgrade.
@@ -129,7 +191,7 @@ func BuildUpgradePlan(installation *Installation, clusterSpec *cluster.Spec) Upg
 	return UpgradePlan{
 		DaemonSet: daemonSetUpgradePlan(installation.DaemonSet, clusterSpec),
 		Operator:  operatorUpgradePlan(installation.Operator, clusterSpec),
-		ConfigMap: configMapUpgradePlan(installation.ConfigMap, clusterSpec),
+		ConfigMap: configUpgradePlan(installation, clusterSpec),
 	}
 }
 
@@ -188,7 +250,8 @@ func operatorUpgradePlan(operator *appsv1.Deployment, clusterSpec *cluster.Spec)
 	return info
 }
 
-func configMapUpgradePlan(configMap *corev1.ConfigMap, clusterSpec *cluster.Spec) ConfigUpdatePlan {
+func configUpgradePlan(installation *Installation, clusterSpec *cluster.Spec) ConfigUpdatePlan {
+	configMap := installation.ConfigMap
 	updatePlan := &ConfigUpdatePlan{}
 
 	var newEnforcementPolicy string
@@ -215,7 +278,96 @@ func configMapUpgradePlan(configMap *corev1.ConfigMap, clusterSpec *cluster.Spec
 	}
 
 	updatePlan.Components = append(updatePlan.Components, policyEnforcementUpdate)
+
+	// The following fields are compared in both directions: they are part of the plan when either
+	// the cluster spec or the installation differ from the chart default, so turning a
+	// feature off is detected as well as turning it on.
+	ciliumConfig := clusterSpec.Cluster.Spec.ClusterNetwork.CNIConfig.Cilium
+	tunnel, nativeRoutingCIDR := defaultTunnel, ""
+	if ciliumConfig.RoutingMode == anywherev1.CiliumRoutingModeNative {
+		tunnel, nativeRoutingCIDR = "disabled", ciliumConfig.IPv4NativeRoutingCIDR
+	}
+	kubeProxyReplacement := defaultKubeProxyReplacement
+	if ciliumConfig.KubeProxyReplacement != "" {
+		kubeProxyReplacement = string(ciliumConfig.KubeProxyReplacement)
+	}
+
+	components := []ConfigComponentUpdatePlan{
+		configMapComponentUpdatePlan(configMap, RoutingModeComponentName, TunnelConfigMapKey, tunnel, defaultTunnel),
+		configMapComponentUpdatePlan(configMap, IPv4NativeRoutingCIDRComponentName, IPv4NativeRoutingCIDRConfigMapKey, nativeRoutingCIDR, ""),
+		configMapComponentUpdatePlan(configMap, KubeProxyReplacementComponentName, KubeProxyReplacementConfigMapKey, kubeProxyReplacement, defaultKubeProxyReplacement),
+		configMapComponentUpdatePlan(configMap, EgressMasqueradeInterfacesComponentName, EgressMasqueradeInterfacesConfigMapKey, ciliumConfig.EgressMasqueradeInterfaces, ""),
+		configMapComponentUpdatePlan(configMap, EgressGatewayComponentName, EgressGatewayConfigMapKey, strconv.FormatBool(ciliumConfig.EgressGateway), defaultDisabled),
+		configMapComponentUpdatePlan(configMap, BGPControlPlaneComponentName, BGPControlPlaneConfigMapKey, strconv.FormatBool(ciliumConfig.BGPControlPlane), defaultDisabled),
+		// The spec can't disable Hubble: the templater enables it when it's configured and the chart
+		// enables it by default otherwise.
+		configMapComponentUpdatePlan(configMap, HubbleComponentName, HubbleConfigMapKey, defaultEnabled, defaultEnabled),
+		deploymentComponentUpdatePlan(installation.HubbleRelay, HubbleRelayComponentName, HubbleRelayDeploymentName, ciliumConfig.Hubble != nil && ciliumConfig.Hubble.Relay),
+		deploymentComponentUpdatePlan(installation.HubbleUI, HubbleUIComponentName, HubbleUIDeploymentName, ciliumConfig.Hubble != nil && ciliumConfig.Hubble.UI),
+	}
+	for _, c := range components {
+		if c.Name != "" {
+			updatePlan.Components = append(updatePlan.Components, c)
+		}
+	}
+
 	updatePlan.generateUpdateReasonFromComponents()
 
 	return *updatePlan
 }
+
+// configMapComponentUpdatePlan compares the value of key in the Cilium ConfigMap with newValue.
+// A missing key is compared as defaultValue. It returns an empty plan when both the current and
+// the new value are the default, since there is nothing to compare.
+func configMapComponentUpdatePlan(configMap *corev1.ConfigMap, name, key, newValue, defaultValue string) ConfigComponentUpdatePlan {
+	oldValue := ""
+	if configMap != nil {
+		oldValue = configMap.Data[key]
+	}
+	currentValue := oldValue
+	if currentValue == "" {
+		currentValue = defaultValue
+	}
+
+	if currentValue == defaultValue && newValue == defaultValue {
+		return ConfigComponentUpdatePlan{}
+	}
+
+	update := ConfigComponentUpdatePlan{
+		Name:     name,
+		OldValue: oldValue,
+		NewValue: newValue,
+	}
+
+	if configMap == nil || currentValue == newValue {
+		return update
+	}
+
+	if oldValue == "" {
+		update.UpdateReason = fmt.Sprintf("Cilium %s field is not present in config", key)
+	} else {
+		update.UpdateReason = fmt.Sprintf("Cilium %s changed: [%s] -> [%s]", key, oldValue, newValue)
+	}
+
+	return update
+}
+
+// deploymentComponentUpdatePlan compares if an optional Cilium deployment is installed with
+// whether it is enabled in the cluster spec.
+func deploymentComponentUpdatePlan(deployment *appsv1.Deployment, name, deploymentName string, enabled bool) ConfigComponentUpdatePlan {
+	installed := deployment != nil
+	if !installed && !enabled {
+		return ConfigComponentUpdatePlan{}
+	}
+
+	update := ConfigComponentUpdatePlan{
+		Name:     name,
+		OldValue: strconv.FormatBool(installed),
+		NewValue: strconv.FormatBool(enabled),
+	}
+	if installed != enabled {
+		update.UpdateReason = fmt.Sprintf("Cilium %s deployment enabled changed: [%t] -> [%t]", deploymentName, installed, enabled)
+	}
+
+	return update
+}
@@ -380,6 +380,239 @@ func TestBuildUpgradePlan(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "RoutingMode and KubeProxyReplacement have changed",
+			installation: &cilium.Installation{
+				DaemonSet: daemonSet("cilium:v1.0.0"),
+				Operator:  deployment("cilium-operator:v1.0.0"),
+				ConfigMap: ciliumConfigMap("default", func(cm *corev1.ConfigMap) {
+					cm.Data[cilium.TunnelConfigMapKey] = "geneve"
+					cm.Data[cilium.KubeProxyReplacementConfigMapKey] = "probe"
+				}),
+			},
+			clusterSpec: test.NewClusterSpec(func(s *cluster.Spec) {
+				s.VersionsBundle.Cilium.Cilium.URI = "cilium:v1.0.0"
+				s.VersionsBundle.Cilium.Operator.URI = "cilium-operator:v1.0.0"
+				s.Cluster.Spec.ClusterNetwork.CNIConfig = &anywherev1.CNIConfig{
+					Cilium: &anywherev1.CiliumConfig{
+						RoutingMode:           anywherev1.CiliumRoutingModeNative,
+						IPv4NativeRoutingCIDR: "10.0.0.0/8",
+						KubeProxyReplacement:  anywherev1.CiliumKubeProxyReplacementStrict,
+					},
+				}
+			}),
+			want: cilium.UpgradePlan{
+				DaemonSet: cilium.VersionedComponentUpgradePlan{
+					OldImage: "cilium:v1.0.0",
+					NewImage: "cilium:v1.0.0",
+				},
+				Operator: cilium.VersionedComponentUpgradePlan{
+					OldImage: "cilium-operator:v1.0.0",
+					NewImage: "cilium-operator:v1.0.0",
+				},
+				ConfigMap: cilium.ConfigUpdatePlan{
+					UpdateReason: "Cilium tunnel changed: [geneve] -> [disabled] - Cilium ipv4-native-routing-cidr field is not present in config - Cilium kube-proxy-replacement changed: [probe] -> [strict]",
+					Components: []cilium.ConfigComponentUpdatePlan{
+						{
+							Name:     cilium.PolicyEnforcementComponentName,
+							OldValue: "default",
+							NewValue: "default",
+						},
+						{
+							Name:         cilium.RoutingModeComponentName,
+							OldValue:     "geneve",
+							NewValue:     "disabled",
+							UpdateReason: "Cilium tunnel changed: [geneve] -> [disabled]",
+						},
+						{
+							Name:         cilium.IPv4NativeRoutingCIDRComponentName,
+							NewValue:     "10.0.0.0/8",
+							UpdateReason: "Cilium ipv4-native-routing-cidr field is not present in config",
+						},
+						{
+							Name:         cilium.KubeProxyReplacementComponentName,
+							OldValue:     "probe",
+							NewValue:     "strict",
+							UpdateReason: "Cilium kube-proxy-replacement changed: [probe] -> [strict]",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "EgressGateway, BGP and masquerade interfaces up to date",
+			installation: &cilium.Installation{
+				DaemonSet: daemonSet("cilium:v1.0.0"),
+				Operator:  deployment("cilium-operator:v1.0.0"),
+				ConfigMap: ciliumConfigMap("default", func(cm *corev1.ConfigMap) {
+					cm.Data[cilium.TunnelConfigMapKey] = "geneve"
+					cm.Data[cilium.EgressGatewayConfigMapKey] = "true"
+					cm.Data[cilium.BGPControlPlaneConfigMapKey] = "true"
+					cm.Data[cilium.EgressMasqueradeInterfacesConfigMapKey] = "eth0"
+				}),
+			},
+			clusterSpec: test.NewClusterSpec(func(s *cluster.Spec) {
+				s.VersionsBundle.Cilium.Cilium.URI = "cilium:v1.0.0"
+				s.VersionsBundle.Cilium.Operator.URI = "cilium-operator:v1.0.0"
+				s.Cluster.Spec.ClusterNetwork.CNIConfig = &anywherev1.CNIConfig{
+					Cilium: &anywherev1.CiliumConfig{
+						RoutingMode:                anywherev1.CiliumRoutingModeTunnel,
+						EgressMasqueradeInterfaces: "eth0",
+						EgressGateway:              true,
+						BGPControlPlane:            true,
+					},
+				}
+			}),
+			want: cilium.UpgradePlan{
+				DaemonSet: cilium.VersionedComponentUpgradePlan{
+					OldImage: "cilium:v1.0.0",
+					NewImage: "cilium:v1.0.0",
+				},
+				Operator: cilium.VersionedComponentUpgradePlan{
+					OldImage: "cilium-operator:v1.0.0",
+					NewImage: "cilium-operator:v1.0.0",
+				},
+				ConfigMap: cilium.ConfigUpdatePlan{
+					Components: []cilium.ConfigComponentUpdatePlan{
+						{
+							Name:     cilium.PolicyEnforcementComponentName,
+							OldValue: "default",
+							NewValue: "default",
+						},
+						{
+							Name:     cilium.EgressMasqueradeInterfacesComponentName,
+							OldValue: "eth0",
+							NewValue: "eth0",
+						},
+						{
+							Name:     cilium.EgressGatewayComponentName,
+							OldValue: "true",
+							NewValue: "true",
+						},
+						{
+							Name:     cilium.BGPControlPlaneComponentName,
+							OldValue: "true",
+							NewValue: "true",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "EgressGateway, BGP and kube-proxy replacement disabled",
+			installation: &cilium.Installation{
+				DaemonSet: daemonSet("cilium:v1.0.0"),
+				Operator:  deployment("cilium-operator:v1.0.0"),
+				ConfigMap: ciliumConfigMap("default", func(cm *corev1.ConfigMap) {
+					cm.Data[cilium.KubeProxyReplacementConfigMapKey] = "strict"
+					cm.Data[cilium.EgressGatewayConfigMapKey] = "true"
+					cm.Data[cilium.BGPControlPlaneConfigMapKey] = "true"
+				}),
+			},
+			clusterSpec: test.NewClusterSpec(func(s *cluster.Spec) {
+				s.VersionsBundle.Cilium.Cilium.URI = "cilium:v1.0.0"
+				s.VersionsBundle.Cilium.Operator.URI = "cilium-operator:v1.0.0"
+				s.Cluster.Spec.ClusterNetwork.CNIConfig = &anywherev1.CNIConfig{
+					Cilium: &anywherev1.CiliumConfig{},
+				}
+			}),
+			want: cilium.UpgradePlan{
+				DaemonSet: cilium.VersionedComponentUpgradePlan{
+					OldImage: "cilium:v1.0.0",
+					NewImage: "cilium:v1.0.0",
+				},
+				Operator: cilium.VersionedComponentUpgradePlan{
+					OldImage: "cilium-operator:v1.0.0",
+					NewImage: "cilium-operator:v1.0.0",
+				},
+				ConfigMap: cilium.ConfigUpdatePlan{
+					UpdateReason: "Cilium kube-proxy-replacement changed: [strict] -> [probe] - Cilium enable-ipv4-egress-gateway changed: [true] -> [false] - Cilium enable-bgp-control-plane changed: [true] -> [false]",
+					Components: []cilium.ConfigComponentUpdatePlan{
+						{
+							Name:     cilium.PolicyEnforcementComponentName,
+							OldValue: "default",
+							NewValue: "default",
+						},
+						{
+							Name:         cilium.KubeProxyReplacementComponentName,
+							OldValue:     "strict",
+							NewValue:     "probe",
+							UpdateReason: "Cilium kube-proxy-replacement changed: [strict] -> [probe]",
+						},
+						{
+							Name:         cilium.EgressGatewayComponentName,
+							OldValue:     "true",
+							NewValue:     "false",
+							UpdateReason: "Cilium enable-ipv4-egress-gateway changed: [true] -> [false]",
+						},
+						{
+							Name:         cilium.BGPControlPlaneComponentName,
+							OldValue:     "true",
+							NewValue:     "false",
+							UpdateReason: "Cilium enable-bgp-control-plane changed: [true] -> [false]",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Hubble relay enabled and UI disabled",
+			installation: &cilium.Installation{
+				DaemonSet: daemonSet("cilium:v1.0.0"),
+				Operator:  deployment("cilium-operator:v1.0.0"),
+				ConfigMap: ciliumConfigMap("default", func(cm *corev1.ConfigMap) {
+					cm.Data[cilium.HubbleConfigMapKey] = "false"
+				}),
+				HubbleUI: deployment("hubble-ui:v1.0.0"),
+			},
+			clusterSpec: test.NewClusterSpec(func(s *cluster.Spec) {
+				s.VersionsBundle.Cilium.Cilium.URI = "cilium:v1.0.0"
+				s.VersionsBundle.Cilium.Operator.URI = "cilium-operator:v1.0.0"
+				s.Cluster.Spec.ClusterNetwork.CNIConfig = &anywherev1.CNIConfig{
+					Cilium: &anywherev1.CiliumConfig{
+						Hubble: &anywherev1.CiliumHubbleConfig{Relay: true},
+					},
+				}
+			}),
+			want: cilium.UpgradePlan{
+				DaemonSet: cilium.VersionedComponentUpgradePlan{
+					OldImage: "cilium:v1.0.0",
+					NewImage: "cilium:v1.0.0",
+				},
+				Operator: cilium.VersionedComponentUpgradePlan{
+					OldImage: "cilium-operator:v1.0.0",
+					NewImage: "cilium-operator:v1.0.0",
+				},
+				ConfigMap: cilium.ConfigUpdatePlan{
+					UpdateReason: "Cilium enable-hubble changed: [false] -> [true] - Cilium hubble-relay deployment enabled changed: [false] -> [true] - Cilium hubble-ui deployment enabled changed: [true] -> [false]",
+					Components: []cilium.ConfigComponentUpdatePlan{
+						{
+							Name:     cilium.PolicyEnforcementComponentName,
+							OldValue: "default",
+							NewValue: "default",
+						},
+						{
+							Name:         cilium.HubbleComponentName,
+							OldValue:     "false",
+							NewValue:     "true",
+							UpdateReason: "Cilium enable-hubble changed: [false] -> [true]",
+						},
+						{
+							Name:         cilium.HubbleRelayComponentName,
+							OldValue:     "false",
+							NewValue:     "true",
+							UpdateReason: "Cilium hubble-relay deployment enabled changed: [false] -> [true]",
+						},
+						{
+							Name:         cilium.HubbleUIComponentName,
+							OldValue:     "true",
+							NewValue:     "false",
+							UpdateReason: "Cilium hubble-ui deployment enabled changed: [true] -> [false]",
+						},
+					},
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -20,6 +20,7 @@ type KubernetesClient interface {
 	WaitForCiliumDaemonSet(ctx context.Context, cluster *types.Cluster) error
 	WaitForCiliumDeployment(ctx context.Context, cluster *types.Cluster) error
 	RolloutRestartCiliumDaemonSet(ctx context.Context, cluster *types.Cluster) error
+	DeleteKubeProxy(ctx context.Context, cluster *types.Cluster) error
 }
 
 // UpgradeTemplater generates a Cilium manifests for upgrade.
@@ -54,6 +55,11 @@ func (u *Upgrader) Upgrade(ctx context.Context, cluster *types.Cluster, currentS
 	if diff != nil {
 		logger.V(1).Info("Upgrading Cilium", "oldVersion", diff.ComponentReports[0].OldVersion, "newVersion", diff.ComponentReports[0].NewVersion)
 	}
+	if chartValuesChanged {
+		// The manifest is generated with rollOutCiliumPods, so any config change restarts the Cilium agents.
+		// waitForCilium waits for the rollout to complete.
+		logger.Info("Cilium configuration changed, Cilium pods will be rolled out")
+	}
 	logger.V(4).Info("Generating Cilium upgrade preflight manifest")
 	preflight, err := u.templater.GenerateUpgradePreflightManifest(ctx, newSpec)
 	if err != nil {
@@ -105,6 +111,13 @@ func (u *Upgrader) Upgrade(ctx context.Context, cluster *types.Cluster, currentS
 		return nil, err
 	}
 
+	if ReplacesKubeProxy(newSpec) {
+		logger.V(3).Info("Removing kube-proxy, Cilium replaces it")
+		if err := u.client.DeleteKubeProxy(ctx, cluster); err != nil {
+			return nil, fmt.Errorf("removing kube-proxy: %v", err)
+		}
+	}
+
 	return diff, nil
 }
 
@@ -160,11 +173,10 @@ func ciliumHelmChartValuesChanged(currentSpec, newSpec *cluster.Spec) bool {
 			return true
 		}
 	} else {
-		if newSpec.Cluster.Spec.ClusterNetwork.CNIConfig.Cilium.PolicyEnforcementMode != currentSpec.Cluster.Spec.ClusterNetwork.CNIConfig.Cilium.PolicyEnforcementMode {
+		if !newSpec.Cluster.Spec.ClusterNetwork.CNIConfig.Cilium.Equal(currentSpec.Cluster.Spec.ClusterNetwork.CNIConfig.Cilium) {
 			return true
 		}
 	}
-	// we can add comparisons for more values here as we start accepting them from cluster spec
 	return false
 }
 
@@ -0,0 +1,80 @@
+package custom
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/aws/eks-anywhere/pkg/retrier"
+	"github.com/aws/eks-anywhere/pkg/types"
+)
+
+// Client allows to interact with the Kubernetes API.
+type Client interface {
+	ApplyKubeSpecFromBytes(ctx context.Context, cluster *types.Cluster, data []byte) error
+	GetControlPlaneNodes(ctx context.Context, kubeconfig string) ([]corev1.Node, error)
+}
+
+// RetrierClient wraps basic kubernetes API operations around a retrier.
+type RetrierClient struct {
+	Client
+	*retrier.Retrier
+}
+
+// NewRetrier constructs a new RetrierClient.
+func NewRetrier(client Client) *RetrierClient {
+	return &RetrierClient{
+		Client:  client,
+		Retrier: retrier.New(10 * time.Minute),
+	}
+}
+
+// Apply creates/updates the objects provided by the yaml document in the cluster.
+func (c *RetrierClient) Apply(ctx context.Context, cluster *types.Cluster, data []byte) error {
+	return c.Retry(
+		func() error {
+			return c.ApplyKubeSpecFromBytes(ctx, cluster, data)
+		},
+	)
+}
+
+// WaitForControlPlaneNodesReady blocks until all control plane nodes report Ready or
+// until the timeout expires. Kubelet doesn't report a node as Ready until a CNI is configured,
+// so this is used as the readiness signal for CNIs not managed by EKS-A.
+func (c *RetrierClient) WaitForControlPlaneNodesReady(ctx context.Context, cluster *types.Cluster) error {
+	return c.Retry(
+		func() error {
+			return c.checkControlPlaneNodesReady(ctx, cluster)
+		},
+	)
+}
+
+func (c *RetrierClient) checkControlPlaneNodesReady(ctx context.Context, cluster *types.Cluster) error {
+	nodes, err := c.GetControlPlaneNodes(ctx, cluster.KubeconfigFile)
+	if err != nil {
+		return err
+	}
+
+	if len(nodes) == 0 {
+		return fmt.Errorf("no control plane nodes found")
+	}
+
+	for _, node := range nodes {
+		if !nodeReady(node) {
+			return fmt.Errorf("node %s is not ready", node.Name)
+		}
+	}
+
+	return nil
+}
+
+func nodeReady(node corev1.Node) bool {
+	for _, c := range node.Status.Conditions {
+		if c.Type == corev1.NodeReady {
+			return c.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
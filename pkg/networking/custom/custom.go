@@ -0,0 +1,28 @@
+package custom
+
+import (
+	"context"
+
+	"github.com/aws/eks-anywhere/pkg/cluster"
+	"github.com/aws/eks-anywhere/pkg/types"
+)
+
+// Custom handles clusters using a CNI not managed by EKS-A. It only installs
+// the user supplied manifest or chart on create and waits for it to be ready.
+type Custom struct {
+	*Upgrader
+	*Installer
+}
+
+// NewCustom constructs a new Custom.
+func NewCustom(client *RetrierClient, helm Helm) *Custom {
+	return &Custom{
+		Installer: NewInstaller(client, helm),
+		Upgrader:  NewUpgrader(client),
+	}
+}
+
+// Install installs the custom CNI, if configured, and waits for it to be ready.
+func (c *Custom) Install(ctx context.Context, cluster *types.Cluster, spec *cluster.Spec, _ []string) error {
+	return c.Installer.Install(ctx, cluster, spec)
+}
@@ -0,0 +1,193 @@
+package custom_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/aws/eks-anywhere/internal/test"
+	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/cluster"
+	"github.com/aws/eks-anywhere/pkg/networking/custom"
+	"github.com/aws/eks-anywhere/pkg/networking/custom/mocks"
+	"github.com/aws/eks-anywhere/pkg/retrier"
+	"github.com/aws/eks-anywhere/pkg/types"
+)
+
+type customTest struct {
+	*WithT
+	ctx     context.Context
+	c       *custom.Custom
+	cluster *types.Cluster
+	client  *mocks.MockClient
+	helm    *mocks.MockHelm
+	spec    *cluster.Spec
+}
+
+func newCustomTest(t *testing.T) *customTest {
+	ctrl := gomock.NewController(t)
+	client := mocks.NewMockClient(ctrl)
+	helm := mocks.NewMockHelm(ctrl)
+	retrierClient := custom.NewRetrier(client)
+	retrierClient.Retrier = retrier.NewWithMaxRetries(1, 0)
+	return &customTest{
+		WithT:  NewWithT(t),
+		ctx:    context.Background(),
+		client: client,
+		helm:   helm,
+		cluster: &types.Cluster{
+			Name:           "w-cluster",
+			KubeconfigFile: "config.kubeconfig",
+		},
+		c: custom.NewCustom(retrierClient, helm),
+		spec: test.NewClusterSpec(func(s *cluster.Spec) {
+			s.Cluster.Spec.ClusterNetwork.CNIConfig = &v1alpha1.CNIConfig{
+				Custom: &v1alpha1.CustomCNIConfig{},
+			}
+		}),
+	}
+}
+
+func (tt *customTest) expectNodesReady(status corev1.ConditionStatus) {
+	tt.client.EXPECT().GetControlPlaneNodes(tt.ctx, tt.cluster.KubeconfigFile).Return(
+		[]corev1.Node{
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "cp-1"},
+				Status: corev1.NodeStatus{
+					Conditions: []corev1.NodeCondition{
+						{Type: corev1.NodeReady, Status: status},
+					},
+				},
+			},
+		}, nil,
+	)
+}
+
+func TestCustomInstallNoManifestSuccess(t *testing.T) {
+	tt := newCustomTest(t)
+	tt.expectNodesReady(corev1.ConditionTrue)
+
+	tt.Expect(tt.c.Install(tt.ctx, tt.cluster, tt.spec, nil)).To(Succeed())
+}
+
+func TestCustomInstallManifestSuccess(t *testing.T) {
+	tt := newCustomTest(t)
+	tt.spec.Cluster.Spec.ClusterNetwork.CNIConfig.Custom.ManifestPath = "testdata/custom_cni_manifest.yaml"
+	tt.client.EXPECT().ApplyKubeSpecFromBytes(
+		tt.ctx,
+		tt.cluster,
+		test.MatchFile("testdata/custom_cni_manifest.yaml"),
+	)
+	tt.expectNodesReady(corev1.ConditionTrue)
+
+	tt.Expect(tt.c.Install(tt.ctx, tt.cluster, tt.spec, nil)).To(Succeed())
+}
+
+func TestCustomInstallErrorReadingManifest(t *testing.T) {
+	tt := newCustomTest(t)
+	tt.spec.Cluster.Spec.ClusterNetwork.CNIConfig.Custom.ManifestPath = "testdata/missing.yaml"
+
+	tt.Expect(tt.c.Install(tt.ctx, tt.cluster, tt.spec, nil)).To(
+		MatchError(ContainSubstring("reading custom cni manifest")),
+	)
+}
+
+func TestCustomInstallErrorApplyingManifest(t *testing.T) {
+	tt := newCustomTest(t)
+	tt.spec.Cluster.Spec.ClusterNetwork.CNIConfig.Custom.ManifestPath = "testdata/custom_cni_manifest.yaml"
+	tt.client.EXPECT().ApplyKubeSpecFromBytes(
+		tt.ctx,
+		tt.cluster,
+		test.MatchFile("testdata/custom_cni_manifest.yaml"),
+	).Return(errors.New("applying"))
+
+	tt.Expect(tt.c.Install(tt.ctx, tt.cluster, tt.spec, nil)).To(
+		MatchError(ContainSubstring("applying custom cni manifest: applying")),
+	)
+}
+
+func TestCustomInstallHelmChartSuccess(t *testing.T) {
+	tt := newCustomTest(t)
+	tt.spec.Cluster.Spec.ClusterNetwork.CNIConfig.Custom.HelmChart = &v1alpha1.CustomCNIHelmChart{
+		Name:       "calico",
+		URI:        "oci://registry/calico",
+		Version:    "v3.25.0",
+		ValuesFile: "values.yaml",
+	}
+	tt.helm.EXPECT().InstallChart(
+		tt.ctx, "calico", "oci://registry/calico", "v3.25.0", tt.cluster.KubeconfigFile, "kube-system", "values.yaml", nil,
+	)
+	tt.expectNodesReady(corev1.ConditionTrue)
+
+	tt.Expect(tt.c.Install(tt.ctx, tt.cluster, tt.spec, nil)).To(Succeed())
+}
+
+func TestCustomInstallHelmChartError(t *testing.T) {
+	tt := newCustomTest(t)
+	tt.spec.Cluster.Spec.ClusterNetwork.CNIConfig.Custom.HelmChart = &v1alpha1.CustomCNIHelmChart{
+		Name:      "calico",
+		URI:       "oci://registry/calico",
+		Version:   "v3.25.0",
+		Namespace: "tigera-operator",
+	}
+	tt.helm.EXPECT().InstallChart(
+		tt.ctx, "calico", "oci://registry/calico", "v3.25.0", tt.cluster.KubeconfigFile, "tigera-operator", "", nil,
+	).Return(errors.New("helm failed"))
+
+	tt.Expect(tt.c.Install(tt.ctx, tt.cluster, tt.spec, nil)).To(
+		MatchError(ContainSubstring("installing custom cni helm chart: helm failed")),
+	)
+}
+
+func TestCustomInstallNodesNotReady(t *testing.T) {
+	tt := newCustomTest(t)
+	tt.expectNodesReady(corev1.ConditionFalse)
+
+	tt.Expect(tt.c.Install(tt.ctx, tt.cluster, tt.spec, nil)).To(
+		MatchError(ContainSubstring("waiting for custom cni to be ready: node cp-1 is not ready")),
+	)
+}
+
+func TestCustomInstallNoNodes(t *testing.T) {
+	tt := newCustomTest(t)
+	tt.client.EXPECT().GetControlPlaneNodes(tt.ctx, tt.cluster.KubeconfigFile).Return(nil, nil)
+
+	tt.Expect(tt.c.Install(tt.ctx, tt.cluster, tt.spec, nil)).To(
+		MatchError(ContainSubstring("no control plane nodes found")),
+	)
+}
+
+func TestInstallerForSpecInstallSuccess(t *testing.T) {
+	tt := newCustomTest(t)
+	retrierClient := custom.NewRetrier(tt.client)
+	retrierClient.Retrier = retrier.NewWithMaxRetries(1, 0)
+	installer := custom.NewInstallerForSpec(retrierClient, tt.helm, tt.spec)
+	tt.expectNodesReady(corev1.ConditionTrue)
+
+	tt.Expect(installer.Install(tt.ctx, tt.cluster)).To(Succeed())
+}
+
+func TestCustomUpgradeSuccess(t *testing.T) {
+	tt := newCustomTest(t)
+	tt.expectNodesReady(corev1.ConditionTrue)
+
+	tt.Expect(tt.c.Upgrade(tt.ctx, tt.cluster, tt.spec, tt.spec, nil)).To(BeNil())
+}
+
+func TestCustomUpgradeNotReady(t *testing.T) {
+	tt := newCustomTest(t)
+	tt.client.EXPECT().GetControlPlaneNodes(tt.ctx, tt.cluster.KubeconfigFile).Return(nil, errors.New("get nodes"))
+
+	_, err := tt.c.Upgrade(tt.ctx, tt.cluster, tt.spec, tt.spec, nil)
+	tt.Expect(err).To(MatchError(ContainSubstring("waiting for custom cni to be ready: get nodes")))
+}
+
+func TestCustomRunPostControlPlaneUpgradeSetup(t *testing.T) {
+	tt := newCustomTest(t)
+	tt.Expect(tt.c.RunPostControlPlaneUpgradeSetup(tt.ctx, tt.cluster)).To(Succeed())
+}
@@ -0,0 +1,98 @@
+package custom
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/cluster"
+	"github.com/aws/eks-anywhere/pkg/constants"
+	"github.com/aws/eks-anywhere/pkg/files"
+	"github.com/aws/eks-anywhere/pkg/logger"
+	"github.com/aws/eks-anywhere/pkg/types"
+)
+
+// Helm allows to install Helm charts in a cluster.
+type Helm interface {
+	InstallChart(ctx context.Context, chart, ociURI, version, kubeconfigFilePath, namespace, valueFilePath string, values []string) error
+}
+
+// InstallerForSpec allows to configure a custom CNI for a particular EKS-A cluster.
+// It's a stateful version of installer, with a fixed cluster Spec.
+type InstallerForSpec struct {
+	installer *Installer
+	spec      *cluster.Spec
+}
+
+// NewInstallerForSpec constructs a new InstallerForSpec.
+func NewInstallerForSpec(client *RetrierClient, helm Helm, spec *cluster.Spec) *InstallerForSpec {
+	return &InstallerForSpec{
+		installer: NewInstaller(client, helm),
+		spec:      spec,
+	}
+}
+
+// Install installs the custom CNI, if it has a manifest or chart configured, and waits for it to be ready.
+func (i *InstallerForSpec) Install(ctx context.Context, cluster *types.Cluster) error {
+	return i.installer.Install(ctx, cluster, i.spec)
+}
+
+// Installer allows to configure a custom CNI in a cluster.
+type Installer struct {
+	k8s    *RetrierClient
+	helm   Helm
+	reader *files.Reader
+}
+
+// NewInstaller constructs a new Installer.
+func NewInstaller(client *RetrierClient, helm Helm) *Installer {
+	return &Installer{
+		k8s:    client,
+		helm:   helm,
+		reader: files.NewReader(),
+	}
+}
+
+// Install applies the custom CNI manifest or Helm chart, if any, and waits for the
+// cluster networking to become ready. EKS-A doesn't manage the custom CNI beyond this.
+func (i *Installer) Install(ctx context.Context, cluster *types.Cluster, spec *cluster.Spec) error {
+	if err := i.apply(ctx, cluster, spec.Cluster.Spec.ClusterNetwork.CNIConfig.Custom); err != nil {
+		return err
+	}
+
+	logger.Info("Waiting for custom CNI to be ready")
+	if err := i.k8s.WaitForControlPlaneNodesReady(ctx, cluster); err != nil {
+		return fmt.Errorf("waiting for custom cni to be ready: %v", err)
+	}
+
+	return nil
+}
+
+func (i *Installer) apply(ctx context.Context, cluster *types.Cluster, custom *v1alpha1.CustomCNIConfig) error {
+	switch {
+	case custom.ManifestPath != "":
+		logger.V(4).Info("Applying custom CNI manifest", "manifest", custom.ManifestPath)
+		manifest, err := i.reader.ReadFile(custom.ManifestPath)
+		if err != nil {
+			return fmt.Errorf("reading custom cni manifest: %v", err)
+		}
+
+		if err = i.k8s.Apply(ctx, cluster, manifest); err != nil {
+			return fmt.Errorf("applying custom cni manifest: %v", err)
+		}
+	case custom.HelmChart != nil:
+		chart := custom.HelmChart
+		namespace := chart.Namespace
+		if namespace == "" {
+			namespace = constants.KubeSystemNamespace
+		}
+
+		if err := i.helm.InstallChart(ctx, chart.Name, chart.URI, chart.Version, cluster.KubeconfigFile, namespace, chart.ValuesFile, nil); err != nil {
+			return fmt.Errorf("installing custom cni helm chart: %v", err)
+		}
+	default:
+		logger.V(4).Info("No custom CNI manifest or chart provided, skipping CNI installation")
+	}
+
+	return nil
+}
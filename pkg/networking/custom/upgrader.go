@@ -0,0 +1,39 @@
+package custom
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/eks-anywhere/pkg/cluster"
+	"github.com/aws/eks-anywhere/pkg/logger"
+	"github.com/aws/eks-anywhere/pkg/types"
+)
+
+// Upgrader allows to upgrade a cluster using a custom CNI.
+type Upgrader struct {
+	client *RetrierClient
+}
+
+// NewUpgrader constructs a new Upgrader.
+func NewUpgrader(client *RetrierClient) *Upgrader {
+	return &Upgrader{
+		client: client,
+	}
+}
+
+// Upgrade doesn't modify the custom CNI, since its lifecycle is owned by the user.
+// It only waits for the cluster networking to be ready and never reports a change diff.
+func (u Upgrader) Upgrade(ctx context.Context, cluster *types.Cluster, _, _ *cluster.Spec, _ []string) (*types.ChangeDiff, error) {
+	logger.V(1).Info("CNI is not managed by EKS Anywhere, skipping CNI upgrade")
+	if err := u.client.WaitForControlPlaneNodesReady(ctx, cluster); err != nil {
+		return nil, fmt.Errorf("waiting for custom cni to be ready: %v", err)
+	}
+
+	return nil, nil
+}
+
+// RunPostControlPlaneUpgradeSetup satisfies the clustermanager.Networking interface.
+// It is a noop for custom CNIs.
+func (u Upgrader) RunPostControlPlaneUpgradeSetup(_ context.Context, _ *types.Cluster) error {
+	return nil
+}
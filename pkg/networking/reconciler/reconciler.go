@@ -28,11 +28,15 @@ func New(ciliumReconciler CiliumReconciler) *Reconciler {
 // Reconcile takes the specified CNI in a cluster to the desired state defined in a cluster Spec
 // It uses a controller.Result to indicate when requeues are needed
 // Intended to be used in a kubernetes controller
-// Only Cilium CNI is supported for now.
+// Only Cilium CNI is supported for now. Custom CNIs are not managed by EKS-A, so they are skipped.
 func (r *Reconciler) Reconcile(ctx context.Context, logger logr.Logger, client client.Client, spec *cluster.Spec) (controller.Result, error) {
-	if spec.Cluster.Spec.ClusterNetwork.CNIConfig.Cilium != nil {
+	switch {
+	case spec.Cluster.Spec.ClusterNetwork.CNIConfig.Cilium != nil:
 		return r.ciliumReconciler.Reconcile(ctx, logger, client, spec)
-	} else {
+	case spec.Cluster.Spec.ClusterNetwork.CNIConfig.Custom != nil:
+		logger.Info("Custom CNI is not managed by EKS Anywhere, skipping CNI reconciliation")
+		return controller.Result{}, nil
+	default:
 		return controller.Result{}, errors.New("unsupported CNI, only Cilium is supported at this time")
 	}
 }
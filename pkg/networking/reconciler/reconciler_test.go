@@ -53,3 +53,23 @@ func TestReconcilerReconcileUnsupportedCNI(t *testing.T) {
 	_, err := r.Reconcile(ctx, logger, client, spec)
 	g.Expect(err).To(MatchError(ContainSubstring("unsupported CNI, only Cilium is supported at this time")))
 }
+
+func TestReconcilerReconcileCustomCNI(t *testing.T) {
+	ctx := context.Background()
+	logger := test.NewNullLogger()
+	client := fake.NewClientBuilder().Build()
+	spec := test.NewClusterSpec(func(s *cluster.Spec) {
+		s.Cluster.Spec.ClusterNetwork.CNIConfig = &v1alpha1.CNIConfig{
+			Custom: &v1alpha1.CustomCNIConfig{},
+		}
+	})
+
+	g := NewWithT(t)
+	ctrl := gomock.NewController(t)
+	ciliumReconciler := mocks.NewMockCiliumReconciler(ctrl)
+
+	r := reconciler.New(ciliumReconciler)
+	result, err := r.Reconcile(ctx, logger, client, spec)
+	g.Expect(result).To(Equal(controller.Result{}))
+	g.Expect(err).NotTo(HaveOccurred())
+}
@@ -26,6 +26,18 @@ func ValidateIP(ip string) error {
 	return nil
 }
 
+// IsIPv6 returns true if ip is a valid IPv6 address.
+func IsIPv6(ip string) bool {
+	parsedIP := net.ParseIP(ip)
+	return parsedIP != nil && parsedIP.To4() == nil
+}
+
+// IsIPv6CIDR returns true if cidr is a valid IPv6 CIDR block.
+func IsIPv6CIDR(cidr string) bool {
+	ip, _, err := net.ParseCIDR(cidr)
+	return err == nil && ip.To4() == nil
+}
+
 // IsIPInUse performs a best effort check to see if an IP address is in use. It is not completely
 // reliable as testing if an IP is in use is inherently difficult, particularly with non-trivial
 // network topologies.
@@ -36,6 +36,21 @@ func TestIsPortValidExpectInvalid(t *testing.T) {
 	}
 }
 
+func TestIsIPv6(t *testing.T) {
+	g := gomega.NewWithT(t)
+	g.Expect(networkutils.IsIPv6("fd00::10")).To(gomega.BeTrue())
+	g.Expect(networkutils.IsIPv6("10.0.0.1")).To(gomega.BeFalse())
+	g.Expect(networkutils.IsIPv6("::ffff:10.0.0.1")).To(gomega.BeFalse())
+	g.Expect(networkutils.IsIPv6("not-an-ip")).To(gomega.BeFalse())
+}
+
+func TestIsIPv6CIDR(t *testing.T) {
+	g := gomega.NewWithT(t)
+	g.Expect(networkutils.IsIPv6CIDR("fd00:10:96::/108")).To(gomega.BeTrue())
+	g.Expect(networkutils.IsIPv6CIDR("10.96.0.0/12")).To(gomega.BeFalse())
+	g.Expect(networkutils.IsIPv6CIDR("fd00::10")).To(gomega.BeFalse())
+}
+
 func TestIsIPInUsePass(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	g := gomega.NewWithT(t)
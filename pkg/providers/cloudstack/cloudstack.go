@@ -833,7 +833,8 @@ func buildTemplateMapCP(clusterSpec *cluster.Spec, controlPlaneMachineSpec, etcd
 
 	if clusterSpec.Cluster.Spec.RegistryMirrorConfiguration != nil {
 		registryMirror := registrymirror.FromCluster(clusterSpec.Cluster)
-		values["registryMirrorMap"] = containerd.ToAPIEndpoints(registryMirror.NamespacedRegistryMap)
+		values["registryMirrorMap"] = containerd.ToAPIEndpointMirrors(registryMirror)
+		values["mirrorRegistries"] = registryMirror.Registries()
 		values["mirrorBase"] = registryMirror.BaseRegistry
 		values["publicMirror"] = containerd.ToAPIEndpoint(registryMirror.CoreEKSAMirror())
 		if len(registryMirror.CACertContent) > 0 {
@@ -882,29 +883,10 @@ func fillDiskOffering(values map[string]interface{}, diskOffering *v1alpha1.Clou
 }
 
 func fillProxyConfigurations(values map[string]interface{}, clusterSpec *cluster.Spec) {
-	datacenterConfigSpec := clusterSpec.CloudStackDatacenter.Spec
 	values["proxyConfig"] = true
-	capacity := len(clusterSpec.Cluster.Spec.ClusterNetwork.Pods.CidrBlocks) +
-		len(clusterSpec.Cluster.Spec.ClusterNetwork.Services.CidrBlocks) +
-		len(clusterSpec.Cluster.Spec.ProxyConfiguration.NoProxy) + 4
-	noProxyList := make([]string, 0, capacity)
-	noProxyList = append(noProxyList, clusterSpec.Cluster.Spec.ClusterNetwork.Pods.CidrBlocks...)
-	noProxyList = append(noProxyList, clusterSpec.Cluster.Spec.ClusterNetwork.Services.CidrBlocks...)
-	noProxyList = append(noProxyList, clusterSpec.Cluster.Spec.ProxyConfiguration.NoProxy...)
-
-	noProxyList = append(noProxyList, clusterapi.NoProxyDefaults()...)
-	for _, az := range datacenterConfigSpec.AvailabilityZones {
-		if cloudStackManagementApiEndpointHostname, err := getHostnameFromUrl(az.ManagementApiEndpoint); err == nil {
-			noProxyList = append(noProxyList, cloudStackManagementApiEndpointHostname)
-		}
-	}
-	noProxyList = append(noProxyList,
-		clusterSpec.Cluster.Spec.ControlPlaneConfiguration.Endpoint.Host,
-	)
-
 	values["httpProxy"] = clusterSpec.Cluster.Spec.ProxyConfiguration.HttpProxy
 	values["httpsProxy"] = clusterSpec.Cluster.Spec.ProxyConfiguration.HttpsProxy
-	values["noProxy"] = noProxyList
+	values["noProxy"] = cluster.NoProxyList(clusterSpec.Config)
 }
 
 func buildTemplateMapMD(clusterSpec *cluster.Spec, workerNodeGroupMachineSpec v1alpha1.CloudStackMachineConfigSpec, workerNodeGroupConfiguration v1alpha1.WorkerNodeGroupConfiguration) map[string]interface{} {
@@ -940,7 +922,8 @@ func buildTemplateMapMD(clusterSpec *cluster.Spec, workerNodeGroupMachineSpec v1
 
 	if clusterSpec.Cluster.Spec.RegistryMirrorConfiguration != nil {
 		registryMirror := registrymirror.FromCluster(clusterSpec.Cluster)
-		values["registryMirrorMap"] = containerd.ToAPIEndpoints(registryMirror.NamespacedRegistryMap)
+		values["registryMirrorMap"] = containerd.ToAPIEndpointMirrors(registryMirror)
+		values["mirrorRegistries"] = registryMirror.Registries()
 		values["mirrorBase"] = registryMirror.BaseRegistry
 		if len(registryMirror.CACertContent) > 0 {
 			values["registryCACert"] = registryMirror.CACertContent
@@ -1016,6 +999,8 @@ func (p *cloudstackProvider) getWorkloadTemplateSpecForCAPISpecUpgrade(ctx conte
 	previousWorkerNodeGroupConfigs := cluster.BuildMapForWorkerNodeGroupsByName(currentSpec.Cluster.Spec.WorkerNodeGroupConfigurations)
 	workloadTemplateNames := make(map[string]string, len(newClusterSpec.Cluster.Spec.WorkerNodeGroupConfigurations))
 	kubeadmconfigTemplateNames := make(map[string]string, len(newClusterSpec.Cluster.Spec.WorkerNodeGroupConfigurations))
+	// The proxy configuration is rendered in the worker nodes files, so changing it requires rolling them out.
+	proxyConfigurationChanged := !currentSpec.Cluster.Spec.ProxyConfiguration.Equal(newClusterSpec.Cluster.Spec.ProxyConfiguration)
 	for _, workerNodeGroupConfiguration := range newClusterSpec.Cluster.Spec.WorkerNodeGroupConfigurations {
 		needsNewWorkloadTemplate, err := p.needsNewMachineTemplate(ctx, workloadCluster, currentSpec, newClusterSpec, workerNodeGroupConfiguration, csdc, previousWorkerNodeGroupConfigs)
 		if err != nil {
@@ -1025,7 +1010,7 @@ func (p *cloudstackProvider) getWorkloadTemplateSpecForCAPISpecUpgrade(ctx conte
 		if err != nil {
 			return nil, err
 		}
-		if !needsNewKubeadmConfigTemplate {
+		if !needsNewKubeadmConfigTemplate && !proxyConfigurationChanged {
 			mdName := machineDeploymentName(newClusterSpec.Cluster.Name, workerNodeGroupConfiguration.Name)
 			md, err := p.providerKubectlClient.GetMachineDeployment(ctx, mdName, executables.WithCluster(bootstrapCluster), executables.WithNamespace(constants.EksaSystemNamespace))
 			if err != nil {
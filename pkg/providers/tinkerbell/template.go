@@ -13,7 +13,6 @@ import (
 	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
 	"github.com/aws/eks-anywhere/pkg/cluster"
 	"github.com/aws/eks-anywhere/pkg/clusterapi"
-	"github.com/aws/eks-anywhere/pkg/config"
 	"github.com/aws/eks-anywhere/pkg/constants"
 	"github.com/aws/eks-anywhere/pkg/crypto"
 	"github.com/aws/eks-anywhere/pkg/executables"
@@ -384,11 +383,13 @@ func buildTemplateMapCP(clusterSpec *cluster.Spec, controlPlaneMachineSpec, etcd
 
 	kubeletExtraArgs := clusterapi.SecureTlsCipherSuitesExtraArgs().
 		Append(clusterapi.ResolvConfExtraArgs(clusterSpec.Cluster.Spec.ClusterNetwork.DNS.ResolvConf)).
-		Append(clusterapi.ControlPlaneNodeLabelsExtraArgs(clusterSpec.Cluster.Spec.ControlPlaneConfiguration))
+		Append(clusterapi.ControlPlaneNodeLabelsExtraArgs(clusterSpec.Cluster.Spec.ControlPlaneConfiguration)).
+		Append(clusterapi.NodeIPExtraArgs(&clusterSpec.Cluster.Spec.ClusterNetwork))
 
 	values := map[string]interface{}{
 		"clusterName":                   clusterSpec.Cluster.Name,
 		"controlPlaneEndpointIp":        clusterSpec.Cluster.Spec.ControlPlaneConfiguration.Endpoint.Host,
+		"kubeVipCidr":                   clusterapi.KubeVipCIDR(clusterSpec.Cluster.Spec.ControlPlaneConfiguration.Endpoint.Host),
 		"controlPlaneReplicas":          clusterSpec.Cluster.Spec.ControlPlaneConfiguration.Count,
 		"controlPlaneSshAuthorizedKey":  controlPlaneMachineSpec.Users[0].SshAuthorizedKeys,
 		"controlPlaneSshUsername":       controlPlaneMachineSpec.Users[0].Name,
@@ -460,7 +461,8 @@ func buildTemplateMapMD(clusterSpec *cluster.Spec, workerNodeGroupMachineSpec v1
 
 	kubeletExtraArgs := clusterapi.SecureTlsCipherSuitesExtraArgs().
 		Append(clusterapi.WorkerNodeLabelsExtraArgs(workerNodeGroupConfiguration)).
-		Append(clusterapi.ResolvConfExtraArgs(clusterSpec.Cluster.Spec.ClusterNetwork.DNS.ResolvConf))
+		Append(clusterapi.ResolvConfExtraArgs(clusterSpec.Cluster.Spec.ClusterNetwork.DNS.ResolvConf)).
+		Append(clusterapi.NodeIPExtraArgs(&clusterSpec.Cluster.Spec.ClusterNetwork))
 
 	values := map[string]interface{}{
 		"clusterName":            clusterSpec.Cluster.Name,
@@ -521,7 +523,8 @@ func omitTinkerbellMachineTemplate(inputSpec []byte) ([]byte, error) {
 
 func populateRegistryMirrorValues(clusterSpec *cluster.Spec, values map[string]interface{}) map[string]interface{} {
 	registryMirror := registrymirror.FromCluster(clusterSpec.Cluster)
-	values["registryMirrorMap"] = containerd.ToAPIEndpoints(registryMirror.NamespacedRegistryMap)
+	values["registryMirrorMap"] = containerd.ToAPIEndpointMirrors(registryMirror)
+	values["mirrorRegistries"] = registryMirror.Registries()
 	values["mirrorBase"] = registryMirror.BaseRegistry
 	values["publicMirror"] = containerd.ToAPIEndpoint(registryMirror.CoreEKSAMirror())
 	if len(registryMirror.CACertContent) > 0 {
@@ -530,12 +533,17 @@ func populateRegistryMirrorValues(clusterSpec *cluster.Spec, values map[string]i
 
 	if registryMirror.Auth {
 		values["registryAuth"] = registryMirror.Auth
-		username, password, err := config.ReadCredentials()
+		values["registryCredentialsScript"] = containerd.CredentialsScript(registryMirror.Registries())
+		username, password, err := registrymirror.ReadCredentials(clusterSpec.RegistryCredentialsSecret)
 		if err != nil {
 			return values
 		}
 		values["registryUsername"] = username
 		values["registryPassword"] = password
 	}
+
+	if upstreamCredentials, err := registryMirror.UpstreamCredentials(); err == nil {
+		values["upstreamCredentials"] = upstreamCredentials
+	}
 	return values
 }
@@ -1,6 +1,7 @@
 package vsphere_test
 
 import (
+	"strings"
 	"testing"
 	"time"
 
@@ -11,6 +12,7 @@ import (
 	v1alpha1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
 	"github.com/aws/eks-anywhere/pkg/cluster"
 	"github.com/aws/eks-anywhere/pkg/providers/vsphere"
+	"github.com/aws/eks-anywhere/pkg/registrymirror"
 	"github.com/aws/eks-anywhere/pkg/utils/ptr"
 )
 
@@ -122,6 +124,45 @@ func TestVsphereTemplateBuilderGenerateCAPISpecControlPlaneInvalidEtcdSSHKey(t *
 	)
 }
 
+func TestVsphereTemplateBuilderGenerateCAPISpecControlPlaneDualStack(t *testing.T) {
+	g := NewWithT(t)
+	spec := test.NewFullClusterSpec(t, "testdata/cluster_main.yaml")
+	spec.Cluster.Spec.ClusterNetwork.Pods.CidrBlocks = []string{"192.168.0.0/16", "fd00:10::/56"}
+	spec.Cluster.Spec.ClusterNetwork.Services.CidrBlocks = []string{"10.96.0.0/12", "fd00::/108"}
+	builder := vsphere.NewVsphereTemplateBuilder(test.FakeNow)
+	cp, err := builder.GenerateCAPISpecControlPlane(spec)
+	g.Expect(err).NotTo(HaveOccurred())
+	test.AssertContentToFile(t, string(cp), "testdata/expected_results_main_dual_stack_cp.yaml")
+}
+
+func TestVsphereTemplateBuilderGenerateCAPISpecWorkersRegistryCredentialsSecret(t *testing.T) {
+	g := NewWithT(t)
+	t.Setenv("REGISTRY_USERNAME", "env-user")
+	t.Setenv("REGISTRY_PASSWORD", "env-pass")
+	spec := test.NewFullClusterSpec(t, "testdata/cluster_mirror_with_auth_config.yaml")
+	spec.RegistryCredentialsSecret = registrymirror.CredentialsSecret("stored-user", "stored-pass")
+	builder := vsphere.NewVsphereTemplateBuilder(time.Now)
+	md, err := builder.GenerateCAPISpecWorkers(spec, nil, nil)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(string(md)).To(ContainSubstring("name: registry-credentials"))
+	g.Expect(string(md)).NotTo(ContainSubstring("stored-user"))
+	g.Expect(string(md)).NotTo(ContainSubstring("env-user"))
+}
+
+func TestVsphereTemplateBuilderGenerateCAPISpecControlPlaneRegistryCredentialsSecret(t *testing.T) {
+	g := NewWithT(t)
+	t.Setenv("REGISTRY_USERNAME", "env-user")
+	t.Setenv("REGISTRY_PASSWORD", "env-pass")
+	spec := test.NewFullClusterSpec(t, "testdata/cluster_mirror_with_auth_config.yaml")
+	spec.RegistryCredentialsSecret = registrymirror.CredentialsSecret("stored-user", "stored-pass")
+	builder := vsphere.NewVsphereTemplateBuilder(time.Now)
+	cp, err := builder.GenerateCAPISpecControlPlane(spec)
+	g.Expect(err).NotTo(HaveOccurred())
+	// Only the Secret holds the credentials, so rotating them doesn't change the KubeadmControlPlane.
+	g.Expect(strings.Count(string(cp), "stored-user")).To(Equal(1))
+	g.Expect(string(cp)).NotTo(ContainSubstring("env-user"))
+}
+
 func invalidSSHKey() string {
 	return "ssh-rsa AAAA    B3NzaC1K73CeQ== testemail@test.com"
 }
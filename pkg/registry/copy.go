@@ -21,6 +21,9 @@ func Copy(ctx context.Context, srcClient StorageClient, dstClient StorageClient,
 	if err != nil {
 		return fmt.Errorf("registry copy: %v", err)
 	}
+	if image.Digest != "" && desc.Digest.String() != image.Digest {
+		return fmt.Errorf("registry copy: digest %s does not match %s", desc.Digest, image.Digest)
+	}
 
 	if len(image.Tag) > 0 {
 		err = dstClient.Tag(ctx, dstStorage, desc, image.Tag)
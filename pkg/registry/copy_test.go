@@ -22,7 +22,8 @@ var srcArtifact = registry.Artifact{
 var expectedSrcRef = srcArtifact.VersionedImage()
 
 var desc = ocispec.Descriptor{
-	URLs: []string{expectedSrcRef},
+	URLs:   []string{expectedSrcRef},
+	Digest: "sha256:6efe21500abbfbb6b3e37b80dd5dea0b11a0d1b145e84298fee5d7784a77e967",
 }
 
 func TestCopy(t *testing.T) {
@@ -59,6 +60,22 @@ func TestCopyTagError(t *testing.T) {
 	assert.EqualError(t, err, "image tag: oops")
 }
 
+func TestCopyDigestMismatch(t *testing.T) {
+	srcClient := mocks.NewMockStorageClient(gomock.NewController(t))
+	dstClient := mocks.NewMockStorageClient(gomock.NewController(t))
+
+	mockSrcRepo := *mocks.NewMockRepository(gomock.NewController(t))
+	mockDstRepo := *mocks.NewMockRepository(gomock.NewController(t))
+
+	srcClient.EXPECT().GetStorage(ctx, srcArtifact).Return(&mockSrcRepo, nil)
+	dstClient.EXPECT().GetStorage(ctx, srcArtifact).Return(&mockDstRepo, nil)
+	dstClient.EXPECT().Destination(srcArtifact).Return(expectedSrcRef)
+	srcClient.EXPECT().CopyGraph(ctx, &mockSrcRepo, expectedSrcRef, &mockDstRepo, expectedSrcRef).Return(ocispec.Descriptor{Digest: "sha256:abc"}, nil)
+
+	err := registry.Copy(ctx, srcClient, dstClient, srcArtifact)
+	assert.EqualError(t, err, "registry copy: digest sha256:abc does not match "+srcArtifact.Digest)
+}
+
 func TestCopyCopyGraphError(t *testing.T) {
 	srcClient := mocks.NewMockStorageClient(gomock.NewController(t))
 	dstClient := mocks.NewMockStorageClient(gomock.NewController(t))
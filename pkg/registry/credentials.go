@@ -9,8 +9,9 @@ import (
 
 // CredentialStore for registry credentials such as ~/.docker/config.json.
 type CredentialStore struct {
-	directory  string
-	configFile *configfile.ConfigFile
+	directory   string
+	configFile  *configfile.ConfigFile
+	credentials map[string]auth.Credential
 }
 
 // NewCredentialStore create a credential store.
@@ -25,6 +26,17 @@ func (cs *CredentialStore) SetDirectory(directory string) {
 	cs.directory = directory
 }
 
+// SetCredential sets the username and password for a registry, overriding the credential file.
+func (cs *CredentialStore) SetCredential(registry, username, password string) {
+	if cs.credentials == nil {
+		cs.credentials = map[string]auth.Credential{}
+	}
+	cs.credentials[registry] = auth.Credential{
+		Username: username,
+		Password: password,
+	}
+}
+
 // Init initialize a credential store.
 func (cs *CredentialStore) Init() (err error) {
 	cs.configFile, err = config.Load(cs.directory)
@@ -39,6 +51,12 @@ func (cs *CredentialStore) Init() (err error) {
 
 // Credential get an authentication credential for a given registry.
 func (cs *CredentialStore) Credential(registry string) (auth.Credential, error) {
+	if cred, ok := cs.credentials[registry]; ok {
+		return cred, nil
+	}
+	if cs.configFile == nil {
+		return auth.EmptyCredential, nil
+	}
 	authConf, err := cs.configFile.GetCredentialsStore(registry).Get(registry)
 	if err != nil {
 		return auth.EmptyCredential, err
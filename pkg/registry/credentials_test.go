@@ -53,3 +53,21 @@ func TestCredentialStore_InitEmpty(t *testing.T) {
 	err := credentialStore.Init()
 	assert.NoError(t, err)
 }
+
+func TestCredentialStore_SetCredential(t *testing.T) {
+	credentialStore := registry.NewCredentialStore()
+	credentialStore.SetDirectory("testdata")
+	err := credentialStore.Init()
+	assert.NoError(t, err)
+
+	credentialStore.SetCredential("localhost", "admin", "secret")
+
+	result, err := credentialStore.Credential("localhost")
+	assert.NoError(t, err)
+	assert.Equal(t, "admin", result.Username)
+	assert.Equal(t, "secret", result.Password)
+
+	result, err = credentialStore.Credential("harbor.eksa.demo:30003")
+	assert.NoError(t, err)
+	assert.Equal(t, "captain", result.Username)
+}
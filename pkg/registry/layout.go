@@ -0,0 +1,243 @@
+package registry
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"oras.land/oras-go/v2"
+	"oras.land/oras-go/v2/content/oci"
+	"oras.land/oras-go/v2/errdef"
+
+	"github.com/aws/eks-anywhere/pkg/docker"
+	"github.com/aws/eks-anywhere/pkg/logger"
+	releasev1 "github.com/aws/eks-anywhere/release/api/v1alpha1"
+)
+
+// OCILayout is an OCI image layout directory holding images tagged with their original reference, so
+// images can be downloaded and imported without a Docker daemon.
+type OCILayout struct {
+	dir   string
+	once  sync.Once
+	store *oci.Store
+	err   error
+}
+
+// NewOCILayout creates an OCI image layout in dir. The layout is only read or created on first use, as
+// when importing it is only available once the artifacts are unpackaged.
+func NewOCILayout(dir string) *OCILayout {
+	return &OCILayout{dir: dir}
+}
+
+// Store opens the OCI image layout.
+func (l *OCILayout) Store(ctx context.Context) (*oci.Store, error) {
+	l.once.Do(func() {
+		if err := os.MkdirAll(l.dir, os.ModePerm); err != nil {
+			l.err = fmt.Errorf("creating OCI layout folder: %v", err)
+			return
+		}
+		l.store, l.err = oci.NewWithContext(ctx, l.dir)
+		if l.err != nil {
+			l.err = fmt.Errorf("opening OCI layout %s: %v", l.dir, l.err)
+		}
+	})
+	return l.store, l.err
+}
+
+// OCILayoutDownloader copies images from their registries into an OCI image layout. Image indexes are
+// copied with all their manifests, so multi-arch images and digests are preserved.
+type OCILayoutDownloader struct {
+	layout          *OCILayout
+	cache           *Cache
+	credentialStore *CredentialStore
+	certificates    *x509.CertPool
+	insecure        bool
+	processor       *docker.ConcurrentImageProcessor
+}
+
+// NewOCILayoutDownloader creates an OCILayoutDownloader reading images with the registry clients in cache.
+func NewOCILayoutDownloader(layout *OCILayout, cache *Cache, credentialStore *CredentialStore, certificates *x509.CertPool, insecure bool) *OCILayoutDownloader {
+	return &OCILayoutDownloader{
+		layout:          layout,
+		cache:           cache,
+		credentialStore: credentialStore,
+		certificates:    certificates,
+		insecure:        insecure,
+		processor:       docker.NewConcurrentImageProcessor(runtime.GOMAXPROCS(0)),
+	}
+}
+
+// Move copies images from their registries into the OCI layout.
+func (d *OCILayoutDownloader) Move(ctx context.Context, images ...string) error {
+	store, err := d.layout.Store(ctx)
+	if err != nil {
+		return err
+	}
+	images = uniqueImages(images)
+
+	// The cache isn't safe for concurrent use, so the clients are created before copying.
+	clients := map[string]StorageClient{}
+	for _, image := range images {
+		host := NewArtifactFromURI(image).Registry
+		if _, ok := clients[host]; ok {
+			continue
+		}
+		client, err := d.cache.Get(NewStorageContext(host, d.credentialStore, d.certificates, d.insecure))
+		if err != nil {
+			return fmt.Errorf("error with repository %s: %v", host, err)
+		}
+		clients[host] = client
+	}
+
+	logger.Info("Pulling images into OCI layout, this might take a while")
+	logger.V(3).Info("Starting pull", "numberOfImages", len(images))
+	return d.processor.Process(ctx, images, func(ctx context.Context, image string) error {
+		artifact := NewArtifactFromURI(image)
+		srcStorage, err := clients[artifact.Registry].GetStorage(ctx, artifact)
+		if err != nil {
+			return fmt.Errorf("repository source: %v", err)
+		}
+		desc, err := oras.Copy(ctx, srcStorage, artifact.VersionedImage(), store, image, oras.CopyOptions{})
+		if err != nil {
+			return fmt.Errorf("copying image %s to OCI layout: %v", image, err)
+		}
+
+		// Signatures are kept with the image, so they can be verified once imported.
+		sigTag := SignatureTag(desc.Digest.String())
+		_, err = oras.Copy(ctx, srcStorage, sigTag, store, layoutSignatureReference(artifact, sigTag), oras.CopyOptions{})
+		if err != nil && !errors.Is(err, errdef.ErrNotFound) {
+			return fmt.Errorf("copying signature of image %s to OCI layout: %v", image, err)
+		}
+		return nil
+	})
+}
+
+// VerifyDigests checks the images in the OCI layout have the digest pinned in their bundle.
+func (d *OCILayoutDownloader) VerifyDigests(ctx context.Context, images []releasev1.Image) error {
+	store, err := d.layout.Store(ctx)
+	if err != nil {
+		return err
+	}
+	return verifyDigests(images, func(image string) (ocispec.Descriptor, error) {
+		return store.Resolve(ctx, image)
+	})
+}
+
+// OCILayoutImporter pushes images from an OCI image layout to a registry, keeping their repository paths.
+type OCILayoutImporter struct {
+	layout    *OCILayout
+	dst       StorageClient
+	endpoint  string
+	processor *docker.ConcurrentImageProcessor
+}
+
+// NewOCILayoutImporter creates an OCILayoutImporter pushing images to dst, the client of the registry
+// endpoint.
+func NewOCILayoutImporter(layout *OCILayout, dst StorageClient, endpoint string) *OCILayoutImporter {
+	return &OCILayoutImporter{
+		layout:    layout,
+		dst:       dst,
+		endpoint:  endpoint,
+		processor: docker.NewConcurrentImageProcessor(runtime.GOMAXPROCS(0)),
+	}
+}
+
+// Move pushes images from the OCI layout to the registry. Images referenced by digest are pushed by
+// digest, and the digest of every pushed image is checked against its reference.
+func (i *OCILayoutImporter) Move(ctx context.Context, images ...string) error {
+	store, err := i.layout.Store(ctx)
+	if err != nil {
+		return err
+	}
+	images = uniqueImages(images)
+
+	logger.Info("Writing images to registry")
+	logger.V(3).Info("Starting registry write", "numberOfImages", len(images))
+	return i.processor.Process(ctx, images, func(ctx context.Context, image string) error {
+		artifact := NewArtifactFromURI(image)
+		dstArtifact, dstRef := destinationForImage(i.endpoint, image)
+		dstStorage, err := i.dst.GetStorage(ctx, dstArtifact)
+		if err != nil {
+			return fmt.Errorf("repository destination: %v", err)
+		}
+
+		desc, err := oras.Copy(ctx, store, image, dstStorage, dstRef, oras.CopyOptions{})
+		if err != nil {
+			return fmt.Errorf("pushing image %s from OCI layout: %v", image, err)
+		}
+		if artifact.Digest != "" && desc.Digest.String() != artifact.Digest {
+			return fmt.Errorf("pushing image %s from OCI layout: digest %s does not match", image, desc.Digest)
+		}
+
+		sigTag := SignatureTag(desc.Digest.String())
+		sigRef := layoutSignatureReference(artifact, sigTag)
+		_, err = store.Resolve(ctx, sigRef)
+		if errors.Is(err, errdef.ErrNotFound) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("resolving signature of image %s in OCI layout: %v", image, err)
+		}
+		if _, err = oras.Copy(ctx, store, sigRef, dstStorage, sigTag, oras.CopyOptions{}); err != nil {
+			return fmt.Errorf("pushing signature of image %s from OCI layout: %v", image, err)
+		}
+		return nil
+	})
+}
+
+// layoutSignatureReference returns the reference the signatures of artifact are stored with in an OCI layout.
+func layoutSignatureReference(artifact Artifact, sigTag string) string {
+	return artifact.Registry + "/" + artifact.Repository + ":" + sigTag
+}
+
+// destinationForImage returns the artifact an image is imported as in the registry endpoint, and the
+// reference it is pushed with: its tag, or its digest if it has no tag.
+func destinationForImage(endpoint, image string) (Artifact, string) {
+	artifact := NewArtifactFromURI(image)
+	dstArtifact := artifact
+	// Curated packages images are pushed under an extra project, as with docker.
+	if project := strings.TrimPrefix(docker.RegistryEndpointForImage(endpoint, image), endpoint); project != "" {
+		dstArtifact.Repository = path.Join(strings.TrimPrefix(project, "/"), artifact.Repository)
+	}
+
+	// The tag parsed from a digest reference is the digest hex, so the digest is used instead.
+	ref := artifact.Tag
+	if artifact.Digest != "" && (ref == "" || strings.HasSuffix(artifact.Digest, ":"+ref)) {
+		ref = artifact.Digest
+	}
+	return dstArtifact, ref
+}
+
+// NewRegistryForEndpoint creates an initialized client for a registry endpoint, a host with an optional
+// project path, as in <host>[:<port>][/<project>].
+func NewRegistryForEndpoint(endpoint string, credentialStore *CredentialStore, certificates *x509.CertPool, insecure bool) (*OCIRegistryClient, error) {
+	host, project, _ := strings.Cut(endpoint, "/")
+	client := NewOCIRegistry(NewStorageContext(host, credentialStore, certificates, insecure))
+	if err := client.Init(); err != nil {
+		return nil, err
+	}
+	client.SetProject(project)
+	return client, nil
+}
+
+func uniqueImages(images []string) []string {
+	seen := make(map[string]struct{}, len(images))
+	unique := make([]string, 0, len(images))
+	for _, image := range images {
+		if _, ok := seen[image]; ok {
+			continue
+		}
+		seen[image] = struct{}{}
+		unique = append(unique, image)
+	}
+	sort.Strings(unique)
+	return unique
+}
@@ -0,0 +1,226 @@
+package registry_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	orascontent "oras.land/oras-go/v2/content"
+
+	"github.com/aws/eks-anywhere/pkg/registry"
+	"github.com/aws/eks-anywhere/pkg/registry/mocks"
+	releasev1 "github.com/aws/eks-anywhere/release/api/v1alpha1"
+)
+
+// fakeRegistry is an in memory OCI distribution registry, implementing the endpoints used to copy images.
+type fakeRegistry struct {
+	*httptest.Server
+	lock      sync.Mutex
+	manifests map[string]fakeManifest
+	blobs     map[string][]byte
+}
+
+type fakeManifest struct {
+	mediaType string
+	content   []byte
+}
+
+func newFakeRegistry(t *testing.T) *fakeRegistry {
+	r := &fakeRegistry{manifests: map[string]fakeManifest{}, blobs: map[string][]byte{}}
+	r.Server = httptest.NewTLSServer(http.HandlerFunc(r.serve))
+	t.Cleanup(r.Close)
+	return r
+}
+
+func (r *fakeRegistry) host() string {
+	return strings.TrimPrefix(r.URL, "https://")
+}
+
+func digestOf(content []byte) string {
+	return fmt.Sprintf("sha256:%x", sha256.Sum256(content))
+}
+
+func (r *fakeRegistry) serve(w http.ResponseWriter, req *http.Request) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	p := strings.TrimPrefix(req.URL.Path, "/v2/")
+	switch {
+	case strings.Contains(p, "/blobs/uploads/"):
+		if req.Method == http.MethodPost {
+			w.Header().Set("Location", req.URL.Path+"upload")
+			w.WriteHeader(http.StatusAccepted)
+			return
+		}
+		content, _ := io.ReadAll(req.Body)
+		r.blobs[req.URL.Query().Get("digest")] = content
+		w.WriteHeader(http.StatusCreated)
+	case strings.Contains(p, "/blobs/"):
+		digest := p[strings.LastIndex(p, "/")+1:]
+		content, ok := r.blobs[digest]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Length", fmt.Sprint(len(content)))
+		w.Header().Set("Docker-Content-Digest", digest)
+		if req.Method == http.MethodGet {
+			_, _ = w.Write(content)
+		}
+	case strings.Contains(p, "/manifests/"):
+		i := strings.LastIndex(p, "/manifests/")
+		repo, ref := p[:i], p[i+len("/manifests/"):]
+		if req.Method == http.MethodPut {
+			content, _ := io.ReadAll(req.Body)
+			m := fakeManifest{mediaType: req.Header.Get("Content-Type"), content: content}
+			r.manifests[repo+"@"+digestOf(content)] = m
+			r.manifests[repo+":"+ref] = m
+			w.Header().Set("Docker-Content-Digest", digestOf(content))
+			w.WriteHeader(http.StatusCreated)
+			return
+		}
+		m, ok := r.manifests[repo+":"+ref]
+		if !ok {
+			m, ok = r.manifests[repo+"@"+ref]
+		}
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", m.mediaType)
+		w.Header().Set("Content-Length", fmt.Sprint(len(m.content)))
+		w.Header().Set("Docker-Content-Digest", digestOf(m.content))
+		if req.Method == http.MethodGet {
+			_, _ = w.Write(m.content)
+		}
+	default:
+		w.WriteHeader(http.StatusOK)
+	}
+}
+
+func (r *fakeRegistry) addBlob(content []byte) ocispec.Descriptor {
+	r.blobs[digestOf(content)] = content
+	return orascontent.NewDescriptorFromBytes(ocispec.MediaTypeImageLayer, content)
+}
+
+func (r *fakeRegistry) addManifest(t *testing.T, repo, tag, mediaType string, manifest interface{}) ocispec.Descriptor {
+	content, err := json.Marshal(manifest)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := fakeManifest{mediaType: mediaType, content: content}
+	r.manifests[repo+"@"+digestOf(content)] = m
+	if tag != "" {
+		r.manifests[repo+":"+tag] = m
+	}
+	return orascontent.NewDescriptorFromBytes(mediaType, content)
+}
+
+// addMultiArchImage adds an image index with a manifest for two platforms and returns its digest.
+func (r *fakeRegistry) addMultiArchImage(t *testing.T, repo, tag string) string {
+	var manifests []ocispec.Descriptor
+	for _, arch := range []string{"amd64", "arm64"} {
+		config := r.addBlob([]byte(fmt.Sprintf(`{"architecture":"%s","os":"linux"}`, arch)))
+		config.MediaType = ocispec.MediaTypeImageConfig
+		layer := r.addBlob([]byte("layer for " + arch))
+		manifest := ocispec.Manifest{MediaType: ocispec.MediaTypeImageManifest, Config: config, Layers: []ocispec.Descriptor{layer}}
+		manifest.SchemaVersion = 2
+		desc := r.addManifest(t, repo, "", ocispec.MediaTypeImageManifest, manifest)
+		desc.Platform = &ocispec.Platform{Architecture: arch, OS: "linux"}
+		manifests = append(manifests, desc)
+	}
+	index := ocispec.Index{MediaType: ocispec.MediaTypeImageIndex, Manifests: manifests}
+	index.SchemaVersion = 2
+	return r.addManifest(t, repo, tag, ocispec.MediaTypeImageIndex, index).Digest.String()
+}
+
+func TestOCILayoutDownloadAndImport(t *testing.T) {
+	g := NewWithT(t)
+	src := newFakeRegistry(t)
+	dst := newFakeRegistry(t)
+	digest := src.addMultiArchImage(t, "eks-anywhere/kube-vip", "v0.5.5")
+	tagged := src.host() + "/eks-anywhere/kube-vip:v0.5.5"
+	pinned := src.host() + "/eks-anywhere/kube-vip@" + digest
+
+	layoutDir := t.TempDir()
+	layout := registry.NewOCILayout(layoutDir)
+	downloader := registry.NewOCILayoutDownloader(layout, registry.NewCache(), credentialStore, nil, true)
+	g.Expect(downloader.Move(ctx, tagged, pinned, tagged)).To(Succeed())
+
+	store, err := layout.Store(ctx)
+	g.Expect(err).NotTo(HaveOccurred())
+	desc, err := store.Resolve(ctx, tagged)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(desc.Digest.String()).To(Equal(digest))
+	g.Expect(desc.MediaType).To(Equal(ocispec.MediaTypeImageIndex))
+
+	dstClient, err := registry.NewRegistryForEndpoint(dst.host()+"/mirror", credentialStore, nil, true)
+	g.Expect(err).NotTo(HaveOccurred())
+	importer := registry.NewOCILayoutImporter(registry.NewOCILayout(layoutDir), dstClient, dst.host()+"/mirror")
+	g.Expect(importer.Move(ctx, tagged, pinned)).To(Succeed())
+
+	g.Expect(digestOf(dst.manifests["mirror/eks-anywhere/kube-vip:v0.5.5"].content)).To(Equal(digest))
+	g.Expect(dst.manifests).To(HaveKey("mirror/eks-anywhere/kube-vip@" + digest))
+	index := ocispec.Index{}
+	g.Expect(json.Unmarshal(dst.manifests["mirror/eks-anywhere/kube-vip@"+digest].content, &index)).To(Succeed())
+	g.Expect(index.Manifests).To(HaveLen(2))
+	for _, m := range index.Manifests {
+		g.Expect(dst.manifests).To(HaveKey("mirror/eks-anywhere/kube-vip@" + m.Digest.String()))
+	}
+	g.Expect(dst.blobs).To(HaveLen(len(src.blobs)))
+}
+
+func TestOCILayoutDownloaderVerifyDigests(t *testing.T) {
+	g := NewWithT(t)
+	src := newFakeRegistry(t)
+	digest := src.addMultiArchImage(t, "eks-anywhere/kube-vip", "v0.5.5")
+	tagged := src.host() + "/eks-anywhere/kube-vip:v0.5.5"
+
+	downloader := registry.NewOCILayoutDownloader(registry.NewOCILayout(t.TempDir()), registry.NewCache(), credentialStore, nil, true)
+	g.Expect(downloader.Move(ctx, tagged)).To(Succeed())
+
+	g.Expect(downloader.VerifyDigests(ctx, []releasev1.Image{{URI: tagged, ImageDigest: digest}, {URI: "unpinned:v1"}})).To(Succeed())
+	err := downloader.VerifyDigests(ctx, []releasev1.Image{{URI: tagged, ImageDigest: "sha256:abc"}})
+	g.Expect(err).To(MatchError(fmt.Sprintf("image digests don't match bundle: %s (%s, expected sha256:abc)", tagged, digest)))
+}
+
+func TestOCILayoutDownloadMissingImage(t *testing.T) {
+	g := NewWithT(t)
+	src := newFakeRegistry(t)
+
+	downloader := registry.NewOCILayoutDownloader(registry.NewOCILayout(t.TempDir()), registry.NewCache(), credentialStore, nil, true)
+	err := downloader.Move(ctx, src.host()+"/eks-anywhere/kube-vip:v0.5.5")
+	g.Expect(err).To(MatchError(ContainSubstring("copying image " + src.host() + "/eks-anywhere/kube-vip:v0.5.5 to OCI layout")))
+}
+
+func TestOCILayoutImportMissingImage(t *testing.T) {
+	g := NewWithT(t)
+	dst := mocks.NewMockStorageClient(gomock.NewController(t))
+	dst.EXPECT().GetStorage(gomock.Any(), gomock.Any()).Return(nil, nil)
+
+	importer := registry.NewOCILayoutImporter(registry.NewOCILayout(t.TempDir()), dst, "harbor.local")
+	err := importer.Move(context.Background(), "public.ecr.aws/eks-anywhere/kube-vip:v0.5.5")
+	g.Expect(err).To(MatchError(ContainSubstring("pushing image public.ecr.aws/eks-anywhere/kube-vip:v0.5.5 from OCI layout")))
+}
+
+func TestOCILayoutImportCuratedPackagesProject(t *testing.T) {
+	g := NewWithT(t)
+	dst := mocks.NewMockStorageClient(gomock.NewController(t))
+	image := "783794618700.dkr.ecr.us-west-2.amazonaws.com/harbor/harbor-core@sha256:6efe21500abbfbb6b3e37b80dd5dea0b11a0d1b145e84298fee5d7784a77e967"
+	expected := registry.NewArtifactFromURI(image)
+	expected.Repository = "eks-anywhere/harbor/harbor-core"
+	dst.EXPECT().GetStorage(gomock.Any(), expected).Return(nil, fmt.Errorf("denied"))
+
+	importer := registry.NewOCILayoutImporter(registry.NewOCILayout(t.TempDir()), dst, "harbor.local")
+	g.Expect(importer.Move(context.Background(), image)).To(MatchError(ContainSubstring("repository destination: denied")))
+}
@@ -0,0 +1,191 @@
+package registry
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"oras.land/oras-go/v2"
+	"oras.land/oras-go/v2/content"
+
+	"github.com/aws/eks-anywhere/pkg/logger"
+)
+
+// cosignSignatureAnnotation holds the base64 signature of a cosign signature layer, its simple signing payload.
+const cosignSignatureAnnotation = "dev.cosignproject.cosign/signature"
+
+// SignaturePolicy defines what happens when an image doesn't have a valid signature.
+type SignaturePolicy string
+
+const (
+	// SignaturePolicyWarn logs a warning for images without a valid signature.
+	SignaturePolicyWarn SignaturePolicy = "warn"
+	// SignaturePolicyBlock fails for images without a valid signature.
+	SignaturePolicyBlock SignaturePolicy = "block"
+)
+
+// ParseSignaturePolicy parses a signature policy name.
+func ParseSignaturePolicy(policy string) (SignaturePolicy, error) {
+	switch p := SignaturePolicy(policy); p {
+	case SignaturePolicyWarn, SignaturePolicyBlock:
+		return p, nil
+	default:
+		return "", fmt.Errorf("invalid signature policy %s, must be one of %s or %s", policy, SignaturePolicyWarn, SignaturePolicyBlock)
+	}
+}
+
+// SignatureTag returns the tag cosign stores the signatures of the image with digest at, in the image repository.
+func SignatureTag(digest string) string {
+	return strings.Replace(digest, ":", "-", 1) + ".sig"
+}
+
+// simpleSigningPayload is the part of the payload signed by cosign identifying the image.
+type simpleSigningPayload struct {
+	Critical struct {
+		Image struct {
+			DockerManifestDigest string `json:"docker-manifest-digest"`
+		} `json:"image"`
+	} `json:"critical"`
+}
+
+// SignatureVerifier verifies the cosign signatures of images against trusted public keys.
+type SignatureVerifier struct {
+	keys   []crypto.PublicKey
+	policy SignaturePolicy
+}
+
+// NewSignatureVerifier creates a SignatureVerifier trusting signatures made with any of keys.
+func NewSignatureVerifier(keys []crypto.PublicKey, policy SignaturePolicy) *SignatureVerifier {
+	return &SignatureVerifier{
+		keys:   keys,
+		policy: policy,
+	}
+}
+
+// LoadPublicKeys reads PEM encoded public keys, as generated by cosign generate-key-pair.
+func LoadPublicKeys(files ...string) ([]crypto.PublicKey, error) {
+	keys := make([]crypto.PublicKey, 0, len(files))
+	for _, file := range files {
+		content, err := os.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("reading public key: %v", err)
+		}
+		block, _ := pem.Decode(content)
+		if block == nil {
+			return nil, fmt.Errorf("public key %s is not PEM encoded", file)
+		}
+		key, err := x509.ParsePKIXPublicKey(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("parsing public key %s: %v", file, err)
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+// Verify checks the image at reference in target has a signature by one of the trusted keys for its digest.
+// The signatures are read from the cosign signature tag of the image digest in target.
+func (v *SignatureVerifier) Verify(ctx context.Context, target oras.ReadOnlyTarget, reference string) error {
+	return v.verify(ctx, target, reference, SignatureTag)
+}
+
+// verify checks the signature of the image at reference in target, reading the signatures from the
+// reference signatureReference returns for the image digest.
+func (v *SignatureVerifier) verify(ctx context.Context, target oras.ReadOnlyTarget, reference string, signatureReference func(digest string) string) error {
+	desc, err := target.Resolve(ctx, reference)
+	if err != nil {
+		return fmt.Errorf("resolving image: %v", err)
+	}
+	digest := desc.Digest.String()
+
+	_, manifestContent, err := oras.FetchBytes(ctx, target, signatureReference(digest), oras.DefaultFetchBytesOptions)
+	if err != nil {
+		return fmt.Errorf("no signature found for %s: %v", digest, err)
+	}
+	manifest := ocispec.Manifest{}
+	if err = json.Unmarshal(manifestContent, &manifest); err != nil {
+		return fmt.Errorf("parsing signature manifest: %v", err)
+	}
+
+	for _, layer := range manifest.Layers {
+		signature, ok := layer.Annotations[cosignSignatureAnnotation]
+		if !ok {
+			continue
+		}
+		payload, err := content.FetchAll(ctx, target, layer)
+		if err != nil {
+			return fmt.Errorf("fetching signature payload: %v", err)
+		}
+		if err = v.verifyPayload(digest, payload, signature); err != nil {
+			logger.V(4).Info("Skipping invalid signature", "digest", digest, "error", err)
+			continue
+		}
+		return nil
+	}
+	return fmt.Errorf("no valid signature found for %s", digest)
+}
+
+// Check verifies the signature of image, at reference in target, and applies the policy. With
+// SignaturePolicyWarn, verification failures are only logged.
+func (v *SignatureVerifier) Check(ctx context.Context, target oras.ReadOnlyTarget, image, reference string) error {
+	return v.check(ctx, target, image, reference, SignatureTag)
+}
+
+func (v *SignatureVerifier) check(ctx context.Context, target oras.ReadOnlyTarget, image, reference string, signatureReference func(digest string) string) error {
+	err := v.verify(ctx, target, reference, signatureReference)
+	if err == nil {
+		logger.V(3).Info("Verified image signature", "image", image)
+		return nil
+	}
+	if v.policy == SignaturePolicyWarn {
+		logger.Info("Warning: image signature verification failed", "image", image, "error", err)
+		return nil
+	}
+	return fmt.Errorf("verifying signature of image %s: %v", image, err)
+}
+
+func (v *SignatureVerifier) verifyPayload(digest string, payload []byte, signature string) error {
+	p := simpleSigningPayload{}
+	if err := json.Unmarshal(payload, &p); err != nil {
+		return fmt.Errorf("parsing signature payload: %v", err)
+	}
+	if p.Critical.Image.DockerManifestDigest != digest {
+		return fmt.Errorf("signature is for digest %s", p.Critical.Image.DockerManifestDigest)
+	}
+	sig, err := base64.StdEncoding.DecodeString(signature)
+	if err != nil {
+		return fmt.Errorf("decoding signature: %v", err)
+	}
+	for _, key := range v.keys {
+		if verifySignature(key, payload, sig) {
+			return nil
+		}
+	}
+	return errors.New("signature doesn't match any trusted key")
+}
+
+func verifySignature(key crypto.PublicKey, payload, signature []byte) bool {
+	hash := sha256.Sum256(payload)
+	switch k := key.(type) {
+	case *ecdsa.PublicKey:
+		return ecdsa.VerifyASN1(k, hash[:], signature)
+	case *rsa.PublicKey:
+		return rsa.VerifyPKCS1v15(k, crypto.SHA256, hash[:], signature) == nil
+	case ed25519.PublicKey:
+		return ed25519.Verify(k, payload, signature)
+	default:
+		return false
+	}
+}
@@ -0,0 +1,203 @@
+package registry_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	orasregistry "oras.land/oras-go/v2/registry"
+
+	"github.com/aws/eks-anywhere/pkg/registry"
+)
+
+func newSigningKey(t *testing.T) *ecdsa.PrivateKey {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return key
+}
+
+// addSignature signs the image with digest in repo as cosign does, storing the signature in the signature tag.
+func (r *fakeRegistry) addSignature(t *testing.T, repo, digest, signedDigest string, key *ecdsa.PrivateKey) {
+	payload := []byte(fmt.Sprintf(`{"critical":{"identity":{"docker-reference":"%s"},"image":{"docker-manifest-digest":"%s"},"type":"cosign container image signature"},"optional":null}`, repo, signedDigest))
+	hash := sha256.Sum256(payload)
+	signature, err := ecdsa.SignASN1(rand.Reader, key, hash[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	layer := r.addBlob(payload)
+	layer.MediaType = "application/vnd.dev.cosign.simplesigning.v1+json"
+	layer.Annotations = map[string]string{"dev.cosignproject.cosign/signature": base64.StdEncoding.EncodeToString(signature)}
+	config := r.addBlob([]byte("{}"))
+	config.MediaType = ocispec.MediaTypeImageConfig
+	manifest := ocispec.Manifest{MediaType: ocispec.MediaTypeImageManifest, Config: config, Layers: []ocispec.Descriptor{layer}}
+	manifest.SchemaVersion = 2
+	r.addManifest(t, repo, registry.SignatureTag(digest), ocispec.MediaTypeImageManifest, manifest)
+}
+
+func writePublicKey(t *testing.T, key interface{}) string {
+	der, err := x509.MarshalPKIXPublicKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	file := filepath.Join(t.TempDir(), "cosign.pub")
+	if err = os.WriteFile(file, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return file
+}
+
+func repositoryFor(t *testing.T, r *fakeRegistry, repo string) orasregistry.Repository {
+	client, err := registry.NewRegistryForEndpoint(r.host(), credentialStore, nil, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	storage, err := client.GetStorage(ctx, registry.NewArtifact(r.host(), repo, "", ""))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return storage
+}
+
+func TestSignatureVerifierVerify(t *testing.T) {
+	g := NewWithT(t)
+	src := newFakeRegistry(t)
+	key := newSigningKey(t)
+	digest := src.addMultiArchImage(t, "eks-anywhere/kube-vip", "v0.5.5")
+	src.addSignature(t, "eks-anywhere/kube-vip", digest, digest, key)
+
+	keys, err := registry.LoadPublicKeys(writePublicKey(t, newSigningKey(t).Public()), writePublicKey(t, key.Public()))
+	g.Expect(err).NotTo(HaveOccurred())
+	verifier := registry.NewSignatureVerifier(keys, registry.SignaturePolicyBlock)
+
+	repo := repositoryFor(t, src, "eks-anywhere/kube-vip")
+	g.Expect(verifier.Verify(ctx, repo, "v0.5.5")).To(Succeed())
+	g.Expect(verifier.Verify(ctx, repo, digest)).To(Succeed())
+}
+
+func TestSignatureVerifierVerifyUntrustedKey(t *testing.T) {
+	g := NewWithT(t)
+	src := newFakeRegistry(t)
+	digest := src.addMultiArchImage(t, "eks-anywhere/kube-vip", "v0.5.5")
+	src.addSignature(t, "eks-anywhere/kube-vip", digest, digest, newSigningKey(t))
+
+	keys, err := registry.LoadPublicKeys(writePublicKey(t, newSigningKey(t).Public()))
+	g.Expect(err).NotTo(HaveOccurred())
+	verifier := registry.NewSignatureVerifier(keys, registry.SignaturePolicyBlock)
+
+	err = verifier.Verify(ctx, repositoryFor(t, src, "eks-anywhere/kube-vip"), "v0.5.5")
+	g.Expect(err).To(MatchError("no valid signature found for " + digest))
+}
+
+func TestSignatureVerifierVerifySignatureForOtherDigest(t *testing.T) {
+	g := NewWithT(t)
+	src := newFakeRegistry(t)
+	key := newSigningKey(t)
+	digest := src.addMultiArchImage(t, "eks-anywhere/kube-vip", "v0.5.5")
+	src.addSignature(t, "eks-anywhere/kube-vip", digest, "sha256:6efe21500abbfbb6b3e37b80dd5dea0b11a0d1b145e84298fee5d7784a77e967", key)
+
+	keys, err := registry.LoadPublicKeys(writePublicKey(t, key.Public()))
+	g.Expect(err).NotTo(HaveOccurred())
+	verifier := registry.NewSignatureVerifier(keys, registry.SignaturePolicyBlock)
+
+	err = verifier.Verify(ctx, repositoryFor(t, src, "eks-anywhere/kube-vip"), "v0.5.5")
+	g.Expect(err).To(MatchError("no valid signature found for " + digest))
+}
+
+func TestSignatureVerifierCheckUnsigned(t *testing.T) {
+	g := NewWithT(t)
+	src := newFakeRegistry(t)
+	digest := src.addMultiArchImage(t, "eks-anywhere/kube-vip", "v0.5.5")
+	repo := repositoryFor(t, src, "eks-anywhere/kube-vip")
+	image := src.host() + "/eks-anywhere/kube-vip:v0.5.5"
+
+	block := registry.NewSignatureVerifier(nil, registry.SignaturePolicyBlock)
+	err := block.Check(ctx, repo, image, "v0.5.5")
+	g.Expect(err).To(MatchError(ContainSubstring("verifying signature of image " + image + ": no signature found for " + digest)))
+
+	warn := registry.NewSignatureVerifier(nil, registry.SignaturePolicyWarn)
+	g.Expect(warn.Check(ctx, repo, image, "v0.5.5")).To(Succeed())
+}
+
+func TestOCILayoutKeepsSignatures(t *testing.T) {
+	g := NewWithT(t)
+	src := newFakeRegistry(t)
+	dst := newFakeRegistry(t)
+	key := newSigningKey(t)
+	digest := src.addMultiArchImage(t, "eks-anywhere/kube-vip", "v0.5.5")
+	src.addSignature(t, "eks-anywhere/kube-vip", digest, digest, key)
+	tagged := src.host() + "/eks-anywhere/kube-vip:v0.5.5"
+
+	layoutDir := t.TempDir()
+	downloader := registry.NewOCILayoutDownloader(registry.NewOCILayout(layoutDir), registry.NewCache(), credentialStore, nil, true)
+	g.Expect(downloader.Move(ctx, tagged)).To(Succeed())
+	dstClient, err := registry.NewRegistryForEndpoint(dst.host(), credentialStore, nil, true)
+	g.Expect(err).NotTo(HaveOccurred())
+	importer := registry.NewOCILayoutImporter(registry.NewOCILayout(layoutDir), dstClient, dst.host())
+	g.Expect(importer.Move(ctx, tagged)).To(Succeed())
+
+	keys, err := registry.LoadPublicKeys(writePublicKey(t, key.Public()))
+	g.Expect(err).NotTo(HaveOccurred())
+	verifier := registry.NewSignatureVerifier(keys, registry.SignaturePolicyBlock)
+	g.Expect(verifier.Verify(ctx, repositoryFor(t, dst, "eks-anywhere/kube-vip"), "v0.5.5")).To(Succeed())
+}
+
+func TestOCILayoutSignatureVerifier(t *testing.T) {
+	g := NewWithT(t)
+	src := newFakeRegistry(t)
+	key := newSigningKey(t)
+	digest := src.addMultiArchImage(t, "eks-anywhere/kube-vip", "v0.5.5")
+	src.addSignature(t, "eks-anywhere/kube-vip", digest, digest, key)
+	src.addMultiArchImage(t, "eks-anywhere/cilium", "v1.11.10")
+	signed := src.host() + "/eks-anywhere/kube-vip:v0.5.5"
+	unsigned := src.host() + "/eks-anywhere/cilium:v1.11.10"
+
+	layoutDir := t.TempDir()
+	downloader := registry.NewOCILayoutDownloader(registry.NewOCILayout(layoutDir), registry.NewCache(), credentialStore, nil, true)
+	g.Expect(downloader.Move(ctx, signed, unsigned)).To(Succeed())
+	keys, err := registry.LoadPublicKeys(writePublicKey(t, key.Public()))
+	g.Expect(err).NotTo(HaveOccurred())
+
+	verifier := registry.NewOCILayoutSignatureVerifier(registry.NewOCILayout(layoutDir), registry.NewSignatureVerifier(keys, registry.SignaturePolicyBlock))
+	g.Expect(verifier.VerifySignatures(ctx, signed)).To(Succeed())
+	err = verifier.VerifySignatures(ctx, signed, unsigned)
+	g.Expect(err).To(MatchError(ContainSubstring("verifying signature of image " + unsigned + ": no signature found")))
+}
+
+func TestLoadPublicKeys(t *testing.T) {
+	g := NewWithT(t)
+	edKey, _, err := ed25519.GenerateKey(rand.Reader)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	keys, err := registry.LoadPublicKeys(writePublicKey(t, newSigningKey(t).Public()), writePublicKey(t, edKey))
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(keys).To(HaveLen(2))
+
+	notPEM := filepath.Join(t.TempDir(), "key")
+	g.Expect(os.WriteFile(notPEM, []byte("key"), 0o644)).To(Succeed())
+	_, err = registry.LoadPublicKeys(notPEM)
+	g.Expect(err).To(MatchError("public key " + notPEM + " is not PEM encoded"))
+}
+
+func TestParseSignaturePolicy(t *testing.T) {
+	g := NewWithT(t)
+	policy, err := registry.ParseSignaturePolicy("warn")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(policy).To(Equal(registry.SignaturePolicyWarn))
+
+	_, err = registry.ParseSignaturePolicy("ignore")
+	g.Expect(err).To(MatchError("invalid signature policy ignore, must be one of warn or block"))
+}
@@ -0,0 +1,114 @@
+package registry
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+
+	"github.com/aws/eks-anywhere/pkg/logger"
+	releasev1 "github.com/aws/eks-anywhere/release/api/v1alpha1"
+)
+
+// ImageVerifier checks images were already imported to a registry endpoint, at the same repository paths
+// the import images command pushes them to.
+type ImageVerifier struct {
+	dst      StorageClient
+	endpoint string
+}
+
+// NewImageVerifier creates an ImageVerifier for the images in dst, the client of the registry endpoint.
+func NewImageVerifier(dst StorageClient, endpoint string) *ImageVerifier {
+	return &ImageVerifier{
+		dst:      dst,
+		endpoint: endpoint,
+	}
+}
+
+// Verify resolves every image in the registry and fails listing the images that can't be found.
+func (v *ImageVerifier) Verify(ctx context.Context, images ...string) error {
+	logger.V(3).Info("Verifying images exist in registry", "registry", v.endpoint, "numberOfImages", len(images))
+	var missing []string
+	for _, image := range uniqueImages(images) {
+		dstArtifact, ref := destinationForImage(v.endpoint, image)
+		dstStorage, err := v.dst.GetStorage(ctx, dstArtifact)
+		if err != nil {
+			return fmt.Errorf("repository destination: %v", err)
+		}
+		if _, err = v.dst.Resolve(ctx, dstStorage, ref); err != nil {
+			logger.V(4).Info("Image not found in registry", "image", image, "error", err)
+			missing = append(missing, image)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("images missing from registry %s: %s", v.endpoint, strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+// VerifyDigests checks the images in the registry have the digest pinned in their bundle.
+func (v *ImageVerifier) VerifyDigests(ctx context.Context, images []releasev1.Image) error {
+	return verifyDigests(images, func(image string) (ocispec.Descriptor, error) {
+		dstArtifact, ref := destinationForImage(v.endpoint, image)
+		dstStorage, err := v.dst.GetStorage(ctx, dstArtifact)
+		if err != nil {
+			return ocispec.Descriptor{}, fmt.Errorf("repository destination: %v", err)
+		}
+		return v.dst.Resolve(ctx, dstStorage, ref)
+	})
+}
+
+// verifyDigests resolves every image with a bundle digest and fails listing the ones that don't match it.
+func verifyDigests(images []releasev1.Image, resolve func(image string) (ocispec.Descriptor, error)) error {
+	var mismatches []string
+	for _, image := range images {
+		if image.ImageDigest == "" {
+			continue
+		}
+		desc, err := resolve(image.VersionedImage())
+		if err != nil {
+			return fmt.Errorf("resolving image %s: %v", image.VersionedImage(), err)
+		}
+		if desc.Digest.String() != image.ImageDigest {
+			mismatches = append(mismatches, fmt.Sprintf("%s (%s, expected %s)", image.VersionedImage(), desc.Digest, image.ImageDigest))
+		}
+	}
+	if len(mismatches) > 0 {
+		return fmt.Errorf("image digests don't match bundle: %s", strings.Join(mismatches, ", "))
+	}
+	return nil
+}
+
+// OCILayoutSignatureVerifier verifies the signatures of images in an OCI image layout, so they can be
+// checked before the images are imported.
+type OCILayoutSignatureVerifier struct {
+	layout   *OCILayout
+	verifier *SignatureVerifier
+}
+
+// NewOCILayoutSignatureVerifier creates an OCILayoutSignatureVerifier for the images in layout.
+func NewOCILayoutSignatureVerifier(layout *OCILayout, verifier *SignatureVerifier) *OCILayoutSignatureVerifier {
+	return &OCILayoutSignatureVerifier{
+		layout:   layout,
+		verifier: verifier,
+	}
+}
+
+// VerifySignatures checks the signature of every image in the OCI layout, applying the verifier policy.
+func (v *OCILayoutSignatureVerifier) VerifySignatures(ctx context.Context, images ...string) error {
+	store, err := v.layout.Store(ctx)
+	if err != nil {
+		return err
+	}
+	for _, image := range uniqueImages(images) {
+		artifact := NewArtifactFromURI(image)
+		signatureReference := func(digest string) string {
+			return layoutSignatureReference(artifact, SignatureTag(digest))
+		}
+		if err = v.verifier.check(ctx, store, image, image, signatureReference); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,57 @@
+package registry_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+
+	"github.com/aws/eks-anywhere/pkg/registry"
+	"github.com/aws/eks-anywhere/pkg/registry/mocks"
+	releasev1 "github.com/aws/eks-anywhere/release/api/v1alpha1"
+)
+
+func TestImageVerifier(t *testing.T) {
+	g := NewWithT(t)
+	dst := newFakeRegistry(t)
+	digest := dst.addMultiArchImage(t, "mirror/eks-anywhere/kube-vip", "v0.5.5")
+	dstClient, err := registry.NewRegistryForEndpoint(dst.host()+"/mirror", credentialStore, nil, true)
+	g.Expect(err).NotTo(HaveOccurred())
+	verifier := registry.NewImageVerifier(dstClient, dst.host()+"/mirror")
+
+	g.Expect(verifier.Verify(ctx,
+		"public.ecr.aws/eks-anywhere/kube-vip:v0.5.5",
+		"public.ecr.aws/eks-anywhere/kube-vip@"+digest,
+	)).To(Succeed())
+
+	err = verifier.Verify(ctx, "public.ecr.aws/eks-anywhere/kube-vip:v0.5.5", "public.ecr.aws/eks-anywhere/cilium:v1.11.10")
+	g.Expect(err).To(MatchError("images missing from registry " + dst.host() + "/mirror: public.ecr.aws/eks-anywhere/cilium:v1.11.10"))
+}
+
+func TestImageVerifierVerifyDigests(t *testing.T) {
+	g := NewWithT(t)
+	dst := newFakeRegistry(t)
+	digest := dst.addMultiArchImage(t, "eks-anywhere/kube-vip", "v0.5.5")
+	dstClient, err := registry.NewRegistryForEndpoint(dst.host(), credentialStore, nil, true)
+	g.Expect(err).NotTo(HaveOccurred())
+	verifier := registry.NewImageVerifier(dstClient, dst.host())
+
+	image := releasev1.Image{URI: "public.ecr.aws/eks-anywhere/kube-vip:v0.5.5", ImageDigest: digest}
+	g.Expect(verifier.VerifyDigests(ctx, []releasev1.Image{image})).To(Succeed())
+
+	image.ImageDigest = "sha256:abc"
+	g.Expect(verifier.VerifyDigests(ctx, []releasev1.Image{image})).To(MatchError(ContainSubstring("image digests don't match bundle")))
+
+	missing := releasev1.Image{URI: "public.ecr.aws/eks-anywhere/cilium:v1.11.10", ImageDigest: digest}
+	g.Expect(verifier.VerifyDigests(ctx, []releasev1.Image{missing})).To(MatchError(ContainSubstring("resolving image public.ecr.aws/eks-anywhere/cilium:v1.11.10")))
+}
+
+func TestImageVerifierRepositoryError(t *testing.T) {
+	g := NewWithT(t)
+	dst := mocks.NewMockStorageClient(gomock.NewController(t))
+	dst.EXPECT().GetStorage(ctx, gomock.Any()).Return(nil, errors.New("denied"))
+
+	verifier := registry.NewImageVerifier(dst, "harbor.local")
+	g.Expect(verifier.Verify(ctx, "public.ecr.aws/eks-anywhere/kube-vip:v0.5.5")).To(MatchError("repository destination: denied"))
+}
@@ -0,0 +1,34 @@
+package containerd
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// CredentialsDir is where the nodes get the registry mirror credentials from the credentials Secret
+	// when they are bootstrapped.
+	CredentialsDir = "/etc/containerd/registry-credentials"
+	// CredentialsScriptFile adds the credentials in CredentialsDir to ConfigAppendFile.
+	CredentialsScriptFile = CredentialsDir + "/append-auth.sh"
+)
+
+// CredentialsScript returns the script that adds the auth sections of registries to ConfigAppendFile with
+// the credentials in CredentialsDir, and then removes them. The credentials are read from the Secret when
+// each machine is bootstrapped instead of being rendered in the machine templates, so rotating them doesn't
+// change the templates and roll out the nodes.
+func CredentialsScript(registries []string) string {
+	quoted := make([]string, 0, len(registries))
+	for _, registry := range registries {
+		quoted = append(quoted, fmt.Sprintf("%q", registry))
+	}
+
+	return fmt.Sprintf(`#!/bin/sh
+set -eu
+username=$(sed -e 's/[\\"]/\\&/g' %[1]s/username)
+password=$(sed -e 's/[\\"]/\\&/g' %[1]s/password)
+for registry in %[2]s; do
+  printf '[plugins."io.containerd.grpc.v1.cri".registry.configs."%%s".auth]\n  username = "%%s"\n  password = "%%s"\n' "$registry" "$username" "$password"
+done >> %[3]s
+rm -f %[1]s/username %[1]s/password`, CredentialsDir, strings.Join(quoted, " "), ConfigAppendFile)
+}
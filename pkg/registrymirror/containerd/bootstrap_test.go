@@ -0,0 +1,44 @@
+package containerd_test
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/pelletier/go-toml/v2"
+
+	"github.com/aws/eks-anywhere/pkg/registrymirror/containerd"
+)
+
+func TestCredentialsScript(t *testing.T) {
+	g := NewWithT(t)
+	root := t.TempDir()
+	credentialsDir := filepath.Join(root, containerd.CredentialsDir)
+	configAppend := filepath.Join(root, containerd.ConfigAppendFile)
+	g.Expect(os.MkdirAll(credentialsDir, 0o700)).To(Succeed())
+	g.Expect(os.WriteFile(filepath.Join(credentialsDir, "username"), []byte(`user`), 0o600)).To(Succeed())
+	g.Expect(os.WriteFile(filepath.Join(credentialsDir, "password"), []byte(`p"a\s&s|`), 0o600)).To(Succeed())
+	g.Expect(os.WriteFile(configAppend, []byte("[plugins.\"io.containerd.grpc.v1.cri\".registry.mirrors]\n"), 0o600)).To(Succeed())
+
+	script := containerd.CredentialsScript([]string{"1.2.3.4:443", "1.2.3.5:443"})
+	script = strings.ReplaceAll(script, containerd.CredentialsDir, credentialsDir)
+	script = strings.ReplaceAll(script, containerd.ConfigAppendFile, configAppend)
+	out, err := exec.Command("sh", "-c", script).CombinedOutput()
+	g.Expect(err).NotTo(HaveOccurred(), string(out))
+
+	content, err := os.ReadFile(configAppend)
+	g.Expect(err).NotTo(HaveOccurred())
+	config := map[string]interface{}{}
+	g.Expect(toml.Unmarshal(content, &config)).To(Succeed())
+	configs := config["plugins"].(map[string]interface{})["io.containerd.grpc.v1.cri"].(map[string]interface{})["registry"].(map[string]interface{})["configs"].(map[string]interface{})
+	for _, registry := range []string{"1.2.3.4:443", "1.2.3.5:443"} {
+		g.Expect(configs[registry]).To(Equal(map[string]interface{}{
+			"auth": map[string]interface{}{"username": "user", "password": `p"a\s&s|`},
+		}))
+	}
+	g.Expect(filepath.Join(credentialsDir, "username")).NotTo(BeAnExistingFile())
+	g.Expect(filepath.Join(credentialsDir, "password")).NotTo(BeAnExistingFile())
+}
@@ -0,0 +1,131 @@
+package containerd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	// ConfigFile is the containerd configuration file of Ubuntu and RHEL nodes.
+	ConfigFile = "/etc/containerd/config.toml"
+	// ConfigAppendFile holds the registry mirror configuration, and is appended to ConfigFile
+	// when the node is bootstrapped.
+	ConfigAppendFile = "/etc/containerd/config_append.toml"
+)
+
+// ConfigFiles are the containerd configuration files that hold the registry mirror credentials.
+var ConfigFiles = []string{ConfigFile, ConfigAppendFile}
+
+// RefreshCredentials sets username and password in the auth sections of registries in a containerd
+// config, and returns the updated config and whether it changed. Sections and keys that don't exist
+// aren't added, so configs without authentication for registries are returned as is.
+func RefreshCredentials(config string, registries []string, username, password string) (string, bool) {
+	authSections := make(map[string]struct{}, len(registries))
+	for _, registry := range registries {
+		authSections[fmt.Sprintf(`[plugins."io.containerd.grpc.v1.cri".registry.configs."%s".auth]`, registry)] = struct{}{}
+	}
+	values := map[string]string{
+		"username": tomlString(username),
+		"password": tomlString(password),
+	}
+
+	lines := strings.Split(config, "\n")
+	inAuth := false
+	changed := false
+	for i, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "[") {
+			_, inAuth = authSections[trimmed]
+			continue
+		}
+		if !inAuth {
+			continue
+		}
+		key, _, found := strings.Cut(trimmed, "=")
+		if !found {
+			continue
+		}
+		value, ok := values[strings.TrimSpace(key)]
+		if !ok {
+			continue
+		}
+		indent := line[:len(line)-len(strings.TrimLeft(line, " \t"))]
+		if updated := indent + strings.TrimSpace(key) + " = " + value; updated != line {
+			lines[i] = updated
+			changed = true
+		}
+	}
+
+	return strings.Join(lines, "\n"), changed
+}
+
+// RefreshCredentialsInFiles refreshes the registry mirror credentials in the ConfigFiles under root
+// that exist, and returns whether any of them changed.
+func RefreshCredentialsInFiles(root string, registries []string, username, password string) (bool, error) {
+	changed := false
+	for _, file := range ConfigFiles {
+		path := filepath.Join(root, file)
+		info, err := os.Stat(path)
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return false, fmt.Errorf("reading containerd config: %v", err)
+		}
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return false, fmt.Errorf("reading containerd config: %v", err)
+		}
+
+		updated, fileChanged := RefreshCredentials(string(content), registries, username, password)
+		if !fileChanged {
+			continue
+		}
+		// The config is replaced with a rename, so containerd never reads a partially written file.
+		tmp := path + ".new"
+		if err = os.WriteFile(tmp, []byte(updated), info.Mode().Perm()); err != nil {
+			return false, fmt.Errorf("writing containerd config: %v", err)
+		}
+		if err = os.Rename(tmp, path); err != nil {
+			return false, fmt.Errorf("writing containerd config: %v", err)
+		}
+		changed = true
+	}
+
+	return changed, nil
+}
+
+// tomlString quotes s as a TOML basic string.
+func tomlString(s string) string {
+	var b strings.Builder
+	b.WriteByte('"')
+	for _, r := range s {
+		switch r {
+		case '"':
+			b.WriteString(`\"`)
+		case '\\':
+			b.WriteString(`\\`)
+		case '\b':
+			b.WriteString(`\b`)
+		case '\t':
+			b.WriteString(`\t`)
+		case '\n':
+			b.WriteString(`\n`)
+		case '\f':
+			b.WriteString(`\f`)
+		case '\r':
+			b.WriteString(`\r`)
+		default:
+			if r < 0x20 || r == 0x7f || r == utf8.RuneError {
+				fmt.Fprintf(&b, `\u%04X`, r)
+			} else {
+				b.WriteRune(r)
+			}
+		}
+	}
+	b.WriteByte('"')
+	return b.String()
+}
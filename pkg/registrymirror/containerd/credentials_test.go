@@ -0,0 +1,110 @@
+package containerd_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/pelletier/go-toml/v2"
+
+	"github.com/aws/eks-anywhere/pkg/registrymirror/containerd"
+)
+
+const containerdConfig = `version = 2
+[plugins."io.containerd.grpc.v1.cri".registry.mirrors]
+  [plugins."io.containerd.grpc.v1.cri".registry.mirrors."public.ecr.aws"]
+    endpoint = ["https://1.2.3.4:443", "https://1.2.3.5:443"]
+  [plugins."io.containerd.grpc.v1.cri".registry.configs."1.2.3.4:443".auth]
+    username = "old-user"
+    password = "old-pass"
+  [plugins."io.containerd.grpc.v1.cri".registry.configs."1.2.3.5:443".auth]
+    username = "old-user"
+    password = "old-pass"
+  [plugins."io.containerd.grpc.v1.cri".registry.configs."docker.io".auth]
+    username = "docker-user"
+    password = "docker-pass"
+`
+
+func TestRefreshCredentials(t *testing.T) {
+	g := NewWithT(t)
+
+	config, changed := containerd.RefreshCredentials(containerdConfig, []string{"1.2.3.4:443", "1.2.3.5:443"}, "user", "pass")
+	g.Expect(changed).To(BeTrue())
+	g.Expect(config).To(Equal(`version = 2
+[plugins."io.containerd.grpc.v1.cri".registry.mirrors]
+  [plugins."io.containerd.grpc.v1.cri".registry.mirrors."public.ecr.aws"]
+    endpoint = ["https://1.2.3.4:443", "https://1.2.3.5:443"]
+  [plugins."io.containerd.grpc.v1.cri".registry.configs."1.2.3.4:443".auth]
+    username = "user"
+    password = "pass"
+  [plugins."io.containerd.grpc.v1.cri".registry.configs."1.2.3.5:443".auth]
+    username = "user"
+    password = "pass"
+  [plugins."io.containerd.grpc.v1.cri".registry.configs."docker.io".auth]
+    username = "docker-user"
+    password = "docker-pass"
+`))
+
+	_, changed = containerd.RefreshCredentials(config, []string{"1.2.3.4:443", "1.2.3.5:443"}, "user", "pass")
+	g.Expect(changed).To(BeFalse())
+}
+
+func TestRefreshCredentialsEscapesPassword(t *testing.T) {
+	g := NewWithT(t)
+	password := "p\"a\\ss\nword\x01=\"x\""
+
+	config, changed := containerd.RefreshCredentials(containerdConfig, []string{"1.2.3.4:443"}, "user", password)
+	g.Expect(changed).To(BeTrue())
+
+	parsed := struct {
+		Plugins map[string]struct {
+			Registry struct {
+				Configs map[string]struct {
+					Auth struct {
+						Username string `toml:"username"`
+						Password string `toml:"password"`
+					} `toml:"auth"`
+				} `toml:"configs"`
+			} `toml:"registry"`
+		} `toml:"plugins"`
+	}{}
+	g.Expect(toml.Unmarshal([]byte(config), &parsed)).To(Succeed())
+	auth := parsed.Plugins["io.containerd.grpc.v1.cri"].Registry.Configs["1.2.3.4:443"].Auth
+	g.Expect(auth.Username).To(Equal("user"))
+	g.Expect(auth.Password).To(Equal(password))
+}
+
+func TestRefreshCredentialsNoAuth(t *testing.T) {
+	g := NewWithT(t)
+	config := `[plugins."io.containerd.grpc.v1.cri".registry.mirrors]
+  [plugins."io.containerd.grpc.v1.cri".registry.mirrors."public.ecr.aws"]
+    endpoint = ["https://1.2.3.4:443"]
+`
+
+	updated, changed := containerd.RefreshCredentials(config, []string{"1.2.3.4:443"}, "user", "pass")
+	g.Expect(changed).To(BeFalse())
+	g.Expect(updated).To(Equal(config))
+}
+
+func TestRefreshCredentialsInFiles(t *testing.T) {
+	g := NewWithT(t)
+	root := t.TempDir()
+	g.Expect(os.MkdirAll(filepath.Join(root, "etc/containerd"), 0o755)).To(Succeed())
+	configFile := filepath.Join(root, "etc/containerd/config.toml")
+	g.Expect(os.WriteFile(configFile, []byte(containerdConfig), 0o600)).To(Succeed())
+
+	changed, err := containerd.RefreshCredentialsInFiles(root, []string{"1.2.3.4:443"}, "user", "pass")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(changed).To(BeTrue())
+	content, err := os.ReadFile(configFile)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(string(content)).To(ContainSubstring(`password = "pass"`))
+	info, err := os.Stat(configFile)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(info.Mode().Perm()).To(Equal(os.FileMode(0o600)))
+
+	changed, err = containerd.RefreshCredentialsInFiles(root, []string{"1.2.3.4:443"}, "user", "pass")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(changed).To(BeFalse())
+}
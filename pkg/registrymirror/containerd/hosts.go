@@ -0,0 +1,87 @@
+package containerd
+
+import (
+	_ "embed"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/aws/eks-anywhere/pkg/constants"
+	"github.com/aws/eks-anywhere/pkg/registrymirror"
+	"github.com/aws/eks-anywhere/pkg/templater"
+)
+
+// CertsDir is the directory containerd reads the registry hosts configuration and certificates from.
+const CertsDir = "/etc/containerd/certs.d"
+
+//go:embed config/hosts.toml
+var hostsTemplate string
+
+// HostsFile is a containerd hosts.toml file, configuring how images from an upstream registry are pulled.
+type HostsFile struct {
+	// Path is the path of the file relative to CertsDir.
+	Path    string
+	Content string
+}
+
+type host struct {
+	Endpoint     string
+	OverridePath bool
+}
+
+// HostsFiles builds the containerd hosts.toml files for every mirrored upstream registry,
+// listing all the registry mirrors in failover order.
+// The curated packages registries are skipped, since they are matched with a regex that
+// can't be used as hosts directory.
+func HostsFiles(registryMirror *registrymirror.RegistryMirror) ([]HostsFile, error) {
+	upstreams := make([]string, 0, len(registryMirror.NamespacedRegistryMap))
+	for upstream := range registryMirror.NamespacedRegistryMap {
+		if upstream == constants.DefaultCuratedPackagesRegistryRegex {
+			continue
+		}
+		upstreams = append(upstreams, upstream)
+	}
+	sort.Strings(upstreams)
+
+	caFile := ""
+	if registryMirror.CACertContent != "" {
+		caFile = filepath.Join(CertsDir, registryMirror.BaseRegistry, "ca.crt")
+	}
+
+	files := make([]HostsFile, 0, len(upstreams))
+	for _, upstream := range upstreams {
+		mirrors := registryMirror.Mirrors(upstream)
+		hosts := make([]host, 0, len(mirrors))
+		for _, mirror := range mirrors {
+			endpoint := ToAPIEndpoint(mirror)
+			hosts = append(hosts, host{Endpoint: endpoint, OverridePath: strings.Contains(endpoint, "/")})
+		}
+		content, err := templater.Execute(hostsTemplate, map[string]interface{}{
+			"server":       upstream,
+			"hosts":        hosts,
+			"caFile":       caFile,
+			"insecureSkip": registryMirror.InsecureSkipVerify,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("building containerd hosts file for %s: %v", upstream, err)
+		}
+		files = append(files, HostsFile{
+			Path:    filepath.Join(upstream, "hosts.toml"),
+			Content: string(content),
+		})
+	}
+	return files, nil
+}
+
+// ToAPIEndpointMirrors utilizes ToAPIEndpoint to turn the mirrors of every upstream registry
+// to valid API endpoints for a local registry, in failover order.
+func ToAPIEndpointMirrors(registryMirror *registrymirror.RegistryMirror) map[string][]string {
+	endpoints := make(map[string][]string)
+	for key := range registryMirror.NamespacedRegistryMap {
+		for _, mirror := range registryMirror.Mirrors(key) {
+			endpoints[key] = append(endpoints[key], ToAPIEndpoint(mirror))
+		}
+	}
+	return endpoints
+}
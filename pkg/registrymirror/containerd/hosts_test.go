@@ -0,0 +1,59 @@
+package containerd_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/aws/eks-anywhere/pkg/constants"
+	"github.com/aws/eks-anywhere/pkg/registrymirror"
+	"github.com/aws/eks-anywhere/pkg/registrymirror/containerd"
+)
+
+func TestHostsFiles(t *testing.T) {
+	g := NewWithT(t)
+	registryMirror := &registrymirror.RegistryMirror{
+		BaseRegistry:       "1.2.3.4:443",
+		FailoverRegistries: []string{"1.2.3.5:443"},
+		NamespacedRegistryMap: map[string]string{
+			constants.DefaultCoreEKSARegistry:             "1.2.3.4:443",
+			constants.DefaultCuratedPackagesRegistryRegex: "1.2.3.4:443/curated-packages",
+		},
+		InsecureSkipVerify: true,
+	}
+
+	files, err := containerd.HostsFiles(registryMirror)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(files).To(Equal([]containerd.HostsFile{
+		{
+			Path: "public.ecr.aws/hosts.toml",
+			Content: `server = "https://public.ecr.aws"
+
+[host."https://1.2.3.4:443"]
+  capabilities = ["pull", "resolve"]
+  skip_verify = true
+
+[host."https://1.2.3.5:443"]
+  capabilities = ["pull", "resolve"]
+  skip_verify = true
+`,
+		},
+	}))
+}
+
+func TestToAPIEndpointMirrors(t *testing.T) {
+	g := NewWithT(t)
+	registryMirror := &registrymirror.RegistryMirror{
+		BaseRegistry:       "1.2.3.4:443",
+		FailoverRegistries: []string{"1.2.3.5:443"},
+		NamespacedRegistryMap: map[string]string{
+			constants.DefaultCoreEKSARegistry:             "1.2.3.4:443/eks-anywhere",
+			constants.DefaultCuratedPackagesRegistryRegex: "1.2.3.4:443/curated-packages",
+		},
+	}
+
+	g.Expect(containerd.ToAPIEndpointMirrors(registryMirror)).To(Equal(map[string][]string{
+		constants.DefaultCoreEKSARegistry:             {"1.2.3.4:443/v2/eks-anywhere", "1.2.3.5:443/v2/eks-anywhere"},
+		constants.DefaultCuratedPackagesRegistryRegex: {"1.2.3.4:443/v2/curated-packages", "1.2.3.5:443/v2/curated-packages"},
+	}))
+}
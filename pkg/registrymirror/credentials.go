@@ -0,0 +1,114 @@
+package registrymirror
+
+import (
+	"crypto/sha256"
+	_ "embed"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/aws/eks-anywhere/pkg/config"
+	"github.com/aws/eks-anywhere/pkg/constants"
+	"github.com/aws/eks-anywhere/pkg/templater"
+)
+
+//go:embed config/credentials-refresher.yaml
+var credentialsRefresherTemplate string
+
+const (
+	// CredentialsSecretName is the name of the Secret in the eksa-system namespace
+	// that stores the registry mirror credentials.
+	CredentialsSecretName = "registry-credentials"
+	// CredentialsRefresherName is the name of the DaemonSet that refreshes
+	// the registry mirror credentials in the containerd configuration of the nodes.
+	CredentialsRefresherName = "registry-credentials-refresher"
+	// CredentialsChecksumAnnotation stores the checksum of the credentials in the refresher pods,
+	// so they are rolled out every time the credentials change.
+	CredentialsChecksumAnnotation = "anywhere.eks.amazonaws.com/registry-credentials-checksum"
+
+	usernameKey = "username"
+	passwordKey = "password"
+)
+
+// CredentialsSecret builds the Secret that stores the registry mirror credentials.
+func CredentialsSecret(username, password string) *corev1.Secret {
+	return &corev1.Secret{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "v1",
+			Kind:       "Secret",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      CredentialsSecretName,
+			Namespace: constants.EksaSystemNamespace,
+			Labels: map[string]string{
+				"clusterctl.cluster.x-k8s.io/move": "true",
+			},
+		},
+		Type: corev1.SecretTypeOpaque,
+		Data: map[string][]byte{
+			usernameKey: []byte(username),
+			passwordKey: []byte(password),
+		},
+	}
+}
+
+// CredentialsFromSecret reads the registry mirror credentials from a Secret built with CredentialsSecret.
+func CredentialsFromSecret(secret *corev1.Secret) (username, password string, err error) {
+	u, ok := secret.Data[usernameKey]
+	if !ok || len(u) == 0 {
+		return "", "", fmt.Errorf("secret %s/%s is missing the %s key", secret.Namespace, secret.Name, usernameKey)
+	}
+	p, ok := secret.Data[passwordKey]
+	if !ok || len(p) == 0 {
+		return "", "", fmt.Errorf("secret %s/%s is missing the %s key", secret.Namespace, secret.Name, passwordKey)
+	}
+
+	return string(u), string(p), nil
+}
+
+// ReadCredentials reads the registry mirror credentials from a Secret built with CredentialsSecret.
+// Before the cluster and its Secret exist, secret is nil and the credentials are read from the
+// REGISTRY_USERNAME and REGISTRY_PASSWORD env vars instead.
+func ReadCredentials(secret *corev1.Secret) (username, password string, err error) {
+	if secret == nil {
+		return config.ReadCredentials()
+	}
+
+	return CredentialsFromSecret(secret)
+}
+
+// CredentialsSecretFromEnv builds the Secret that stores the registry mirror credentials
+// from the REGISTRY_USERNAME and REGISTRY_PASSWORD env vars.
+func CredentialsSecretFromEnv() (*corev1.Secret, error) {
+	username, password, err := config.ReadCredentials()
+	if err != nil {
+		return nil, err
+	}
+
+	return CredentialsSecret(username, password), nil
+}
+
+// CredentialsRefresherManifest generates the DaemonSet that rewrites the registry mirror credentials
+// in the containerd configuration of every node and restarts containerd when they change.
+// The credentials are refreshed once per rollout by an init container running the registry-credentials-refresher
+// binary from image, and the pod then idles with pauseImage until the next rotation rolls the DaemonSet out.
+// The DaemonSet reads the credentials from the CredentialsSecretName Secret in the same cluster.
+func CredentialsRefresherManifest(r *RegistryMirror, image, pauseImage, username, password string) ([]byte, error) {
+	data := map[string]interface{}{
+		"name":             CredentialsRefresherName,
+		"namespace":        constants.EksaSystemNamespace,
+		"secretName":       CredentialsSecretName,
+		"image":            image,
+		"pauseImage":       pauseImage,
+		"mirrorRegistries": r.Registries(),
+		"checksumKey":      CredentialsChecksumAnnotation,
+		"checksum":         credentialsChecksum(username, password),
+	}
+
+	return templater.Execute(credentialsRefresherTemplate, data)
+}
+
+func credentialsChecksum(username, password string) string {
+	return fmt.Sprintf("%x", sha256.Sum256([]byte(username+"\n"+password)))
+}
@@ -0,0 +1,102 @@
+package registrymirror_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/aws/eks-anywhere/internal/test"
+	"github.com/aws/eks-anywhere/pkg/constants"
+	"github.com/aws/eks-anywhere/pkg/registrymirror"
+)
+
+func TestCredentialsSecret(t *testing.T) {
+	g := NewWithT(t)
+	secret := registrymirror.CredentialsSecret("user", "pass")
+	g.Expect(secret.Name).To(Equal(registrymirror.CredentialsSecretName))
+	g.Expect(secret.Namespace).To(Equal(constants.EksaSystemNamespace))
+
+	username, password, err := registrymirror.CredentialsFromSecret(secret)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(username).To(Equal("user"))
+	g.Expect(password).To(Equal("pass"))
+}
+
+func TestCredentialsFromSecretMissingKeys(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    map[string][]byte
+		wantErr string
+	}{
+		{
+			name:    "missing username",
+			data:    map[string][]byte{"password": []byte("pass")},
+			wantErr: "secret eksa-system/registry-credentials is missing the username key",
+		},
+		{
+			name:    "empty password",
+			data:    map[string][]byte{"username": []byte("user"), "password": {}},
+			wantErr: "secret eksa-system/registry-credentials is missing the password key",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			secret := registrymirror.CredentialsSecret("", "")
+			secret.Data = tt.data
+			_, _, err := registrymirror.CredentialsFromSecret(secret)
+			g.Expect(err).To(MatchError(tt.wantErr))
+		})
+	}
+}
+
+func TestReadCredentials(t *testing.T) {
+	g := NewWithT(t)
+	t.Setenv("REGISTRY_USERNAME", "env-user")
+	t.Setenv("REGISTRY_PASSWORD", "env-pass")
+
+	username, password, err := registrymirror.ReadCredentials(registrymirror.CredentialsSecret("user", "pass"))
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(username).To(Equal("user"))
+	g.Expect(password).To(Equal("pass"))
+
+	username, password, err = registrymirror.ReadCredentials(nil)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(username).To(Equal("env-user"))
+	g.Expect(password).To(Equal("env-pass"))
+}
+
+func TestCredentialsSecretFromEnv(t *testing.T) {
+	g := NewWithT(t)
+	t.Setenv("REGISTRY_USERNAME", "env-user")
+	t.Setenv("REGISTRY_PASSWORD", "env-pass")
+
+	secret, err := registrymirror.CredentialsSecretFromEnv()
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(secret).To(Equal(registrymirror.CredentialsSecret("env-user", "env-pass")))
+}
+
+func TestCredentialsRefresherManifest(t *testing.T) {
+	g := NewWithT(t)
+	r := &registrymirror.RegistryMirror{
+		BaseRegistry:       "1.2.3.4:443",
+		FailoverRegistries: []string{"5.6.7.8:443"},
+		Auth:               true,
+	}
+
+	manifest, err := registrymirror.CredentialsRefresherManifest(r, "public.ecr.aws/eks-anywhere/eks-anywhere-cluster-controller:v0.0.1", "public.ecr.aws/eks-distro/kubernetes/pause:v1.23.7", "user", "pass")
+	g.Expect(err).NotTo(HaveOccurred())
+	test.AssertContentToFile(t, string(manifest), "testdata/credentials_refresher.yaml")
+}
+
+func TestCredentialsRefresherManifestChecksumChanges(t *testing.T) {
+	g := NewWithT(t)
+	r := &registrymirror.RegistryMirror{BaseRegistry: "1.2.3.4:443", Auth: true}
+
+	old, err := registrymirror.CredentialsRefresherManifest(r, "image", "pause", "user", "s3cr3t-old")
+	g.Expect(err).NotTo(HaveOccurred())
+	rotated, err := registrymirror.CredentialsRefresherManifest(r, "image", "pause", "user", "s3cr3t-rotated")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(rotated).NotTo(Equal(old))
+	g.Expect(string(rotated)).NotTo(ContainSubstring("s3cr3t"))
+}
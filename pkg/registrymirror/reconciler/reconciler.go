@@ -0,0 +1,166 @@
+package reconciler
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	kubeadmv1 "sigs.k8s.io/cluster-api/bootstrap/kubeadm/api/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	anywhereCluster "github.com/aws/eks-anywhere/pkg/cluster"
+	"github.com/aws/eks-anywhere/pkg/constants"
+	"github.com/aws/eks-anywhere/pkg/controller"
+	"github.com/aws/eks-anywhere/pkg/controller/clientutil"
+	"github.com/aws/eks-anywhere/pkg/controller/serverside"
+	"github.com/aws/eks-anywhere/pkg/registrymirror"
+	"github.com/aws/eks-anywhere/pkg/registrymirror/containerd"
+)
+
+// RemoteClientRegistry defines methods for remote cluster controller clients.
+type RemoteClientRegistry interface {
+	GetClient(ctx context.Context, cluster client.ObjectKey) (client.Client, error)
+}
+
+// Reconciler propagates the registry mirror credentials to the nodes of a cluster.
+type Reconciler struct {
+	client               client.Client
+	remoteClientRegistry RemoteClientRegistry
+}
+
+// New returns a new Reconciler.
+func New(client client.Client, remoteClientRegistry RemoteClientRegistry) *Reconciler {
+	return &Reconciler{
+		client:               client,
+		remoteClientRegistry: remoteClientRegistry,
+	}
+}
+
+// Reconcile copies the registry mirror credentials Secret from the management cluster to the workload cluster
+// and deploys the DaemonSet that refreshes the credentials in the containerd configuration of its nodes.
+// Machines read the credentials from the Secret when they are bootstrapped, so the KubeadmControlPlane is left
+// as is and rotating the credentials doesn't roll out the control plane. Worker KubeadmConfigTemplates of clusters
+// created with the credentials inline are updated in place, so their new nodes use the new credentials.
+// It's a no-op if the cluster doesn't use an authenticated registry mirror or the credentials Secret doesn't exist.
+func (r *Reconciler) Reconcile(ctx context.Context, log logr.Logger, cluster *anywherev1.Cluster) (controller.Result, error) {
+	if !cluster.RegistryAuth() {
+		return controller.Result{}, nil
+	}
+
+	secret := &corev1.Secret{}
+	key := types.NamespacedName{Namespace: constants.EksaSystemNamespace, Name: registrymirror.CredentialsSecretName}
+	if err := r.client.Get(ctx, key, secret); apierrors.IsNotFound(err) {
+		log.Info("Registry mirror credentials secret not found, skipping credentials refresh", "secret", key)
+		return controller.Result{}, nil
+	} else if err != nil {
+		return controller.Result{}, errors.Wrap(err, "fetching registry mirror credentials secret")
+	}
+
+	username, password, err := registrymirror.CredentialsFromSecret(secret)
+	if err != nil {
+		return controller.Result{}, err
+	}
+
+	clusterSpec, err := anywhereCluster.BuildSpec(ctx, clientutil.NewKubeClient(r.client), cluster)
+	if err != nil {
+		return controller.Result{}, err
+	}
+
+	mirror := registrymirror.FromCluster(cluster)
+	manifest, err := registrymirror.CredentialsRefresherManifest(
+		mirror,
+		clusterSpec.VersionsBundle.Eksa.ClusterController.VersionedImage(),
+		clusterSpec.VersionsBundle.KubeDistro.Pause.VersionedImage(),
+		username,
+		password,
+	)
+	if err != nil {
+		return controller.Result{}, errors.Wrap(err, "generating registry credentials refresher manifest")
+	}
+
+	if err = r.refreshWorkerTemplates(ctx, log, cluster, mirror.Registries(), username, password); err != nil {
+		return controller.Result{}, err
+	}
+
+	rClient, err := r.remoteClientRegistry.GetClient(ctx, controller.CapiClusterObjectKey(cluster))
+	if err != nil {
+		return controller.Result{}, errors.Wrap(err, "getting workload cluster's client to reconcile registry mirror credentials")
+	}
+
+	// A self-managed cluster already reads the credentials from the Secret being reconciled.
+	if !cluster.IsSelfManaged() {
+		if err = serverside.ReconcileObject(ctx, rClient, registrymirror.CredentialsSecret(username, password)); err != nil {
+			return controller.Result{}, errors.Wrap(err, "applying registry mirror credentials secret")
+		}
+	}
+
+	log.Info("Applying registry credentials refresher", "registries", mirror.Registries())
+	if err = serverside.ReconcileYaml(ctx, rClient, manifest); err != nil {
+		return controller.Result{}, errors.Wrap(err, "applying registry credentials refresher manifest")
+	}
+
+	return controller.Result{}, nil
+}
+
+// refreshWorkerTemplates updates the registry mirror credentials in the containerd config appended by the
+// KubeadmConfigTemplates of the cluster's MachineDeployments, for templates that have them inline. Templates
+// are patched in place, so running machines aren't rolled out.
+func (r *Reconciler) refreshWorkerTemplates(ctx context.Context, log logr.Logger, cluster *anywherev1.Cluster, registries []string, username, password string) error {
+	machineDeployments := &clusterv1.MachineDeploymentList{}
+	if err := r.client.List(ctx, machineDeployments,
+		client.InNamespace(constants.EksaSystemNamespace),
+		client.MatchingLabels{clusterv1.ClusterLabelName: cluster.Name},
+	); err != nil {
+		return errors.Wrap(err, "listing machine deployments to refresh registry mirror credentials")
+	}
+
+	for _, md := range machineDeployments.Items {
+		configRef := md.Spec.Template.Spec.Bootstrap.ConfigRef
+		if configRef == nil || configRef.Kind != "KubeadmConfigTemplate" {
+			continue
+		}
+
+		template := &kubeadmv1.KubeadmConfigTemplate{}
+		key := types.NamespacedName{Namespace: constants.EksaSystemNamespace, Name: configRef.Name}
+		if err := r.client.Get(ctx, key, template); apierrors.IsNotFound(err) {
+			continue
+		} else if err != nil {
+			return errors.Wrapf(err, "fetching kubeadm config template %s", configRef.Name)
+		}
+
+		patch := client.MergeFrom(template.DeepCopy())
+		if !refreshFiles(template.Spec.Template.Spec.Files, registries, username, password) {
+			continue
+		}
+
+		log.Info("Refreshing registry mirror credentials in kubeadm config template", "template", key)
+		if err := r.client.Patch(ctx, template, patch); err != nil {
+			return errors.Wrapf(err, "patching kubeadm config template %s", configRef.Name)
+		}
+	}
+
+	return nil
+}
+
+// refreshFiles updates the registry mirror credentials in the containerd config append file, if present.
+// It returns true if the file changed.
+func refreshFiles(files []kubeadmv1.File, registries []string, username, password string) bool {
+	changed := false
+	for i := range files {
+		file := &files[i]
+		if file.Path != containerd.ConfigAppendFile {
+			continue
+		}
+		if content, fileChanged := containerd.RefreshCredentials(file.Content, registries, username, password); fileChanged {
+			file.Content = content
+			changed = true
+		}
+	}
+
+	return changed
+}
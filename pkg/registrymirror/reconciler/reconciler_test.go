@@ -0,0 +1,254 @@
+package reconciler_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	eksdv1 "github.com/aws/eks-distro-build-tooling/release/api/v1alpha1"
+	"github.com/go-logr/logr"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	kubeadmv1 "sigs.k8s.io/cluster-api/bootstrap/kubeadm/api/v1beta1"
+	controlplanev1 "sigs.k8s.io/cluster-api/controlplane/kubeadm/api/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/aws/eks-anywhere/internal/test"
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/controller"
+	"github.com/aws/eks-anywhere/pkg/registrymirror"
+	"github.com/aws/eks-anywhere/pkg/registrymirror/reconciler"
+	reconcilermocks "github.com/aws/eks-anywhere/pkg/registrymirror/reconciler/mocks"
+	releasev1 "github.com/aws/eks-anywhere/release/api/v1alpha1"
+)
+
+type reconcilerTest struct {
+	*WithT
+	ctx                  context.Context
+	cluster              *anywherev1.Cluster
+	remoteClientRegistry *reconcilermocks.MockRemoteClientRegistry
+	objs                 []runtime.Object
+	client               client.Client
+}
+
+func newReconcilerTest(t *testing.T) *reconcilerTest {
+	ctrl := gomock.NewController(t)
+	bundle := test.Bundle()
+	cluster := &anywherev1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-cluster",
+			Namespace: "eksa-system",
+		},
+		Spec: anywherev1.ClusterSpec{
+			KubernetesVersion: "1.20",
+			BundlesRef: &anywherev1.BundlesRef{
+				Name:       bundle.Name,
+				Namespace:  bundle.Namespace,
+				APIVersion: bundle.APIVersion,
+			},
+			RegistryMirrorConfiguration: &anywherev1.RegistryMirrorConfiguration{
+				Endpoint:     "1.2.3.4",
+				Port:         "443",
+				Authenticate: true,
+			},
+			ManagementCluster: anywherev1.ManagementCluster{Name: "mgmt"},
+		},
+	}
+
+	return &reconcilerTest{
+		WithT:                NewWithT(t),
+		ctx:                  context.Background(),
+		cluster:              cluster,
+		remoteClientRegistry: reconcilermocks.NewMockRemoteClientRegistry(ctrl),
+		objs: []runtime.Object{
+			bundle,
+			test.EksdRelease(),
+			registrymirror.CredentialsSecret("user", "pass"),
+		},
+	}
+}
+
+func (tt *reconcilerTest) reconciler() *reconciler.Reconciler {
+	scheme := runtime.NewScheme()
+	_ = corev1.AddToScheme(scheme)
+	_ = releasev1.AddToScheme(scheme)
+	_ = eksdv1.AddToScheme(scheme)
+	_ = clusterv1.AddToScheme(scheme)
+	_ = kubeadmv1.AddToScheme(scheme)
+	_ = controlplanev1.AddToScheme(scheme)
+	tt.client = fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(tt.objs...).Build()
+
+	return reconciler.New(tt.client, tt.remoteClientRegistry)
+}
+
+func nullLog() logr.Logger {
+	return logr.New(logf.NullLogSink{})
+}
+
+func TestReconcileNoAuthentication(t *testing.T) {
+	tt := newReconcilerTest(t)
+	tt.cluster.Spec.RegistryMirrorConfiguration.Authenticate = false
+
+	result, err := tt.reconciler().Reconcile(tt.ctx, nullLog(), tt.cluster)
+	tt.Expect(err).ToNot(HaveOccurred())
+	tt.Expect(result).To(Equal(controller.Result{}))
+}
+
+func TestReconcileCredentialsSecretNotFound(t *testing.T) {
+	tt := newReconcilerTest(t)
+	tt.objs = tt.objs[:2]
+
+	result, err := tt.reconciler().Reconcile(tt.ctx, nullLog(), tt.cluster)
+	tt.Expect(err).ToNot(HaveOccurred())
+	tt.Expect(result).To(Equal(controller.Result{}))
+}
+
+func TestReconcileInvalidCredentialsSecret(t *testing.T) {
+	tt := newReconcilerTest(t)
+	tt.objs[2] = registrymirror.CredentialsSecret("user", "")
+
+	_, err := tt.reconciler().Reconcile(tt.ctx, nullLog(), tt.cluster)
+	tt.Expect(err).To(MatchError(ContainSubstring("missing the password key")))
+}
+
+func TestReconcileBuildClusterSpecError(t *testing.T) {
+	tt := newReconcilerTest(t)
+	tt.cluster.Spec.BundlesRef.Name = "missing-bundle"
+
+	_, err := tt.reconciler().Reconcile(tt.ctx, nullLog(), tt.cluster)
+	tt.Expect(err).To(HaveOccurred())
+}
+
+func TestReconcileRemoteGetClientError(t *testing.T) {
+	tt := newReconcilerTest(t)
+	tt.remoteClientRegistry.EXPECT().GetClient(tt.ctx, gomock.AssignableToTypeOf(client.ObjectKey{})).Return(nil, errors.New("client error"))
+
+	_, err := tt.reconciler().Reconcile(tt.ctx, nullLog(), tt.cluster)
+	tt.Expect(err).To(MatchError(ContainSubstring("getting workload cluster's client to reconcile registry mirror credentials: client error")))
+}
+
+func TestReconcileRefreshesWorkerTemplates(t *testing.T) {
+	tt := newReconcilerTest(t)
+	tt.objs = append(tt.objs,
+		machineDeployment("my-cluster-md-0", "my-cluster", "my-cluster-md-0-1"),
+		kubeadmConfigTemplate("my-cluster-md-0-1", "old-user", "old-pass"),
+		machineDeployment("other-cluster-md-0", "other-cluster", "other-cluster-md-0-1"),
+		kubeadmConfigTemplate("other-cluster-md-0-1", "old-user", "old-pass"),
+	)
+	tt.remoteClientRegistry.EXPECT().GetClient(tt.ctx, gomock.AssignableToTypeOf(client.ObjectKey{})).Return(nil, errors.New("client error"))
+
+	_, err := tt.reconciler().Reconcile(tt.ctx, nullLog(), tt.cluster)
+	tt.Expect(err).To(MatchError(ContainSubstring("client error")))
+
+	template := &kubeadmv1.KubeadmConfigTemplate{}
+	tt.Expect(tt.client.Get(tt.ctx, types.NamespacedName{Namespace: "eksa-system", Name: "my-cluster-md-0-1"}, template)).To(Succeed())
+	tt.Expect(template.Spec.Template.Spec.Files[0].Content).To(Equal(containerdConfigAppend("user", "pass")))
+	tt.Expect(template.Spec.Template.Spec.Files[1].Content).To(Equal("other"))
+
+	other := &kubeadmv1.KubeadmConfigTemplate{}
+	tt.Expect(tt.client.Get(tt.ctx, types.NamespacedName{Namespace: "eksa-system", Name: "other-cluster-md-0-1"}, other)).To(Succeed())
+	tt.Expect(other.Spec.Template.Spec.Files[0].Content).To(Equal(containerdConfigAppend("old-user", "old-pass")))
+}
+
+func TestReconcileLeavesControlPlaneUnchanged(t *testing.T) {
+	tt := newReconcilerTest(t)
+	tt.objs = append(tt.objs, kubeadmControlPlane("my-cluster", "old-user", "old-pass"))
+	tt.remoteClientRegistry.EXPECT().GetClient(tt.ctx, gomock.AssignableToTypeOf(client.ObjectKey{})).Return(nil, errors.New("client error"))
+
+	_, err := tt.reconciler().Reconcile(tt.ctx, nullLog(), tt.cluster)
+	tt.Expect(err).To(MatchError(ContainSubstring("client error")))
+
+	kcp := &controlplanev1.KubeadmControlPlane{}
+	tt.Expect(tt.client.Get(tt.ctx, types.NamespacedName{Namespace: "eksa-system", Name: "my-cluster"}, kcp)).To(Succeed())
+	tt.Expect(kcp.Spec).To(Equal(kubeadmControlPlane("my-cluster", "old-user", "old-pass").Spec))
+	tt.Expect(kcp.ResourceVersion).To(Equal("999"))
+}
+
+func kubeadmControlPlane(name, username, password string) *controlplanev1.KubeadmControlPlane {
+	return &controlplanev1.KubeadmControlPlane{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "eksa-system",
+		},
+		Spec: controlplanev1.KubeadmControlPlaneSpec{
+			KubeadmConfigSpec: kubeadmv1.KubeadmConfigSpec{
+				Files: []kubeadmv1.File{
+					{
+						Path:    "/etc/containerd/config_append.toml",
+						Content: containerdConfigAppend(username, password),
+					},
+					{
+						Path:    "/etc/other",
+						Content: "other",
+					},
+				},
+			},
+		},
+	}
+}
+
+func machineDeployment(name, clusterName, templateName string) *clusterv1.MachineDeployment {
+	return &clusterv1.MachineDeployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "eksa-system",
+			Labels:    map[string]string{clusterv1.ClusterLabelName: clusterName},
+		},
+		Spec: clusterv1.MachineDeploymentSpec{
+			ClusterName: clusterName,
+			Template: clusterv1.MachineTemplateSpec{
+				Spec: clusterv1.MachineSpec{
+					ClusterName: clusterName,
+					Bootstrap: clusterv1.Bootstrap{
+						ConfigRef: &corev1.ObjectReference{
+							Kind: "KubeadmConfigTemplate",
+							Name: templateName,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func kubeadmConfigTemplate(name, username, password string) *kubeadmv1.KubeadmConfigTemplate {
+	return &kubeadmv1.KubeadmConfigTemplate{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "eksa-system",
+		},
+		Spec: kubeadmv1.KubeadmConfigTemplateSpec{
+			Template: kubeadmv1.KubeadmConfigTemplateResource{
+				Spec: kubeadmv1.KubeadmConfigSpec{
+					Files: []kubeadmv1.File{
+						{
+							Path:    "/etc/containerd/config_append.toml",
+							Content: containerdConfigAppend(username, password),
+						},
+						{
+							Path:    "/etc/other",
+							Content: "other",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func containerdConfigAppend(username, password string) string {
+	return `[plugins."io.containerd.grpc.v1.cri".registry.mirrors]
+  [plugins."io.containerd.grpc.v1.cri".registry.mirrors."public.ecr.aws"]
+    endpoint = ["https://1.2.3.4:443"]
+  [plugins."io.containerd.grpc.v1.cri".registry.configs."1.2.3.4:443".auth]
+    username = "` + username + `"
+    password = "` + password + `"
+`
+}
@@ -8,6 +8,7 @@ import (
 	"strings"
 
 	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/config"
 	"github.com/aws/eks-anywhere/pkg/constants"
 )
 
@@ -15,8 +16,16 @@ import (
 type RegistryMirror struct {
 	// BaseRegistry is the address of the registry mirror without namespace. Just the host and the port.
 	BaseRegistry string
+	// FailoverRegistries are the addresses of additional registry mirrors, tried in order after BaseRegistry.
+	// They serve the same namespaces as BaseRegistry.
+	FailoverRegistries []string
 	// NamespacedRegistryMap stores mirror mappings for artifact registries
 	NamespacedRegistryMap map[string]string
+	// SkipMirrorUpstreams lists the upstream registries pulled from directly instead of through the registry mirror.
+	SkipMirrorUpstreams []string
+	// AuthenticatedUpstreams lists the upstream registries that require their own credentials
+	// when images are pulled from them directly.
+	AuthenticatedUpstreams []string
 	// Auth should be marked as true if authentication is required for the registry mirror
 	Auth bool
 	// CACertContent defines the contents registry mirror CA certificate
@@ -27,6 +36,13 @@ type RegistryMirror struct {
 	InsecureSkipVerify bool
 }
 
+// Credentials are the username and password to pull images from a registry.
+type Credentials struct {
+	Registry string
+	Username string
+	Password string
+}
+
 var re = regexp.MustCompile(constants.DefaultCuratedPackagesRegistryRegex)
 
 // FromCluster is a constructor for RegistryMirror from a cluster schema.
@@ -41,30 +57,74 @@ func FromClusterRegistryMirrorConfiguration(config *v1alpha1.RegistryMirrorConfi
 	}
 	registryMap := make(map[string]string)
 	base := net.JoinHostPort(config.Endpoint, config.Port)
+	skipped := make(map[string]bool)
+	var skipMirrorUpstreams, authenticatedUpstreams []string
+	for _, upstream := range config.Upstreams {
+		if upstream.SkipMirror {
+			skipped[registryKey(upstream.Registry)] = true
+			skipMirrorUpstreams = append(skipMirrorUpstreams, upstream.Registry)
+		}
+		if upstream.Authenticate {
+			authenticatedUpstreams = append(authenticatedUpstreams, upstream.Registry)
+		}
+	}
 	// add registry mirror base address
 	// for each namespace, add corresponding endpoint
 	for _, ociNamespace := range config.OCINamespaces {
-		mirror := filepath.Join(base, ociNamespace.Namespace)
-		if re.MatchString(ociNamespace.Registry) {
-			// handle curated packages in all regions
-			// static key makes it easier for mirror lookup
-			registryMap[constants.DefaultCuratedPackagesRegistryRegex] = mirror
-		} else {
-			registryMap[ociNamespace.Registry] = mirror
+		// handle curated packages in all regions
+		// static key makes it easier for mirror lookup
+		key := registryKey(ociNamespace.Registry)
+		if skipped[key] {
+			continue
 		}
+		registryMap[key] = filepath.Join(base, ociNamespace.Namespace)
 	}
-	if len(registryMap) == 0 {
+	if len(registryMap) == 0 && !skipped[constants.DefaultCoreEKSARegistry] {
 		// for backward compatibility, default mapping for public.ecr.aws is added
 		// when no namespace mapping is specified
 		registryMap[constants.DefaultCoreEKSARegistry] = base
 	}
+	var failoverRegistries []string
+	for _, failover := range config.FailoverEndpoints {
+		failoverRegistries = append(failoverRegistries, net.JoinHostPort(failover.Endpoint, failover.Port))
+	}
 	return &RegistryMirror{
-		BaseRegistry:          base,
-		NamespacedRegistryMap: registryMap,
-		Auth:                  config.Authenticate,
-		CACertContent:         config.CACertContent,
-		InsecureSkipVerify:    config.InsecureSkipVerify,
+		BaseRegistry:           base,
+		FailoverRegistries:     failoverRegistries,
+		NamespacedRegistryMap:  registryMap,
+		SkipMirrorUpstreams:    skipMirrorUpstreams,
+		AuthenticatedUpstreams: authenticatedUpstreams,
+		Auth:                   config.Authenticate,
+		CACertContent:          config.CACertContent,
+		InsecureSkipVerify:     config.InsecureSkipVerify,
+	}
+}
+
+func registryKey(registry string) string {
+	if re.MatchString(registry) {
+		return constants.DefaultCuratedPackagesRegistryRegex
+	}
+	return registry
+}
+
+// Registries returns the addresses of all the registry mirrors, in failover order.
+func (r *RegistryMirror) Registries() []string {
+	return append([]string{r.BaseRegistry}, r.FailoverRegistries...)
+}
+
+// Mirrors returns the mirror of the upstream registry key in every registry mirror, in failover order.
+// It returns nil if the upstream registry is not mirrored.
+func (r *RegistryMirror) Mirrors(key string) []string {
+	mirror, ok := r.NamespacedRegistryMap[key]
+	if !ok {
+		return nil
+	}
+	namespace := strings.TrimPrefix(mirror, r.BaseRegistry)
+	mirrors := make([]string, 0, len(r.FailoverRegistries)+1)
+	for _, registry := range r.Registries() {
+		mirrors = append(mirrors, registry+namespace)
 	}
+	return mirrors
 }
 
 // CoreEKSAMirror returns the configured mirror for public.ecr.aws.
@@ -77,6 +137,31 @@ func (r *RegistryMirror) CuratedPackagesMirror() string {
 	return r.NamespacedRegistryMap[constants.DefaultCuratedPackagesRegistryRegex]
 }
 
+// SkipsMirror returns true if images from the upstream registry are pulled from it directly,
+// instead of through the registry mirror.
+func (r *RegistryMirror) SkipsMirror(registry string) bool {
+	key := registryKey(registry)
+	for _, upstream := range r.SkipMirrorUpstreams {
+		if registryKey(upstream) == key {
+			return true
+		}
+	}
+	return false
+}
+
+// UpstreamCredentials reads the credentials of the AuthenticatedUpstreams from the environment.
+func (r *RegistryMirror) UpstreamCredentials() ([]Credentials, error) {
+	credentials := make([]Credentials, 0, len(r.AuthenticatedUpstreams))
+	for _, upstream := range r.AuthenticatedUpstreams {
+		username, password, err := config.ReadUpstreamCredentials(upstream)
+		if err != nil {
+			return nil, err
+		}
+		credentials = append(credentials, Credentials{Registry: upstream, Username: username, Password: password})
+	}
+	return credentials, nil
+}
+
 // ReplaceRegistry replaces the host in a url with corresponding registry mirror
 // It supports full URLs and container image URLs
 // If the provided original url is malformed, there are no guarantees
@@ -92,12 +177,26 @@ func (r *RegistryMirror) ReplaceRegistry(url string) string {
 		u, _ = urllib.Parse("oci://" + url)
 		u.Scheme = ""
 	}
-	key := u.Host
-	if re.MatchString(key) {
-		key = constants.DefaultCuratedPackagesRegistryRegex
-	}
-	if v, ok := r.NamespacedRegistryMap[key]; ok {
+	if v, ok := r.NamespacedRegistryMap[registryKey(u.Host)]; ok {
 		return strings.Replace(url, u.Host, v, 1)
 	}
 	return url
 }
+
+// ReplaceRegistryWithFailover works like ReplaceRegistry, but returns the url in every
+// registry mirror, in failover order.
+// If no corresponding registry mirror, it will return only the original URL.
+func (r *RegistryMirror) ReplaceRegistryWithFailover(url string) []string {
+	if r == nil {
+		return []string{url}
+	}
+
+	urls := []string{r.ReplaceRegistry(url)}
+	if urls[0] == url {
+		return urls
+	}
+	for _, registry := range r.FailoverRegistries {
+		urls = append(urls, strings.Replace(urls[0], r.BaseRegistry, registry, 1))
+	}
+	return urls
+}
@@ -155,6 +155,71 @@ func TestFromClusterRegistryMirrorConfiguration(t *testing.T) {
 				Auth: true,
 			},
 		},
+		{
+			testName: "failover endpoints and upstreams",
+			config: &v1alpha1.RegistryMirrorConfiguration{
+				Endpoint: "harbor.eksa.demo",
+				Port:     "30003",
+				FailoverEndpoints: []v1alpha1.RegistryMirrorEndpoint{
+					{
+						Endpoint: "harbor-2.eksa.demo",
+						Port:     "443",
+					},
+				},
+				OCINamespaces: []v1alpha1.OCINamespace{
+					{
+						Registry:  "public.ecr.aws",
+						Namespace: "eks-anywhere",
+					},
+					{
+						Registry:  "783794618700.dkr.ecr.us-west-2.amazonaws.com",
+						Namespace: "curated-packages",
+					},
+				},
+				Upstreams: []v1alpha1.UpstreamRegistry{
+					{
+						Registry:     "783794618700.dkr.ecr.us-west-2.amazonaws.com",
+						SkipMirror:   true,
+						Authenticate: true,
+					},
+				},
+			},
+			want: &registrymirror.RegistryMirror{
+				BaseRegistry:       "harbor.eksa.demo:30003",
+				FailoverRegistries: []string{"harbor-2.eksa.demo:443"},
+				NamespacedRegistryMap: map[string]string{
+					constants.DefaultCoreEKSARegistry: "harbor.eksa.demo:30003/eks-anywhere",
+				},
+				SkipMirrorUpstreams:    []string{"783794618700.dkr.ecr.us-west-2.amazonaws.com"},
+				AuthenticatedUpstreams: []string{"783794618700.dkr.ecr.us-west-2.amazonaws.com"},
+			},
+		},
+		{
+			testName: "every namespace skipped",
+			config: &v1alpha1.RegistryMirrorConfiguration{
+				Endpoint: "harbor.eksa.demo",
+				Port:     "30003",
+				OCINamespaces: []v1alpha1.OCINamespace{
+					{
+						Registry:  "783794618700.dkr.ecr.us-west-2.amazonaws.com",
+						Namespace: "curated-packages",
+					},
+				},
+				Upstreams: []v1alpha1.UpstreamRegistry{
+					{
+						Registry:   "783794618700.dkr.ecr.us-west-2.amazonaws.com",
+						SkipMirror: true,
+					},
+				},
+			},
+			want: &registrymirror.RegistryMirror{
+				BaseRegistry: "harbor.eksa.demo:30003",
+				NamespacedRegistryMap: map[string]string{
+					constants.DefaultCoreEKSARegistry: "harbor.eksa.demo:30003",
+				},
+				SkipMirrorUpstreams: []string{"783794618700.dkr.ecr.us-west-2.amazonaws.com"},
+			},
+		},
 		{
 			testName: "namespace for both eksa and curated packages",
 			config: &v1alpha1.RegistryMirrorConfiguration{
@@ -385,3 +450,49 @@ func TestReplaceRegistry(t *testing.T) {
 		})
 	}
 }
+
+func TestMirrors(t *testing.T) {
+	g := NewWithT(t)
+	registryMirror := &registrymirror.RegistryMirror{
+		BaseRegistry:       "harbor.eksa.demo:30003",
+		FailoverRegistries: []string{"harbor-2.eksa.demo:443", "harbor-3.eksa.demo:443"},
+		NamespacedRegistryMap: map[string]string{
+			constants.DefaultCoreEKSARegistry: "harbor.eksa.demo:30003/eks-anywhere",
+		},
+	}
+	g.Expect(registryMirror.Mirrors(constants.DefaultCoreEKSARegistry)).To(Equal([]string{
+		"harbor.eksa.demo:30003/eks-anywhere",
+		"harbor-2.eksa.demo:443/eks-anywhere",
+		"harbor-3.eksa.demo:443/eks-anywhere",
+	}))
+	g.Expect(registryMirror.Mirrors("docker.io")).To(BeNil())
+}
+
+func TestReplaceRegistryWithFailover(t *testing.T) {
+	g := NewWithT(t)
+	registryMirror := &registrymirror.RegistryMirror{
+		BaseRegistry:       "harbor.eksa.demo:30003",
+		FailoverRegistries: []string{"harbor-2.eksa.demo:443"},
+		NamespacedRegistryMap: map[string]string{
+			constants.DefaultCoreEKSARegistry: "harbor.eksa.demo:30003/eks-anywhere",
+		},
+	}
+	g.Expect(registryMirror.ReplaceRegistryWithFailover("oci://public.ecr.aws/product/chart")).To(Equal([]string{
+		"oci://harbor.eksa.demo:30003/eks-anywhere/product/chart",
+		"oci://harbor-2.eksa.demo:443/eks-anywhere/product/chart",
+	}))
+	g.Expect(registryMirror.ReplaceRegistryWithFailover("docker.io/product/image:tag")).To(Equal([]string{"docker.io/product/image:tag"}))
+
+	var noMirror *registrymirror.RegistryMirror
+	g.Expect(noMirror.ReplaceRegistryWithFailover("public.ecr.aws/product/image:tag")).To(Equal([]string{"public.ecr.aws/product/image:tag"}))
+}
+
+func TestSkipsMirror(t *testing.T) {
+	g := NewWithT(t)
+	registryMirror := &registrymirror.RegistryMirror{
+		SkipMirrorUpstreams: []string{"783794618700.dkr.ecr.us-west-2.amazonaws.com", "docker.io"},
+	}
+	g.Expect(registryMirror.SkipsMirror("783794618700.dkr.ecr.us-east-1.amazonaws.com")).To(BeTrue())
+	g.Expect(registryMirror.SkipsMirror("docker.io")).To(BeTrue())
+	g.Expect(registryMirror.SkipsMirror("public.ecr.aws")).To(BeFalse())
+}
@@ -0,0 +1,31 @@
+package registrymirror
+
+import (
+	"context"
+	"fmt"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/aws/eks-anywhere/pkg/types"
+)
+
+// KubectlClient applies manifests to a cluster.
+type KubectlClient interface {
+	ApplyKubeSpecFromBytes(ctx context.Context, cluster *types.Cluster, data []byte) error
+}
+
+// RotateCredentials stores new registry mirror credentials in the management cluster.
+// The EKS Anywhere controller propagates them from there to the nodes of the clusters
+// that use an authenticated registry mirror.
+func RotateCredentials(ctx context.Context, kubectl KubectlClient, managementCluster *types.Cluster, username, password string) error {
+	secret, err := yaml.Marshal(CredentialsSecret(username, password))
+	if err != nil {
+		return fmt.Errorf("marshalling registry mirror credentials secret: %v", err)
+	}
+
+	if err = kubectl.ApplyKubeSpecFromBytes(ctx, managementCluster, secret); err != nil {
+		return fmt.Errorf("updating registry mirror credentials secret: %v", err)
+	}
+
+	return nil
+}
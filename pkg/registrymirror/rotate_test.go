@@ -0,0 +1,43 @@
+package registrymirror_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+
+	"github.com/aws/eks-anywhere/pkg/registrymirror"
+	"github.com/aws/eks-anywhere/pkg/registrymirror/mocks"
+	"github.com/aws/eks-anywhere/pkg/types"
+)
+
+func TestRotateCredentials(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	mgmt := &types.Cluster{Name: "mgmt", KubeconfigFile: "mgmt.kubeconfig"}
+	kubectl := mocks.NewMockKubectlClient(gomock.NewController(t))
+	kubectl.EXPECT().ApplyKubeSpecFromBytes(ctx, mgmt, gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ *types.Cluster, data []byte) error {
+			g.Expect(string(data)).To(ContainSubstring("name: registry-credentials"))
+			g.Expect(string(data)).To(ContainSubstring("namespace: eksa-system"))
+			g.Expect(string(data)).To(ContainSubstring("password: cGFzcw=="))
+			return nil
+		},
+	)
+
+	g.Expect(registrymirror.RotateCredentials(ctx, kubectl, mgmt, "user", "pass")).To(Succeed())
+}
+
+func TestRotateCredentialsApplyError(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	mgmt := &types.Cluster{Name: "mgmt"}
+	kubectl := mocks.NewMockKubectlClient(gomock.NewController(t))
+	kubectl.EXPECT().ApplyKubeSpecFromBytes(ctx, mgmt, gomock.Any()).Return(errors.New("connection refused"))
+
+	g.Expect(registrymirror.RotateCredentials(ctx, kubectl, mgmt, "user", "pass")).To(
+		MatchError("updating registry mirror credentials secret: connection refused"),
+	)
+}
@@ -0,0 +1,113 @@
+package servicelb
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/eks-anywhere/pkg/cluster"
+	"github.com/aws/eks-anywhere/pkg/logger"
+	"github.com/aws/eks-anywhere/pkg/retrier"
+	"github.com/aws/eks-anywhere/pkg/types"
+)
+
+const (
+	deploymentWaitTimeout = "5m"
+	addressPoolsTimeout   = 2 * time.Minute
+)
+
+// KubernetesClient provides Kubernetes API access.
+type KubernetesClient interface {
+	ApplyKubeSpecFromBytes(ctx context.Context, cluster *types.Cluster, data []byte) error
+	WaitForDeployment(ctx context.Context, cluster *types.Cluster, timeout string, condition string, target string, namespace string) error
+}
+
+// Installer installs and upgrades the Service load balancer in a cluster.
+type Installer struct {
+	k8s             KubernetesClient
+	templateBuilder *TemplateBuilder
+	retrier         *retrier.Retrier
+}
+
+// InstallerOpt allows to customize an Installer on construction.
+type InstallerOpt func(*Installer)
+
+// WithRetrier sets the retrier used to apply the address pools.
+func WithRetrier(retrier *retrier.Retrier) InstallerOpt {
+	return func(i *Installer) {
+		i.retrier = retrier
+	}
+}
+
+// NewInstaller creates a new Installer.
+func NewInstaller(k8s KubernetesClient, opts ...InstallerOpt) *Installer {
+	i := &Installer{
+		k8s:             k8s,
+		templateBuilder: &TemplateBuilder{},
+		retrier:         retrier.New(addressPoolsTimeout),
+	}
+	for _, opt := range opts {
+		opt(i)
+	}
+	return i
+}
+
+// Install installs the Service load balancer in cluster and configures its address pools.
+// It's a noop if the cluster spec doesn't configure a Service load balancer.
+func (i *Installer) Install(ctx context.Context, cluster *types.Cluster, spec *cluster.Spec) error {
+	lb := spec.Cluster.Spec.ServiceLoadBalancer
+	if lb == nil {
+		return nil
+	}
+
+	manifest, err := i.templateBuilder.GenerateManifest(spec)
+	if err != nil {
+		return fmt.Errorf("generating service load balancer manifest: %v", err)
+	}
+
+	if err = i.k8s.ApplyKubeSpecFromBytes(ctx, cluster, manifest); err != nil {
+		return fmt.Errorf("applying service load balancer manifest: %v", err)
+	}
+
+	namespace, deployment := ControllerDeployment(lb.Provider)
+	if err = i.k8s.WaitForDeployment(ctx, cluster, deploymentWaitTimeout, "Available", deployment, namespace); err != nil {
+		return fmt.Errorf("waiting for service load balancer to be available: %v", err)
+	}
+
+	pools, err := i.templateBuilder.GenerateAddressPoolsManifest(spec)
+	if err != nil {
+		return fmt.Errorf("generating service load balancer address pools: %v", err)
+	}
+
+	// Admission webhooks might not be serving yet right after the deployment is available
+	err = i.retrier.Retry(func() error {
+		return i.k8s.ApplyKubeSpecFromBytes(ctx, cluster, pools)
+	})
+	if err != nil {
+		return fmt.Errorf("applying service load balancer address pools: %v", err)
+	}
+
+	return nil
+}
+
+// Upgrade reapplies the Service load balancer when its configuration or the bundle changed.
+// Removing the load balancer from the cluster spec doesn't uninstall it.
+func (i *Installer) Upgrade(ctx context.Context, cluster *types.Cluster, currentSpec, newSpec *cluster.Spec) error {
+	newLB := newSpec.Cluster.Spec.ServiceLoadBalancer
+	if newLB == nil {
+		if currentSpec.Cluster.Spec.ServiceLoadBalancer != nil {
+			logger.Info("Warning: service load balancer removed from the cluster spec, it won't be uninstalled from the cluster")
+		}
+		return nil
+	}
+
+	if newLB.Equal(currentSpec.Cluster.Spec.ServiceLoadBalancer) &&
+		currentSpec.VersionsBundle.KubeVipCloudProvider.Version == newSpec.VersionsBundle.KubeVipCloudProvider.Version &&
+		currentSpec.VersionsBundle.MetalLB.Version == newSpec.VersionsBundle.MetalLB.Version {
+		logger.V(3).Info("Service load balancer is up to date")
+		return nil
+	}
+
+	logger.Info("Upgrading service load balancer")
+	return i.Install(ctx, cluster, newSpec)
+}
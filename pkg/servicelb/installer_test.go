@@ -0,0 +1,122 @@
+package servicelb_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+
+	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/cluster"
+	"github.com/aws/eks-anywhere/pkg/retrier"
+	"github.com/aws/eks-anywhere/pkg/servicelb"
+	"github.com/aws/eks-anywhere/pkg/types"
+)
+
+type installerTest struct {
+	*WithT
+	ctx       context.Context
+	k8s       *MockKubernetesClient
+	installer *servicelb.Installer
+	cluster   *types.Cluster
+	spec      *cluster.Spec
+}
+
+func newInstallerTest(t *testing.T) *installerTest {
+	ctrl := gomock.NewController(t)
+	k8s := NewMockKubernetesClient(ctrl)
+	return &installerTest{
+		WithT:     NewWithT(t),
+		ctx:       context.Background(),
+		k8s:       k8s,
+		installer: servicelb.NewInstaller(k8s, servicelb.WithRetrier(retrier.NewWithMaxRetries(2, 0))),
+		cluster:   &types.Cluster{Name: "test-cluster", KubeconfigFile: "kubeconfig"},
+		spec:      newServiceLBSpec(v1alpha1.MetalLBServiceLoadBalancer),
+	}
+}
+
+func TestInstallerInstallSuccess(t *testing.T) {
+	tt := newInstallerTest(t)
+	gomock.InOrder(
+		tt.k8s.EXPECT().ApplyKubeSpecFromBytes(tt.ctx, tt.cluster, gomock.Any()),
+		tt.k8s.EXPECT().WaitForDeployment(tt.ctx, tt.cluster, "5m", "Available", "controller", "metallb-system"),
+		tt.k8s.EXPECT().ApplyKubeSpecFromBytes(tt.ctx, tt.cluster, gomock.Any()).Return(errors.New("webhook not ready")),
+		tt.k8s.EXPECT().ApplyKubeSpecFromBytes(tt.ctx, tt.cluster, gomock.Any()),
+	)
+
+	tt.Expect(tt.installer.Install(tt.ctx, tt.cluster, tt.spec)).To(Succeed())
+}
+
+func TestInstallerInstallNoServiceLoadBalancer(t *testing.T) {
+	tt := newInstallerTest(t)
+	tt.spec.Cluster.Spec.ServiceLoadBalancer = nil
+
+	tt.Expect(tt.installer.Install(tt.ctx, tt.cluster, tt.spec)).To(Succeed())
+}
+
+func TestInstallerInstallErrorApplyingManifest(t *testing.T) {
+	tt := newInstallerTest(t)
+	tt.k8s.EXPECT().ApplyKubeSpecFromBytes(tt.ctx, tt.cluster, gomock.Any()).Return(errors.New("apply error"))
+
+	tt.Expect(tt.installer.Install(tt.ctx, tt.cluster, tt.spec)).To(
+		MatchError(ContainSubstring("applying service load balancer manifest: apply error")),
+	)
+}
+
+func TestInstallerInstallErrorWaitingForDeployment(t *testing.T) {
+	tt := newInstallerTest(t)
+	tt.k8s.EXPECT().ApplyKubeSpecFromBytes(tt.ctx, tt.cluster, gomock.Any())
+	tt.k8s.EXPECT().WaitForDeployment(tt.ctx, tt.cluster, "5m", "Available", "controller", "metallb-system").Return(errors.New("timeout"))
+
+	tt.Expect(tt.installer.Install(tt.ctx, tt.cluster, tt.spec)).To(
+		MatchError(ContainSubstring("waiting for service load balancer to be available: timeout")),
+	)
+}
+
+func TestInstallerInstallErrorApplyingAddressPools(t *testing.T) {
+	tt := newInstallerTest(t)
+	tt.k8s.EXPECT().ApplyKubeSpecFromBytes(tt.ctx, tt.cluster, gomock.Any())
+	tt.k8s.EXPECT().WaitForDeployment(tt.ctx, tt.cluster, "5m", "Available", "controller", "metallb-system")
+	tt.k8s.EXPECT().ApplyKubeSpecFromBytes(tt.ctx, tt.cluster, gomock.Any()).Return(errors.New("webhook not ready")).Times(2)
+
+	tt.Expect(tt.installer.Install(tt.ctx, tt.cluster, tt.spec)).To(
+		MatchError(ContainSubstring("applying service load balancer address pools: webhook not ready")),
+	)
+}
+
+func TestInstallerUpgradeNoChanges(t *testing.T) {
+	tt := newInstallerTest(t)
+	currentSpec := tt.spec.DeepCopy()
+
+	tt.Expect(tt.installer.Upgrade(tt.ctx, tt.cluster, currentSpec, tt.spec)).To(Succeed())
+}
+
+func TestInstallerUpgradeRemoved(t *testing.T) {
+	tt := newInstallerTest(t)
+	currentSpec := tt.spec.DeepCopy()
+	tt.spec.Cluster.Spec.ServiceLoadBalancer = nil
+
+	tt.Expect(tt.installer.Upgrade(tt.ctx, tt.cluster, currentSpec, tt.spec)).To(Succeed())
+}
+
+func TestInstallerUpgradeNewVersion(t *testing.T) {
+	tt := newInstallerTest(t)
+	currentSpec := tt.spec.DeepCopy()
+	currentSpec.VersionsBundle.MetalLB.Version = "v0.13.5"
+	tt.k8s.EXPECT().ApplyKubeSpecFromBytes(tt.ctx, tt.cluster, gomock.Any()).Times(2)
+	tt.k8s.EXPECT().WaitForDeployment(tt.ctx, tt.cluster, "5m", "Available", "controller", "metallb-system")
+
+	tt.Expect(tt.installer.Upgrade(tt.ctx, tt.cluster, currentSpec, tt.spec)).To(Succeed())
+}
+
+func TestInstallerUpgradeAddedToCluster(t *testing.T) {
+	tt := newInstallerTest(t)
+	currentSpec := tt.spec.DeepCopy()
+	currentSpec.Cluster.Spec.ServiceLoadBalancer = nil
+	tt.k8s.EXPECT().ApplyKubeSpecFromBytes(tt.ctx, tt.cluster, gomock.Any()).Times(2)
+	tt.k8s.EXPECT().WaitForDeployment(tt.ctx, tt.cluster, "5m", "Available", "controller", "metallb-system")
+
+	tt.Expect(tt.installer.Upgrade(tt.ctx, tt.cluster, currentSpec, tt.spec)).To(Succeed())
+}
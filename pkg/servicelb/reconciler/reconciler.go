@@ -0,0 +1,114 @@
+package reconciler
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	anywhereCluster "github.com/aws/eks-anywhere/pkg/cluster"
+	"github.com/aws/eks-anywhere/pkg/controller"
+	"github.com/aws/eks-anywhere/pkg/controller/clientutil"
+	"github.com/aws/eks-anywhere/pkg/controller/serverside"
+	"github.com/aws/eks-anywhere/pkg/servicelb"
+)
+
+const requeueAfter = 30 * time.Second
+
+// RemoteClientRegistry defines methods for remote cluster controller clients.
+type RemoteClientRegistry interface {
+	GetClient(ctx context.Context, cluster client.ObjectKey) (client.Client, error)
+}
+
+// Reconciler allows to reconcile the Service load balancer of a cluster.
+type Reconciler struct {
+	client               client.Client
+	remoteClientRegistry RemoteClientRegistry
+	templateBuilder      *servicelb.TemplateBuilder
+}
+
+// New returns a new Reconciler.
+func New(client client.Client, remoteClientRegistry RemoteClientRegistry) *Reconciler {
+	return &Reconciler{
+		client:               client,
+		remoteClientRegistry: remoteClientRegistry,
+		templateBuilder:      &servicelb.TemplateBuilder{},
+	}
+}
+
+// Reconcile takes the Service load balancer in the workload cluster to the state defined in the cluster spec.
+// It uses a controller.Result to indicate when requeues are needed.
+func (r *Reconciler) Reconcile(ctx context.Context, log logr.Logger, cluster *anywherev1.Cluster) (controller.Result, error) {
+	if cluster.Spec.ServiceLoadBalancer == nil {
+		return controller.Result{}, nil
+	}
+
+	clusterSpec, err := anywhereCluster.BuildSpec(ctx, clientutil.NewKubeClient(r.client), cluster)
+	if err != nil {
+		return controller.Result{}, err
+	}
+
+	rClient, err := r.remoteClientRegistry.GetClient(ctx, controller.CapiClusterObjectKey(cluster))
+	if err != nil {
+		return controller.Result{}, errors.Wrap(err, "getting workload cluster's client to reconcile service load balancer")
+	}
+
+	manifest, err := r.templateBuilder.GenerateManifest(clusterSpec)
+	if err != nil {
+		return controller.Result{}, errors.Wrap(err, "generating service load balancer manifest")
+	}
+
+	log.Info("Applying service load balancer manifest", "provider", cluster.Spec.ServiceLoadBalancer.Provider)
+	if err = serverside.ReconcileYaml(ctx, rClient, manifest); err != nil {
+		return controller.Result{}, errors.Wrap(err, "applying service load balancer manifest")
+	}
+
+	available, err := controllerAvailable(ctx, rClient, cluster.Spec.ServiceLoadBalancer.Provider)
+	if err != nil {
+		return controller.Result{}, err
+	}
+	if !available {
+		log.Info("Service load balancer is not available yet, requeuing")
+		return controller.ResultWithRequeue(requeueAfter), nil
+	}
+
+	pools, err := r.templateBuilder.GenerateAddressPoolsManifest(clusterSpec)
+	if err != nil {
+		return controller.Result{}, errors.Wrap(err, "generating service load balancer address pools")
+	}
+
+	if err = serverside.ReconcileYaml(ctx, rClient, pools); err != nil {
+		// Admission webhooks might not be serving yet right after the controller is available
+		log.Info("Failed applying service load balancer address pools, requeuing", "error", err.Error())
+		return controller.ResultWithRequeue(requeueAfter), nil
+	}
+
+	return controller.Result{}, nil
+}
+
+func controllerAvailable(ctx context.Context, c client.Client, provider anywherev1.ServiceLoadBalancerProvider) (bool, error) {
+	namespace, name := servicelb.ControllerDeployment(provider)
+	deployment := &appsv1.Deployment{}
+	err := c.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, deployment)
+	if apierrors.IsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, errors.Wrapf(err, "fetching deployment %s", name)
+	}
+
+	for _, condition := range deployment.Status.Conditions {
+		if condition.Type == appsv1.DeploymentAvailable {
+			return condition.Status == corev1.ConditionTrue, nil
+		}
+	}
+
+	return false, nil
+}
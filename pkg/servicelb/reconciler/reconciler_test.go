@@ -0,0 +1,115 @@
+package reconciler_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	eksdv1 "github.com/aws/eks-distro-build-tooling/release/api/v1alpha1"
+	"github.com/go-logr/logr"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/aws/eks-anywhere/internal/test"
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/controller"
+	"github.com/aws/eks-anywhere/pkg/servicelb/reconciler"
+	reconcilermocks "github.com/aws/eks-anywhere/pkg/servicelb/reconciler/mocks"
+	releasev1 "github.com/aws/eks-anywhere/release/api/v1alpha1"
+)
+
+type reconcilerTest struct {
+	*WithT
+	ctx                  context.Context
+	cluster              *anywherev1.Cluster
+	remoteClientRegistry *reconcilermocks.MockRemoteClientRegistry
+	reconciler           *reconciler.Reconciler
+}
+
+func newReconcilerTest(t *testing.T) *reconcilerTest {
+	ctrl := gomock.NewController(t)
+	remoteClientRegistry := reconcilermocks.NewMockRemoteClientRegistry(ctrl)
+
+	bundle := test.Bundle()
+	eksdRelease := test.EksdRelease()
+	cluster := &anywherev1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-cluster",
+			Namespace: "eksa-system",
+		},
+		Spec: anywherev1.ClusterSpec{
+			KubernetesVersion: "1.20",
+			BundlesRef: &anywherev1.BundlesRef{
+				Name:       bundle.Name,
+				Namespace:  bundle.Namespace,
+				APIVersion: bundle.APIVersion,
+			},
+			ServiceLoadBalancer: &anywherev1.ServiceLoadBalancerConfiguration{
+				Provider: anywherev1.KubeVipServiceLoadBalancer,
+				AddressPools: []anywherev1.ServiceLoadBalancerAddressPool{
+					{Name: "default", Addresses: []string{"10.0.0.0/28"}},
+				},
+			},
+		},
+	}
+
+	scheme := runtime.NewScheme()
+	_ = releasev1.AddToScheme(scheme)
+	_ = eksdv1.AddToScheme(scheme)
+	_ = clusterv1.AddToScheme(scheme)
+	cl := fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(bundle, eksdRelease).Build()
+
+	return &reconcilerTest{
+		WithT:                NewWithT(t),
+		ctx:                  context.Background(),
+		cluster:              cluster,
+		remoteClientRegistry: remoteClientRegistry,
+		reconciler:           reconciler.New(cl, remoteClientRegistry),
+	}
+}
+
+func nullLog() logr.Logger {
+	return logr.New(logf.NullLogSink{})
+}
+
+func TestReconcileNoServiceLoadBalancer(t *testing.T) {
+	tt := newReconcilerTest(t)
+	tt.cluster.Spec.ServiceLoadBalancer = nil
+
+	result, err := tt.reconciler.Reconcile(tt.ctx, nullLog(), tt.cluster)
+	tt.Expect(err).ToNot(HaveOccurred())
+	tt.Expect(result).To(Equal(controller.Result{}))
+}
+
+func TestReconcileBuildClusterSpecError(t *testing.T) {
+	tt := newReconcilerTest(t)
+	tt.cluster.Spec.BundlesRef.Name = "missing-bundle"
+
+	result, err := tt.reconciler.Reconcile(tt.ctx, nullLog(), tt.cluster)
+	tt.Expect(err).To(HaveOccurred())
+	tt.Expect(result).To(Equal(controller.Result{}))
+}
+
+func TestReconcileRemoteGetClientError(t *testing.T) {
+	tt := newReconcilerTest(t)
+	tt.remoteClientRegistry.EXPECT().GetClient(tt.ctx, gomock.AssignableToTypeOf(client.ObjectKey{})).Return(nil, errors.New("client error"))
+
+	result, err := tt.reconciler.Reconcile(tt.ctx, nullLog(), tt.cluster)
+	tt.Expect(err).To(MatchError(ContainSubstring("getting workload cluster's client to reconcile service load balancer: client error")))
+	tt.Expect(result).To(Equal(controller.Result{}))
+}
+
+func TestReconcileMissingFromBundleError(t *testing.T) {
+	tt := newReconcilerTest(t)
+	tt.remoteClientRegistry.EXPECT().GetClient(tt.ctx, gomock.AssignableToTypeOf(client.ObjectKey{})).Return(fake.NewClientBuilder().Build(), nil)
+
+	result, err := tt.reconciler.Reconcile(tt.ctx, nullLog(), tt.cluster)
+	tt.Expect(err).To(MatchError(ContainSubstring("kube-vip cloud provider is not available in the bundle")))
+	tt.Expect(result).To(Equal(controller.Result{}))
+}
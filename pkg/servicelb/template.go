@@ -0,0 +1,117 @@
+package servicelb
+
+import (
+	_ "embed"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/cluster"
+	"github.com/aws/eks-anywhere/pkg/constants"
+	"github.com/aws/eks-anywhere/pkg/templater"
+)
+
+//go:embed config/kube-vip.yaml
+var kubeVipTemplate string
+
+//go:embed config/kube-vip-pools.yaml
+var kubeVipPoolsTemplate string
+
+//go:embed config/metallb-pools.yaml
+var metalLBPoolsTemplate string
+
+const (
+	// MetalLBNamespace is the namespace where MetalLB runs.
+	MetalLBNamespace = "metallb-system"
+	// MetalLBControllerDeployment is the name of the MetalLB controller deployment.
+	MetalLBControllerDeployment = "controller"
+	// KubeVipCloudProviderDeployment is the name of the kube-vip cloud provider deployment.
+	KubeVipCloudProviderDeployment = "kube-vip-cloud-provider"
+)
+
+// TemplateBuilder generates the Service load balancer manifests.
+type TemplateBuilder struct{}
+
+// GenerateManifest generates the manifest with the load balancer components.
+func (t *TemplateBuilder) GenerateManifest(spec *cluster.Spec) ([]byte, error) {
+	lb := spec.Cluster.Spec.ServiceLoadBalancer
+	switch lb.Provider {
+	case v1alpha1.KubeVipServiceLoadBalancer:
+		bundle := spec.VersionsBundle.KubeVipCloudProvider
+		if bundle.KubeVip.URI == "" || bundle.CloudProvider.URI == "" {
+			return nil, fmt.Errorf("kube-vip cloud provider is not available in the bundle for kubernetes version %s", spec.Cluster.Spec.KubernetesVersion)
+		}
+		data := map[string]interface{}{
+			"kubeVipImage":       bundle.KubeVip.VersionedImage(),
+			"cloudProviderImage": bundle.CloudProvider.VersionedImage(),
+			"controlPlaneTaints": spec.Cluster.Spec.ControlPlaneConfiguration.Taints,
+		}
+		return templater.Execute(kubeVipTemplate, data)
+	case v1alpha1.MetalLBServiceLoadBalancer:
+		manifest := spec.VersionsBundle.MetalLB.Manifest
+		if manifest.URI == "" {
+			return nil, fmt.Errorf("metallb is not available in the bundle for kubernetes version %s", spec.Cluster.Spec.KubernetesVersion)
+		}
+		m, err := spec.LoadManifest(manifest)
+		if err != nil {
+			return nil, fmt.Errorf("loading metallb manifest: %v", err)
+		}
+		return m.Content, nil
+	default:
+		return nil, fmt.Errorf("service load balancer provider %s not supported", lb.Provider)
+	}
+}
+
+// GenerateAddressPoolsManifest generates the manifest that configures the load balancer address pools.
+// It can only be applied once the load balancer components are running.
+func (t *TemplateBuilder) GenerateAddressPoolsManifest(spec *cluster.Spec) ([]byte, error) {
+	lb := spec.Cluster.Spec.ServiceLoadBalancer
+	switch lb.Provider {
+	case v1alpha1.KubeVipServiceLoadBalancer:
+		// kube-vip cloud provider looks up the keys cidr-<namespace> and range-<namespace>
+		// for the Service namespace, falling back to cidr-global and range-global.
+		pools := make([]map[string]string, 0, len(lb.AddressPools))
+		for _, pool := range lb.AddressPools {
+			cidrs, ranges := splitAddresses(pool.Addresses)
+			pools = append(pools, map[string]string{
+				"namespace": pool.Name,
+				"cidrs":     strings.Join(cidrs, ","),
+				"ranges":    strings.Join(ranges, ","),
+			})
+		}
+		return templater.Execute(kubeVipPoolsTemplate, map[string]interface{}{"pools": pools})
+	case v1alpha1.MetalLBServiceLoadBalancer:
+		pools := make([]map[string]interface{}, 0, len(lb.AddressPools))
+		for _, pool := range lb.AddressPools {
+			pools = append(pools, map[string]interface{}{
+				"name":      pool.Name,
+				"addresses": pool.Addresses,
+			})
+		}
+		return templater.Execute(metalLBPoolsTemplate, map[string]interface{}{"pools": pools})
+	default:
+		return nil, fmt.Errorf("service load balancer provider %s not supported", lb.Provider)
+	}
+}
+
+// splitAddresses separates CIDR blocks from IP ranges, since kube-vip configures them with different keys.
+func splitAddresses(addresses []string) (cidrs, ranges []string) {
+	for _, address := range addresses {
+		if _, _, err := net.ParseCIDR(address); err == nil {
+			cidrs = append(cidrs, address)
+		} else {
+			ranges = append(ranges, address)
+		}
+	}
+	return cidrs, ranges
+}
+
+// ControllerDeployment returns the namespace and name of the deployment that must be available
+// before the address pools can be applied.
+func ControllerDeployment(provider v1alpha1.ServiceLoadBalancerProvider) (namespace, name string) {
+	if provider == v1alpha1.MetalLBServiceLoadBalancer {
+		return MetalLBNamespace, MetalLBControllerDeployment
+	}
+	return constants.KubeSystemNamespace, KubeVipCloudProviderDeployment
+}
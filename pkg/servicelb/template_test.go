@@ -0,0 +1,129 @@
+package servicelb_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/aws/eks-anywhere/internal/test"
+	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/cluster"
+	"github.com/aws/eks-anywhere/pkg/servicelb"
+	releasev1 "github.com/aws/eks-anywhere/release/api/v1alpha1"
+)
+
+func newServiceLBSpec(provider v1alpha1.ServiceLoadBalancerProvider) *cluster.Spec {
+	return test.NewClusterSpec(func(s *cluster.Spec) {
+		s.Cluster.Spec.KubernetesVersion = v1alpha1.Kube124
+		s.Cluster.Spec.ControlPlaneConfiguration.Taints = []corev1.Taint{
+			{Key: "node-role.kubernetes.io/control-plane", Effect: "NoSchedule"},
+		}
+		s.Cluster.Spec.ServiceLoadBalancer = &v1alpha1.ServiceLoadBalancerConfiguration{
+			Provider: provider,
+			AddressPools: []v1alpha1.ServiceLoadBalancerAddressPool{
+				{Name: "default", Addresses: []string{"10.10.0.0/28", "10.10.1.1-10.10.1.10"}},
+				{Name: "global", Addresses: []string{"10.20.0.0/28"}},
+			},
+		}
+		s.VersionsBundle.KubeVipCloudProvider = releasev1.KubeVipCloudProviderBundle{
+			Version:       "v0.0.4",
+			KubeVip:       releasev1.Image{URI: "public.ecr.aws/l0g8r8j6/kube-vip/kube-vip:v0.5.5-eks-a-v0.0.0-dev-build.1"},
+			CloudProvider: releasev1.Image{URI: "public.ecr.aws/l0g8r8j6/kube-vip/kube-vip-cloud-provider:v0.0.4-eks-a-v0.0.0-dev-build.1"},
+		}
+		s.VersionsBundle.MetalLB = releasev1.MetalLBBundle{
+			Version:  "v0.13.7",
+			Manifest: releasev1.Manifest{URI: "testdata/metallb.yaml"},
+		}
+	})
+}
+
+func TestTemplateBuilderGenerateManifestKubeVip(t *testing.T) {
+	g := NewWithT(t)
+	spec := newServiceLBSpec(v1alpha1.KubeVipServiceLoadBalancer)
+	tb := &servicelb.TemplateBuilder{}
+
+	manifest, err := tb.GenerateManifest(spec)
+	g.Expect(err).NotTo(HaveOccurred())
+	test.AssertContentToFile(t, string(manifest), "testdata/expected_results_kube_vip.yaml")
+}
+
+func TestTemplateBuilderGenerateManifestKubeVipMissingFromBundle(t *testing.T) {
+	g := NewWithT(t)
+	spec := newServiceLBSpec(v1alpha1.KubeVipServiceLoadBalancer)
+	spec.VersionsBundle.KubeVipCloudProvider = releasev1.KubeVipCloudProviderBundle{}
+	tb := &servicelb.TemplateBuilder{}
+
+	_, err := tb.GenerateManifest(spec)
+	g.Expect(err).To(MatchError(ContainSubstring("kube-vip cloud provider is not available in the bundle for kubernetes version 1.24")))
+}
+
+func TestTemplateBuilderGenerateManifestMetalLB(t *testing.T) {
+	g := NewWithT(t)
+	spec := newServiceLBSpec(v1alpha1.MetalLBServiceLoadBalancer)
+	tb := &servicelb.TemplateBuilder{}
+
+	manifest, err := tb.GenerateManifest(spec)
+	g.Expect(err).NotTo(HaveOccurred())
+	test.AssertContentToFile(t, string(manifest), "testdata/metallb.yaml")
+}
+
+func TestTemplateBuilderGenerateManifestMetalLBMissingFromBundle(t *testing.T) {
+	g := NewWithT(t)
+	spec := newServiceLBSpec(v1alpha1.MetalLBServiceLoadBalancer)
+	spec.VersionsBundle.MetalLB = releasev1.MetalLBBundle{}
+	tb := &servicelb.TemplateBuilder{}
+
+	_, err := tb.GenerateManifest(spec)
+	g.Expect(err).To(MatchError(ContainSubstring("metallb is not available in the bundle for kubernetes version 1.24")))
+}
+
+func TestTemplateBuilderGenerateManifestUnsupportedProvider(t *testing.T) {
+	g := NewWithT(t)
+	spec := newServiceLBSpec("nginx")
+	tb := &servicelb.TemplateBuilder{}
+
+	_, err := tb.GenerateManifest(spec)
+	g.Expect(err).To(MatchError(ContainSubstring("service load balancer provider nginx not supported")))
+}
+
+func TestTemplateBuilderGenerateAddressPoolsManifest(t *testing.T) {
+	tests := []struct {
+		name     string
+		provider v1alpha1.ServiceLoadBalancerProvider
+		wantFile string
+	}{
+		{
+			name:     "kube-vip",
+			provider: v1alpha1.KubeVipServiceLoadBalancer,
+			wantFile: "testdata/expected_results_kube_vip_pools.yaml",
+		},
+		{
+			name:     "metallb",
+			provider: v1alpha1.MetalLBServiceLoadBalancer,
+			wantFile: "testdata/expected_results_metallb_pools.yaml",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			spec := newServiceLBSpec(tt.provider)
+			tb := &servicelb.TemplateBuilder{}
+
+			manifest, err := tb.GenerateAddressPoolsManifest(spec)
+			g.Expect(err).NotTo(HaveOccurred())
+			test.AssertContentToFile(t, string(manifest), tt.wantFile)
+		})
+	}
+}
+
+func TestControllerDeployment(t *testing.T) {
+	g := NewWithT(t)
+	namespace, name := servicelb.ControllerDeployment(v1alpha1.MetalLBServiceLoadBalancer)
+	g.Expect(namespace).To(Equal("metallb-system"))
+	g.Expect(name).To(Equal("controller"))
+
+	namespace, name = servicelb.ControllerDeployment(v1alpha1.KubeVipServiceLoadBalancer)
+	g.Expect(namespace).To(Equal("kube-system"))
+	g.Expect(name).To(Equal("kube-vip-cloud-provider"))
+}
@@ -0,0 +1,144 @@
+package airgap
+
+import (
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/cluster"
+	"github.com/aws/eks-anywhere/pkg/registry"
+	"github.com/aws/eks-anywhere/pkg/registrymirror"
+)
+
+// Requirement kinds.
+const (
+	KindImage         = "image"
+	KindChart         = "chart"
+	KindPackageBundle = "package bundle"
+	KindOSImage       = "os image"
+	KindHookImage     = "hook image"
+	KindEksdArtifact  = "eks-d artifact"
+)
+
+// Requirement is an artifact the cluster needs during the install.
+type Requirement struct {
+	Kind string
+	// Source is the original location of the artifact.
+	Source string
+	// Location is where the cluster gets the artifact from: the registry mirror for images and
+	// charts, the file server for OS images and files.
+	Location string
+}
+
+// IsImage returns true if the requirement is pulled from a registry.
+func (r Requirement) IsImage() bool {
+	return r.Kind == KindImage || r.Kind == KindChart || r.Kind == KindPackageBundle
+}
+
+// Requirements lists the images, charts, OS images and EKS-D artifacts the cluster spec needs.
+// Images and charts are located in the registry mirror of the cluster, if any.
+func Requirements(spec *cluster.Spec) []Requirement {
+	mirror := registrymirror.FromCluster(spec.Cluster)
+	r := &requirements{mirror: mirror, seen: map[string]bool{}}
+
+	bundle := spec.VersionsBundle
+	charts := bundle.Charts()
+	chartNames := make([]string, 0, len(charts))
+	for name := range charts {
+		chartNames = append(chartNames, name)
+	}
+	sort.Strings(chartNames)
+	for _, name := range chartNames {
+		r.addImage(KindChart, charts[name].VersionedImage())
+	}
+	for _, image := range bundle.Images() {
+		r.addImage(KindImage, image.VersionedImage())
+	}
+
+	r.addOSImages(spec)
+
+	r.addFile(KindEksdArtifact, bundle.EksD.EksDReleaseUrl)
+	r.addFile(KindEksdArtifact, bundle.EksD.Components)
+
+	return r.list
+}
+
+// PackageRequirements lists the curated packages charts and images, located in the registry mirror
+// of the cluster, if any.
+func PackageRequirements(spec *cluster.Spec, charts, images []registry.Artifact) []Requirement {
+	r := &requirements{mirror: registrymirror.FromCluster(spec.Cluster), seen: map[string]bool{}}
+	for _, chart := range charts {
+		r.addImage(KindChart, chart.VersionedImage())
+	}
+	for _, image := range images {
+		r.addImage(KindImage, image.VersionedImage())
+	}
+
+	return r.list
+}
+
+type requirements struct {
+	mirror *registrymirror.RegistryMirror
+	seen   map[string]bool
+	list   []Requirement
+}
+
+func (r *requirements) addImage(kind, image string) {
+	r.add(kind, image, r.mirror.ReplaceRegistry(image))
+}
+
+func (r *requirements) addFile(kind, url string) {
+	r.add(kind, url, url)
+}
+
+func (r *requirements) add(kind, source, location string) {
+	if source == "" || r.seen[location] {
+		return
+	}
+	r.seen[location] = true
+	r.list = append(r.list, Requirement{Kind: kind, Source: source, Location: location})
+}
+
+func (r *requirements) addOSImages(spec *cluster.Spec) {
+	bundle := spec.VersionsBundle
+	switch {
+	case spec.VSphereDatacenter != nil:
+		// The OVA is only imported when a Bottlerocket machine config doesn't set a template.
+		// Other OS families always require a template.
+		for _, machineConfig := range spec.VSphereMachineConfigs {
+			if machineConfig.Spec.Template == "" && machineConfig.Spec.OSFamily == v1alpha1.Bottlerocket {
+				r.addFile(KindOSImage, bundle.EksD.Ova.Bottlerocket.URI)
+			}
+		}
+	case spec.TinkerbellDatacenter != nil:
+		datacenter := spec.TinkerbellDatacenter.Spec
+		if datacenter.OSImageURL != "" {
+			r.addFile(KindOSImage, datacenter.OSImageURL)
+		} else if usesBottlerocket(spec.TinkerbellMachineConfigs) {
+			r.addFile(KindOSImage, bundle.EksD.Raw.Bottlerocket.URI)
+		}
+
+		hook := bundle.Tinkerbell.TinkerbellStack.Hook
+		for _, uri := range []string{hook.Vmlinuz.Amd.URI, hook.Initramfs.Amd.URI} {
+			r.add(KindHookImage, uri, hookImageURL(datacenter.HookImagesURLPath, uri))
+		}
+	}
+}
+
+func usesBottlerocket(machineConfigs map[string]*v1alpha1.TinkerbellMachineConfig) bool {
+	for _, machineConfig := range machineConfigs {
+		if machineConfig.Spec.OSFamily == v1alpha1.Bottlerocket {
+			return true
+		}
+	}
+	return false
+}
+
+// hookImageURL returns the url of the hook image in the hook images path override, if set.
+func hookImageURL(overridePath, uri string) string {
+	if overridePath == "" || uri == "" {
+		return uri
+	}
+	return strings.TrimSuffix(overridePath, "/") + "/" + path.Base(uri)
+}
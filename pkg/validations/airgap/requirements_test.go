@@ -0,0 +1,187 @@
+package airgap_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/aws/eks-anywhere/internal/test"
+	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/cluster"
+	"github.com/aws/eks-anywhere/pkg/registry"
+	"github.com/aws/eks-anywhere/pkg/validations/airgap"
+)
+
+func airgapClusterSpec(opts ...test.ClusterSpecOpt) *cluster.Spec {
+	return test.NewClusterSpec(append([]test.ClusterSpecOpt{func(s *cluster.Spec) {
+		s.Cluster.Spec.RegistryMirrorConfiguration = &v1alpha1.RegistryMirrorConfiguration{
+			Endpoint: "harbor.local",
+			Port:     "443",
+		}
+		s.VersionsBundle.Cilium.HelmChart.URI = "public.ecr.aws/isovalent/cilium:1.11.10"
+		s.VersionsBundle.Cilium.Cilium.URI = "public.ecr.aws/isovalent/cilium:v1.11.10"
+		s.VersionsBundle.Cilium.Operator.URI = "public.ecr.aws/isovalent/operator-generic:v1.11.10"
+		s.VersionsBundle.EksD.EksDReleaseUrl = "https://distro.eks.amazonaws.com/kubernetes-1-23/kubernetes-1-23-eks-7.yaml"
+		s.VersionsBundle.EksD.Components = "https://distro.eks.amazonaws.com/crds/releases.distro.eks.amazonaws.com-v1alpha1.yaml"
+	}}, opts...)...)
+}
+
+func TestRequirementsRegistryMirror(t *testing.T) {
+	g := NewWithT(t)
+	spec := airgapClusterSpec()
+
+	g.Expect(airgap.Requirements(spec)).To(Equal([]airgap.Requirement{
+		{
+			Kind:     airgap.KindChart,
+			Source:   "public.ecr.aws/isovalent/cilium:1.11.10",
+			Location: "harbor.local:443/isovalent/cilium:1.11.10",
+		},
+		{
+			Kind:     airgap.KindImage,
+			Source:   "public.ecr.aws/isovalent/cilium:v1.11.10",
+			Location: "harbor.local:443/isovalent/cilium:v1.11.10",
+		},
+		{
+			Kind:     airgap.KindImage,
+			Source:   "public.ecr.aws/isovalent/operator-generic:v1.11.10",
+			Location: "harbor.local:443/isovalent/operator-generic:v1.11.10",
+		},
+		{
+			Kind:     airgap.KindEksdArtifact,
+			Source:   "https://distro.eks.amazonaws.com/kubernetes-1-23/kubernetes-1-23-eks-7.yaml",
+			Location: "https://distro.eks.amazonaws.com/kubernetes-1-23/kubernetes-1-23-eks-7.yaml",
+		},
+		{
+			Kind:     airgap.KindEksdArtifact,
+			Source:   "https://distro.eks.amazonaws.com/crds/releases.distro.eks.amazonaws.com-v1alpha1.yaml",
+			Location: "https://distro.eks.amazonaws.com/crds/releases.distro.eks.amazonaws.com-v1alpha1.yaml",
+		},
+	}))
+}
+
+func TestRequirementsNoRegistryMirror(t *testing.T) {
+	g := NewWithT(t)
+	spec := airgapClusterSpec(func(s *cluster.Spec) {
+		s.Cluster.Spec.RegistryMirrorConfiguration = nil
+	})
+
+	requirements := airgap.Requirements(spec)
+	g.Expect(requirements).To(HaveLen(5))
+	for _, r := range requirements {
+		g.Expect(r.Location).To(Equal(r.Source))
+	}
+}
+
+func TestRequirementsVSphereOVA(t *testing.T) {
+	g := NewWithT(t)
+	ova := "https://anywhere-assets.eks.amazonaws.com/bottlerocket-vmware-k8s-1.23-x86_64.ova"
+	spec := airgapClusterSpec(func(s *cluster.Spec) {
+		s.VSphereDatacenter = &v1alpha1.VSphereDatacenterConfig{}
+		s.VSphereMachineConfigs = map[string]*v1alpha1.VSphereMachineConfig{
+			"cp":     {Spec: v1alpha1.VSphereMachineConfigSpec{OSFamily: v1alpha1.Bottlerocket}},
+			"worker": {Spec: v1alpha1.VSphereMachineConfigSpec{OSFamily: v1alpha1.Bottlerocket}},
+		}
+		s.VersionsBundle.EksD.Ova.Bottlerocket.URI = ova
+	})
+
+	g.Expect(airgap.Requirements(spec)).To(ContainElement(airgap.Requirement{
+		Kind:     airgap.KindOSImage,
+		Source:   ova,
+		Location: ova,
+	}))
+}
+
+func TestRequirementsVSphereUbuntu(t *testing.T) {
+	g := NewWithT(t)
+	spec := airgapClusterSpec(func(s *cluster.Spec) {
+		s.VSphereDatacenter = &v1alpha1.VSphereDatacenterConfig{}
+		s.VSphereMachineConfigs = map[string]*v1alpha1.VSphereMachineConfig{
+			"cp": {Spec: v1alpha1.VSphereMachineConfigSpec{OSFamily: v1alpha1.Ubuntu}},
+		}
+		s.VersionsBundle.EksD.Ova.Bottlerocket.URI = "https://anywhere-assets.eks.amazonaws.com/bottlerocket.ova"
+	})
+
+	for _, r := range airgap.Requirements(spec) {
+		g.Expect(r.Kind).NotTo(Equal(airgap.KindOSImage))
+	}
+}
+
+func TestRequirementsVSphereTemplate(t *testing.T) {
+	g := NewWithT(t)
+	spec := airgapClusterSpec(func(s *cluster.Spec) {
+		s.VSphereDatacenter = &v1alpha1.VSphereDatacenterConfig{}
+		s.VSphereMachineConfigs = map[string]*v1alpha1.VSphereMachineConfig{
+			"cp": {Spec: v1alpha1.VSphereMachineConfigSpec{Template: "/SDDC-Datacenter/vm/Templates/bottlerocket"}},
+		}
+		s.VersionsBundle.EksD.Ova.Bottlerocket.URI = "https://anywhere-assets.eks.amazonaws.com/bottlerocket.ova"
+	})
+
+	for _, r := range airgap.Requirements(spec) {
+		g.Expect(r.Kind).NotTo(Equal(airgap.KindOSImage))
+	}
+}
+
+func TestRequirementsTinkerbell(t *testing.T) {
+	g := NewWithT(t)
+	spec := airgapClusterSpec(func(s *cluster.Spec) {
+		s.TinkerbellDatacenter = &v1alpha1.TinkerbellDatacenterConfig{
+			Spec: v1alpha1.TinkerbellDatacenterConfigSpec{
+				OSImageURL:        "http://10.0.0.1:8080/ubuntu.gz",
+				HookImagesURLPath: "http://10.0.0.1:8080/hook/",
+			},
+		}
+		s.VersionsBundle.Tinkerbell.TinkerbellStack.Hook.Vmlinuz.Amd.URI = "https://anywhere-assets.eks.amazonaws.com/hook/vmlinuz-x86_64"
+		s.VersionsBundle.Tinkerbell.TinkerbellStack.Hook.Initramfs.Amd.URI = "https://anywhere-assets.eks.amazonaws.com/hook/initramfs-x86_64"
+	})
+
+	g.Expect(airgap.Requirements(spec)).To(ContainElements(
+		airgap.Requirement{Kind: airgap.KindOSImage, Source: "http://10.0.0.1:8080/ubuntu.gz", Location: "http://10.0.0.1:8080/ubuntu.gz"},
+		airgap.Requirement{Kind: airgap.KindHookImage, Source: "https://anywhere-assets.eks.amazonaws.com/hook/vmlinuz-x86_64", Location: "http://10.0.0.1:8080/hook/vmlinuz-x86_64"},
+		airgap.Requirement{Kind: airgap.KindHookImage, Source: "https://anywhere-assets.eks.amazonaws.com/hook/initramfs-x86_64", Location: "http://10.0.0.1:8080/hook/initramfs-x86_64"},
+	))
+}
+
+func TestRequirementsTinkerbellBottlerocket(t *testing.T) {
+	g := NewWithT(t)
+	raw := "https://anywhere-assets.eks.amazonaws.com/bottlerocket-metal-k8s-1.23-x86_64.img.gz"
+	spec := airgapClusterSpec(func(s *cluster.Spec) {
+		s.TinkerbellDatacenter = &v1alpha1.TinkerbellDatacenterConfig{}
+		s.TinkerbellMachineConfigs = map[string]*v1alpha1.TinkerbellMachineConfig{
+			"cp": {Spec: v1alpha1.TinkerbellMachineConfigSpec{OSFamily: v1alpha1.Bottlerocket}},
+		}
+		s.VersionsBundle.EksD.Raw.Bottlerocket.URI = raw
+	})
+
+	g.Expect(airgap.Requirements(spec)).To(ContainElement(airgap.Requirement{
+		Kind:     airgap.KindOSImage,
+		Source:   raw,
+		Location: raw,
+	}))
+}
+
+func TestPackageRequirements(t *testing.T) {
+	g := NewWithT(t)
+	spec := airgapClusterSpec()
+	bundle := registry.NewArtifactFromURI("public.ecr.aws/eks-anywhere/eks-anywhere-packages-bundles:v1-23-latest")
+	image := registry.NewArtifact("public.ecr.aws", "eks-anywhere/harbor/harbor-core", "", "sha256:0ed3ef2cd0d4be4f5d0a5fbc4a4a8bb2d1b42bbb3bbd1e1e5a6ed3c8e0e0a1b7")
+
+	g.Expect(airgap.PackageRequirements(spec, []registry.Artifact{bundle, bundle}, []registry.Artifact{image})).To(Equal([]airgap.Requirement{
+		{
+			Kind:     airgap.KindChart,
+			Source:   "public.ecr.aws/eks-anywhere/eks-anywhere-packages-bundles:v1-23-latest",
+			Location: "harbor.local:443/eks-anywhere/eks-anywhere-packages-bundles:v1-23-latest",
+		},
+		{
+			Kind:     airgap.KindImage,
+			Source:   image.VersionedImage(),
+			Location: "harbor.local:443/eks-anywhere/harbor/harbor-core@sha256:0ed3ef2cd0d4be4f5d0a5fbc4a4a8bb2d1b42bbb3bbd1e1e5a6ed3c8e0e0a1b7",
+		},
+	}))
+}
+
+func TestRequirementIsImage(t *testing.T) {
+	g := NewWithT(t)
+	g.Expect(airgap.Requirement{Kind: airgap.KindChart}.IsImage()).To(BeTrue())
+	g.Expect(airgap.Requirement{Kind: airgap.KindPackageBundle}.IsImage()).To(BeTrue())
+	g.Expect(airgap.Requirement{Kind: airgap.KindOSImage}.IsImage()).To(BeFalse())
+}
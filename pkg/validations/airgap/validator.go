@@ -0,0 +1,191 @@
+package airgap
+
+import (
+	"context"
+	"crypto/x509"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"text/tabwriter"
+
+	"github.com/aws/eks-anywhere/pkg/registry"
+)
+
+// Requirement check statuses in a Report.
+const (
+	StatusPass = "pass"
+	StatusFail = "fail"
+)
+
+// ImageChecker checks an image or chart can be pulled from its registry.
+type ImageChecker interface {
+	CheckImage(ctx context.Context, image string) error
+}
+
+// FileChecker checks a file can be downloaded from its server.
+type FileChecker interface {
+	CheckFile(ctx context.Context, location string) error
+}
+
+// Validator checks the requirements of an air-gapped install are reachable from the admin machine.
+type Validator struct {
+	images ImageChecker
+	files  FileChecker
+}
+
+// NewValidator returns a Validator checking images with images and files with files.
+func NewValidator(images ImageChecker, files FileChecker) *Validator {
+	return &Validator{
+		images: images,
+		files:  files,
+	}
+}
+
+// Validate checks every requirement. Failures are recorded in the report, so every requirement is checked.
+func (v *Validator) Validate(ctx context.Context, requirements []Requirement) *Report {
+	report := &Report{}
+	for _, r := range requirements {
+		var err error
+		if r.IsImage() {
+			err = v.images.CheckImage(ctx, r.Location)
+		} else {
+			err = v.files.CheckFile(ctx, r.Location)
+		}
+		report.Add(r, err)
+	}
+	return report
+}
+
+// Result is the result of checking a requirement.
+type Result struct {
+	Requirement
+	Status string
+	Error  string
+}
+
+// Report lists the requirements of an air-gapped install and whether they are reachable.
+type Report struct {
+	Results []Result
+}
+
+// Add records the result of checking a requirement.
+func (r *Report) Add(requirement Requirement, err error) {
+	result := Result{Requirement: requirement, Status: StatusPass}
+	if err != nil {
+		result.Status = StatusFail
+		result.Error = err.Error()
+	}
+	r.Results = append(r.Results, result)
+}
+
+// Failed returns the number of requirements that aren't reachable.
+func (r *Report) Failed() int {
+	failed := 0
+	for _, result := range r.Results {
+		if result.Status == StatusFail {
+			failed++
+		}
+	}
+	return failed
+}
+
+// WriteTable pretty-prints the report as a table.
+func (r *Report) WriteTable(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 10, 4, 3, ' ', 0)
+	fmt.Fprintln(tw, "KIND\tLOCATION\tSTATUS")
+	for _, result := range r.Results {
+		status := result.Status
+		if result.Error != "" {
+			status += ": " + result.Error
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\n", result.Kind, result.Location, status)
+	}
+	return tw.Flush()
+}
+
+// RegistryImageChecker resolves images in their registries.
+type RegistryImageChecker struct {
+	cache           *registry.Cache
+	credentialStore *registry.CredentialStore
+	certificates    *x509.CertPool
+	insecure        bool
+}
+
+// NewRegistryImageChecker returns a RegistryImageChecker using the registry clients in cache.
+func NewRegistryImageChecker(cache *registry.Cache, credentialStore *registry.CredentialStore, certificates *x509.CertPool, insecure bool) *RegistryImageChecker {
+	return &RegistryImageChecker{
+		cache:           cache,
+		credentialStore: credentialStore,
+		certificates:    certificates,
+		insecure:        insecure,
+	}
+}
+
+// CheckImage returns an error if the image can't be resolved in its registry.
+func (c *RegistryImageChecker) CheckImage(ctx context.Context, image string) error {
+	artifact := registry.NewArtifactFromURI(image)
+	client, err := c.cache.Get(registry.NewStorageContext(artifact.Registry, c.credentialStore, c.certificates, c.insecure))
+	if err != nil {
+		return fmt.Errorf("error with repository %s: %v", artifact.Registry, err)
+	}
+	storage, err := client.GetStorage(ctx, artifact)
+	if err != nil {
+		return err
+	}
+	if _, err = client.Resolve(ctx, storage, artifact.VersionedImage()); err != nil {
+		return fmt.Errorf("resolving image: %v", err)
+	}
+	return nil
+}
+
+// HTTPFileChecker checks files are reachable in their http servers. Locations without scheme
+// are checked in the local filesystem.
+type HTTPFileChecker struct {
+	client *http.Client
+}
+
+// NewHTTPFileChecker returns a HTTPFileChecker sending the requests with client.
+func NewHTTPFileChecker(client *http.Client) *HTTPFileChecker {
+	return &HTTPFileChecker{client: client}
+}
+
+// CheckFile returns an error if the file doesn't exist or its server can't be reached.
+func (c *HTTPFileChecker) CheckFile(ctx context.Context, location string) error {
+	u, err := url.Parse(location)
+	if err != nil || u.Scheme == "" {
+		if _, err = os.Stat(location); err != nil {
+			return err
+		}
+		return nil
+	}
+
+	resp, err := c.do(ctx, http.MethodHead, location)
+	if err != nil {
+		return err
+	}
+	// Some file servers don't support HEAD requests.
+	if resp.StatusCode == http.StatusMethodNotAllowed {
+		if resp, err = c.do(ctx, http.MethodGet, location); err != nil {
+			return err
+		}
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("unexpected response: %s", resp.Status)
+	}
+	return nil
+}
+
+func (c *HTTPFileChecker) do(ctx context.Context, method, location string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, method, location, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	resp.Body.Close()
+	return resp, nil
+}
@@ -0,0 +1,105 @@
+package airgap_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/aws/eks-anywhere/pkg/validations/airgap"
+)
+
+type fakeChecker struct {
+	unreachable map[string]bool
+	checked     []string
+}
+
+func (f *fakeChecker) CheckImage(_ context.Context, image string) error {
+	return f.check(image)
+}
+
+func (f *fakeChecker) CheckFile(_ context.Context, location string) error {
+	return f.check(location)
+}
+
+func (f *fakeChecker) check(location string) error {
+	f.checked = append(f.checked, location)
+	if f.unreachable[location] {
+		return errors.New("not found")
+	}
+	return nil
+}
+
+func TestValidatorValidate(t *testing.T) {
+	g := NewWithT(t)
+	images := &fakeChecker{unreachable: map[string]bool{"harbor.local/cilium:v1": true}}
+	files := &fakeChecker{}
+	requirements := []airgap.Requirement{
+		{Kind: airgap.KindChart, Location: "harbor.local/cilium:1"},
+		{Kind: airgap.KindImage, Location: "harbor.local/cilium:v1"},
+		{Kind: airgap.KindOSImage, Location: "http://10.0.0.1/ubuntu.gz"},
+	}
+
+	report := airgap.NewValidator(images, files).Validate(context.Background(), requirements)
+	g.Expect(images.checked).To(Equal([]string{"harbor.local/cilium:1", "harbor.local/cilium:v1"}))
+	g.Expect(files.checked).To(Equal([]string{"http://10.0.0.1/ubuntu.gz"}))
+	g.Expect(report.Failed()).To(Equal(1))
+	g.Expect(report.Results).To(Equal([]airgap.Result{
+		{Requirement: requirements[0], Status: airgap.StatusPass},
+		{Requirement: requirements[1], Status: airgap.StatusFail, Error: "not found"},
+		{Requirement: requirements[2], Status: airgap.StatusPass},
+	}))
+}
+
+func TestReportWriteTable(t *testing.T) {
+	g := NewWithT(t)
+	report := &airgap.Report{}
+	report.Add(airgap.Requirement{Kind: airgap.KindImage, Location: "harbor.local/cilium:v1"}, nil)
+	report.Add(airgap.Requirement{Kind: airgap.KindOSImage, Location: "http://10.0.0.1/ubuntu.gz"}, errors.New("unexpected response: 404 Not Found"))
+
+	var b bytes.Buffer
+	g.Expect(report.WriteTable(&b)).To(Succeed())
+	g.Expect(b.String()).To(Equal(
+		"KIND       LOCATION                    STATUS\n" +
+			"image      harbor.local/cilium:v1      pass\n" +
+			"os image   http://10.0.0.1/ubuntu.gz   fail: unexpected response: 404 Not Found\n",
+	))
+}
+
+func TestHTTPFileCheckerCheckFile(t *testing.T) {
+	g := NewWithT(t)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/ubuntu.gz":
+		case "/get-only.gz":
+			if r.Method == http.MethodHead {
+				w.WriteHeader(http.StatusMethodNotAllowed)
+			}
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	checker := airgap.NewHTTPFileChecker(server.Client())
+	ctx := context.Background()
+
+	g.Expect(checker.CheckFile(ctx, server.URL+"/ubuntu.gz")).To(Succeed())
+	g.Expect(checker.CheckFile(ctx, server.URL+"/get-only.gz")).To(Succeed())
+	g.Expect(checker.CheckFile(ctx, server.URL+"/missing.gz")).To(MatchError("unexpected response: 404 Not Found"))
+}
+
+func TestHTTPFileCheckerCheckLocalFile(t *testing.T) {
+	g := NewWithT(t)
+	file := filepath.Join(t.TempDir(), "ubuntu.gz")
+	g.Expect(os.WriteFile(file, []byte("image"), 0o644)).To(Succeed())
+	checker := airgap.NewHTTPFileChecker(http.DefaultClient)
+
+	g.Expect(checker.CheckFile(context.Background(), file)).To(Succeed())
+	g.Expect(checker.CheckFile(context.Background(), file+".missing")).NotTo(Succeed())
+}
@@ -46,19 +46,73 @@ func ValidateCertForRegistryMirror(clusterSpec *cluster.Spec, tlsValidator TlsVa
 	return nil
 }
 
-// ValidateAuthenticationForRegistryMirror checks if REGISTRY_USERNAME and REGISTRY_PASSWORD is set if authenticated registry mirrors are used.
+// ValidateAuthenticationForRegistryMirror checks if REGISTRY_USERNAME and REGISTRY_PASSWORD is set if authenticated registry mirrors are used,
+// and if the credentials of the authenticated upstream registries are set.
 func ValidateAuthenticationForRegistryMirror(clusterSpec *cluster.Spec) error {
 	cluster := clusterSpec.Cluster
-	if cluster.Spec.RegistryMirrorConfiguration != nil && cluster.Spec.RegistryMirrorConfiguration.Authenticate {
+	if cluster.Spec.RegistryMirrorConfiguration == nil {
+		return nil
+	}
+	if cluster.Spec.RegistryMirrorConfiguration.Authenticate {
 		_, _, err := config.ReadCredentials()
 		if err != nil {
 			return err
 		}
 	}
+	for _, upstream := range cluster.Spec.RegistryMirrorConfiguration.Upstreams {
+		if !upstream.Authenticate {
+			continue
+		}
+		if _, _, err := config.ReadUpstreamCredentials(upstream.Registry); err != nil {
+			return fmt.Errorf("upstream registry %s requires authentication: %v", upstream.Registry, err)
+		}
+	}
 	return nil
 }
 
 // ValidateManagementClusterName checks if the management cluster specified in the workload cluster spec is valid.
+// ValidateRegistryMirrorForBottlerocket checks the registry mirror doesn't use failover endpoints or upstream
+// registry settings with Bottlerocket machines, as their bootstrap settings only take one mirror endpoint.
+func ValidateRegistryMirrorForBottlerocket(clusterSpec *cluster.Spec) error {
+	mirrorConfig := clusterSpec.Cluster.Spec.RegistryMirrorConfiguration
+	if mirrorConfig == nil || (len(mirrorConfig.FailoverEndpoints) == 0 && len(mirrorConfig.Upstreams) == 0) {
+		return nil
+	}
+	if !usesBottlerocket(clusterSpec.Config) {
+		return nil
+	}
+	return fmt.Errorf("registryMirrorConfiguration failoverEndpoints and upstreams are not supported for %s", v1alpha1.Bottlerocket)
+}
+
+func usesBottlerocket(config *cluster.Config) bool {
+	for _, m := range config.VSphereMachineConfigs {
+		if m.OSFamily() == v1alpha1.Bottlerocket {
+			return true
+		}
+	}
+	for _, m := range config.CloudStackMachineConfigs {
+		if m.OSFamily() == v1alpha1.Bottlerocket {
+			return true
+		}
+	}
+	for _, m := range config.SnowMachineConfigs {
+		if m.OSFamily() == v1alpha1.Bottlerocket {
+			return true
+		}
+	}
+	for _, m := range config.NutanixMachineConfigs {
+		if m.OSFamily() == v1alpha1.Bottlerocket {
+			return true
+		}
+	}
+	for _, m := range config.TinkerbellMachineConfigs {
+		if m.OSFamily() == v1alpha1.Bottlerocket {
+			return true
+		}
+	}
+	return false
+}
+
 func ValidateManagementClusterName(ctx context.Context, k KubectlClient, mgmtCluster *types.Cluster, mgmtClusterName string) error {
 	cluster, err := k.GetEksaCluster(ctx, mgmtCluster, mgmtClusterName)
 	if err != nil {
@@ -79,3 +133,27 @@ func ValidateK8s125Support(clusterSpec *cluster.Spec) error {
 	}
 	return nil
 }
+
+// ValidateServiceLoadBalancerBundle checks the bundle for the cluster Kubernetes version ships the artifacts
+// of the configured Service load balancer provider.
+func ValidateServiceLoadBalancerBundle(clusterSpec *cluster.Spec) error {
+	lb := clusterSpec.Cluster.Spec.ServiceLoadBalancer
+	if lb == nil {
+		return nil
+	}
+
+	switch lb.Provider {
+	case v1alpha1.KubeVipServiceLoadBalancer:
+		bundle := clusterSpec.VersionsBundle.KubeVipCloudProvider
+		if bundle.KubeVip.URI == "" || bundle.CloudProvider.URI == "" {
+			return fmt.Errorf("kube-vip cloud provider is not available in the bundle for kubernetes version %s", clusterSpec.Cluster.Spec.KubernetesVersion)
+		}
+	case v1alpha1.MetalLBServiceLoadBalancer:
+		bundle := clusterSpec.VersionsBundle.MetalLB
+		if bundle.Manifest.URI == "" {
+			return fmt.Errorf("metallb is not available in the bundle for kubernetes version %s", clusterSpec.Cluster.Spec.KubernetesVersion)
+		}
+	}
+
+	return nil
+}
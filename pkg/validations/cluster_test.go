@@ -17,6 +17,7 @@ import (
 	"github.com/aws/eks-anywhere/pkg/types"
 	"github.com/aws/eks-anywhere/pkg/validations"
 	"github.com/aws/eks-anywhere/pkg/validations/mocks"
+	releasev1 "github.com/aws/eks-anywhere/release/api/v1alpha1"
 )
 
 type clusterTest struct {
@@ -151,6 +152,87 @@ func TestValidateAuthenticationForRegistryMirrorAuthValid(t *testing.T) {
 	tt.Expect(validations.ValidateAuthenticationForRegistryMirror(tt.clusterSpec)).To(Succeed())
 }
 
+func TestValidateAuthenticationForRegistryMirrorUpstreamAuthInvalid(t *testing.T) {
+	tt := newTest(t, withTLS())
+	tt.clusterSpec.Cluster.Spec.RegistryMirrorConfiguration.Authenticate = false
+	tt.clusterSpec.Cluster.Spec.RegistryMirrorConfiguration.Upstreams = []anywherev1.UpstreamRegistry{
+		{
+			Registry:     "docker.io",
+			SkipMirror:   true,
+			Authenticate: true,
+		},
+	}
+
+	tt.Expect(validations.ValidateAuthenticationForRegistryMirror(tt.clusterSpec)).To(
+		MatchError("upstream registry docker.io requires authentication: please set REGISTRY_USERNAME_DOCKER_IO env var"))
+
+	t.Setenv("REGISTRY_USERNAME_DOCKER_IO", "username")
+	t.Setenv("REGISTRY_PASSWORD_DOCKER_IO", "password")
+	tt.Expect(validations.ValidateAuthenticationForRegistryMirror(tt.clusterSpec)).To(Succeed())
+}
+
+func TestValidateRegistryMirrorForBottlerocket(t *testing.T) {
+	tests := []struct {
+		name     string
+		osFamily anywherev1.OSFamily
+		mirror   *anywherev1.RegistryMirrorConfiguration
+		wantErr  string
+	}{
+		{
+			name:     "no registry mirror",
+			osFamily: anywherev1.Bottlerocket,
+		},
+		{
+			name:     "bottlerocket single endpoint",
+			osFamily: anywherev1.Bottlerocket,
+			mirror:   &anywherev1.RegistryMirrorConfiguration{Endpoint: "1.2.3.4"},
+		},
+		{
+			name:     "ubuntu failover endpoints",
+			osFamily: anywherev1.Ubuntu,
+			mirror: &anywherev1.RegistryMirrorConfiguration{
+				Endpoint:          "1.2.3.4",
+				FailoverEndpoints: []anywherev1.RegistryMirrorEndpoint{{Endpoint: "1.2.3.5"}},
+			},
+		},
+		{
+			name:     "bottlerocket failover endpoints",
+			osFamily: anywherev1.Bottlerocket,
+			mirror: &anywherev1.RegistryMirrorConfiguration{
+				Endpoint:          "1.2.3.4",
+				FailoverEndpoints: []anywherev1.RegistryMirrorEndpoint{{Endpoint: "1.2.3.5"}},
+			},
+			wantErr: "registryMirrorConfiguration failoverEndpoints and upstreams are not supported for bottlerocket",
+		},
+		{
+			name:     "bottlerocket upstreams",
+			osFamily: anywherev1.Bottlerocket,
+			mirror: &anywherev1.RegistryMirrorConfiguration{
+				Endpoint:  "1.2.3.4",
+				Upstreams: []anywherev1.UpstreamRegistry{{Registry: "docker.io", SkipMirror: true}},
+			},
+			wantErr: "registryMirrorConfiguration failoverEndpoints and upstreams are not supported for bottlerocket",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tt := newTest(t)
+			tt.clusterSpec.Cluster.Spec.RegistryMirrorConfiguration = tc.mirror
+			tt.clusterSpec.VSphereMachineConfigs = map[string]*anywherev1.VSphereMachineConfig{
+				"cp": {Spec: anywherev1.VSphereMachineConfigSpec{OSFamily: anywherev1.Ubuntu}},
+				"md": {Spec: anywherev1.VSphereMachineConfigSpec{OSFamily: tc.osFamily}},
+			}
+
+			err := validations.ValidateRegistryMirrorForBottlerocket(tt.clusterSpec)
+			if tc.wantErr == "" {
+				tt.Expect(err).To(Succeed())
+			} else {
+				tt.Expect(err).To(MatchError(tc.wantErr))
+			}
+		})
+	}
+}
+
 func TestValidateManagementClusterNameValid(t *testing.T) {
 	mgmtName := "test"
 	tt := newTest(t, withKubectl())
@@ -232,3 +314,40 @@ func TestValidateK8s125SupportActive(t *testing.T) {
 	os.Setenv(features.K8s125SupportEnvVar, "true")
 	tt.Expect(validations.ValidateK8s125Support(tt.clusterSpec)).To(Succeed())
 }
+
+func TestValidateServiceLoadBalancerBundleNoServiceLoadBalancer(t *testing.T) {
+	tt := newTest(t)
+	tt.Expect(validations.ValidateServiceLoadBalancerBundle(tt.clusterSpec)).To(Succeed())
+}
+
+func TestValidateServiceLoadBalancerBundleKubeVip(t *testing.T) {
+	tt := newTest(t)
+	tt.clusterSpec.Cluster.Spec.ServiceLoadBalancer = &anywherev1.ServiceLoadBalancerConfiguration{
+		Provider: anywherev1.KubeVipServiceLoadBalancer,
+	}
+	tt.clusterSpec.VersionsBundle.KubeVipCloudProvider = releasev1.KubeVipCloudProviderBundle{
+		KubeVip:       releasev1.Image{URI: "public.ecr.aws/kube-vip/kube-vip:v0.5.5"},
+		CloudProvider: releasev1.Image{URI: "public.ecr.aws/kube-vip/kube-vip-cloud-provider:v0.0.4"},
+	}
+	tt.Expect(validations.ValidateServiceLoadBalancerBundle(tt.clusterSpec)).To(Succeed())
+}
+
+func TestValidateServiceLoadBalancerBundleKubeVipMissing(t *testing.T) {
+	tt := newTest(t)
+	tt.clusterSpec.Cluster.Spec.KubernetesVersion = anywherev1.Kube124
+	tt.clusterSpec.Cluster.Spec.ServiceLoadBalancer = &anywherev1.ServiceLoadBalancerConfiguration{
+		Provider: anywherev1.KubeVipServiceLoadBalancer,
+	}
+	tt.Expect(validations.ValidateServiceLoadBalancerBundle(tt.clusterSpec)).To(
+		MatchError(ContainSubstring("kube-vip cloud provider is not available in the bundle for kubernetes version 1.24")))
+}
+
+func TestValidateServiceLoadBalancerBundleMetalLBMissing(t *testing.T) {
+	tt := newTest(t)
+	tt.clusterSpec.Cluster.Spec.KubernetesVersion = anywherev1.Kube124
+	tt.clusterSpec.Cluster.Spec.ServiceLoadBalancer = &anywherev1.ServiceLoadBalancerConfiguration{
+		Provider: anywherev1.MetalLBServiceLoadBalancer,
+	}
+	tt.Expect(validations.ValidateServiceLoadBalancerBundle(tt.clusterSpec)).To(
+		MatchError(ContainSubstring("metallb is not available in the bundle for kubernetes version 1.24")))
+}
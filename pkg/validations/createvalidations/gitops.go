@@ -17,6 +17,7 @@ var (
 	clusterResourceType      = fmt.Sprintf("clusters.%s", v1alpha1.GroupVersion.Group)
 	fluxConfigResourceType   = fmt.Sprintf("fluxconfigs.%s", v1alpha1.GroupVersion.Group)
 	gitOpsConfigResourceType = fmt.Sprintf("gitopsconfigs.%s", v1alpha1.GroupVersion.Group)
+	argoCDConfigResourceType = fmt.Sprintf("argocdconfigs.%s", v1alpha1.GroupVersion.Group)
 )
 
 func ValidateGitOps(ctx context.Context, k validations.KubectlClient, cluster *types.Cluster, clusterSpec *cluster.Spec) error {
@@ -28,6 +29,10 @@ func ValidateGitOps(ctx context.Context, k validations.KubectlClient, cluster *t
 		return fmt.Errorf("invalid fluxConfig: %v", err)
 	}
 
+	if err := validateArgoCDConfig(ctx, k, cluster, clusterSpec); err != nil {
+		return fmt.Errorf("invalid argoCDConfig: %v", err)
+	}
+
 	return nil
 }
 
@@ -99,3 +104,38 @@ func validateFluxConfig(ctx context.Context, k validations.KubectlClient, cluste
 
 	return nil
 }
+
+func validateArgoCDConfig(ctx context.Context, k validations.KubectlClient, cluster *types.Cluster, clusterSpec *cluster.Spec) error {
+	if clusterSpec.ArgoCDConfig == nil {
+		return nil
+	}
+
+	argoCDConfig := &v1alpha1.ArgoCDConfig{}
+	err := k.GetObject(ctx, argoCDConfigResourceType, clusterSpec.ArgoCDConfig.Name, clusterSpec.Cluster.Namespace, cluster.KubeconfigFile, argoCDConfig)
+	if err == nil {
+		return fmt.Errorf("argoCDConfig %s already exists", clusterSpec.Cluster.Spec.GitOpsRef.Name)
+	}
+	if !apierrors.IsNotFound(err) {
+		return fmt.Errorf("fetching argoCDConfig in cluster: %v", err)
+	}
+
+	mgmtCluster := &v1alpha1.Cluster{}
+	if err := k.GetObject(ctx, clusterResourceType, clusterSpec.Cluster.ManagedBy(), clusterSpec.Cluster.Namespace, cluster.KubeconfigFile, mgmtCluster); err != nil {
+		return err
+	}
+
+	if mgmtCluster.Spec.GitOpsRef == nil || mgmtCluster.Spec.GitOpsRef.Kind != v1alpha1.ArgoCDConfigKind {
+		return errors.New("expected the management cluster to use an argoCDConfig for its workload clusters to use one")
+	}
+
+	mgmtArgoCDConfig := &v1alpha1.ArgoCDConfig{}
+	if err := k.GetObject(ctx, argoCDConfigResourceType, mgmtCluster.Spec.GitOpsRef.Name, clusterSpec.Cluster.Namespace, cluster.KubeconfigFile, mgmtArgoCDConfig); err != nil {
+		return err
+	}
+
+	if !mgmtArgoCDConfig.Spec.Equal(&clusterSpec.ArgoCDConfig.Spec) {
+		return errors.New("expected argoCDConfig.spec to be the same between management and its workload clusters")
+	}
+
+	return nil
+}
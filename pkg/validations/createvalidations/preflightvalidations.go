@@ -37,6 +37,13 @@ func (v *CreateValidations) BuildValidations(ctx context.Context) []validations.
 				Err:         validations.ValidateCertForRegistryMirror(v.Opts.Spec, v.Opts.TlsValidator),
 			}
 		},
+		func() *validations.ValidationResult {
+			return &validations.ValidationResult{
+				Name:        "validate registry mirror for bottlerocket",
+				Remediation: "remove failoverEndpoints and upstreams from registryMirrorConfiguration or use a different osFamily",
+				Err:         validations.ValidateRegistryMirrorForBottlerocket(v.Opts.Spec),
+			}
+		},
 		func() *validations.ValidationResult {
 			return &validations.ValidationResult{
 				Name:        "validate authentication for git provider",
@@ -52,6 +59,13 @@ func (v *CreateValidations) BuildValidations(ctx context.Context) []validations.
 				Silent:      true,
 			}
 		},
+		func() *validations.ValidationResult {
+			return &validations.ValidationResult{
+				Name:        "validate service load balancer artifacts in bundle",
+				Remediation: "use an EKS Anywhere version whose bundle includes the service load balancer provider or remove serviceLoadBalancer from the cluster spec",
+				Err:         validations.ValidateServiceLoadBalancerBundle(v.Opts.Spec),
+			}
+		},
 	}
 
 	if v.Opts.Spec.Cluster.IsManaged() {
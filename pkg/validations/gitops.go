@@ -9,14 +9,42 @@ import (
 )
 
 func ValidateAuthenticationForGitProvider(clusterSpec *cluster.Spec, cliConfig *config.CliConfig) error {
-	if clusterSpec.FluxConfig == nil || clusterSpec.FluxConfig.Spec.Git == nil {
+	git := clusterSpec.GitProviderConfig()
+	if git == nil || cliConfig == nil {
 		return nil
 	}
 
-	if cliConfig == nil {
-		return nil
+	switch {
+	case git.IsHTTPS() && git.GithubApp != nil:
+		return validateGithubAppAuthentication(cliConfig)
+	case git.IsHTTPS():
+		return validateHttpsAuthentication(cliConfig)
+	default:
+		return validateSshAuthentication(cliConfig)
+	}
+}
+
+func validateHttpsAuthentication(cliConfig *config.CliConfig) error {
+	if cliConfig.GitUsername == "" || cliConfig.GitPassword == "" {
+		return errors.New("provide a username and password or access token via the EKSA_GIT_USERNAME and EKSA_GIT_PASSWORD environment variables in order to use the generic git Flux provider with https")
+	}
+
+	return nil
+}
+
+func validateGithubAppAuthentication(cliConfig *config.CliConfig) error {
+	if cliConfig.GitGithubAppPrivateKeyFile == "" {
+		return errors.New("provide a path to the GitHub App private key file via the EKSA_GIT_GITHUB_APP_PRIVATE_KEY environment variable in order to use the generic git Flux provider with a GitHub App")
+	}
+
+	if !FileExistsAndIsNotEmpty(cliConfig.GitGithubAppPrivateKeyFile) {
+		return fmt.Errorf("GitHub App private key file does not exist at %s or is empty", cliConfig.GitGithubAppPrivateKeyFile)
 	}
 
+	return nil
+}
+
+func validateSshAuthentication(cliConfig *config.CliConfig) error {
 	if cliConfig.GitPrivateKeyFile == "" {
 		return errors.New("provide a path to a private key file via the EKSA_GIT_PRIVATE_KEY in order to use the generic git Flux provider")
 	}
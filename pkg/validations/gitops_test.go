@@ -122,6 +122,59 @@ func TestValidateGitOpsGitProviderNoAuthForWorkloadCluster(t *testing.T) {
 				GitKnownHostsFile:   "testdata/git_empty_file",
 			},
 		},
+		{
+			name:    "Https missing password",
+			wantErr: fmt.Errorf("provide a username and password or access token via the EKSA_GIT_USERNAME and EKSA_GIT_PASSWORD environment variables in order to use the generic git Flux provider with https"),
+			git: &v1alpha1.GitProviderConfig{
+				RepositoryUrl: "https://git.example.com/testRepo.git",
+			},
+			cliConfig: &config.CliConfig{
+				GitUsername: testEnvVar,
+				GitPassword: emptyVar,
+			},
+		},
+		{
+			name:    "Https username and password populated",
+			wantErr: nil,
+			git: &v1alpha1.GitProviderConfig{
+				RepositoryUrl: "https://git.example.com/testRepo.git",
+			},
+			cliConfig: &config.CliConfig{
+				GitUsername: testEnvVar,
+				GitPassword: testEnvVar,
+			},
+		},
+		{
+			name:    "Github app missing private key",
+			wantErr: fmt.Errorf("provide a path to the GitHub App private key file via the EKSA_GIT_GITHUB_APP_PRIVATE_KEY environment variable in order to use the generic git Flux provider with a GitHub App"),
+			git: &v1alpha1.GitProviderConfig{
+				RepositoryUrl: "https://github.com/org/testRepo.git",
+				GithubApp:     &v1alpha1.GithubAppConfig{AppID: 1234, InstallationID: 5678},
+			},
+			cliConfig: &config.CliConfig{},
+		},
+		{
+			name:    "Github app empty private key",
+			wantErr: fmt.Errorf("GitHub App private key file does not exist at testdata/git_empty_file or is empty"),
+			git: &v1alpha1.GitProviderConfig{
+				RepositoryUrl: "https://github.com/org/testRepo.git",
+				GithubApp:     &v1alpha1.GithubAppConfig{AppID: 1234, InstallationID: 5678},
+			},
+			cliConfig: &config.CliConfig{
+				GitGithubAppPrivateKeyFile: "testdata/git_empty_file",
+			},
+		},
+		{
+			name:    "Github app private key populated",
+			wantErr: nil,
+			git: &v1alpha1.GitProviderConfig{
+				RepositoryUrl: "https://github.com/org/testRepo.git",
+				GithubApp:     &v1alpha1.GithubAppConfig{AppID: 1234, InstallationID: 5678},
+			},
+			cliConfig: &config.CliConfig{
+				GitGithubAppPrivateKeyFile: "testdata/git_nonempty_private_key",
+			},
+		},
 		{
 			name:    "No known hosts",
 			wantErr: fmt.Errorf("SSH known hosts file does not exist at testdata/git_empty_file or is empty"),
@@ -12,6 +12,8 @@ import (
 	"github.com/aws/eks-anywhere/pkg/validations"
 )
 
+var argoCDConfigResourceType = fmt.Sprintf("argocdconfigs.%s", v1alpha1.GroupVersion.Group)
+
 func ValidateImmutableFields(ctx context.Context, k validations.KubectlClient, cluster *types.Cluster, spec *cluster.Spec, provider providers.Provider) error {
 	prevSpec, err := k.GetEksaCluster(ctx, cluster, spec.Cluster.Name)
 	if err != nil {
@@ -56,9 +58,13 @@ func ValidateImmutableFields(ctx context.Context, k validations.KubectlClient, c
 	if !v1alpha1.CNIPluginSame(nSpec.ClusterNetwork, oSpec.ClusterNetwork) {
 		return fmt.Errorf("spec.clusterNetwork.CNI/CNIConfig is immutable")
 	}
-
-	if !nSpec.ProxyConfiguration.Equal(oSpec.ProxyConfiguration) {
-		return fmt.Errorf("spec.proxyConfiguration is immutable")
+	if nSpec.ClusterNetwork.CNIConfig != nil && nSpec.ClusterNetwork.CNIConfig.Cilium != nil &&
+		oSpec.ClusterNetwork.CNIConfig != nil && oSpec.ClusterNetwork.CNIConfig.Cilium != nil &&
+		!nSpec.ClusterNetwork.CNIConfig.Cilium.RoutingModeEqual(oSpec.ClusterNetwork.CNIConfig.Cilium) {
+		return fmt.Errorf("spec.clusterNetwork.cniConfig.cilium.routingMode and ipv4NativeRoutingCIDR are immutable")
+	}
+	if v1alpha1.CiliumLeavesStrictKubeProxyReplacement(nSpec.ClusterNetwork, oSpec.ClusterNetwork) {
+		return fmt.Errorf("spec.clusterNetwork.cniConfig.cilium.kubeProxyReplacement can't be changed from strict, kube-proxy has been removed from the cluster")
 	}
 
 	oldETCD := oSpec.ExternalEtcdConfiguration
@@ -168,6 +174,18 @@ func ValidateGitOpsImmutableFields(ctx context.Context, k validations.KubectlCli
 			}
 		}
 
+		if prevGitOps.Spec.Gitlab != nil {
+			if !prevGitOps.Spec.Gitlab.Equal(clusterSpec.FluxConfig.Spec.Gitlab) {
+				return errors.New("fluxConfig spec.gitlab is immutable")
+			}
+		}
+
+		if prevGitOps.Spec.BitbucketServer != nil {
+			if !prevGitOps.Spec.BitbucketServer.Equal(clusterSpec.FluxConfig.Spec.BitbucketServer) {
+				return errors.New("fluxConfig spec.bitbucketServer is immutable")
+			}
+		}
+
 		if prevGitOps.Spec.Branch != clusterSpec.FluxConfig.Spec.Branch {
 			return errors.New("fluxConfig spec.branch is immutable")
 		}
@@ -179,6 +197,28 @@ func ValidateGitOpsImmutableFields(ctx context.Context, k validations.KubectlCli
 		if prevGitOps.Spec.SystemNamespace != clusterSpec.FluxConfig.Spec.SystemNamespace {
 			return errors.New("fluxConfig spec.systemNamespace is immutable")
 		}
+
+	case v1alpha1.ArgoCDConfigKind:
+		prevGitOps := &v1alpha1.ArgoCDConfig{}
+		if err := k.GetObject(ctx, argoCDConfigResourceType, clusterSpec.Cluster.Spec.GitOpsRef.Name, clusterSpec.Cluster.Namespace, cluster.KubeconfigFile, prevGitOps); err != nil {
+			return err
+		}
+
+		if !prevGitOps.Spec.Git.Equal(clusterSpec.ArgoCDConfig.Spec.Git) {
+			return errors.New("argoCDConfig spec.git is immutable")
+		}
+
+		if prevGitOps.Spec.Branch != clusterSpec.ArgoCDConfig.Spec.Branch {
+			return errors.New("argoCDConfig spec.branch is immutable")
+		}
+
+		if prevGitOps.Spec.ClusterConfigPath != clusterSpec.ArgoCDConfig.Spec.ClusterConfigPath {
+			return errors.New("argoCDConfig spec.clusterConfigPath is immutable")
+		}
+
+		if prevGitOps.Spec.SystemNamespace != clusterSpec.ArgoCDConfig.Spec.SystemNamespace {
+			return errors.New("argoCDConfig spec.systemNamespace is immutable")
+		}
 	}
 
 	return nil
@@ -180,6 +180,47 @@ func TestValidateGitOpsImmutableFieldsFluxConfig(t *testing.T) {
 			},
 			wantErr: "fluxConfig spec.github.personal is immutable",
 		},
+		{
+			name: "gitlab hostname diff",
+			new: &v1alpha1.FluxConfig{
+				Spec: v1alpha1.FluxConfigSpec{
+					Gitlab: &v1alpha1.GitlabProviderConfig{
+						Owner:      "a",
+						Repository: "a",
+						Hostname:   "gitlab.example.com",
+					},
+				},
+			},
+			old: &v1alpha1.FluxConfig{
+				Spec: v1alpha1.FluxConfigSpec{
+					Gitlab: &v1alpha1.GitlabProviderConfig{
+						Owner:      "a",
+						Repository: "a",
+					},
+				},
+			},
+			wantErr: "fluxConfig spec.gitlab is immutable",
+		},
+		{
+			name: "bitbucket server removed",
+			new: &v1alpha1.FluxConfig{
+				Spec: v1alpha1.FluxConfigSpec{
+					Github: &v1alpha1.GithubProviderConfig{
+						Repository: "a",
+					},
+				},
+			},
+			old: &v1alpha1.FluxConfig{
+				Spec: v1alpha1.FluxConfigSpec{
+					BitbucketServer: &v1alpha1.BitbucketServerProviderConfig{
+						Owner:      "a",
+						Repository: "a",
+						Hostname:   "bitbucket.example.com",
+					},
+				},
+			},
+			wantErr: "fluxConfig spec.bitbucketServer is immutable",
+		},
 		{
 			name: "branch diff",
 			new: &v1alpha1.FluxConfig{
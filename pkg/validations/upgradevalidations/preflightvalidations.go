@@ -56,6 +56,11 @@ func (u *UpgradeValidations) PreflightValidations(ctx context.Context) (err erro
 			Remediation: "ensure that the cluster kubernetes version is incremented by one minor version exactly (e.g. 1.18 -> 1.19)",
 			Err:         ValidateServerVersionSkew(ctx, u.Opts.Spec.Cluster.Spec.KubernetesVersion, u.Opts.WorkloadCluster, k),
 		},
+		{
+			Name:        "validate registry mirror for bottlerocket",
+			Remediation: "remove failoverEndpoints and upstreams from registryMirrorConfiguration or use a different osFamily",
+			Err:         validations.ValidateRegistryMirrorForBottlerocket(u.Opts.Spec),
+		},
 		{
 			Name:        "validate authentication for git provider",
 			Remediation: fmt.Sprintf("ensure %s, %s env variable are set and valid", config.EksaGitPrivateKeyTokenEnv, config.EksaGitKnownHostsFileEnv),
@@ -72,6 +77,11 @@ func (u *UpgradeValidations) PreflightValidations(ctx context.Context) (err erro
 			Err:         validations.ValidateK8s125Support(u.Opts.Spec),
 			Silent:      true,
 		},
+		{
+			Name:        "validate service load balancer artifacts in bundle",
+			Remediation: "use an EKS Anywhere version whose bundle includes the service load balancer provider or remove serviceLoadBalancer from the cluster spec",
+			Err:         validations.ValidateServiceLoadBalancerBundle(u.Opts.Spec),
+		},
 	}
 
 	return validations.ProcessValidationResults(upgradeValidations)
@@ -900,6 +900,51 @@ func TestPreflightValidationsVsphere(t *testing.T) {
 				s.Cluster.Spec.ClusterNetwork.Pods = v1alpha1.Pods{}
 			},
 		},
+		{
+			name:               "ValidationClusterNetworkCiliumRoutingModeImmutable",
+			clusterVersion:     "v1.19.16-eks-1-19-4",
+			upgradeVersion:     "1.19",
+			getClusterResponse: goodClusterResponse,
+			cpResponse:         nil,
+			workerResponse:     nil,
+			nodeResponse:       nil,
+			crdResponse:        nil,
+			wantErr:            composeError("spec.clusterNetwork.cniConfig.cilium.routingMode and ipv4NativeRoutingCIDR are immutable"),
+			modifyExistingSpecFunc: func(s *cluster.Spec) {
+				s.Cluster.Spec.ClusterNetwork.CNIConfig = &v1alpha1.CNIConfig{
+					Cilium: &v1alpha1.CiliumConfig{
+						RoutingMode:           v1alpha1.CiliumRoutingModeNative,
+						IPv4NativeRoutingCIDR: "10.0.0.0/8",
+					},
+				}
+			},
+			modifyDefaultSpecFunc: func(s *cluster.Spec) {
+				s.Cluster.Spec.ClusterNetwork.CNIConfig = &v1alpha1.CNIConfig{
+					Cilium: &v1alpha1.CiliumConfig{},
+				}
+			},
+		},
+		{
+			name:               "ValidationClusterNetworkCiliumLeaveStrictKubeProxyReplacement",
+			clusterVersion:     "v1.19.16-eks-1-19-4",
+			upgradeVersion:     "1.19",
+			getClusterResponse: goodClusterResponse,
+			cpResponse:         nil,
+			workerResponse:     nil,
+			nodeResponse:       nil,
+			crdResponse:        nil,
+			wantErr:            composeError("spec.clusterNetwork.cniConfig.cilium.kubeProxyReplacement can't be changed from strict, kube-proxy has been removed from the cluster"),
+			modifyExistingSpecFunc: func(s *cluster.Spec) {
+				s.Cluster.Spec.ClusterNetwork.CNIConfig = &v1alpha1.CNIConfig{
+					Cilium: &v1alpha1.CiliumConfig{KubeProxyReplacement: v1alpha1.CiliumKubeProxyReplacementStrict},
+				}
+			},
+			modifyDefaultSpecFunc: func(s *cluster.Spec) {
+				s.Cluster.Spec.ClusterNetwork.CNIConfig = &v1alpha1.CNIConfig{
+					Cilium: &v1alpha1.CiliumConfig{},
+				}
+			},
+		},
 		{
 			name:               "ValidationClusterNetworkServicesImmutable",
 			clusterVersion:     "v1.19.16-eks-1-19-4",
@@ -929,7 +974,7 @@ func TestPreflightValidationsVsphere(t *testing.T) {
 			},
 		},
 		{
-			name:               "ValidationProxyConfigurationImmutable",
+			name:               "ValidationProxyConfigurationMutable",
 			clusterVersion:     "v1.19.16-eks-1-19-4",
 			upgradeVersion:     "1.19",
 			getClusterResponse: goodClusterResponse,
@@ -937,7 +982,7 @@ func TestPreflightValidationsVsphere(t *testing.T) {
 			workerResponse:     nil,
 			nodeResponse:       nil,
 			crdResponse:        nil,
-			wantErr:            composeError("spec.proxyConfiguration is immutable"),
+			wantErr:            nil,
 			modifyExistingSpecFunc: func(s *cluster.Spec) {
 				s.Cluster.Spec.ProxyConfiguration = &v1alpha1.ProxyConfiguration{
 					HttpProxy:  "httpproxy2",
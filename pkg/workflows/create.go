@@ -255,6 +255,15 @@ func (s *CreateWorkloadClusterTask) Run(ctx context.Context, commandContext *tas
 		}
 	}
 
+	if commandContext.ClusterSpec.Cluster.Spec.ServiceLoadBalancer != nil {
+		logger.Info("Installing service load balancer on workload cluster")
+		err = commandContext.ClusterManager.InstallServiceLoadBalancer(ctx, workloadCluster, commandContext.ClusterSpec)
+		if err != nil {
+			commandContext.SetError(err)
+			return &CollectDiagnosticsTask{}
+		}
+	}
+
 	err = commandContext.ClusterManager.InstallStorageClass(ctx, workloadCluster, commandContext.Provider)
 	if err != nil {
 		commandContext.SetError(err)
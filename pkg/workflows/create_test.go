@@ -326,6 +326,31 @@ func TestCreateRunAWSIamConfigSuccess(t *testing.T) {
 	}
 }
 
+func TestCreateRunServiceLoadBalancerSuccess(t *testing.T) {
+	test := newCreateTest(t)
+
+	test.clusterSpec.Cluster.Spec.ServiceLoadBalancer = &v1alpha1.ServiceLoadBalancerConfiguration{
+		Provider: v1alpha1.KubeVipServiceLoadBalancer,
+	}
+	test.clusterManager.EXPECT().InstallServiceLoadBalancer(test.ctx, test.workloadCluster, test.clusterSpec)
+	test.expectSetup()
+	test.expectCreateBootstrap()
+	test.expectCreateWorkload()
+	test.expectInstallResourcesOnManagementTask()
+	test.expectMoveManagement()
+	test.expectInstallEksaComponents()
+	test.expectInstallGitOpsManager()
+	test.expectWriteClusterConfig()
+	test.expectDeleteBootstrap()
+	test.expectPreflightValidationsToPass()
+	test.expectCuratedPackagesInstallation()
+
+	err := test.run()
+	if err != nil {
+		t.Fatalf("Create.Run() err = %v, want err = nil", err)
+	}
+}
+
 func TestCreateRunSuccessForceCleanup(t *testing.T) {
 	test := newCreateTest(t)
 	test.forceCleanup = true
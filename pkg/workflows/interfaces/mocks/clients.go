@@ -296,6 +296,20 @@ func (mr *MockClusterManagerMockRecorder) InstallNetworking(arg0, arg1, arg2, ar
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstallNetworking", reflect.TypeOf((*MockClusterManager)(nil).InstallNetworking), arg0, arg1, arg2, arg3)
 }
 
+// InstallServiceLoadBalancer mocks base method.
+func (m *MockClusterManager) InstallServiceLoadBalancer(arg0 context.Context, arg1 *types.Cluster, arg2 *cluster.Spec) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InstallServiceLoadBalancer", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InstallServiceLoadBalancer indicates an expected call of InstallServiceLoadBalancer.
+func (mr *MockClusterManagerMockRecorder) InstallServiceLoadBalancer(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstallServiceLoadBalancer", reflect.TypeOf((*MockClusterManager)(nil).InstallServiceLoadBalancer), arg0, arg1, arg2)
+}
+
 // InstallStorageClass mocks base method.
 func (m *MockClusterManager) InstallStorageClass(arg0 context.Context, arg1 *types.Cluster, arg2 providers.Provider) error {
 	m.ctrl.T.Helper()
@@ -805,3 +819,15 @@ func (mr *MockPackageInstallerMockRecorder) InstallCuratedPackages(arg0 interfac
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstallCuratedPackages", reflect.TypeOf((*MockPackageInstaller)(nil).InstallCuratedPackages), arg0)
 }
+
+// UpgradeCuratedPackages mocks base method.
+func (m *MockPackageInstaller) UpgradeCuratedPackages(arg0 context.Context, arg1 *cluster.Spec) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "UpgradeCuratedPackages", arg0, arg1)
+}
+
+// UpgradeCuratedPackages indicates an expected call of UpgradeCuratedPackages.
+func (mr *MockPackageInstallerMockRecorder) UpgradeCuratedPackages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpgradeCuratedPackages", reflect.TypeOf((*MockPackageInstaller)(nil).UpgradeCuratedPackages), arg0, arg1)
+}
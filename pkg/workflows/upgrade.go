@@ -26,12 +26,14 @@ type Upgrade struct {
 	capiManager       interfaces.CAPIManager
 	eksdInstaller     interfaces.EksdInstaller
 	eksdUpgrader      interfaces.EksdUpgrader
+	packageInstaller  interfaces.PackageInstaller
 	upgradeChangeDiff *types.ChangeDiff
 }
 
 func NewUpgrade(bootstrapper interfaces.Bootstrapper, provider providers.Provider,
 	capiManager interfaces.CAPIManager,
 	clusterManager interfaces.ClusterManager, gitOpsManager interfaces.GitOpsManager, writer filewriter.FileWriter, eksdUpgrader interfaces.EksdUpgrader, eksdInstaller interfaces.EksdInstaller,
+	packageInstaller interfaces.PackageInstaller,
 ) *Upgrade {
 	upgradeChangeDiff := types.NewChangeDiff()
 	return &Upgrade{
@@ -43,6 +45,7 @@ func NewUpgrade(bootstrapper interfaces.Bootstrapper, provider providers.Provide
 		capiManager:       capiManager,
 		eksdUpgrader:      eksdUpgrader,
 		eksdInstaller:     eksdInstaller,
+		packageInstaller:  packageInstaller,
 		upgradeChangeDiff: upgradeChangeDiff,
 	}
 }
@@ -69,6 +72,7 @@ func (c *Upgrade) Run(ctx context.Context, clusterSpec *cluster.Spec, management
 		CAPIManager:       c.capiManager,
 		EksdInstaller:     c.eksdInstaller,
 		EksdUpgrader:      c.eksdUpgrader,
+		PackageInstaller:  c.packageInstaller,
 		UpgradeChangeDiff: c.upgradeChangeDiff,
 	}
 	if features.IsActive(features.CheckpointEnabled()) {
@@ -114,6 +118,8 @@ type resumeEksaReconcile struct {
 	eksaSpecDiff bool
 }
 
+type upgradeCuratedPackagesTask struct{}
+
 type writeClusterConfigTask struct{}
 
 func (s *setupAndValidateTasks) Run(ctx context.Context, commandContext *task.CommandContext) task.Task {
@@ -611,7 +617,7 @@ func (s *resumeEksaReconcile) Run(ctx context.Context, commandContext *task.Comm
 	if !s.eksaSpecDiff {
 		return nil
 	}
-	return &writeClusterConfigTask{}
+	return &upgradeCuratedPackagesTask{}
 }
 
 func (s *resumeEksaReconcile) Name() string {
@@ -625,6 +631,25 @@ func (s *resumeEksaReconcile) Checkpoint() *task.CompletedTask {
 }
 
 func (s *resumeEksaReconcile) Restore(ctx context.Context, commandContext *task.CommandContext, completedTask *task.CompletedTask) (task.Task, error) {
+	return &upgradeCuratedPackagesTask{}, nil
+}
+
+func (s *upgradeCuratedPackagesTask) Run(ctx context.Context, commandContext *task.CommandContext) task.Task {
+	commandContext.PackageInstaller.UpgradeCuratedPackages(ctx, commandContext.CurrentClusterSpec)
+	return &writeClusterConfigTask{}
+}
+
+func (s *upgradeCuratedPackagesTask) Name() string {
+	return "upgrade-curated-packages"
+}
+
+func (s *upgradeCuratedPackagesTask) Checkpoint() *task.CompletedTask {
+	return &task.CompletedTask{
+		Checkpoint: nil,
+	}
+}
+
+func (s *upgradeCuratedPackagesTask) Restore(ctx context.Context, commandContext *task.CommandContext, completedTask *task.CompletedTask) (task.Task, error) {
 	return &writeClusterConfigTask{}, nil
 }
 
@@ -32,6 +32,7 @@ type upgradeTestSetup struct {
 	eksdInstaller      *mocks.MockEksdInstaller
 	eksdUpgrader       *mocks.MockEksdUpgrader
 	capiManager        *mocks.MockCAPIManager
+	packageInstaller   *mocks.MockPackageInstaller
 	datacenterConfig   providers.DatacenterConfig
 	machineConfigs     []providers.MachineConfig
 	workflow           *workflows.Upgrade
@@ -57,8 +58,9 @@ func newUpgradeTest(t *testing.T) *upgradeTestSetup {
 	eksdUpgrader := mocks.NewMockEksdUpgrader(mockCtrl)
 	datacenterConfig := &v1alpha1.VSphereDatacenterConfig{}
 	capiUpgrader := mocks.NewMockCAPIManager(mockCtrl)
+	packageInstaller := mocks.NewMockPackageInstaller(mockCtrl)
 	machineConfigs := []providers.MachineConfig{&v1alpha1.VSphereMachineConfig{}}
-	workflow := workflows.NewUpgrade(bootstrapper, provider, capiUpgrader, clusterManager, gitOpsManager, writer, eksdUpgrader, eksdInstaller)
+	workflow := workflows.NewUpgrade(bootstrapper, provider, capiUpgrader, clusterManager, gitOpsManager, writer, eksdUpgrader, eksdInstaller, packageInstaller)
 
 	for _, e := range featureEnvVars {
 		t.Setenv(e, "true")
@@ -75,6 +77,7 @@ func newUpgradeTest(t *testing.T) *upgradeTestSetup {
 		eksdInstaller:    eksdInstaller,
 		eksdUpgrader:     eksdUpgrader,
 		capiManager:      capiUpgrader,
+		packageInstaller: packageInstaller,
 		datacenterConfig: datacenterConfig,
 		machineConfigs:   machineConfigs,
 		workflow:         workflow,
@@ -195,6 +198,10 @@ func (c *upgradeTestSetup) expectNotToCreateBootstrap() {
 	c.clusterManager.EXPECT().InstallCAPI(c.ctx, gomock.Not(gomock.Nil()), c.bootstrapCluster, c.provider).Times(0)
 }
 
+func (c *upgradeTestSetup) expectUpgradeCuratedPackages() {
+	c.packageInstaller.EXPECT().UpgradeCuratedPackages(c.ctx, c.currentClusterSpec)
+}
+
 func (c *upgradeTestSetup) expectWriteClusterConfig() {
 	gomock.InOrder(
 		c.provider.EXPECT().DatacenterConfig(c.newClusterSpec).Return(c.datacenterConfig),
@@ -428,6 +435,7 @@ func TestUpgradeRunSuccess(t *testing.T) {
 	test.expectMoveManagementToBootstrap()
 	test.expectUpgradeWorkload(test.bootstrapCluster, test.workloadCluster)
 	test.expectMoveManagementToWorkload()
+	test.expectUpgradeCuratedPackages()
 	test.expectWriteClusterConfig()
 	test.expectDeleteBootstrap()
 	test.expectDatacenterConfig()
@@ -460,6 +468,7 @@ func TestUpgradeRunProviderNeedsUpgradeSuccess(t *testing.T) {
 	test.expectMoveManagementToBootstrap()
 	test.expectUpgradeWorkload(test.bootstrapCluster, test.workloadCluster)
 	test.expectMoveManagementToWorkload()
+	test.expectUpgradeCuratedPackages()
 	test.expectWriteClusterConfig()
 	test.expectDeleteBootstrap()
 	test.expectDatacenterConfig()
@@ -515,6 +524,7 @@ func TestUpgradeWorkloadRunSuccess(t *testing.T) {
 	test.expectNotToCreateBootstrap()
 	test.expectNotToMoveManagementToBootstrap()
 	test.expectNotToMoveManagementToWorkload()
+	test.expectUpgradeCuratedPackages()
 	test.expectWriteClusterConfig()
 	test.expectNotToDeleteBootstrap()
 	test.expectDatacenterConfig()
@@ -579,6 +589,7 @@ func TestUpgradeWithCheckpointSecondRunSuccess(t *testing.T) {
 	test2.expectSetup()
 	test2.expectUpgradeWorkload(test2.bootstrapCluster, test2.workloadCluster)
 	test2.expectMoveManagementToWorkload()
+	test2.expectUpgradeCuratedPackages()
 	test2.expectWriteClusterConfig()
 	test2.expectDeleteBootstrap()
 	test2.expectDatacenterConfig()
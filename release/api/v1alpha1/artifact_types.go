@@ -150,4 +150,6 @@ type Manifest struct {
 	// +kubebuilder:validation:Required
 	// URI points to the manifest yaml file
 	URI string `json:"uri,omitempty"`
+	// The sha256 checksum of the manifest yaml file
+	SHA256 string `json:"sha256,omitempty"`
 }
@@ -65,6 +65,12 @@ func (vb *VersionsBundle) Manifests() map[string][]*string {
 		"kindnetd": {
 			&vb.Kindnetd.Manifest.URI,
 		},
+		"metallb": {
+			&vb.MetalLB.Manifest.URI,
+		},
+		"argo-cd": {
+			&vb.ArgoCD.Manifest.URI,
+		},
 		"eks-anywhere-cluster-controller": {
 			&vb.Eksa.Components.URI,
 		},
@@ -83,6 +89,51 @@ func (vb *VersionsBundle) Manifests() map[string][]*string {
 	}
 }
 
+// ManifestChecksums returns the sha256 checksum published for each manifest in the bundle, keyed by
+// manifest URI. Manifests published without a checksum are not included.
+func (vb *VersionsBundle) ManifestChecksums() map[string]string {
+	checksums := map[string]string{}
+	for _, m := range []Manifest{
+		vb.ClusterAPI.Components,
+		vb.ClusterAPI.Metadata,
+		vb.Bootstrap.Components,
+		vb.Bootstrap.Metadata,
+		vb.ControlPlane.Components,
+		vb.ControlPlane.Metadata,
+		vb.CertManager.Manifest,
+		vb.Docker.Components,
+		vb.Docker.ClusterTemplate,
+		vb.Docker.Metadata,
+		vb.VSphere.Components,
+		vb.VSphere.ClusterTemplate,
+		vb.VSphere.Metadata,
+		vb.CloudStack.Components,
+		vb.CloudStack.Metadata,
+		vb.Tinkerbell.Components,
+		vb.Tinkerbell.ClusterTemplate,
+		vb.Tinkerbell.Metadata,
+		vb.Snow.Components,
+		vb.Snow.Metadata,
+		vb.Nutanix.Components,
+		vb.Nutanix.ClusterTemplate,
+		vb.Nutanix.Metadata,
+		vb.Cilium.Manifest,
+		vb.Kindnetd.Manifest,
+		vb.MetalLB.Manifest,
+		vb.ArgoCD.Manifest,
+		vb.Eksa.Components,
+		vb.ExternalEtcdBootstrap.Components,
+		vb.ExternalEtcdBootstrap.Metadata,
+		vb.ExternalEtcdController.Components,
+		vb.ExternalEtcdController.Metadata,
+	} {
+		if m.URI != "" && m.SHA256 != "" {
+			checksums[m.URI] = m.SHA256
+		}
+	}
+	return checksums
+}
+
 func (vb *VersionsBundle) Ovas() []Archive {
 	return []Archive{
 		vb.EksD.Ova.Bottlerocket,
@@ -162,6 +213,35 @@ func (vb *VersionsBundle) NutanixImages() []Image {
 	return i
 }
 
+// ServiceLoadBalancerImages returns the images for the Service load balancers present in the bundle.
+func (vb *VersionsBundle) ServiceLoadBalancerImages() []Image {
+	i := make([]Image, 0, 4)
+	for _, image := range []Image{
+		vb.KubeVipCloudProvider.KubeVip,
+		vb.KubeVipCloudProvider.CloudProvider,
+		vb.MetalLB.Controller,
+		vb.MetalLB.Speaker,
+	} {
+		if image.URI != "" {
+			i = append(i, image)
+		}
+	}
+
+	return i
+}
+
+// ArgoCDImages returns the Argo CD images present in the bundle.
+func (vb *VersionsBundle) ArgoCDImages() []Image {
+	i := make([]Image, 0, 2)
+	for _, image := range []Image{vb.ArgoCD.ArgoCD, vb.ArgoCD.Redis} {
+		if image.URI != "" {
+			i = append(i, image)
+		}
+	}
+
+	return i
+}
+
 func (vb *VersionsBundle) SharedImages() []Image {
 	return []Image{
 		vb.Bootstrap.Controller,
@@ -207,6 +287,8 @@ func (vb *VersionsBundle) Images() []Image {
 		vb.SnowImages(),
 		vb.TinkerbellImages(),
 		vb.NutanixImages(),
+		vb.ServiceLoadBalancerImages(),
+		vb.ArgoCDImages(),
 	}
 
 	size := 0
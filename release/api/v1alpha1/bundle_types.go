@@ -81,6 +81,9 @@ type VersionsBundle struct {
 	Haproxy                    HaproxyBundle                    `json:"haproxy,omitempty"`
 	Snow                       SnowBundle                       `json:"snow,omitempty"`
 	Nutanix                    NutanixBundle                    `json:"nutanix,omitempty"`
+	KubeVipCloudProvider       KubeVipCloudProviderBundle       `json:"kubeVipCloudProvider,omitempty"`
+	MetalLB                    MetalLBBundle                    `json:"metalLB,omitempty"`
+	ArgoCD                     ArgoCDBundle                     `json:"argoCd,omitempty"`
 	// This field has been deprecated
 	Aws *AwsBundle `json:"aws,omitempty"`
 }
@@ -225,6 +228,29 @@ type KindnetdBundle struct {
 	Manifest Manifest `json:"manifest"`
 }
 
+// KubeVipCloudProviderBundle contains the images to run kube-vip as a load balancer for Services.
+type KubeVipCloudProviderBundle struct {
+	Version       string `json:"version,omitempty"`
+	KubeVip       Image  `json:"kubeVip"`
+	CloudProvider Image  `json:"cloudProvider"`
+}
+
+// MetalLBBundle contains the artifacts to run MetalLB as a load balancer for Services.
+type MetalLBBundle struct {
+	Version    string   `json:"version,omitempty"`
+	Controller Image    `json:"controller"`
+	Speaker    Image    `json:"speaker"`
+	Manifest   Manifest `json:"manifest"`
+}
+
+// ArgoCDBundle contains the artifacts to run Argo CD as the GitOps engine of a cluster.
+type ArgoCDBundle struct {
+	Version  string   `json:"version,omitempty"`
+	ArgoCD   Image    `json:"argoCd"`
+	Redis    Image    `json:"redis"`
+	Manifest Manifest `json:"manifest"`
+}
+
 type FluxBundle struct {
 	Version                string `json:"version,omitempty"`
 	SourceController       Image  `json:"sourceController"`
@@ -64,6 +64,24 @@ func (in *Archive) DeepCopy() *Archive {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArgoCDBundle) DeepCopyInto(out *ArgoCDBundle) {
+	*out = *in
+	in.ArgoCD.DeepCopyInto(&out.ArgoCD)
+	in.Redis.DeepCopyInto(&out.Redis)
+	out.Manifest = in.Manifest
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArgoCDBundle.
+func (in *ArgoCDBundle) DeepCopy() *ArgoCDBundle {
+	if in == nil {
+		return nil
+	}
+	out := new(ArgoCDBundle)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AwsBundle) DeepCopyInto(out *AwsBundle) {
 	*out = *in
@@ -516,6 +534,23 @@ func (in *KindnetdBundle) DeepCopy() *KindnetdBundle {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeVipCloudProviderBundle) DeepCopyInto(out *KubeVipCloudProviderBundle) {
+	*out = *in
+	in.KubeVip.DeepCopyInto(&out.KubeVip)
+	in.CloudProvider.DeepCopyInto(&out.CloudProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeVipCloudProviderBundle.
+func (in *KubeVipCloudProviderBundle) DeepCopy() *KubeVipCloudProviderBundle {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeVipCloudProviderBundle)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KubeadmBootstrapBundle) DeepCopyInto(out *KubeadmBootstrapBundle) {
 	*out = *in
@@ -569,6 +604,24 @@ func (in *Manifest) DeepCopy() *Manifest {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MetalLBBundle) DeepCopyInto(out *MetalLBBundle) {
+	*out = *in
+	in.Controller.DeepCopyInto(&out.Controller)
+	in.Speaker.DeepCopyInto(&out.Speaker)
+	out.Manifest = in.Manifest
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetalLBBundle.
+func (in *MetalLBBundle) DeepCopy() *MetalLBBundle {
+	if in == nil {
+		return nil
+	}
+	out := new(MetalLBBundle)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NutanixBundle) DeepCopyInto(out *NutanixBundle) {
 	*out = *in
@@ -848,6 +901,9 @@ func (in *VersionsBundle) DeepCopyInto(out *VersionsBundle) {
 	in.Haproxy.DeepCopyInto(&out.Haproxy)
 	in.Snow.DeepCopyInto(&out.Snow)
 	in.Nutanix.DeepCopyInto(&out.Nutanix)
+	in.KubeVipCloudProvider.DeepCopyInto(&out.KubeVipCloudProvider)
+	in.MetalLB.DeepCopyInto(&out.MetalLB)
+	in.ArgoCD.DeepCopyInto(&out.ArgoCD)
 	if in.Aws != nil {
 		in, out := &in.Aws, &out.Aws
 		*out = new(AwsBundle)
@@ -676,6 +676,21 @@ var bundleReleaseAssetsConfigMap = []assettypes.AssetConfig{
 			"projectPath",
 		},
 	},
+	// Kube-vip cloud provider artifacts
+	{
+		ProjectName: "kube-vip-cloud-provider",
+		ProjectPath: "projects/kube-vip/kube-vip-cloud-provider",
+		Images: []*assettypes.Image{
+			{
+				RepoName: "kube-vip-cloud-provider",
+			},
+		},
+		ImageRepoPrefix: "kube-vip",
+		ImageTagOptions: []string{
+			"gitTag",
+			"projectPath",
+		},
+	},
 	// Envoy artifacts
 	{
 		ProjectName: "envoy",
@@ -721,6 +736,68 @@ var bundleReleaseAssetsConfigMap = []assettypes.AssetConfig{
 			"projectPath",
 		},
 	},
+	// MetalLB artifacts
+	{
+		ProjectName: "metallb",
+		ProjectPath: "projects/metallb/metallb",
+		Images: []*assettypes.Image{
+			{
+				AssetName: "metallb-controller",
+				RepoName:  "controller",
+			},
+			{
+				AssetName: "metallb-speaker",
+				RepoName:  "speaker",
+			},
+		},
+		ImageRepoPrefix: "metallb",
+		ImageTagOptions: []string{
+			"gitTag",
+			"projectPath",
+		},
+		Manifests: []*assettypes.ManifestComponent{
+			{
+				Name:          "metallb",
+				ManifestFiles: []string{"metallb.yaml"},
+			},
+		},
+	},
+	// Argo CD artifacts
+	{
+		ProjectName: "argo-cd",
+		ProjectPath: "projects/argoproj/argo-cd",
+		Images: []*assettypes.Image{
+			{
+				RepoName: "argocd",
+			},
+		},
+		ImageRepoPrefix: "argoproj",
+		ImageTagOptions: []string{
+			"gitTag",
+			"projectPath",
+		},
+		Manifests: []*assettypes.ManifestComponent{
+			{
+				Name:          "argo-cd",
+				ManifestFiles: []string{"install.yaml"},
+			},
+		},
+	},
+	// Redis artifacts
+	{
+		ProjectName: "redis",
+		ProjectPath: "projects/redis/redis",
+		Images: []*assettypes.Image{
+			{
+				RepoName: "redis",
+			},
+		},
+		ImageRepoPrefix: "redis",
+		ImageTagOptions: []string{
+			"gitTag",
+			"projectPath",
+		},
+	},
 	// Notification-controller artifacts
 	{
 		ProjectName: "notification-controller",
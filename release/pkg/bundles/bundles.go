@@ -101,6 +101,21 @@ func GetVersionsBundles(r *releasetypes.ReleaseConfig, imageDigests map[string]s
 		return nil, errors.Wrapf(err, "Error getting bundle for Haproxy")
 	}
 
+	kubeVipCloudProviderBundle, err := GetKubeVipCloudProviderBundle(r, imageDigests)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Error getting bundle for kube-vip cloud provider")
+	}
+
+	metalLBBundle, err := GetMetalLBBundle(r, imageDigests)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Error getting bundle for MetalLB")
+	}
+
+	argoCDBundle, err := GetArgoCDBundle(r, imageDigests)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Error getting bundle for Argo CD")
+	}
+
 	fluxBundle, err := GetFluxBundle(r, imageDigests)
 	if err != nil {
 		return nil, errors.Wrapf(err, "Error getting bundle for Flux controllers")
@@ -199,6 +214,9 @@ func GetVersionsBundles(r *releasetypes.ReleaseConfig, imageDigests map[string]s
 			Haproxy:                    haproxyBundle,
 			Snow:                       snowBundle,
 			Nutanix:                    nutanixBundle,
+			KubeVipCloudProvider:       kubeVipCloudProviderBundle,
+			MetalLB:                    metalLBBundle,
+			ArgoCD:                     argoCDBundle,
 		}
 		versionsBundles = append(versionsBundles, versionsBundle)
 	}
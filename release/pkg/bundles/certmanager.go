@@ -53,17 +53,17 @@ func GetCertManagerBundle(r *releasetypes.ReleaseConfig, imageDigests map[string
 		}
 		if artifact.Manifest != nil {
 			manifestArtifact := artifact.Manifest
-			bundleManifestArtifact := anywherev1alpha1.Manifest{
-				URI: manifestArtifact.ReleaseCdnURI,
-			}
-
-			bundleManifestArtifacts[manifestArtifact.ReleaseName] = bundleManifestArtifact
-
 			manifestHash, err := version.GenerateManifestHash(r, manifestArtifact)
 			if err != nil {
 				return anywherev1alpha1.CertManagerBundle{}, err
 			}
 
+			bundleManifestArtifact := anywherev1alpha1.Manifest{
+				URI:    manifestArtifact.ReleaseCdnURI,
+				SHA256: manifestHash,
+			}
+
+			bundleManifestArtifacts[manifestArtifact.ReleaseName] = bundleManifestArtifact
 			artifactHashes = append(artifactHashes, manifestHash)
 		}
 	}
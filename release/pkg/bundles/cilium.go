@@ -25,6 +25,7 @@ import (
 	"github.com/aws/eks-anywhere/release/pkg/constants"
 	"github.com/aws/eks-anywhere/release/pkg/filereader"
 	releasetypes "github.com/aws/eks-anywhere/release/pkg/types"
+	"github.com/aws/eks-anywhere/release/pkg/version"
 )
 
 const (
@@ -67,8 +68,14 @@ func GetCiliumBundle(r *releasetypes.ReleaseConfig) (anywherev1alpha1.CiliumBund
 	for _, artifact := range artifacts {
 		if artifact.Manifest != nil {
 			manifestArtifact := artifact.Manifest
+			manifestHash, err := version.GenerateManifestHash(r, manifestArtifact)
+			if err != nil {
+				return anywherev1alpha1.CiliumBundle{}, err
+			}
+
 			bundleManifestArtifact := anywherev1alpha1.Manifest{
-				URI: manifestArtifact.ReleaseCdnURI,
+				URI:    manifestArtifact.ReleaseCdnURI,
+				SHA256: manifestHash,
 			}
 
 			bundleManifestArtifacts[manifestArtifact.ReleaseName] = bundleManifestArtifact
@@ -61,16 +61,17 @@ func GetDockerBundle(r *releasetypes.ReleaseConfig, imageDigests map[string]stri
 
 			if artifact.Manifest != nil {
 				manifestArtifact := artifact.Manifest
-				bundleManifestArtifact := anywherev1alpha1.Manifest{
-					URI: manifestArtifact.ReleaseCdnURI,
-				}
-
-				bundleManifestArtifacts[manifestArtifact.ReleaseName] = bundleManifestArtifact
-
 				manifestHash, err := version.GenerateManifestHash(r, manifestArtifact)
 				if err != nil {
 					return anywherev1alpha1.DockerBundle{}, err
 				}
+
+				bundleManifestArtifact := anywherev1alpha1.Manifest{
+					URI:    manifestArtifact.ReleaseCdnURI,
+					SHA256: manifestHash,
+				}
+
+				bundleManifestArtifacts[manifestArtifact.ReleaseName] = bundleManifestArtifact
 				artifactHashes = append(artifactHashes, manifestHash)
 			}
 		}
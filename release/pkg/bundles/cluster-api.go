@@ -68,16 +68,17 @@ func GetCoreClusterAPIBundle(r *releasetypes.ReleaseConfig, imageDigests map[str
 					continue
 				}
 
-				bundleManifestArtifact := anywherev1alpha1.Manifest{
-					URI: manifestArtifact.ReleaseCdnURI,
-				}
-				bundleManifestArtifacts[manifestArtifact.ReleaseName] = bundleManifestArtifact
-
 				manifestHash, err := version.GenerateManifestHash(r, manifestArtifact)
 				if err != nil {
 					return anywherev1alpha1.CoreClusterAPI{}, err
 				}
 
+				bundleManifestArtifact := anywherev1alpha1.Manifest{
+					URI:    manifestArtifact.ReleaseCdnURI,
+					SHA256: manifestHash,
+				}
+
+				bundleManifestArtifacts[manifestArtifact.ReleaseName] = bundleManifestArtifact
 				artifactHashes = append(artifactHashes, manifestHash)
 			}
 		}
@@ -149,16 +150,17 @@ func GetKubeadmBootstrapBundle(r *releasetypes.ReleaseConfig, imageDigests map[s
 					continue
 				}
 
-				bundleManifestArtifact := anywherev1alpha1.Manifest{
-					URI: manifestArtifact.ReleaseCdnURI,
-				}
-				bundleManifestArtifacts[manifestArtifact.ReleaseName] = bundleManifestArtifact
-
 				manifestHash, err := version.GenerateManifestHash(r, manifestArtifact)
 				if err != nil {
 					return anywherev1alpha1.KubeadmBootstrapBundle{}, err
 				}
 
+				bundleManifestArtifact := anywherev1alpha1.Manifest{
+					URI:    manifestArtifact.ReleaseCdnURI,
+					SHA256: manifestHash,
+				}
+
+				bundleManifestArtifacts[manifestArtifact.ReleaseName] = bundleManifestArtifact
 				artifactHashes = append(artifactHashes, manifestHash)
 			}
 		}
@@ -230,16 +232,17 @@ func GetKubeadmControlPlaneBundle(r *releasetypes.ReleaseConfig, imageDigests ma
 					continue
 				}
 
-				bundleManifestArtifact := anywherev1alpha1.Manifest{
-					URI: manifestArtifact.ReleaseCdnURI,
-				}
-				bundleManifestArtifacts[manifestArtifact.ReleaseName] = bundleManifestArtifact
-
 				manifestHash, err := version.GenerateManifestHash(r, manifestArtifact)
 				if err != nil {
 					return anywherev1alpha1.KubeadmControlPlaneBundle{}, err
 				}
 
+				bundleManifestArtifact := anywherev1alpha1.Manifest{
+					URI:    manifestArtifact.ReleaseCdnURI,
+					SHA256: manifestHash,
+				}
+
+				bundleManifestArtifacts[manifestArtifact.ReleaseName] = bundleManifestArtifact
 				artifactHashes = append(artifactHashes, manifestHash)
 			}
 		}
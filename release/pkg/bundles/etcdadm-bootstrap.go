@@ -61,17 +61,17 @@ func GetEtcdadmBootstrapBundle(r *releasetypes.ReleaseConfig, imageDigests map[s
 
 			if artifact.Manifest != nil {
 				manifestArtifact := artifact.Manifest
-				bundleManifestArtifact := anywherev1alpha1.Manifest{
-					URI: manifestArtifact.ReleaseCdnURI,
-				}
-
-				bundleManifestArtifacts[manifestArtifact.ReleaseName] = bundleManifestArtifact
-
 				manifestHash, err := version.GenerateManifestHash(r, manifestArtifact)
 				if err != nil {
 					return anywherev1alpha1.EtcdadmBootstrapBundle{}, err
 				}
 
+				bundleManifestArtifact := anywherev1alpha1.Manifest{
+					URI:    manifestArtifact.ReleaseCdnURI,
+					SHA256: manifestHash,
+				}
+
+				bundleManifestArtifacts[manifestArtifact.ReleaseName] = bundleManifestArtifact
 				artifactHashes = append(artifactHashes, manifestHash)
 			}
 		}
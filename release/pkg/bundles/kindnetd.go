@@ -36,17 +36,17 @@ func GetKindnetdBundle(r *releasetypes.ReleaseConfig) (anywherev1alpha1.Kindnetd
 			manifestArtifact := artifact.Manifest
 			sourceBranch = manifestArtifact.SourcedFromBranch
 
-			bundleManifestArtifact := anywherev1alpha1.Manifest{
-				URI: manifestArtifact.ReleaseCdnURI,
-			}
-
-			bundleManifestArtifacts[manifestArtifact.ReleaseName] = bundleManifestArtifact
-
 			manifestHash, err := version.GenerateManifestHash(r, manifestArtifact)
 			if err != nil {
 				return anywherev1alpha1.KindnetdBundle{}, err
 			}
 
+			bundleManifestArtifact := anywherev1alpha1.Manifest{
+				URI:    manifestArtifact.ReleaseCdnURI,
+				SHA256: manifestHash,
+			}
+
+			bundleManifestArtifacts[manifestArtifact.ReleaseName] = bundleManifestArtifact
 			artifactHashes = append(artifactHashes, manifestHash)
 		}
 	}
@@ -0,0 +1,81 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bundles
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	anywherev1alpha1 "github.com/aws/eks-anywhere/release/api/v1alpha1"
+	"github.com/aws/eks-anywhere/release/pkg/constants"
+	releasetypes "github.com/aws/eks-anywhere/release/pkg/types"
+	bundleutils "github.com/aws/eks-anywhere/release/pkg/util/bundles"
+	"github.com/aws/eks-anywhere/release/pkg/version"
+)
+
+func GetKubeVipCloudProviderBundle(r *releasetypes.ReleaseConfig, imageDigests map[string]string) (anywherev1alpha1.KubeVipCloudProviderBundle, error) {
+	kubeVipCloudProviderBundleArtifacts := map[string][]releasetypes.Artifact{
+		"kube-vip":                r.BundleArtifactsTable["kube-vip"],
+		"kube-vip-cloud-provider": r.BundleArtifactsTable["kube-vip-cloud-provider"],
+	}
+	sortedComponentNames := bundleutils.SortArtifactsMap(kubeVipCloudProviderBundleArtifacts)
+
+	var sourceBranch string
+	var componentChecksum string
+	bundleImageArtifacts := map[string]anywherev1alpha1.Image{}
+	artifactHashes := []string{}
+
+	for _, componentName := range sortedComponentNames {
+		for _, artifact := range kubeVipCloudProviderBundleArtifacts[componentName] {
+			imageArtifact := artifact.Image
+			if componentName == "kube-vip-cloud-provider" {
+				sourceBranch = imageArtifact.SourcedFromBranch
+			}
+
+			bundleImageArtifact := anywherev1alpha1.Image{
+				Name:        imageArtifact.AssetName,
+				Description: fmt.Sprintf("Container image for %s image", imageArtifact.AssetName),
+				OS:          imageArtifact.OS,
+				Arch:        imageArtifact.Arch,
+				URI:         imageArtifact.ReleaseImageURI,
+				ImageDigest: imageDigests[imageArtifact.ReleaseImageURI],
+			}
+			bundleImageArtifacts[imageArtifact.AssetName] = bundleImageArtifact
+			artifactHashes = append(artifactHashes, bundleImageArtifact.ImageDigest)
+		}
+	}
+
+	if r.DryRun {
+		componentChecksum = version.FakeComponentChecksum
+	} else {
+		componentChecksum = version.GenerateComponentHash(artifactHashes, r.DryRun)
+	}
+	version, err := version.BuildComponentVersion(
+		version.NewVersionerWithGITTAG(r.BuildRepoSource, constants.KubeVipCloudProviderProjectPath, sourceBranch, r),
+		componentChecksum,
+	)
+	if err != nil {
+		return anywherev1alpha1.KubeVipCloudProviderBundle{}, errors.Wrapf(err, "Error getting version for kube-vip-cloud-provider")
+	}
+
+	bundle := anywherev1alpha1.KubeVipCloudProviderBundle{
+		Version:       version,
+		KubeVip:       bundleImageArtifacts["kube-vip"],
+		CloudProvider: bundleImageArtifacts["kube-vip-cloud-provider"],
+	}
+
+	return bundle, nil
+}
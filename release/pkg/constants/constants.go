@@ -27,6 +27,7 @@ const (
 	YamlSeparator            = "\n---\n"
 
 	// Project paths.
+	ArgoCDProjectPath                   = "projects/argoproj/argo-cd"
 	CapasProjectPath                    = "projects/aws/cluster-api-provider-aws-snow"
 	CapcProjectPath                     = "projects/kubernetes-sigs/cluster-api-provider-cloudstack"
 	CapiProjectPath                     = "projects/kubernetes-sigs/cluster-api"
@@ -43,6 +44,8 @@ const (
 	ImageBuilderProjectPath             = "projects/kubernetes-sigs/image-builder"
 	KindProjectPath                     = "projects/kubernetes-sigs/kind"
 	KubeRbacProxyProjectPath            = "projects/brancz/kube-rbac-proxy"
+	KubeVipCloudProviderProjectPath     = "projects/kube-vip/kube-vip-cloud-provider"
+	MetalLBProjectPath                  = "projects/metallb/metallb"
 	PackagesProjectPath                 = "projects/aws/eks-anywhere-packages"
 
 	// Date format with standard reference time values